@@ -14,6 +14,7 @@ type Access interface {
 	IsAdmin() bool
 	IsSystem() bool
 	TeamNames() []string
+	TeamRoles() map[string][]string
 	CSRFToken() string
 	UserName() string
 }
@@ -7,6 +7,9 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/skymarshal/token"
 	jwt "github.com/dgrijalva/jwt-go"
 )
 
@@ -20,15 +23,17 @@ type AccessFactory interface {
 }
 
 type accessFactory struct {
-	publicKey      *rsa.PublicKey
-	rolesActionMap map[string]string
+	publicKey            *rsa.PublicKey
+	serviceAccountTokens db.ServiceAccountTokenFactory
+	rolesActionMap       map[string]string
 }
 
-func NewAccessFactory(key *rsa.PublicKey) AccessFactory {
+func NewAccessFactory(key *rsa.PublicKey, serviceAccountTokens db.ServiceAccountTokenFactory) AccessFactory {
 
 	factory := accessFactory{
-		publicKey:      key,
-		rolesActionMap: map[string]string{},
+		publicKey:            key,
+		serviceAccountTokens: serviceAccountTokens,
+		rolesActionMap:       map[string]string{},
 	}
 
 	// Copy rolesActionMap
@@ -50,6 +55,10 @@ func (a *accessFactory) Create(r *http.Request, action string) Access {
 		return &access{&jwt.Token{}, action, a}
 	}
 
+	if token.IsAPIToken(header[7:]) {
+		return &access{a.serviceAccountToken(r, action, header[7:]), action, a}
+	}
+
 	token, err := jwt.Parse(header[7:], a.validate)
 	if err != nil {
 		return &access{&jwt.Token{}, action, a}
@@ -58,6 +67,64 @@ func (a *accessFactory) Create(r *http.Request, action string) Access {
 	return &access{token, action, a}
 }
 
+// serviceAccountToken verifies the API token of a service account and
+// returns the claims a JWT for the account would carry. A token scoped to a
+// pipeline grants its role only for requests about that pipeline, i.e. on
+// routes with the pipeline in their path.
+func (a *accessFactory) serviceAccountToken(r *http.Request, action string, apiToken string) *jwt.Token {
+	if a.serviceAccountTokens == nil {
+		return &jwt.Token{}
+	}
+
+	account, found, err := a.serviceAccountTokens.UseToken(token.HashAPIToken(apiToken))
+	if err != nil || !found {
+		return &jwt.Token{}
+	}
+
+	teams := map[string][]string{}
+	if account.PipelineName == "" {
+		teams[account.TeamName] = []string{account.Role}
+	} else if pipelineName, found := routePipelineName(r, action); found && pipelineName == account.PipelineName {
+		teams[account.TeamName] = []string{account.Role}
+	}
+
+	return &jwt.Token{
+		Valid: true,
+		Claims: jwt.MapClaims{
+			"teams":     teams,
+			"user_name": account.AccountName,
+		},
+	}
+}
+
+// routePipelineName returns the pipeline named in the path of the request,
+// according to the route handling it. The ":pipeline_name" query param can't
+// be trusted for this: the router merges route params into the query, so a
+// client can set it on routes which are not about a pipeline at all.
+func routePipelineName(r *http.Request, action string) (string, bool) {
+	for _, route := range atc.Routes {
+		if route.Name != action {
+			continue
+		}
+
+		routeSegments := strings.Split(strings.Trim(route.Path, "/"), "/")
+		pathSegments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		if len(routeSegments) != len(pathSegments) {
+			return "", false
+		}
+
+		for i, segment := range routeSegments {
+			if segment == ":pipeline_name" {
+				return pathSegments[i], true
+			}
+		}
+
+		return "", false
+	}
+
+	return "", false
+}
+
 func (a *accessFactory) validate(token *jwt.Token) (interface{}, error) {
 
 	if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
@@ -4,6 +4,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"crypto/rand"
 	"crypto/rsa"
+	"errors"
 	"fmt"
 	"github.com/dgrijalva/jwt-go"
 	"net/http"
@@ -13,6 +14,9 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/skymarshal/token"
 )
 
 var _ = Describe("AccessorFactory", func() {
@@ -20,6 +24,8 @@ var _ = Describe("AccessorFactory", func() {
 	var access accessor.Access
 	var key *rsa.PrivateKey
 	var req *http.Request
+	var fakeServiceAccountTokens *dbfakes.FakeServiceAccountTokenFactory
+	var action string
 
 	Describe("Create", func() {
 		BeforeEach(func() {
@@ -31,13 +37,16 @@ var _ = Describe("AccessorFactory", func() {
 
 			publicKey := &key.PublicKey
 			//publicKey = rsa.GenerateKey(random, bits)
-			accessorFactory = accessor.NewAccessFactory(publicKey)
+			fakeServiceAccountTokens = new(dbfakes.FakeServiceAccountTokenFactory)
+			accessorFactory = accessor.NewAccessFactory(publicKey, fakeServiceAccountTokens)
 
 			req, err = http.NewRequest("GET", "localhost:8080", nil)
 			Expect(err).NotTo(HaveOccurred())
+
+			action = "some-action"
 		})
 		JustBeforeEach(func() {
-			access = accessorFactory.Create(req, "some-action")
+			access = accessorFactory.Create(req, action)
 		})
 
 		Context("when request has jwt token set", func() {
@@ -86,6 +95,120 @@ var _ = Describe("AccessorFactory", func() {
 				Expect(access).ToNot(BeNil())
 			})
 		})
+
+		Context("when request has a service account token set", func() {
+			BeforeEach(func() {
+				req.Header.Add("Authorization", "Bearer cst_some-token")
+			})
+
+			It("looks up the token by its hash", func() {
+				Expect(fakeServiceAccountTokens.UseTokenCallCount()).To(Equal(1))
+				Expect(fakeServiceAccountTokens.UseTokenArgsForCall(0)).To(Equal(token.HashAPIToken("cst_some-token")))
+			})
+
+			Context("when the token is found", func() {
+				BeforeEach(func() {
+					fakeServiceAccountTokens.UseTokenReturns(db.ServiceAccountAccess{
+						TeamName:    "some-team",
+						AccountName: "deploy-bot",
+						TokenName:   "ci",
+						Role:        "member",
+					}, true, nil)
+				})
+
+				It("authenticates as the service account with its role", func() {
+					Expect(access.IsAuthenticated()).To(BeTrue())
+					Expect(access.UserName()).To(Equal("deploy-bot"))
+					Expect(access.TeamRoles()).To(Equal(map[string][]string{"some-team": {"member"}}))
+					Expect(access.IsAdmin()).To(BeFalse())
+				})
+
+				Context("when the token is scoped to a pipeline", func() {
+					BeforeEach(func() {
+						fakeServiceAccountTokens.UseTokenReturns(db.ServiceAccountAccess{
+							TeamName:     "some-team",
+							AccountName:  "deploy-bot",
+							TokenName:    "ci",
+							Role:         "member",
+							PipelineName: "some-pipeline",
+						}, true, nil)
+					})
+
+					It("grants no roles outside of the pipeline", func() {
+						Expect(access.IsAuthenticated()).To(BeTrue())
+						Expect(access.TeamRoles()).To(BeEmpty())
+					})
+
+					Context("when the request is about the pipeline", func() {
+						BeforeEach(func() {
+							action = atc.GetPipeline
+							req.URL.Path = "/api/v1/teams/some-team/pipelines/some-pipeline"
+							req.URL.RawQuery = ":pipeline_name=some-pipeline&:team_name=some-team"
+						})
+
+						It("grants the role", func() {
+							Expect(access.TeamRoles()).To(Equal(map[string][]string{"some-team": {"member"}}))
+						})
+					})
+
+					Context("when the request is about another pipeline", func() {
+						BeforeEach(func() {
+							action = atc.GetPipeline
+							req.URL.Path = "/api/v1/teams/some-team/pipelines/other-pipeline"
+							req.URL.RawQuery = ":pipeline_name=some-pipeline&:team_name=some-team"
+						})
+
+						It("grants no roles, even with the pipeline in the query", func() {
+							Expect(access.TeamRoles()).To(BeEmpty())
+						})
+					})
+
+					Context("when the pipeline is only given in the query of a team route", func() {
+						BeforeEach(func() {
+							action = atc.ListPipelines
+							req.URL.Path = "/api/v1/teams/some-team/pipelines"
+							req.URL.RawQuery = ":team_name=some-team&:pipeline_name=some-pipeline"
+						})
+
+						It("grants no roles", func() {
+							Expect(access.TeamRoles()).To(BeEmpty())
+						})
+					})
+
+					Context("when the pipeline is only given in the query of a build route", func() {
+						BeforeEach(func() {
+							action = atc.GetBuild
+							req.URL.Path = "/api/v1/builds/42"
+							req.URL.RawQuery = ":build_id=42&:pipeline_name=some-pipeline"
+						})
+
+						It("grants no roles", func() {
+							Expect(access.TeamRoles()).To(BeEmpty())
+						})
+					})
+				})
+			})
+
+			Context("when the token is not found", func() {
+				BeforeEach(func() {
+					fakeServiceAccountTokens.UseTokenReturns(db.ServiceAccountAccess{}, false, nil)
+				})
+
+				It("is not authenticated", func() {
+					Expect(access.IsAuthenticated()).To(BeFalse())
+				})
+			})
+
+			Context("when looking up the token fails", func() {
+				BeforeEach(func() {
+					fakeServiceAccountTokens.UseTokenReturns(db.ServiceAccountAccess{}, false, errors.New("nope"))
+				})
+
+				It("is not authenticated", func() {
+					Expect(access.IsAuthenticated()).To(BeFalse())
+				})
+			})
+		})
 	})
 
 	Describe("CustomizeRolesMapping", func() {
@@ -94,7 +217,7 @@ var _ = Describe("AccessorFactory", func() {
 		)
 
 		BeforeEach(func() {
-			accessorFactory = accessor.NewAccessFactory(&rsa.PublicKey{})
+			accessorFactory = accessor.NewAccessFactory(&rsa.PublicKey{}, nil)
 		})
 
 		JustBeforeEach(func() {
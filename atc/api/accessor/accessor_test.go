@@ -36,7 +36,7 @@ var _ = Describe("Accessor", func() {
 		Expect(err).NotTo(HaveOccurred())
 
 		publicKey := &key.PublicKey
-		accessorFactory = accessor.NewAccessFactory(publicKey)
+		accessorFactory = accessor.NewAccessFactory(publicKey, nil)
 
 	})
 
@@ -458,6 +458,11 @@ var _ = Describe("Accessor", func() {
 		Entry("pipeline-operator :: "+atc.SaveConfig, atc.SaveConfig, "pipeline-operator", false),
 		Entry("viewer :: "+atc.SaveConfig, atc.SaveConfig, "viewer", false),
 
+		Entry("owner :: "+atc.DryRunConfig, atc.DryRunConfig, "owner", true),
+		Entry("member :: "+atc.DryRunConfig, atc.DryRunConfig, "member", true),
+		Entry("pipeline-operator :: "+atc.DryRunConfig, atc.DryRunConfig, "pipeline-operator", false),
+		Entry("viewer :: "+atc.DryRunConfig, atc.DryRunConfig, "viewer", false),
+
 		Entry("owner :: "+atc.GetConfig, atc.GetConfig, "owner", true),
 		Entry("member :: "+atc.GetConfig, atc.GetConfig, "member", true),
 		Entry("pipeline-operator :: "+atc.GetConfig, atc.GetConfig, "pipeline-operator", true),
@@ -503,6 +508,16 @@ var _ = Describe("Accessor", func() {
 		Entry("pipeline-operator :: "+atc.AbortBuild, atc.AbortBuild, "pipeline-operator", true),
 		Entry("viewer :: "+atc.AbortBuild, atc.AbortBuild, "viewer", false),
 
+		Entry("owner :: "+atc.ApproveBuild, atc.ApproveBuild, "owner", true),
+		Entry("member :: "+atc.ApproveBuild, atc.ApproveBuild, "member", true),
+		Entry("pipeline-operator :: "+atc.ApproveBuild, atc.ApproveBuild, "pipeline-operator", true),
+		Entry("viewer :: "+atc.ApproveBuild, atc.ApproveBuild, "viewer", false),
+
+		Entry("owner :: "+atc.RejectBuild, atc.RejectBuild, "owner", true),
+		Entry("member :: "+atc.RejectBuild, atc.RejectBuild, "member", true),
+		Entry("pipeline-operator :: "+atc.RejectBuild, atc.RejectBuild, "pipeline-operator", true),
+		Entry("viewer :: "+atc.RejectBuild, atc.RejectBuild, "viewer", false),
+
 		Entry("owner :: "+atc.GetBuildPreparation, atc.GetBuildPreparation, "owner", true),
 		Entry("member :: "+atc.GetBuildPreparation, atc.GetBuildPreparation, "member", true),
 		Entry("pipeline-operator :: "+atc.GetBuildPreparation, atc.GetBuildPreparation, "pipeline-operator", true),
@@ -538,6 +553,16 @@ var _ = Describe("Accessor", func() {
 		Entry("pipeline-operator :: "+atc.ListJobInputs, atc.ListJobInputs, "pipeline-operator", true),
 		Entry("viewer :: "+atc.ListJobInputs, atc.ListJobInputs, "viewer", true),
 
+		Entry("owner :: "+atc.ListJobTaskResourceUsage, atc.ListJobTaskResourceUsage, "owner", true),
+		Entry("member :: "+atc.ListJobTaskResourceUsage, atc.ListJobTaskResourceUsage, "member", true),
+		Entry("pipeline-operator :: "+atc.ListJobTaskResourceUsage, atc.ListJobTaskResourceUsage, "pipeline-operator", true),
+		Entry("viewer :: "+atc.ListJobTaskResourceUsage, atc.ListJobTaskResourceUsage, "viewer", true),
+
+		Entry("owner :: "+atc.ExplainJobInputs, atc.ExplainJobInputs, "owner", true),
+		Entry("member :: "+atc.ExplainJobInputs, atc.ExplainJobInputs, "member", true),
+		Entry("pipeline-operator :: "+atc.ExplainJobInputs, atc.ExplainJobInputs, "pipeline-operator", true),
+		Entry("viewer :: "+atc.ExplainJobInputs, atc.ExplainJobInputs, "viewer", true),
+
 		Entry("owner :: "+atc.GetJobBuild, atc.GetJobBuild, "owner", true),
 		Entry("member :: "+atc.GetJobBuild, atc.GetJobBuild, "member", true),
 		Entry("pipeline-operator :: "+atc.GetJobBuild, atc.GetJobBuild, "pipeline-operator", true),
@@ -628,6 +653,16 @@ var _ = Describe("Accessor", func() {
 		Entry("pipeline-operator :: "+atc.DisableResourceVersion, atc.DisableResourceVersion, "pipeline-operator", true),
 		Entry("viewer :: "+atc.DisableResourceVersion, atc.DisableResourceVersion, "viewer", false),
 
+		Entry("owner :: "+atc.AddResourceVersionLabel, atc.AddResourceVersionLabel, "owner", true),
+		Entry("member :: "+atc.AddResourceVersionLabel, atc.AddResourceVersionLabel, "member", true),
+		Entry("pipeline-operator :: "+atc.AddResourceVersionLabel, atc.AddResourceVersionLabel, "pipeline-operator", true),
+		Entry("viewer :: "+atc.AddResourceVersionLabel, atc.AddResourceVersionLabel, "viewer", false),
+
+		Entry("owner :: "+atc.RemoveResourceVersionLabel, atc.RemoveResourceVersionLabel, "owner", true),
+		Entry("member :: "+atc.RemoveResourceVersionLabel, atc.RemoveResourceVersionLabel, "member", true),
+		Entry("pipeline-operator :: "+atc.RemoveResourceVersionLabel, atc.RemoveResourceVersionLabel, "pipeline-operator", true),
+		Entry("viewer :: "+atc.RemoveResourceVersionLabel, atc.RemoveResourceVersionLabel, "viewer", false),
+
 		Entry("owner :: "+atc.ListBuildsWithVersionAsInput, atc.ListBuildsWithVersionAsInput, "owner", true),
 		Entry("member :: "+atc.ListBuildsWithVersionAsInput, atc.ListBuildsWithVersionAsInput, "member", true),
 		Entry("pipeline-operator :: "+atc.ListBuildsWithVersionAsInput, atc.ListBuildsWithVersionAsInput, "pipeline-operator", true),
@@ -678,6 +713,16 @@ var _ = Describe("Accessor", func() {
 		Entry("pipeline-operator :: "+atc.UnpausePipeline, atc.UnpausePipeline, "pipeline-operator", true),
 		Entry("viewer :: "+atc.UnpausePipeline, atc.UnpausePipeline, "viewer", false),
 
+		Entry("owner :: "+atc.FreezePipeline, atc.FreezePipeline, "owner", true),
+		Entry("member :: "+atc.FreezePipeline, atc.FreezePipeline, "member", true),
+		Entry("pipeline-operator :: "+atc.FreezePipeline, atc.FreezePipeline, "pipeline-operator", true),
+		Entry("viewer :: "+atc.FreezePipeline, atc.FreezePipeline, "viewer", false),
+
+		Entry("owner :: "+atc.UnfreezePipeline, atc.UnfreezePipeline, "owner", true),
+		Entry("member :: "+atc.UnfreezePipeline, atc.UnfreezePipeline, "member", true),
+		Entry("pipeline-operator :: "+atc.UnfreezePipeline, atc.UnfreezePipeline, "pipeline-operator", true),
+		Entry("viewer :: "+atc.UnfreezePipeline, atc.UnfreezePipeline, "viewer", false),
+
 		Entry("owner :: "+atc.ExposePipeline, atc.ExposePipeline, "owner", true),
 		Entry("member :: "+atc.ExposePipeline, atc.ExposePipeline, "member", true),
 		Entry("pipeline-operator :: "+atc.ExposePipeline, atc.ExposePipeline, "pipeline-operator", false),
@@ -798,6 +843,46 @@ var _ = Describe("Accessor", func() {
 		Entry("pipeline-operator :: "+atc.ListVolumes, atc.ListVolumes, "pipeline-operator", true),
 		Entry("viewer :: "+atc.ListVolumes, atc.ListVolumes, "viewer", true),
 
+		Entry("owner :: "+atc.ListLocks, atc.ListLocks, "owner", true),
+		Entry("member :: "+atc.ListLocks, atc.ListLocks, "member", true),
+		Entry("pipeline-operator :: "+atc.ListLocks, atc.ListLocks, "pipeline-operator", true),
+		Entry("viewer :: "+atc.ListLocks, atc.ListLocks, "viewer", true),
+
+		Entry("owner :: "+atc.ReleaseLock, atc.ReleaseLock, "owner", true),
+		Entry("member :: "+atc.ReleaseLock, atc.ReleaseLock, "member", false),
+		Entry("pipeline-operator :: "+atc.ReleaseLock, atc.ReleaseLock, "pipeline-operator", false),
+		Entry("viewer :: "+atc.ReleaseLock, atc.ReleaseLock, "viewer", false),
+
+		Entry("owner :: "+atc.ListTeamQueue, atc.ListTeamQueue, "owner", true),
+		Entry("member :: "+atc.ListTeamQueue, atc.ListTeamQueue, "member", true),
+		Entry("pipeline-operator :: "+atc.ListTeamQueue, atc.ListTeamQueue, "pipeline-operator", true),
+		Entry("viewer :: "+atc.ListTeamQueue, atc.ListTeamQueue, "viewer", true),
+
+		Entry("owner :: "+atc.ListServiceAccounts, atc.ListServiceAccounts, "owner", true),
+		Entry("member :: "+atc.ListServiceAccounts, atc.ListServiceAccounts, "member", false),
+		Entry("pipeline-operator :: "+atc.ListServiceAccounts, atc.ListServiceAccounts, "pipeline-operator", false),
+		Entry("viewer :: "+atc.ListServiceAccounts, atc.ListServiceAccounts, "viewer", false),
+
+		Entry("owner :: "+atc.CreateServiceAccount, atc.CreateServiceAccount, "owner", true),
+		Entry("member :: "+atc.CreateServiceAccount, atc.CreateServiceAccount, "member", false),
+		Entry("pipeline-operator :: "+atc.CreateServiceAccount, atc.CreateServiceAccount, "pipeline-operator", false),
+		Entry("viewer :: "+atc.CreateServiceAccount, atc.CreateServiceAccount, "viewer", false),
+
+		Entry("owner :: "+atc.DestroyServiceAccount, atc.DestroyServiceAccount, "owner", true),
+		Entry("member :: "+atc.DestroyServiceAccount, atc.DestroyServiceAccount, "member", false),
+		Entry("pipeline-operator :: "+atc.DestroyServiceAccount, atc.DestroyServiceAccount, "pipeline-operator", false),
+		Entry("viewer :: "+atc.DestroyServiceAccount, atc.DestroyServiceAccount, "viewer", false),
+
+		Entry("owner :: "+atc.CreateServiceAccountToken, atc.CreateServiceAccountToken, "owner", true),
+		Entry("member :: "+atc.CreateServiceAccountToken, atc.CreateServiceAccountToken, "member", false),
+		Entry("pipeline-operator :: "+atc.CreateServiceAccountToken, atc.CreateServiceAccountToken, "pipeline-operator", false),
+		Entry("viewer :: "+atc.CreateServiceAccountToken, atc.CreateServiceAccountToken, "viewer", false),
+
+		Entry("owner :: "+atc.RevokeServiceAccountToken, atc.RevokeServiceAccountToken, "owner", true),
+		Entry("member :: "+atc.RevokeServiceAccountToken, atc.RevokeServiceAccountToken, "member", false),
+		Entry("pipeline-operator :: "+atc.RevokeServiceAccountToken, atc.RevokeServiceAccountToken, "pipeline-operator", false),
+		Entry("viewer :: "+atc.RevokeServiceAccountToken, atc.RevokeServiceAccountToken, "viewer", false),
+
 		Entry("owner :: "+atc.ListDestroyingVolumes, atc.ListDestroyingVolumes, "owner", true),
 		Entry("member :: "+atc.ListDestroyingVolumes, atc.ListDestroyingVolumes, "member", true),
 		Entry("pipeline-operator :: "+atc.ListDestroyingVolumes, atc.ListDestroyingVolumes, "pipeline-operator", true),
@@ -847,6 +932,11 @@ var _ = Describe("Accessor", func() {
 		Entry("pipeline-operator :: "+atc.GetArtifact, atc.GetArtifact, "pipeline-operator", false),
 		Entry("viewer :: "+atc.GetArtifact, atc.GetArtifact, "viewer", false),
 
+		Entry("owner :: "+atc.DiffArtifact, atc.DiffArtifact, "owner", true),
+		Entry("member :: "+atc.DiffArtifact, atc.DiffArtifact, "member", true),
+		Entry("pipeline-operator :: "+atc.DiffArtifact, atc.DiffArtifact, "pipeline-operator", false),
+		Entry("viewer :: "+atc.DiffArtifact, atc.DiffArtifact, "viewer", false),
+
 		Entry("owner :: "+atc.ListBuildArtifacts, atc.ListBuildArtifacts, "owner", true),
 		Entry("member :: "+atc.ListBuildArtifacts, atc.ListBuildArtifacts, "member", true),
 		Entry("pipeline-operator :: "+atc.ListBuildArtifacts, atc.ListBuildArtifacts, "pipeline-operator", true),
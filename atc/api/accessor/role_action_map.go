@@ -11,6 +11,7 @@ import (
 // requiredRoles should be a const, never be updated.
 var requiredRoles = map[string]string{
 	atc.SaveConfig:                    "member",
+	atc.DryRunConfig:                  "member",
 	atc.GetConfig:                     "viewer",
 	atc.GetCC:                         "viewer",
 	atc.GetBuild:                      "viewer",
@@ -21,6 +22,8 @@ var requiredRoles = map[string]string{
 	atc.BuildEvents:                   "viewer",
 	atc.BuildResources:                "viewer",
 	atc.AbortBuild:                    "pipeline-operator",
+	atc.ApproveBuild:                  "pipeline-operator",
+	atc.RejectBuild:                   "pipeline-operator",
 	atc.GetBuildPreparation:           "viewer",
 	atc.GetJob:                        "viewer",
 	atc.CreateJobBuild:                "pipeline-operator",
@@ -34,6 +37,8 @@ var requiredRoles = map[string]string{
 	atc.GetVersionsDB:                 "viewer",
 	atc.JobBadge:                      "viewer",
 	atc.MainJobBadge:                  "viewer",
+	atc.ListJobTaskResourceUsage:      "viewer",
+	atc.ExplainJobInputs:              "viewer",
 	atc.ClearTaskCache:                "pipeline-operator",
 	atc.ListAllResources:              "viewer",
 	atc.ListResources:                 "viewer",
@@ -49,6 +54,8 @@ var requiredRoles = map[string]string{
 	atc.EnableResourceVersion:         "pipeline-operator",
 	atc.DisableResourceVersion:        "pipeline-operator",
 	atc.PinResourceVersion:            "pipeline-operator",
+	atc.AddResourceVersionLabel:       "pipeline-operator",
+	atc.RemoveResourceVersionLabel:    "pipeline-operator",
 	atc.ListBuildsWithVersionAsInput:  "viewer",
 	atc.ListBuildsWithVersionAsOutput: "viewer",
 	atc.GetResourceCausality:          "viewer",
@@ -59,6 +66,8 @@ var requiredRoles = map[string]string{
 	atc.OrderPipelines:                "member",
 	atc.PausePipeline:                 "pipeline-operator",
 	atc.UnpausePipeline:               "pipeline-operator",
+	atc.FreezePipeline:                "pipeline-operator",
+	atc.UnfreezePipeline:              "pipeline-operator",
 	atc.ExposePipeline:                "member",
 	atc.HidePipeline:                  "member",
 	atc.RenamePipeline:                "member",
@@ -83,6 +92,14 @@ var requiredRoles = map[string]string{
 	atc.ListDestroyingContainers:      "viewer",
 	atc.ReportWorkerContainers:        "member",
 	atc.ListVolumes:                   "viewer",
+	atc.ListLocks:                     "viewer",
+	atc.ReleaseLock:                   "owner",
+	atc.ListTeamQueue:                 "viewer",
+	atc.ListServiceAccounts:           "owner",
+	atc.CreateServiceAccount:          "owner",
+	atc.DestroyServiceAccount:         "owner",
+	atc.CreateServiceAccountToken:     "owner",
+	atc.RevokeServiceAccountToken:     "owner",
 	atc.ListDestroyingVolumes:         "viewer",
 	atc.ReportWorkerVolumes:           "member",
 	atc.ListTeams:                     "viewer",
@@ -93,6 +110,7 @@ var requiredRoles = map[string]string{
 	atc.ListTeamBuilds:                "viewer",
 	atc.CreateArtifact:                "member",
 	atc.GetArtifact:                   "member",
+	atc.DiffArtifact:                  "member",
 	atc.ListBuildArtifacts:            "viewer",
 }
 
@@ -15,6 +15,7 @@ import (
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/api/containerserver/containerserverfakes"
+	"github.com/concourse/concourse/atc/api/jobserver/jobserverfakes"
 	"github.com/concourse/concourse/atc/auditor/auditorfakes"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/credsfakes"
@@ -57,6 +58,7 @@ var (
 	credsManagers           creds.Managers
 	interceptTimeoutFactory *containerserverfakes.FakeInterceptTimeoutFactory
 	interceptTimeout        *containerserverfakes.FakeInterceptTimeout
+	fakeInputExplainer      *jobserverfakes.FakeInputExplainer
 	expire                  time.Duration
 	isTLSEnabled            bool
 	cliDownloadsDir         string
@@ -102,6 +104,8 @@ var _ = BeforeEach(func() {
 	interceptTimeout = new(containerserverfakes.FakeInterceptTimeout)
 	interceptTimeoutFactory.NewInterceptTimeoutReturns(interceptTimeout)
 
+	fakeInputExplainer = new(jobserverfakes.FakeInputExplainer)
+
 	dbTeam = new(dbfakes.FakeTeam)
 	dbTeam.IDReturns(734)
 	dbTeamFactory.FindTeamReturns(dbTeam, true, nil)
@@ -192,6 +196,7 @@ var _ = BeforeEach(func() {
 		fakeVarSourcePool,
 		credsManagers,
 		interceptTimeoutFactory,
+		fakeInputExplainer,
 	)
 
 	Expect(err).NotTo(HaveOccurred())
@@ -1,8 +1,10 @@
 package api_test
 
 import (
+	"archive/tar"
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"io"
 	"io/ioutil"
@@ -10,7 +12,9 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/DataDog/zstd"
 	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
@@ -358,4 +362,331 @@ var _ = Describe("Artifacts API", func() {
 			})
 		})
 	})
+
+	Describe("POST /api/v1/teams/:team_name/artifacts/:artifact_id/diff", func() {
+		var response *http.Response
+
+		BeforeEach(func() {
+			fakeaccess.IsAuthenticatedReturns(true)
+			fakeaccess.IsAuthorizedReturns(true)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = http.Post(
+				server.URL+"/api/v1/teams/some-team/artifacts/18/diff",
+				"application/json",
+				bytes.NewBufferString(`{
+					"some-file": {"mode": 420, "digest": "some-digest"},
+					"changed-file": {"mode": 420, "digest": "new-digest"}
+				}`),
+			)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the artifact is not found", func() {
+			BeforeEach(func() {
+				dbTeam.FindWorkerArtifactReturns(nil, false, nil)
+			})
+
+			It("returns 410", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusGone))
+			})
+		})
+
+		Context("when the artifact is found", func() {
+			var fakeArtifact *dbfakes.FakeWorkerArtifact
+
+			BeforeEach(func() {
+				fakeArtifact = new(dbfakes.FakeWorkerArtifact)
+				dbTeam.FindWorkerArtifactReturns(fakeArtifact, true, nil)
+			})
+
+			Context("when it has no manifest", func() {
+				BeforeEach(func() {
+					fakeArtifact.ManifestReturns(nil, false, nil)
+				})
+
+				It("returns 410", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusGone))
+				})
+			})
+
+			Context("when it has a manifest and its volume is still around", func() {
+				BeforeEach(func() {
+					fakeArtifact.ManifestReturns(atc.ArtifactManifest{
+						"some-file":    {Mode: 0644, Digest: "some-digest"},
+						"changed-file": {Mode: 0644, Digest: "old-digest"},
+					}, true, nil)
+
+					fakeVolume := new(dbfakes.FakeCreatedVolume)
+					fakeVolume.HandleReturns("some-handle")
+					dbTeam.FindVolumeForWorkerArtifactReturns(fakeVolume, true, nil)
+
+					fakeWorkerClient.FindVolumeReturns(new(workerfakes.FakeVolume), true, nil)
+				})
+
+				It("looks up the artifact by id", func() {
+					Expect(dbTeam.FindWorkerArtifactArgsForCall(0)).To(Equal(18))
+				})
+
+				It("returns the entries missing from the artifact", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`{"missing":["changed-file"]}`))
+				})
+			})
+
+			Context("when it is at the end of too long a chain of artifacts", func() {
+				BeforeEach(func() {
+					fakeArtifact.GenerationReturns(10)
+					fakeArtifact.ManifestReturns(atc.ArtifactManifest{}, true, nil)
+				})
+
+				It("returns 410 so that everything is uploaded again", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusGone))
+				})
+			})
+
+			Context("when the manifest removes entries of the artifact", func() {
+				BeforeEach(func() {
+					fakeArtifact.ManifestReturns(atc.ArtifactManifest{
+						"some-file":    {Mode: 0644, Digest: "some-digest"},
+						"removed-file": {Mode: 0644, Digest: "removed-digest"},
+					}, true, nil)
+
+					dbTeam.FindVolumeForWorkerArtifactReturns(new(dbfakes.FakeCreatedVolume), true, nil)
+
+					fakeWorkerClient.FindVolumeReturns(new(workerfakes.FakeVolume), true, nil)
+				})
+
+				It("returns 410", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusGone))
+				})
+			})
+
+			Context("when its volume is gone", func() {
+				BeforeEach(func() {
+					fakeArtifact.ManifestReturns(atc.ArtifactManifest{}, true, nil)
+
+					fakeVolume := new(dbfakes.FakeCreatedVolume)
+					dbTeam.FindVolumeForWorkerArtifactReturns(fakeVolume, true, nil)
+
+					fakeWorkerClient.FindVolumeReturns(nil, false, nil)
+				})
+
+				It("returns 410", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusGone))
+				})
+			})
+		})
+	})
+
+	Describe("POST /api/v1/teams/:team_name/artifacts with a manifest", func() {
+		var (
+			query    url.Values
+			response *http.Response
+
+			fakeVolume   *workerfakes.FakeVolume
+			fakeArtifact *dbfakes.FakeWorkerArtifact
+			streamedIn   map[string]string
+
+			manifest atc.ArtifactManifest
+		)
+
+		BeforeEach(func() {
+			fakeaccess.IsAuthenticatedReturns(true)
+			fakeaccess.IsAuthorizedReturns(true)
+
+			query = url.Values{"platform": {"some-platform"}}
+
+			manifest = atc.ArtifactManifest{
+				"kept-file":    {Mode: 0644, Digest: "kept-digest"},
+				"changed-file": {Mode: 0644, Digest: "new-digest"},
+			}
+
+			fakeArtifact = new(dbfakes.FakeWorkerArtifact)
+			fakeArtifact.IDReturns(19)
+
+			fakeVolume = new(workerfakes.FakeVolume)
+			fakeVolume.InitializeArtifactReturns(fakeArtifact, nil)
+			fakeVolume.StreamInStub = func(ctx context.Context, path string, body io.Reader) error {
+				streamedIn = readZstdTar(body)
+				return nil
+			}
+
+			fakeWorkerClient.CreateVolumeReturns(fakeVolume, nil)
+		})
+
+		JustBeforeEach(func() {
+			body := new(bytes.Buffer)
+			Expect(json.NewEncoder(body).Encode(manifest)).To(Succeed())
+
+			_, err := body.Write(zstdTar(map[string]string{"changed-file": "new-contents"}))
+			Expect(err).NotTo(HaveOccurred())
+
+			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/artifacts?"+query.Encode(), body)
+			Expect(err).NotTo(HaveOccurred())
+
+			request.Header.Set("Content-Type", atc.IncrementalArtifactContentType)
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("streams in the uploaded contents and saves the manifest", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+			Expect(streamedIn).To(Equal(map[string]string{"changed-file": "new-contents"}))
+
+			Expect(fakeArtifact.SetManifestCallCount()).To(Equal(1))
+			savedManifest, generation := fakeArtifact.SetManifestArgsForCall(0)
+			Expect(savedManifest).To(Equal(manifest))
+			Expect(generation).To(Equal(0))
+		})
+
+		Context("with a base artifact", func() {
+			var (
+				fakeBaseArtifact *dbfakes.FakeWorkerArtifact
+				fakeBaseVolume   *workerfakes.FakeVolume
+				fakeChildVolume  *workerfakes.FakeVolume
+			)
+
+			BeforeEach(func() {
+				query.Set("base", "18")
+
+				fakeBaseArtifact = new(dbfakes.FakeWorkerArtifact)
+				fakeBaseArtifact.GenerationReturns(2)
+				fakeBaseArtifact.ManifestReturns(atc.ArtifactManifest{
+					"kept-file":    {Mode: 0644, Digest: "kept-digest"},
+					"changed-file": {Mode: 0644, Digest: "old-digest"},
+				}, true, nil)
+				dbTeam.FindWorkerArtifactReturns(fakeBaseArtifact, true, nil)
+
+				dbTeam.FindVolumeForWorkerArtifactReturns(new(dbfakes.FakeCreatedVolume), true, nil)
+
+				fakeBaseVolume = new(workerfakes.FakeVolume)
+				fakeWorkerClient.FindVolumeReturns(fakeBaseVolume, true, nil)
+
+				fakeChildVolume = new(workerfakes.FakeVolume)
+				fakeChildVolume.InitializeArtifactReturns(fakeArtifact, nil)
+				fakeChildVolume.StreamInStub = fakeVolume.StreamInStub
+				fakeWorkerClient.CreateChildVolumeForArtifactReturns(fakeChildVolume, true, nil)
+			})
+
+			It("streams the uploaded entries into a copy-on-write volume of the base artifact", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+				Expect(dbTeam.FindWorkerArtifactArgsForCall(0)).To(Equal(18))
+
+				Expect(fakeWorkerClient.CreateChildVolumeForArtifactCallCount()).To(Equal(1))
+				_, teamID, parent := fakeWorkerClient.CreateChildVolumeForArtifactArgsForCall(0)
+				Expect(teamID).To(Equal(734))
+				Expect(parent).To(Equal(fakeBaseVolume))
+
+				Expect(fakeWorkerClient.CreateVolumeCallCount()).To(BeZero())
+				Expect(fakeBaseVolume.StreamOutCallCount()).To(BeZero())
+
+				Expect(streamedIn).To(Equal(map[string]string{"changed-file": "new-contents"}))
+
+				Expect(fakeArtifact.SetManifestCallCount()).To(Equal(1))
+				savedManifest, generation := fakeArtifact.SetManifestArgsForCall(0)
+				Expect(savedManifest).To(Equal(manifest))
+				Expect(generation).To(Equal(3))
+			})
+
+			Context("when the base artifact is at the end of too long a chain", func() {
+				BeforeEach(func() {
+					fakeBaseArtifact.GenerationReturns(10)
+				})
+
+				It("returns 410 without creating a volume", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusGone))
+					Expect(fakeWorkerClient.CreateVolumeCallCount()).To(BeZero())
+					Expect(fakeWorkerClient.CreateChildVolumeForArtifactCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the base artifact is gone", func() {
+				BeforeEach(func() {
+					dbTeam.FindWorkerArtifactReturns(nil, false, nil)
+				})
+
+				It("returns 410 without creating a volume", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusGone))
+					Expect(fakeWorkerClient.CreateVolumeCallCount()).To(BeZero())
+					Expect(fakeWorkerClient.CreateChildVolumeForArtifactCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the manifest removes entries of the base artifact", func() {
+				BeforeEach(func() {
+					delete(manifest, "kept-file")
+				})
+
+				It("returns 410 without creating a volume", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusGone))
+					Expect(fakeWorkerClient.CreateChildVolumeForArtifactCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the base artifact's worker is gone", func() {
+				BeforeEach(func() {
+					fakeWorkerClient.CreateChildVolumeForArtifactReturns(nil, false, nil)
+				})
+
+				It("returns 410", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusGone))
+				})
+			})
+		})
+	})
 })
+
+func zstdTar(files map[string]string) []byte {
+	buf := new(bytes.Buffer)
+
+	zstdWriter := zstd.NewWriter(buf)
+	tarWriter := tar.NewWriter(zstdWriter)
+
+	for name, contents := range files {
+		err := tarWriter.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(contents)),
+			Typeflag: tar.TypeReg,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = tarWriter.Write([]byte(contents))
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	Expect(tarWriter.Close()).To(Succeed())
+	Expect(zstdWriter.Close()).To(Succeed())
+
+	return buf.Bytes()
+}
+
+func readZstdTar(src io.Reader) map[string]string {
+	files := map[string]string{}
+
+	tarReader := tar.NewReader(zstd.NewReader(src))
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+
+		Expect(err).NotTo(HaveOccurred())
+
+		contents, err := ioutil.ReadAll(tarReader)
+		Expect(err).NotTo(HaveOccurred())
+
+		files[header.Name] = string(contents)
+	}
+
+	return files
+}
@@ -1,10 +1,15 @@
 package artifactserver
 
 import (
+	"bufio"
 	"encoding/json"
+	"io"
 	"net/http"
+	"strconv"
+	"unicode"
 
 	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/worker"
@@ -16,24 +21,77 @@ func (s *Server) CreateArtifact(team db.Team) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 
-		// TODO: can probably check if fly sent us an etag header
-		// which we can lookup in the checksum field
-		// that way we don't have to create another volume.
-
-		workerSpec := worker.WorkerSpec{
-			TeamID:   team.ID(),
-			Platform: r.FormValue("platform"),
-		}
-
-		volumeSpec := worker.VolumeSpec{
-			Strategy: baggageclaim.EmptyStrategy{},
+		var manifest atc.ArtifactManifest
+		var contents io.Reader = r.Body
+		var base *baseArtifact
+
+		// incremental uploads send the artifact's manifest followed by only the
+		// entries missing from the base artifact
+		if r.Header.Get("Content-Type") == atc.IncrementalArtifactContentType {
+			var err error
+			manifest, contents, err = readIncrementalUpload(r.Body)
+			if err != nil {
+				hLog.Error("failed-to-read-manifest", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			if baseID := r.FormValue("base"); baseID != "" {
+				artifactID, err := strconv.Atoi(baseID)
+				if err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+
+				previous, found, err := s.findBaseArtifact(hLog, team, artifactID)
+				if err != nil {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+
+				if !found || manifest.Removes(previous.manifest) {
+					w.WriteHeader(http.StatusGone)
+					return
+				}
+
+				base = &previous
+			}
 		}
 
-		volume, err := s.workerClient.CreateVolume(hLog, volumeSpec, workerSpec, db.VolumeTypeArtifact)
-		if err != nil {
-			hLog.Error("failed-to-create-volume", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
+		var volume worker.Volume
+		if base != nil {
+			// the upload only has the changed entries, which are written on
+			// top of a copy-on-write volume of the base artifact
+			child, found, err := s.workerClient.CreateChildVolumeForArtifact(hLog, team.ID(), base.volume)
+			if err != nil {
+				hLog.Error("failed-to-create-child-volume", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			if !found {
+				w.WriteHeader(http.StatusGone)
+				return
+			}
+
+			volume = child
+		} else {
+			workerSpec := worker.WorkerSpec{
+				TeamID:   team.ID(),
+				Platform: r.FormValue("platform"),
+			}
+
+			volumeSpec := worker.VolumeSpec{
+				Strategy: baggageclaim.EmptyStrategy{},
+			}
+
+			var err error
+			volume, err = s.workerClient.CreateVolume(hLog, volumeSpec, workerSpec, db.VolumeTypeArtifact)
+			if err != nil {
+				hLog.Error("failed-to-create-volume", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
 		}
 
 		// NOTE: there's a race condition here between when the
@@ -59,15 +117,53 @@ func (s *Server) CreateArtifact(team db.Team) http.Handler {
 			return
 		}
 
-		err = volume.StreamIn(r.Context(), "/", r.Body)
+		err = volume.StreamIn(r.Context(), "/", contents)
 		if err != nil {
 			hLog.Error("failed-to-stream-volume-contents", err)
 			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
 
+		if manifest != nil {
+			generation := 0
+			if base != nil {
+				generation = base.generation + 1
+			}
+
+			err = artifact.SetManifest(manifest, generation)
+			if err != nil {
+				hLog.Error("failed-to-save-artifact-manifest", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+
 		w.WriteHeader(http.StatusCreated)
 
 		json.NewEncoder(w).Encode(present.WorkerArtifact(artifact))
 	})
 }
+
+func readIncrementalUpload(body io.Reader) (atc.ArtifactManifest, io.Reader, error) {
+	decoder := json.NewDecoder(body)
+
+	var manifest atc.ArtifactManifest
+	err := decoder.Decode(&manifest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contents := bufio.NewReader(io.MultiReader(decoder.Buffered(), body))
+
+	// skip any whitespace trailing the manifest
+	for {
+		b, err := contents.Peek(1)
+		if err != nil || !unicode.IsSpace(rune(b[0])) {
+			break
+		}
+
+		_, _ = contents.ReadByte()
+	}
+
+	return manifest, contents, nil
+}
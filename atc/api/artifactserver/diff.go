@@ -0,0 +1,116 @@
+package artifactserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+func (s *Server) DiffArtifact(team db.Team) http.Handler {
+	logger := s.logger.Session("diff-artifact")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		artifactID, err := strconv.Atoi(r.FormValue(":artifact_id"))
+		if err != nil {
+			logger.Error("failed-to-get-artifact-id", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var manifest atc.ArtifactManifest
+		err = json.NewDecoder(r.Body).Decode(&manifest)
+		if err != nil {
+			logger.Error("failed-to-decode-manifest", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		base, found, err := s.findBaseArtifact(logger, team, artifactID)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found || manifest.Removes(base.manifest) {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+
+		err = json.NewEncoder(w).Encode(atc.ArtifactDiff{
+			Missing: manifest.Missing(base.manifest),
+		})
+		if err != nil {
+			logger.Error("failed-to-encode-diff", err)
+		}
+	})
+}
+
+// maxArtifactGeneration limits how many artifacts can be assembled on top of
+// each other. Every generation adds a layer to the copy-on-write volume, which
+// some volume drivers can only stack so high, and keeps the whole chain from
+// being garbage collected; past this the client uploads everything again.
+const maxArtifactGeneration = 10
+
+type baseArtifact struct {
+	manifest   atc.ArtifactManifest
+	volume     worker.Volume
+	generation int
+}
+
+// findBaseArtifact finds an artifact that a new one can be assembled from,
+// i.e. one that has a manifest, whose volume is still around and which is not
+// already at the end of too long a chain of artifacts.
+func (s *Server) findBaseArtifact(logger lager.Logger, team db.Team, artifactID int) (baseArtifact, bool, error) {
+	artifact, found, err := team.FindWorkerArtifact(artifactID)
+	if err != nil {
+		logger.Error("failed-to-find-artifact", err)
+		return baseArtifact{}, false, err
+	}
+
+	if !found || artifact.Generation() >= maxArtifactGeneration {
+		return baseArtifact{}, false, nil
+	}
+
+	manifest, found, err := artifact.Manifest()
+	if err != nil {
+		logger.Error("failed-to-get-artifact-manifest", err)
+		return baseArtifact{}, false, err
+	}
+
+	if !found {
+		return baseArtifact{}, false, nil
+	}
+
+	artifactVolume, found, err := team.FindVolumeForWorkerArtifact(artifactID)
+	if err != nil {
+		logger.Error("failed-to-get-artifact-volume", err)
+		return baseArtifact{}, false, err
+	}
+
+	if !found {
+		return baseArtifact{}, false, nil
+	}
+
+	volume, found, err := s.workerClient.FindVolume(logger, team.ID(), artifactVolume.Handle())
+	if err != nil {
+		logger.Error("failed-to-get-worker-volume", err)
+		return baseArtifact{}, false, err
+	}
+
+	if !found {
+		return baseArtifact{}, false, nil
+	}
+
+	return baseArtifact{
+		manifest:   manifest,
+		volume:     volume,
+		generation: artifact.Generation(),
+	}, true, nil
+}
@@ -105,6 +105,7 @@ var _ = Describe("Builds API", func() {
 						fakeBuild.ReapTimeReturns(time.Unix(200, 0))
 
 						dbTeam.CreateStartedBuildReturns(fakeBuild, nil)
+						fakeAccess.UserNameReturns("some-user")
 					})
 
 					It("returns 201 Created", func() {
@@ -115,9 +116,11 @@ var _ = Describe("Builds API", func() {
 						Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
 					})
 
-					It("creates a started build", func() {
+					It("creates a started build on behalf of the user", func() {
 						Expect(dbTeam.CreateStartedBuildCallCount()).To(Equal(1))
-						Expect(dbTeam.CreateStartedBuildArgsForCall(0)).To(Equal(plan))
+						actualPlan, createdBy := dbTeam.CreateStartedBuildArgsForCall(0)
+						Expect(actualPlan).To(Equal(plan))
+						Expect(createdBy).To(Equal("some-user"))
 					})
 
 					It("returns the created build", func() {
@@ -585,6 +588,20 @@ var _ = Describe("Builds API", func() {
 						"reap_time": 200
 					}`))
 						})
+
+						Context("when a container of the build is being kept for debugging", func() {
+							BeforeEach(func() {
+								build.DebugUntilReturns(time.Unix(300, 0))
+							})
+
+							It("returns until when", func() {
+								var returned atc.Build
+								err := json.NewDecoder(response.Body).Decode(&returned)
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(returned.DebugUntil).To(Equal(int64(300)))
+							})
+						})
 					})
 				})
 			})
@@ -1056,6 +1073,167 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/builds/:build_id/approve", func() {
+		var (
+			response *http.Response
+			fakeJob  *dbfakes.FakeJob
+		)
+
+		BeforeEach(func() {
+			fakeJob = new(dbfakes.FakeJob)
+			fakeJob.ConfigReturns(atc.JobConfig{
+				Name:             "some-job",
+				RequiresApproval: &atc.ApprovalConfig{Roles: []string{"owner"}},
+			})
+			fakePipeline.JobReturns(fakeJob, true, nil)
+
+			build.TeamNameReturns("some-team")
+			build.JobNameReturns("some-job")
+			build.PipelineReturns(fakePipeline, true, nil)
+			dbBuildFactory.BuildReturns(build, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/builds/128/approve", bytes.NewBufferString(`{"comment":"ship it"}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				fakeAccess.UserNameReturns("some-user")
+			})
+
+			Context("when the user does not have a role allowed to approve", func() {
+				BeforeEach(func() {
+					fakeAccess.TeamRolesReturns(map[string][]string{"some-team": {"member"}})
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+
+				It("does not approve the build", func() {
+					Expect(build.ApproveCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the user has a role allowed to approve", func() {
+				BeforeEach(func() {
+					fakeAccess.TeamRolesReturns(map[string][]string{"some-team": {"owner"}})
+				})
+
+				Context("when the build is awaiting approval", func() {
+					BeforeEach(func() {
+						build.ApproveReturns(true, nil)
+					})
+
+					It("returns 204", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+					})
+
+					It("records the approver and comment", func() {
+						Expect(build.ApproveCallCount()).To(Equal(1))
+						approver, comment := build.ApproveArgsForCall(0)
+						Expect(approver).To(Equal("some-user"))
+						Expect(comment).To(Equal("ship it"))
+					})
+				})
+
+				Context("when the build is not awaiting approval", func() {
+					BeforeEach(func() {
+						build.ApproveReturns(false, nil)
+					})
+
+					It("returns 409", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					})
+				})
+
+				Context("when approving the build fails", func() {
+					BeforeEach(func() {
+						build.ApproveReturns(false, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
+				Context("when the job does not require approval", func() {
+					BeforeEach(func() {
+						fakeJob.ConfigReturns(atc.JobConfig{Name: "some-job"})
+					})
+
+					It("returns 409", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/builds/:build_id/reject", func() {
+		var response *http.Response
+
+		BeforeEach(func() {
+			fakeJob := new(dbfakes.FakeJob)
+			fakeJob.ConfigReturns(atc.JobConfig{
+				Name:             "some-job",
+				RequiresApproval: &atc.ApprovalConfig{},
+			})
+			fakePipeline.JobReturns(fakeJob, true, nil)
+
+			build.TeamNameReturns("some-team")
+			build.JobNameReturns("some-job")
+			build.PipelineReturns(fakePipeline, true, nil)
+			dbBuildFactory.BuildReturns(build, true, nil)
+
+			fakeAccess.IsAuthenticatedReturns(true)
+			fakeAccess.IsAuthorizedReturns(true)
+			fakeAccess.UserNameReturns("some-user")
+			fakeAccess.TeamRolesReturns(map[string][]string{"some-team": {"pipeline-operator"}})
+			build.RejectReturns(true, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/builds/128/reject", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns 204", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+		})
+
+		It("rejects the build", func() {
+			Expect(build.RejectCallCount()).To(Equal(1))
+			approver, comment := build.RejectArgsForCall(0)
+			Expect(approver).To(Equal("some-user"))
+			Expect(comment).To(BeEmpty())
+		})
+	})
+
 	Describe("GET /api/v1/builds/:build_id/preparation", func() {
 		var response *http.Response
 
@@ -0,0 +1,96 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ApproveBuild(build db.Build) http.Handler {
+	return s.decideApproval(build, "approve", build.Approve)
+}
+
+func (s *Server) RejectBuild(build db.Build) http.Handler {
+	return s.decideApproval(build, "reject", build.Reject)
+}
+
+func (s *Server) decideApproval(
+	build db.Build,
+	action string,
+	decide func(approver string, comment string) (bool, error),
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session(action, lager.Data{
+			"build": build.ID(),
+		})
+
+		var request atc.ApprovalRequest
+		if r.ContentLength != 0 {
+			err := json.NewDecoder(r.Body).Decode(&request)
+			if err != nil {
+				logger.Info("malformed-request", lager.Data{"error": err.Error()})
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+
+		if build.JobName() == "" {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		pipeline, found, err := build.Pipeline()
+		if err != nil {
+			logger.Error("failed-to-get-pipeline", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		job, found, err := pipeline.Job(build.JobName())
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		approvalConfig := job.Config().RequiresApproval
+		if approvalConfig == nil {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+		if !acc.IsAdmin() && !approvalConfig.AllowsRoles(acc.TeamRoles()[build.TeamName()]) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		decided, err := decide(acc.UserName(), request.Comment)
+		if err != nil {
+			logger.Error("failed-to-decide-approval", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !decided {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -410,6 +410,73 @@ var _ = Describe("Config API", func() {
 							})
 						})
 
+						Context("when it is a dry run", func() {
+							var fakePipeline *dbfakes.FakePipeline
+
+							BeforeEach(func() {
+								path, err := atc.Routes.CreatePathForRoute(atc.DryRunConfig, rata.Params{
+									"team_name":     "a-team",
+									"pipeline_name": "a-pipeline",
+								})
+								Expect(err).NotTo(HaveOccurred())
+								request.URL.Path = path
+
+								existingConfig := pipelineConfig
+								existingConfig.Resources = atc.ResourceConfigs{
+									{
+										Name:   "some-resource",
+										Type:   "some-type",
+										Source: atc.Source{"source-config": "some-old-value"},
+									},
+								}
+
+								fakePipeline = new(dbfakes.FakePipeline)
+								fakePipeline.ConfigReturns(existingConfig, nil)
+								dbTeam.PipelineReturns(fakePipeline, true, nil)
+							})
+
+							It("returns 200", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusOK))
+							})
+
+							It("does not save anything", func() {
+								Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+								Expect(dbTeamFactory.NotifyResourceScannerCallCount()).To(Equal(0))
+							})
+
+							It("returns the diff and its impact", func() {
+								var saveResponse atc.SaveConfigResponse
+								Expect(json.NewDecoder(response.Body).Decode(&saveResponse)).To(Succeed())
+
+								Expect(saveResponse.DryRun).ToNot(BeNil())
+								Expect(saveResponse.DryRun.Created).To(BeFalse())
+								Expect(saveResponse.DryRun.Diff).To(ContainSubstring("resource some-resource has changed"))
+								Expect(saveResponse.DryRun.Impact).To(Equal(atc.ConfigImpact{
+									RecheckedResources: []atc.ConfigImpactReason{
+										{Name: "some-resource", Reason: "source has changed"},
+									},
+									ResetResources: []atc.ConfigImpactReason{
+										{Name: "some-resource", Reason: "source has changed"},
+									},
+								}))
+							})
+
+							Context("when the pipeline does not exist", func() {
+								BeforeEach(func() {
+									dbTeam.PipelineReturns(nil, false, nil)
+								})
+
+								It("returns that it would be created", func() {
+									var saveResponse atc.SaveConfigResponse
+									Expect(json.NewDecoder(response.Body).Decode(&saveResponse)).To(Succeed())
+
+									Expect(saveResponse.DryRun).ToNot(BeNil())
+									Expect(saveResponse.DryRun.Created).To(BeTrue())
+									Expect(saveResponse.DryRun.Diff).To(ContainSubstring("job some-job has been added"))
+								})
+							})
+						})
+
 						Context("when the config is invalid", func() {
 							BeforeEach(func() {
 								pipelineConfig.Groups[0].Resources = []string{"missing-resource"}
@@ -439,6 +506,35 @@ var _ = Describe("Config API", func() {
 								Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
 							})
 						})
+
+						Context("when the team's network policy rejects a task", func() {
+							BeforeEach(func() {
+								atc.NetworkPolicies = atc.TaskNetworkPolicies{
+									"a-team": {RequireRestrictedEgress: true},
+								}
+							})
+
+							AfterEach(func() {
+								atc.NetworkPolicies = nil
+							})
+
+							It("returns 400", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							})
+
+							It("returns error JSON", func() {
+								Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`
+								{
+									"errors": [
+										"invalid network policy:\n\tjobs.some-job.task.some-task network policy requires tasks to set 'egress: none'\n"
+									]
+								}`))
+							})
+
+							It("does not save it", func() {
+								Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+							})
+						})
 					})
 
 					Context("YAML", func() {
@@ -1,6 +1,7 @@
 package configserver
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -19,8 +20,17 @@ import (
 )
 
 func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
-	session := s.logger.Session("set-config")
+	s.saveConfig(w, r, s.logger.Session("set-config"), false)
+}
+
+// DryRunConfig validates the config and responds with what saving it would
+// change, without saving it. It has its own route so that servers which do
+// not support dry runs reject the request rather than saving the config.
+func (s *Server) DryRunConfig(w http.ResponseWriter, r *http.Request) {
+	s.saveConfig(w, r, s.logger.Session("dry-run-config"), true)
+}
 
+func (s *Server) saveConfig(w http.ResponseWriter, r *http.Request, session lager.Logger, dryRun bool) {
 	query := r.URL.Query()
 
 	checkCredentials := false
@@ -61,16 +71,17 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	pipelineName := rata.Param(r, "pipeline_name")
+	teamName := rata.Param(r, "team_name")
+
 	warnings, errorMessages := configvalidate.Validate(config)
+	errorMessages = append(errorMessages, configvalidate.ValidateNetworkPolicy(config, atc.NetworkPolicies.ForTeam(teamName))...)
 	if len(errorMessages) > 0 {
 		session.Info("ignoring-invalid-config", lager.Data{"errors": errorMessages})
 		s.handleBadRequest(w, errorMessages...)
 		return
 	}
 
-	pipelineName := rata.Param(r, "pipeline_name")
-	teamName := rata.Param(r, "team_name")
-
 	if checkCredentials {
 		variables := creds.NewVariables(s.secretManager, teamName, pipelineName, false)
 
@@ -81,8 +92,6 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	session.Info("saving")
-
 	team, found, err := s.teamFactory.FindTeam(teamName)
 	if err != nil {
 		session.Error("failed-to-find-team", err)
@@ -96,6 +105,13 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if dryRun {
+		s.dryRun(w, session, team, pipelineName, config, warnings)
+		return
+	}
+
+	session.Info("saving")
+
 	_, created, err := team.SavePipeline(pipelineName, config, version, true)
 	if err != nil {
 		session.Error("failed-to-save-config", err)
@@ -123,6 +139,43 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 	s.writeSaveConfigResponse(w, atc.SaveConfigResponse{Warnings: warnings})
 }
 
+// dryRun responds with what saving the config would change, without saving
+// it.
+func (s *Server) dryRun(w http.ResponseWriter, session lager.Logger, team db.Team, pipelineName string, config atc.Config, warnings []atc.ConfigWarning) {
+	var existingConfig atc.Config
+
+	pipeline, found, err := team.Pipeline(pipelineName)
+	if err != nil {
+		session.Error("failed-to-find-pipeline", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if found {
+		existingConfig, err = pipeline.Config()
+		if err != nil {
+			session.Error("failed-to-get-pipeline-config", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	diff := new(bytes.Buffer)
+	existingConfig.Diff(diff, config)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	s.writeSaveConfigResponse(w, atc.SaveConfigResponse{
+		Warnings: warnings,
+		DryRun: &atc.ConfigDryRun{
+			Created: !found,
+			Diff:    diff.String(),
+			Impact:  existingConfig.Impact(config),
+		},
+	})
+}
+
 // Simply validate that the credentials exist; don't do anything with the actual secrets
 func validateCredParams(credMgrVars vars.Variables, config atc.Config, session lager.Logger) error {
 	var errs error
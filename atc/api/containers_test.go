@@ -3,6 +3,7 @@ package api_test
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -169,6 +170,34 @@ var _ = Describe("Containers API", func() {
 					})
 				})
 
+				Context("when a container is being kept for debugging", func() {
+					var debugUntil time.Time
+
+					BeforeEach(func() {
+						debugUntil = time.Now().Add(30 * time.Minute)
+
+						fakeDebugContainer := new(dbfakes.FakeCreatedContainer)
+						fakeDebugContainer.HandleReturns("some-debug-handle")
+						fakeDebugContainer.StateReturns(atc.ContainerStateCreated)
+						fakeDebugContainer.DebugUntilReturns(debugUntil)
+
+						dbTeam.ContainersReturns([]db.Container{fakeDebugContainer}, nil)
+					})
+
+					It("returns when it expires", func() {
+						response, err := client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+
+						var containers []atc.Container
+						err = json.NewDecoder(response.Body).Decode(&containers)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(containers).To(HaveLen(1))
+						Expect(containers[0].DebugUntil).To(Equal(debugUntil.Unix()))
+						Expect(containers[0].ExpiresIn).To(Or(Equal("30m0s"), Equal("29m59s")))
+					})
+				})
+
 				Context("when no containers are found", func() {
 					BeforeEach(func() {
 						dbTeam.ContainersReturns([]db.Container{}, nil)
@@ -17,10 +17,12 @@ import (
 	"github.com/concourse/concourse/atc/api/containerserver"
 	"github.com/concourse/concourse/atc/api/infoserver"
 	"github.com/concourse/concourse/atc/api/jobserver"
+	"github.com/concourse/concourse/atc/api/lockserver"
 	"github.com/concourse/concourse/atc/api/loglevelserver"
 	"github.com/concourse/concourse/atc/api/pipelineserver"
 	"github.com/concourse/concourse/atc/api/resourceserver"
 	"github.com/concourse/concourse/atc/api/resourceserver/versionserver"
+	"github.com/concourse/concourse/atc/api/serviceaccountserver"
 	"github.com/concourse/concourse/atc/api/teamserver"
 	"github.com/concourse/concourse/atc/api/volumeserver"
 	"github.com/concourse/concourse/atc/api/workerserver"
@@ -69,6 +71,7 @@ func NewHandler(
 	varSourcePool creds.VarSourcePool,
 	credsManagers creds.Managers,
 	interceptTimeoutFactory containerserver.InterceptTimeoutFactory,
+	inputExplainer jobserver.InputExplainer,
 ) (http.Handler, error) {
 
 	absCLIDownloadsDir, err := filepath.Abs(cliDownloadsDir)
@@ -82,7 +85,7 @@ func NewHandler(
 
 	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, eventHandlerFactory)
 	checkServer := checkserver.NewServer(logger, dbCheckFactory)
-	jobServer := jobserver.NewServer(logger, externalURL, secretManager, dbJobFactory, dbCheckFactory)
+	jobServer := jobserver.NewServer(logger, externalURL, secretManager, dbJobFactory, dbCheckFactory, inputExplainer)
 	resourceServer := resourceserver.NewServer(logger, secretManager, varSourcePool, dbCheckFactory, dbResourceFactory, dbResourceConfigFactory)
 
 	versionServer := versionserver.NewServer(logger, externalURL)
@@ -94,14 +97,17 @@ func NewHandler(
 	cliServer := cliserver.NewServer(logger, absCLIDownloadsDir)
 	containerServer := containerserver.NewServer(logger, workerClient, secretManager, varSourcePool, interceptTimeoutFactory, containerRepository, destroyer)
 	volumesServer := volumeserver.NewServer(logger, volumeRepository, destroyer)
+	lockServer := lockserver.NewServer(logger)
+	serviceAccountServer := serviceaccountserver.NewServer(logger)
 	teamServer := teamserver.NewServer(logger, dbTeamFactory, externalURL)
 	infoServer := infoserver.NewServer(logger, version, workerVersion, externalURL, clusterName, credsManagers)
 	artifactServer := artifactserver.NewServer(logger, workerClient)
 	usersServer := usersserver.NewServer(logger, dbUserFactory)
 
 	handlers := map[string]http.Handler{
-		atc.GetConfig:  http.HandlerFunc(configServer.GetConfig),
-		atc.SaveConfig: http.HandlerFunc(configServer.SaveConfig),
+		atc.GetConfig:    http.HandlerFunc(configServer.GetConfig),
+		atc.SaveConfig:   http.HandlerFunc(configServer.SaveConfig),
+		atc.DryRunConfig: http.HandlerFunc(configServer.DryRunConfig),
 
 		atc.GetCC: http.HandlerFunc(ccServer.GetCC),
 
@@ -110,6 +116,8 @@ func NewHandler(
 		atc.GetBuild:            buildHandlerFactory.HandlerFor(buildServer.GetBuild),
 		atc.BuildResources:      buildHandlerFactory.HandlerFor(buildServer.BuildResources),
 		atc.AbortBuild:          buildHandlerFactory.HandlerFor(buildServer.AbortBuild),
+		atc.ApproveBuild:        buildHandlerFactory.HandlerFor(buildServer.ApproveBuild),
+		atc.RejectBuild:         buildHandlerFactory.HandlerFor(buildServer.RejectBuild),
 		atc.GetBuildPlan:        buildHandlerFactory.HandlerFor(buildServer.GetBuildPlan),
 		atc.GetBuildPreparation: buildHandlerFactory.HandlerFor(buildServer.GetBuildPreparation),
 		atc.BuildEvents:         buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
@@ -134,6 +142,9 @@ func NewHandler(
 
 		atc.ClearTaskCache: pipelineHandlerFactory.HandlerFor(jobServer.ClearTaskCache),
 
+		atc.ListJobTaskResourceUsage: pipelineHandlerFactory.HandlerFor(jobServer.ListJobTaskResourceUsage),
+		atc.ExplainJobInputs:         pipelineHandlerFactory.HandlerFor(jobServer.ExplainJobInputs),
+
 		atc.ListAllPipelines:    http.HandlerFunc(pipelineServer.ListAllPipelines),
 		atc.ListPipelines:       http.HandlerFunc(pipelineServer.ListPipelines),
 		atc.GetPipeline:         pipelineHandlerFactory.HandlerFor(pipelineServer.GetPipeline),
@@ -141,6 +152,8 @@ func NewHandler(
 		atc.OrderPipelines:      http.HandlerFunc(pipelineServer.OrderPipelines),
 		atc.PausePipeline:       pipelineHandlerFactory.HandlerFor(pipelineServer.PausePipeline),
 		atc.UnpausePipeline:     pipelineHandlerFactory.HandlerFor(pipelineServer.UnpausePipeline),
+		atc.FreezePipeline:      pipelineHandlerFactory.HandlerFor(pipelineServer.FreezePipeline),
+		atc.UnfreezePipeline:    pipelineHandlerFactory.HandlerFor(pipelineServer.UnfreezePipeline),
 		atc.ExposePipeline:      pipelineHandlerFactory.HandlerFor(pipelineServer.ExposePipeline),
 		atc.HidePipeline:        pipelineHandlerFactory.HandlerFor(pipelineServer.HidePipeline),
 		atc.GetVersionsDB:       pipelineHandlerFactory.HandlerFor(pipelineServer.GetVersionsDB),
@@ -164,6 +177,8 @@ func NewHandler(
 		atc.EnableResourceVersion:         pipelineHandlerFactory.HandlerFor(versionServer.EnableResourceVersion),
 		atc.DisableResourceVersion:        pipelineHandlerFactory.HandlerFor(versionServer.DisableResourceVersion),
 		atc.PinResourceVersion:            pipelineHandlerFactory.HandlerFor(versionServer.PinResourceVersion),
+		atc.AddResourceVersionLabel:       pipelineHandlerFactory.HandlerFor(versionServer.AddResourceVersionLabel),
+		atc.RemoveResourceVersionLabel:    pipelineHandlerFactory.HandlerFor(versionServer.RemoveResourceVersionLabel),
 		atc.ListBuildsWithVersionAsInput:  pipelineHandlerFactory.HandlerFor(versionServer.ListBuildsWithVersionAsInput),
 		atc.ListBuildsWithVersionAsOutput: pipelineHandlerFactory.HandlerFor(versionServer.ListBuildsWithVersionAsOutput),
 		atc.GetResourceCausality:          pipelineHandlerFactory.HandlerFor(versionServer.GetCausality),
@@ -202,8 +217,20 @@ func NewHandler(
 		atc.DestroyTeam:    http.HandlerFunc(teamServer.DestroyTeam),
 		atc.ListTeamBuilds: http.HandlerFunc(teamServer.ListTeamBuilds),
 
+		atc.ListLocks:   teamHandlerFactory.HandlerFor(lockServer.ListLocks),
+		atc.ReleaseLock: teamHandlerFactory.HandlerFor(lockServer.ReleaseLock),
+
+		atc.ListTeamQueue: http.HandlerFunc(teamServer.ListTeamQueue),
+
+		atc.ListServiceAccounts:       teamHandlerFactory.HandlerFor(serviceAccountServer.ListServiceAccounts),
+		atc.CreateServiceAccount:      teamHandlerFactory.HandlerFor(serviceAccountServer.CreateServiceAccount),
+		atc.DestroyServiceAccount:     teamHandlerFactory.HandlerFor(serviceAccountServer.DestroyServiceAccount),
+		atc.CreateServiceAccountToken: teamHandlerFactory.HandlerFor(serviceAccountServer.CreateServiceAccountToken),
+		atc.RevokeServiceAccountToken: teamHandlerFactory.HandlerFor(serviceAccountServer.RevokeServiceAccountToken),
+
 		atc.CreateArtifact: teamHandlerFactory.HandlerFor(artifactServer.CreateArtifact),
 		atc.GetArtifact:    teamHandlerFactory.HandlerFor(artifactServer.GetArtifact),
+		atc.DiffArtifact:   teamHandlerFactory.HandlerFor(artifactServer.DiffArtifact),
 	}
 
 	return rata.NewRouter(atc.Routes, wrapper.Wrap(handlers))
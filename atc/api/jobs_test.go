@@ -6,12 +6,14 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/algorithm"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -112,11 +114,13 @@ var _ = Describe("Jobs API", func() {
 						Get: "some-input",
 					},
 					{
-						Get:      "some-name",
-						Resource: "some-other-input",
-						Params:   atc.Params{"secret": "params"},
-						Passed:   []string{"a", "b"},
-						Trigger:  true,
+						Get:       "some-name",
+						Resource:  "some-other-input",
+						Params:    atc.Params{"secret": "params"},
+						Passed:    []string{"a", "b"},
+						PassedAny: []string{"c", "d"},
+						Labeled:   []string{"deployed-to-prod"},
+						Trigger:   true,
 					},
 					{
 						Put: "some-output",
@@ -195,6 +199,13 @@ var _ = Describe("Jobs API", func() {
 							"a",
 							"b"
 						],
+						"passed_any": [
+							"c",
+							"d"
+						],
+						"labeled": [
+							"deployed-to-prod"
+						],
 						"trigger": true
 					}
 				],
@@ -1555,12 +1566,16 @@ var _ = Describe("Jobs API", func() {
 							build.StatusReturns(db.BuildStatusStarted)
 							build.StartTimeReturns(time.Unix(1, 0))
 							build.EndTimeReturns(time.Unix(100, 0))
+							build.CreatedByReturns("some-user")
+							build.TriggerReturns(&atc.BuildTrigger{Type: atc.BuildTriggerTypeManual})
 
 							fakeJob.CreateBuildReturns(build, nil)
+							fakeaccess.UserNameReturns("some-user")
 						})
 
-						It("triggers the build", func() {
+						It("triggers the build on behalf of the user", func() {
 							Expect(fakeJob.CreateBuildCallCount()).To(Equal(1))
+							Expect(fakeJob.CreateBuildArgsForCall(0)).To(Equal("some-user"))
 						})
 
 						Context("when finding the pipeline resources fails", func() {
@@ -1638,7 +1653,9 @@ var _ = Describe("Jobs API", func() {
 							"pipeline_name": "a-pipeline",
 							"team_name": "some-team",
 							"start_time": 1,
-							"end_time": 100
+							"end_time": 100,
+							"created_by": "some-user",
+							"trigger": {"type": "manual"}
 						}`))
 								})
 							})
@@ -1837,6 +1854,357 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/explain", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/explain")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the job is found", func() {
+				BeforeEach(func() {
+					fakeJob.NameReturns("some-job")
+					fakeJob.ConfigReturns(atc.JobConfig{
+						Name: "some-job",
+						Plan: atc.PlanSequence{
+							{
+								Get:      "some-input",
+								Resource: "some-resource",
+								Passed:   []string{"upstream"},
+							},
+						},
+					})
+
+					fakePipeline.JobReturns(fakeJob, true, nil)
+				})
+
+				Context("when loading the versions db fails", func() {
+					BeforeEach(func() {
+						fakePipeline.LoadVersionsDBReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
+				Context("when loading the versions db succeeds", func() {
+					var (
+						versionsDB   *algorithm.VersionsDB
+						fakeResource *dbfakes.FakeResource
+					)
+
+					BeforeEach(func() {
+						fakeResource = new(dbfakes.FakeResource)
+						fakeResource.NameReturns("some-resource")
+
+						versionsDB = &algorithm.VersionsDB{
+							JobIDs:      map[string]int{"some-job": 1, "upstream": 2},
+							ResourceIDs: map[string]int{"some-resource": 11},
+						}
+
+						fakePipeline.LoadVersionsDBReturns(versionsDB, nil)
+						fakePipeline.ResourcesReturns(db.Resources{fakeResource}, nil)
+
+						fakePipeline.ResourceVersionStub = func(id int) (atc.ResourceVersion, bool, error) {
+							return atc.ResourceVersion{
+								ID:      id,
+								Version: atc.Version{"ref": strconv.Itoa(id)},
+							}, true, nil
+						}
+					})
+
+					Context("when explaining the inputs fails", func() {
+						BeforeEach(func() {
+							fakeInputExplainer.ExplainJobInputsReturns(algorithm.Explanation{}, errors.New("nope"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+
+					Context("when the inputs are resolved", func() {
+						BeforeEach(func() {
+							fakeInputExplainer.ExplainJobInputsReturns(algorithm.Explanation{
+								Resolved: true,
+								Inputs: []algorithm.InputExplanation{
+									{
+										Name:       "some-input",
+										ResourceID: 11,
+										Passed:     algorithm.JobSet{2: {}},
+										Candidates: []algorithm.CandidateExplanation{
+											{VersionID: 101, EliminatedBy: []int{2}},
+											{VersionID: 100},
+										},
+										VersionID: 100,
+									},
+								},
+							}, nil)
+						})
+
+						It("returns 200 OK", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+						})
+
+						It("returns Content-Type 'application/json'", func() {
+							Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+						})
+
+						It("explains the inputs of the job against the pipeline's versions", func() {
+							Expect(fakeInputExplainer.ExplainJobInputsCallCount()).To(Equal(1))
+
+							_, pipeline, versions, job, resources := fakeInputExplainer.ExplainJobInputsArgsForCall(0)
+							Expect(pipeline).To(Equal(fakePipeline))
+							Expect(versions).To(Equal(versionsDB))
+							Expect(job).To(Equal(fakeJob))
+							Expect(resources).To(Equal(db.Resources{fakeResource}))
+						})
+
+						It("presents the resolution of the inputs", func() {
+							body, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(body).To(MatchJSON(`{
+								"resolved": true,
+								"inputs": [
+									{
+										"name": "some-input",
+										"resource": "some-resource",
+										"passed": ["upstream"],
+										"version": {"ref": "100"},
+										"candidates": [
+											{"id": 101, "version": {"ref": "101"}, "eliminated_by": ["upstream"]},
+											{"id": 100, "version": {"ref": "100"}}
+										]
+									}
+								]
+							}`))
+						})
+					})
+
+					Context("when the inputs conflict", func() {
+						BeforeEach(func() {
+							fakeInputExplainer.ExplainJobInputsReturns(algorithm.Explanation{
+								Resolved: false,
+								Inputs: []algorithm.InputExplanation{
+									{
+										Name:       "some-input",
+										ResourceID: 11,
+										Passed:     algorithm.JobSet{2: {}},
+										Candidates: []algorithm.CandidateExplanation{},
+									},
+								},
+								Conflicts: []algorithm.ConflictExplanation{
+									{JobID: 2, Inputs: []string{"some-input"}},
+								},
+							}, nil)
+						})
+
+						It("presents the conflicting job", func() {
+							body, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(body).To(MatchJSON(`{
+								"resolved": false,
+								"inputs": [
+									{
+										"name": "some-input",
+										"resource": "some-resource",
+										"passed": ["upstream"],
+										"candidates": []
+									}
+								],
+								"conflicts": [
+									{"job": "upstream", "inputs": ["some-input"]}
+								]
+							}`))
+						})
+					})
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/resource-usage", func() {
+		var response *http.Response
+		var queryParams string
+
+		BeforeEach(func() {
+			queryParams = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/resource-usage" + queryParams)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated and not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(false)
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("and the pipeline is private", func() {
+				BeforeEach(func() {
+					fakePipeline.PublicReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("and the pipeline is public", func() {
+				BeforeEach(func() {
+					fakePipeline.PublicReturns(true)
+					fakePipeline.JobReturns(fakeJob, true, nil)
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when getting the job fails", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, errors.New("some-error"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when getting the job succeeds", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(fakeJob, true, nil)
+				})
+
+				Context("when getting the resource usage fails", func() {
+					BeforeEach(func() {
+						fakeJob.TaskResourceUsageReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
+				Context("when getting the resource usage succeeds", func() {
+					BeforeEach(func() {
+						fakeJob.TaskResourceUsageReturns([]atc.BuildTaskResourceUsage{
+							{
+								BuildID:   42,
+								BuildName: "3",
+								StepName:  "some-task",
+								Time:      1,
+								Usage: atc.TaskResourceUsage{
+									CPUTime:       1000,
+									PeakMemory:    2048,
+									AverageMemory: 1024,
+									PeakDisk:      4096,
+									OutputSizes:   map[string]uint64{"some-output": 512},
+								},
+							},
+						}, nil)
+					})
+
+					It("returns 200 OK", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("returns Content-Type 'application/json'", func() {
+						Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+					})
+
+					It("defaults the limit", func() {
+						Expect(fakeJob.TaskResourceUsageCallCount()).To(Equal(1))
+						Expect(fakeJob.TaskResourceUsageArgsForCall(0)).To(Equal(atc.PaginationAPIDefaultLimit))
+					})
+
+					It("returns the resource usage", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`[
+							{
+								"build_id": 42,
+								"build_name": "3",
+								"step_name": "some-task",
+								"time": 1,
+								"usage": {
+									"cpu_time": 1000,
+									"peak_memory": 2048,
+									"average_memory": 1024,
+									"peak_disk": 4096,
+									"output_sizes": {"some-output": 512}
+								}
+							}
+						]`))
+					})
+
+					Context("when a limit is given", func() {
+						BeforeEach(func() {
+							queryParams = "?limit=5"
+						})
+
+						It("passes the limit through", func() {
+							Expect(fakeJob.TaskResourceUsageArgsForCall(0)).To(Equal(5))
+						})
+					})
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", func() {
 		var response *http.Response
 
@@ -0,0 +1,179 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/algorithm"
+)
+
+func (s *Server) ExplainJobInputs(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("explain-job-inputs")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jobName := r.FormValue(":job_name")
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		versions, err := pipeline.LoadVersionsDB()
+		if err != nil {
+			logger.Error("failed-to-load-versions-db", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		resources, err := pipeline.Resources()
+		if err != nil {
+			logger.Error("failed-to-get-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		explanation, err := s.inputExplainer.ExplainJobInputs(logger, pipeline, versions, job, resources)
+		if err != nil {
+			logger.Error("failed-to-explain-next-input-mapping", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presented, err := s.presentExplanation(logger, pipeline, job, versions, explanation)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(presented)
+		if err != nil {
+			logger.Error("failed-to-encode-explanation", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) presentExplanation(
+	logger lager.Logger,
+	pipeline db.Pipeline,
+	job db.Job,
+	versions *algorithm.VersionsDB,
+	explanation algorithm.Explanation,
+) (atc.JobInputsExplanation, error) {
+	jobNames := map[int]string{}
+	for name, id := range versions.JobIDs {
+		jobNames[id] = name
+	}
+
+	resourceNames := map[string]string{}
+	for _, input := range job.Config().Inputs() {
+		resourceNames[input.Name] = input.Resource
+	}
+
+	presentVersion := func(versionID int) (atc.Version, error) {
+		resourceVersion, found, err := pipeline.ResourceVersion(versionID)
+		if err != nil {
+			logger.Error("failed-to-get-resource-version", err, lager.Data{"version-id": versionID})
+			return nil, err
+		}
+
+		if !found {
+			return nil, nil
+		}
+
+		return resourceVersion.Version, nil
+	}
+
+	presentJobs := func(ids []int) []string {
+		names := []string{}
+		for _, id := range ids {
+			names = append(names, jobNames[id])
+		}
+
+		sort.Strings(names)
+
+		return names
+	}
+
+	presented := atc.JobInputsExplanation{
+		Resolved: explanation.Resolved,
+		Inputs:   []atc.InputExplanation{},
+	}
+
+	for _, input := range explanation.Inputs {
+		passed := []int{}
+		for id := range input.Passed {
+			passed = append(passed, id)
+		}
+
+		passedAny := []int{}
+		for id := range input.PassedAny {
+			passedAny = append(passedAny, id)
+		}
+
+		presentedInput := atc.InputExplanation{
+			Name:       input.Name,
+			Resource:   resourceNames[input.Name],
+			Passed:     presentJobs(passed),
+			PassedAny:  presentJobs(passedAny),
+			Labeled:    input.Labeled,
+			Pinned:     input.PinnedVersionID != 0,
+			Candidates: []atc.CandidateExplanation{},
+		}
+
+		for _, candidate := range input.Candidates {
+			version, err := presentVersion(candidate.VersionID)
+			if err != nil {
+				return atc.JobInputsExplanation{}, err
+			}
+
+			presentedCandidate := atc.CandidateExplanation{
+				ID:      candidate.VersionID,
+				Version: version,
+			}
+
+			if len(candidate.EliminatedBy) > 0 {
+				presentedCandidate.EliminatedBy = presentJobs(candidate.EliminatedBy)
+			}
+
+			if candidate.VersionID == input.VersionID {
+				presentedInput.Version = version
+			}
+
+			presentedInput.Candidates = append(presentedInput.Candidates, presentedCandidate)
+		}
+
+		if explanation.Resolved && presentedInput.Version == nil {
+			version, err := presentVersion(input.VersionID)
+			if err != nil {
+				return atc.JobInputsExplanation{}, err
+			}
+
+			presentedInput.Version = version
+		}
+
+		presented.Inputs = append(presented.Inputs, presentedInput)
+	}
+
+	for _, conflict := range explanation.Conflicts {
+		presented.Conflicts = append(presented.Conflicts, atc.ConstraintConflict{
+			Job:    jobNames[conflict.JobID],
+			Inputs: conflict.Inputs,
+		})
+	}
+
+	return presented, nil
+}
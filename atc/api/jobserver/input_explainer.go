@@ -0,0 +1,20 @@
+package jobserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/algorithm"
+)
+
+//go:generate counterfeiter . InputExplainer
+
+// InputExplainer explains how the next inputs of a job would be resolved.
+type InputExplainer interface {
+	ExplainJobInputs(
+		logger lager.Logger,
+		pipeline db.Pipeline,
+		versions *algorithm.VersionsDB,
+		job db.Job,
+		resources db.Resources,
+	) (algorithm.Explanation, error)
+}
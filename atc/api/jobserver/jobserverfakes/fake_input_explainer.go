@@ -0,0 +1,126 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package jobserverfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/jobserver"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/algorithm"
+)
+
+type FakeInputExplainer struct {
+	ExplainJobInputsStub        func(lager.Logger, db.Pipeline, *algorithm.VersionsDB, db.Job, db.Resources) (algorithm.Explanation, error)
+	explainJobInputsMutex       sync.RWMutex
+	explainJobInputsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 db.Pipeline
+		arg3 *algorithm.VersionsDB
+		arg4 db.Job
+		arg5 db.Resources
+	}
+	explainJobInputsReturns struct {
+		result1 algorithm.Explanation
+		result2 error
+	}
+	explainJobInputsReturnsOnCall map[int]struct {
+		result1 algorithm.Explanation
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeInputExplainer) ExplainJobInputs(arg1 lager.Logger, arg2 db.Pipeline, arg3 *algorithm.VersionsDB, arg4 db.Job, arg5 db.Resources) (algorithm.Explanation, error) {
+	fake.explainJobInputsMutex.Lock()
+	ret, specificReturn := fake.explainJobInputsReturnsOnCall[len(fake.explainJobInputsArgsForCall)]
+	fake.explainJobInputsArgsForCall = append(fake.explainJobInputsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 db.Pipeline
+		arg3 *algorithm.VersionsDB
+		arg4 db.Job
+		arg5 db.Resources
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("ExplainJobInputs", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.explainJobInputsMutex.Unlock()
+	if fake.ExplainJobInputsStub != nil {
+		return fake.ExplainJobInputsStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.explainJobInputsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInputExplainer) ExplainJobInputsCallCount() int {
+	fake.explainJobInputsMutex.RLock()
+	defer fake.explainJobInputsMutex.RUnlock()
+	return len(fake.explainJobInputsArgsForCall)
+}
+
+func (fake *FakeInputExplainer) ExplainJobInputsCalls(stub func(lager.Logger, db.Pipeline, *algorithm.VersionsDB, db.Job, db.Resources) (algorithm.Explanation, error)) {
+	fake.explainJobInputsMutex.Lock()
+	defer fake.explainJobInputsMutex.Unlock()
+	fake.ExplainJobInputsStub = stub
+}
+
+func (fake *FakeInputExplainer) ExplainJobInputsArgsForCall(i int) (lager.Logger, db.Pipeline, *algorithm.VersionsDB, db.Job, db.Resources) {
+	fake.explainJobInputsMutex.RLock()
+	defer fake.explainJobInputsMutex.RUnlock()
+	argsForCall := fake.explainJobInputsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeInputExplainer) ExplainJobInputsReturns(result1 algorithm.Explanation, result2 error) {
+	fake.explainJobInputsMutex.Lock()
+	defer fake.explainJobInputsMutex.Unlock()
+	fake.ExplainJobInputsStub = nil
+	fake.explainJobInputsReturns = struct {
+		result1 algorithm.Explanation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInputExplainer) ExplainJobInputsReturnsOnCall(i int, result1 algorithm.Explanation, result2 error) {
+	fake.explainJobInputsMutex.Lock()
+	defer fake.explainJobInputsMutex.Unlock()
+	fake.ExplainJobInputsStub = nil
+	if fake.explainJobInputsReturnsOnCall == nil {
+		fake.explainJobInputsReturnsOnCall = make(map[int]struct {
+			result1 algorithm.Explanation
+			result2 error
+		})
+	}
+	fake.explainJobInputsReturnsOnCall[i] = struct {
+		result1 algorithm.Explanation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInputExplainer) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.explainJobInputsMutex.RLock()
+	defer fake.explainJobInputsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeInputExplainer) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ jobserver.InputExplainer = new(FakeInputExplainer)
@@ -0,0 +1,183 @@
+package api_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Locks API", func() {
+	var fakeaccess *accessorfakes.FakeAccess
+
+	BeforeEach(func() {
+		fakeaccess = new(accessorfakes.FakeAccess)
+	})
+
+	JustBeforeEach(func() {
+		fakeAccessor.CreateReturns(fakeaccess)
+	})
+
+	Describe("GET /api/v1/teams/a-team/locks", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/locks")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when getting the locks succeeds", func() {
+				BeforeEach(func() {
+					dbTeam.LocksReturns([]atc.Lock{
+						{
+							Name: "staging-env",
+							Holder: &atc.LockBuild{
+								ID:           1,
+								Name:         "12",
+								JobName:      "deploy",
+								PipelineName: "some-pipeline",
+								Since:        100,
+							},
+							Waiters: []atc.LockBuild{
+								{
+									ID:           2,
+									Name:         "3",
+									JobName:      "deploy",
+									PipelineName: "other-pipeline",
+									Since:        200,
+								},
+							},
+						},
+					}, nil)
+				})
+
+				It("returns 200 OK with the locks", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					var locks []atc.Lock
+					err = json.Unmarshal(body, &locks)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(locks).To(HaveLen(1))
+					Expect(locks[0].Holder.PipelineName).To(Equal("some-pipeline"))
+					Expect(locks[0].Waiters[0].PipelineName).To(Equal("other-pipeline"))
+				})
+			})
+
+			Context("when getting the locks fails", func() {
+				BeforeEach(func() {
+					dbTeam.LocksReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/a-team/locks/staging-env", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/a-team/locks/staging-env", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not an admin", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+				fakeaccess.IsAdminReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			It("does not release the lock", func() {
+				Expect(dbTeam.ReleaseLockCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when an admin", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+				fakeaccess.IsAdminReturns(true)
+			})
+
+			Context("when the lock is held", func() {
+				BeforeEach(func() {
+					dbTeam.ReleaseLockReturns(true, nil)
+				})
+
+				It("releases the lock", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+					Expect(dbTeam.ReleaseLockCallCount()).To(Equal(1))
+					Expect(dbTeam.ReleaseLockArgsForCall(0)).To(Equal("staging-env"))
+				})
+			})
+
+			Context("when the lock is not held", func() {
+				BeforeEach(func() {
+					dbTeam.ReleaseLockReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when releasing the lock fails", func() {
+				BeforeEach(func() {
+					dbTeam.ReleaseLockReturns(false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+})
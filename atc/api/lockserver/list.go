@@ -0,0 +1,28 @@
+package lockserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListLocks(team db.Team) http.Handler {
+	hLog := s.logger.Session("list-locks")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		locks, err := team.Locks()
+		if err != nil {
+			hLog.Error("failed-to-get-locks", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(locks)
+		if err != nil {
+			hLog.Error("failed-to-encode-locks", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
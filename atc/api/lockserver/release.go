@@ -0,0 +1,35 @@
+package lockserver
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ReleaseLock(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lockName := r.FormValue(":lock_name")
+
+		hLog := s.logger.Session("release-lock", lager.Data{
+			"team": team.Name(),
+			"lock": lockName,
+		})
+
+		released, err := team.ReleaseLock(lockName)
+		if err != nil {
+			hLog.Error("failed-to-release-lock", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !released {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		hLog.Info("released")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
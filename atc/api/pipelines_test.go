@@ -1029,6 +1029,148 @@ var _ = Describe("Pipelines API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/freeze", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/freeze", bytes.NewBufferString(`{"build_id":42,"comment":"incident"}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				fakeTeam.PipelineReturns(dbPipeline, true, nil)
+			})
+
+			Context("when freezing the pipeline succeeds", func() {
+				BeforeEach(func() {
+					dbPipeline.FreezeReturns([]atc.FrozenResource{
+						{Resource: "some-resource", Version: atc.Version{"ref": "abc"}},
+					}, true, nil)
+				})
+
+				It("freezes the pipeline to the build with the comment", func() {
+					Expect(dbPipeline.FreezeCallCount()).To(Equal(1))
+					buildID, comment := dbPipeline.FreezeArgsForCall(0)
+					Expect(buildID).To(Equal(42))
+					Expect(comment).To(Equal("incident"))
+				})
+
+				It("returns 200 with the pinned resources", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`[{"resource":"some-resource","version":{"ref":"abc"}}]`))
+				})
+			})
+
+			Context("when the build is not part of the pipeline", func() {
+				BeforeEach(func() {
+					dbPipeline.FreezeReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when freezing the pipeline fails", func() {
+				BeforeEach(func() {
+					dbPipeline.FreezeReturns(nil, false, errors.New("welp"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/unfreeze", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/unfreeze", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				fakeTeam.PipelineReturns(dbPipeline, true, nil)
+			})
+
+			Context("when the pipeline is frozen", func() {
+				BeforeEach(func() {
+					dbPipeline.UnfreezeReturns(true, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(dbPipeline.UnfreezeCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the pipeline is not frozen", func() {
+				BeforeEach(func() {
+					dbPipeline.UnfreezeReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when unfreezing the pipeline fails", func() {
+				BeforeEach(func() {
+					dbPipeline.UnfreezeReturns(false, errors.New("welp"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/expose", func() {
 		var response *http.Response
 
@@ -1351,6 +1493,13 @@ var _ = Describe("Pipelines API", func() {
 								InputName: "some-input-name",
 							},
 						},
+						VersionLabels: []algorithm.VersionLabel{
+							{
+								VersionID:  73,
+								ResourceID: 127,
+								Label:      "deployed-to-prod",
+							},
+						},
 						JobIDs: map[string]int{
 							"bad-luck-job": 13,
 						},
@@ -1406,6 +1555,13 @@ var _ = Describe("Pipelines API", func() {
 						"InputName": "some-input-name"
 					}
 				],
+				"VersionLabels": [
+					{
+						"ResourceID": 127,
+						"VersionID": 73,
+						"Label": "deployed-to-prod"
+					}
+				],
 				"JobIDs": {
 						"bad-luck-job": 13
 				},
@@ -1765,6 +1921,7 @@ var _ = Describe("Pipelines API", func() {
 						fakeBuild.ReapTimeReturns(time.Unix(200, 0))
 
 						dbPipeline.CreateStartedBuildReturns(fakeBuild, nil)
+						fakeaccess.UserNameReturns("some-user")
 					})
 
 					It("returns 201 Created", func() {
@@ -1775,9 +1932,11 @@ var _ = Describe("Pipelines API", func() {
 						Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
 					})
 
-					It("creates a started build", func() {
+					It("creates a started build on behalf of the user", func() {
 						Expect(dbPipeline.CreateStartedBuildCallCount()).To(Equal(1))
-						Expect(dbPipeline.CreateStartedBuildArgsForCall(0)).To(Equal(plan))
+						actualPlan, createdBy := dbPipeline.CreateStartedBuildArgsForCall(0)
+						Expect(actualPlan).To(Equal(plan))
+						Expect(createdBy).To(Equal("some-user"))
 					})
 
 					It("returns the created build", func() {
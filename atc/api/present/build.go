@@ -26,6 +26,14 @@ func Build(build db.Build) atc.Build {
 		TeamName:     build.TeamName(),
 		Status:       string(build.Status()),
 		APIURL:       apiURL,
+
+		CreatedBy: build.CreatedBy(),
+		Trigger:   build.Trigger(),
+
+		AwaitingApproval: build.AwaitingApproval(),
+		Approval:         build.Approval(),
+
+		Priority: build.Priority(),
 	}
 
 	if !build.StartTime().IsZero() {
@@ -40,5 +48,9 @@ func Build(build db.Build) atc.Build {
 		atcBuild.ReapTime = build.ReapTime().Unix()
 	}
 
+	if !build.DebugUntil().IsZero() {
+		atcBuild.DebugUntil = build.DebugUntil().Unix()
+	}
+
 	return atcBuild
 }
@@ -32,6 +32,14 @@ func Container(container db.Container, expiresAt time.Time) atc.Container {
 		User:             meta.User,
 	}
 
+	if created, ok := container.(db.CreatedContainer); ok && !created.DebugUntil().IsZero() {
+		atcContainer.DebugUntil = created.DebugUntil().Unix()
+
+		if expiresAt.IsZero() {
+			expiresAt = created.DebugUntil()
+		}
+	}
+
 	if !expiresAt.IsZero() {
 		atcContainer.ExpiresIn = expiresAt.Sub(time.Now()).Round(time.Second).String()
 	}
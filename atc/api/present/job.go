@@ -1,6 +1,8 @@
 package present
 
 import (
+	"time"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -32,10 +34,12 @@ func Job(
 	sanitizedInputs := []atc.JobInput{}
 	for _, input := range job.Config().Inputs() {
 		sanitizedInputs = append(sanitizedInputs, atc.JobInput{
-			Name:     input.Name,
-			Resource: input.Resource,
-			Passed:   input.Passed,
-			Trigger:  input.Trigger,
+			Name:      input.Name,
+			Resource:  input.Resource,
+			Passed:    input.Passed,
+			PassedAny: input.PassedAny,
+			Labeled:   input.Labeled,
+			Trigger:   input.Trigger,
 		})
 	}
 
@@ -47,6 +51,19 @@ func Job(
 		})
 	}
 
+	var nextScheduledBuild int64
+	if schedule := job.Config().Schedule; schedule != nil {
+		after := job.LastScheduled()
+		if after.IsZero() {
+			after = time.Now()
+		}
+
+		next, err := schedule.NextFireTime(after, job.ID())
+		if err == nil {
+			nextScheduledBuild = next.Unix()
+		}
+	}
+
 	return atc.Job{
 		ID: job.ID(),
 
@@ -60,6 +77,7 @@ func Job(
 		NextBuild:            presentedNextBuild,
 		TransitionBuild:      presentedTransitionBuild,
 		HasNewInputs:         job.HasNewInputs(),
+		NextScheduledBuild:   nextScheduledBuild,
 
 		Inputs:  sanitizedInputs,
 		Outputs: sanitizedOutputs,
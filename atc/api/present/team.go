@@ -6,9 +6,13 @@ import (
 )
 
 func Team(team db.Team) atc.Team {
+	defaultJobPriority := team.DefaultJobPriority()
+
 	return atc.Team{
 		ID:   team.ID(),
 		Name: team.Name(),
 		Auth: team.Auth(),
+
+		DefaultJobPriority: &defaultJobPriority,
 	}
 }
@@ -40,6 +40,8 @@ func Worker(workerInfo db.Worker) atc.Worker {
 		State:            string(workerInfo.State()),
 		Version:          version,
 		Ephemeral:        workerInfo.Ephemeral(),
+
+		EnforcesNetworkRules: workerInfo.EnforcesNetworkRules(),
 	}
 
 	if !workerInfo.StartTime().IsZero() {
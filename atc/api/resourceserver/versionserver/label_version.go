@@ -0,0 +1,84 @@
+package versionserver
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) AddResourceVersionLabel(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("add-resource-version-label")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resource, resourceConfigVersionID, label, ok := s.labelTarget(logger, pipeline, w, r)
+		if !ok {
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+
+		found, err := resource.AddVersionLabel(resourceConfigVersionID, label, acc.UserName())
+		if err != nil {
+			logger.Error("failed-to-add-resource-version-label", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if !found {
+			logger.Debug("resource-version-id-not-found", lager.Data{"resource_config_version_id": resourceConfigVersionID})
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func (s *Server) RemoveResourceVersionLabel(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("remove-resource-version-label")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resource, resourceConfigVersionID, label, ok := s.labelTarget(logger, pipeline, w, r)
+		if !ok {
+			return
+		}
+
+		found, err := resource.RemoveVersionLabel(resourceConfigVersionID, label)
+		if err != nil {
+			logger.Error("failed-to-remove-resource-version-label", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if !found {
+			logger.Debug("resource-version-label-not-found", lager.Data{"resource_config_version_id": resourceConfigVersionID, "label": label})
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func (s *Server) labelTarget(logger lager.Logger, pipeline db.Pipeline, w http.ResponseWriter, r *http.Request) (db.Resource, int, string, bool) {
+	resourceName := r.FormValue(":resource_name")
+	resource, found, err := pipeline.Resource(resourceName)
+	if err != nil {
+		logger.Error("failed-to-get-resource", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, 0, "", false
+	}
+	if !found {
+		logger.Debug("resource-not-found", lager.Data{"resource": resourceName})
+		w.WriteHeader(http.StatusNotFound)
+		return nil, 0, "", false
+	}
+
+	resourceConfigVersionID, err := strconv.Atoi(r.FormValue(":resource_config_version_id"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return nil, 0, "", false
+	}
+
+	label := r.FormValue(":label")
+	if label == "" || strings.ContainsAny(label, " \t\r\n") {
+		w.WriteHeader(http.StatusBadRequest)
+		return nil, 0, "", false
+	}
+
+	return resource, resourceConfigVersionID, label, true
+}
@@ -77,6 +77,14 @@ func (s *Server) ListResourceVersions(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
+		acc := accessor.GetAccessor(r)
+		hideMetadata := !resource.Public() && !acc.IsAuthorized(teamName)
+
+		if len(r.Form["match"]) > 0 || r.FormValue("semver") != "" {
+			s.listFilteredResourceVersions(logger, w, r, resource, limit, hideMetadata)
+			return
+		}
+
 		versions, pagination, found, err := resource.Versions(db.Page{
 			Until: until,
 			Since: since,
@@ -108,9 +116,6 @@ func (s *Server) ListResourceVersions(pipeline db.Pipeline) http.Handler {
 
 		w.WriteHeader(http.StatusOK)
 
-		acc := accessor.GetAccessor(r)
-		hideMetadata := !resource.Public() && !acc.IsAuthorized(teamName)
-
 		versions = present.ResourceVersions(hideMetadata, versions)
 
 		err = json.NewEncoder(w).Encode(versions)
@@ -121,6 +126,74 @@ func (s *Server) ListResourceVersions(pipeline db.Pipeline) http.Handler {
 	})
 }
 
+// listFilteredResourceVersions responds with the newest versions matching the
+// version filter given by the match, semver and semver_field params. Filtered
+// versions are not paginated.
+func (s *Server) listFilteredResourceVersions(logger lager.Logger, w http.ResponseWriter, r *http.Request, resource db.Resource, limit int, hideMetadata bool) {
+	filter := atc.VersionFilter{
+		Semver:      r.FormValue("semver"),
+		SemverField: r.FormValue("semver_field"),
+	}
+
+	for _, match := range r.Form["match"] {
+		kv := strings.SplitN(match, ":", 2)
+		if len(kv) != 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid match '%s': expected NAME:PATTERN", match)
+			return
+		}
+
+		if filter.Fields == nil {
+			filter.Fields = map[string]string{}
+		}
+
+		filter.Fields[kv[0]] = kv[1]
+	}
+
+	err := filter.Validate()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "invalid version filter: %s", err)
+		return
+	}
+
+	versions, err := resource.FilteredVersions(filter, limit)
+	if err != nil {
+		logger.Error("failed-to-get-filtered-resource-versions", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if hideMetadata && len(filter.Fields) > 0 {
+		// don't reveal metadata through versions which only match on it
+		matcher, err := filter.Matcher()
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid version filter: %s", err)
+			return
+		}
+
+		visible := []atc.ResourceVersion{}
+		for _, version := range versions {
+			if matcher.Matches(version.Version, nil) {
+				visible = append(visible, version)
+			}
+		}
+
+		versions = visible
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(present.ResourceVersions(hideMetadata, versions))
+	if err != nil {
+		logger.Error("failed-to-encode-resource-versions", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
 func (s *Server) addNextLink(w http.ResponseWriter, teamName, pipelineName, resourceName string, page db.Page) {
 	w.Header().Add("Link", fmt.Sprintf(
 		`<%s/api/v1/teams/%s/pipelines/%s/resources/%s/versions?%s=%d&%s=%d>; rel="%s"`,
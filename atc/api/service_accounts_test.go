@@ -0,0 +1,394 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/skymarshal/token"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Accounts API", func() {
+	var fakeaccess *accessorfakes.FakeAccess
+
+	BeforeEach(func() {
+		fakeaccess = new(accessorfakes.FakeAccess)
+	})
+
+	JustBeforeEach(func() {
+		fakeAccessor.CreateReturns(fakeaccess)
+	})
+
+	Describe("GET /api/v1/teams/a-team/service-accounts", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/service-accounts")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when getting the service accounts succeeds", func() {
+				BeforeEach(func() {
+					dbTeam.ServiceAccountsReturns([]atc.ServiceAccount{
+						{
+							Name: "deploy-bot",
+							Tokens: []atc.ServiceAccountToken{
+								{
+									Name:      "ci",
+									Role:      "member",
+									Pipeline:  "some-pipeline",
+									ExpiresAt: 200,
+									CreatedAt: 100,
+								},
+							},
+						},
+					}, nil)
+				})
+
+				It("returns 200 OK with the service accounts", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"name": "deploy-bot",
+							"tokens": [
+								{
+									"name": "ci",
+									"role": "member",
+									"pipeline": "some-pipeline",
+									"expires_at": 200,
+									"created_at": 100
+								}
+							]
+						}
+					]`))
+				})
+			})
+
+			Context("when getting the service accounts fails", func() {
+				BeforeEach(func() {
+					dbTeam.ServiceAccountsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/a-team/service-accounts/deploy-bot", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/service-accounts/deploy-bot", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			It("does not create the service account", func() {
+				Expect(dbTeam.CreateServiceAccountCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the service account is created", func() {
+				BeforeEach(func() {
+					dbTeam.CreateServiceAccountReturns(true, nil)
+				})
+
+				It("returns 201 Created", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+					Expect(dbTeam.CreateServiceAccountArgsForCall(0)).To(Equal("deploy-bot"))
+				})
+			})
+
+			Context("when the service account already exists", func() {
+				BeforeEach(func() {
+					dbTeam.CreateServiceAccountReturns(false, nil)
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+			})
+
+			Context("when creating the service account fails", func() {
+				BeforeEach(func() {
+					dbTeam.CreateServiceAccountReturns(false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/a-team/service-accounts/deploy-bot", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/a-team/service-accounts/deploy-bot", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the service account exists", func() {
+				BeforeEach(func() {
+					dbTeam.DestroyServiceAccountReturns(true, nil)
+				})
+
+				It("destroys the service account", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+					Expect(dbTeam.DestroyServiceAccountArgsForCall(0)).To(Equal("deploy-bot"))
+				})
+			})
+
+			Context("when the service account does not exist", func() {
+				BeforeEach(func() {
+					dbTeam.DestroyServiceAccountReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+
+	Describe("POST /api/v1/teams/a-team/service-accounts/deploy-bot/tokens", func() {
+		var (
+			request  atc.ServiceAccountToken
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			request = atc.ServiceAccountToken{
+				Name:      "ci",
+				Role:      "member",
+				Pipeline:  "some-pipeline",
+				ExpiresAt: time.Now().Add(time.Hour).Unix(),
+			}
+		})
+
+		JustBeforeEach(func() {
+			payload, err := json.Marshal(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Post(server.URL+"/api/v1/teams/a-team/service-accounts/deploy-bot/tokens", "application/json", bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			It("does not create a token", func() {
+				Expect(dbTeam.CreateServiceAccountTokenCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the token is created", func() {
+				BeforeEach(func() {
+					dbTeam.CreateServiceAccountTokenReturns(true, nil)
+				})
+
+				It("returns 201 Created with the token", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					var created atc.ServiceAccountToken
+					err := json.NewDecoder(response.Body).Decode(&created)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(created.Name).To(Equal("ci"))
+					Expect(created.Role).To(Equal("member"))
+					Expect(created.Pipeline).To(Equal("some-pipeline"))
+					Expect(token.IsAPIToken(created.Token)).To(BeTrue())
+				})
+
+				It("stores only the hash of the token", func() {
+					var created atc.ServiceAccountToken
+					err := json.NewDecoder(response.Body).Decode(&created)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(dbTeam.CreateServiceAccountTokenCallCount()).To(Equal(1))
+					accountName, accountToken, tokenHash := dbTeam.CreateServiceAccountTokenArgsForCall(0)
+					Expect(accountName).To(Equal("deploy-bot"))
+					Expect(accountToken).To(Equal(request))
+					Expect(tokenHash).To(Equal(token.HashAPIToken(created.Token)))
+				})
+			})
+
+			Context("when the token is invalid", func() {
+				BeforeEach(func() {
+					request.Role = "bogus"
+				})
+
+				It("returns 400 with the error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(Equal("unknown role 'bogus'"))
+				})
+
+				It("does not create a token", func() {
+					Expect(dbTeam.CreateServiceAccountTokenCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the service account or pipeline does not exist", func() {
+				BeforeEach(func() {
+					dbTeam.CreateServiceAccountTokenReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when a token with the name already exists", func() {
+				BeforeEach(func() {
+					dbTeam.CreateServiceAccountTokenReturns(false, db.ErrServiceAccountTokenExists)
+				})
+
+				It("returns 409", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+				})
+			})
+
+			Context("when creating the token fails", func() {
+				BeforeEach(func() {
+					dbTeam.CreateServiceAccountTokenReturns(false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/a-team/service-accounts/deploy-bot/tokens/ci", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/a-team/service-accounts/deploy-bot/tokens/ci", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the token exists", func() {
+				BeforeEach(func() {
+					dbTeam.RevokeServiceAccountTokenReturns(true, nil)
+				})
+
+				It("revokes the token", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+					accountName, tokenName := dbTeam.RevokeServiceAccountTokenArgsForCall(0)
+					Expect(accountName).To(Equal("deploy-bot"))
+					Expect(tokenName).To(Equal("ci"))
+				})
+			})
+
+			Context("when the token does not exist", func() {
+				BeforeEach(func() {
+					dbTeam.RevokeServiceAccountTokenReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+})
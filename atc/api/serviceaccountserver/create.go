@@ -0,0 +1,35 @@
+package serviceaccountserver
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) CreateServiceAccount(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accountName := r.FormValue(":service_account_name")
+
+		hLog := s.logger.Session("create-service-account", lager.Data{
+			"team":            team.Name(),
+			"service-account": accountName,
+		})
+
+		created, err := team.CreateServiceAccount(accountName)
+		if err != nil {
+			hLog.Error("failed-to-create-service-account", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !created {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		hLog.Info("created")
+
+		w.WriteHeader(http.StatusCreated)
+	})
+}
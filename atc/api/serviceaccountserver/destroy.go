@@ -0,0 +1,35 @@
+package serviceaccountserver
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) DestroyServiceAccount(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accountName := r.FormValue(":service_account_name")
+
+		hLog := s.logger.Session("destroy-service-account", lager.Data{
+			"team":            team.Name(),
+			"service-account": accountName,
+		})
+
+		destroyed, err := team.DestroyServiceAccount(accountName)
+		if err != nil {
+			hLog.Error("failed-to-destroy-service-account", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !destroyed {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		hLog.Info("destroyed")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,28 @@
+package serviceaccountserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListServiceAccounts(team db.Team) http.Handler {
+	hLog := s.logger.Session("list-service-accounts")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accounts, err := team.ServiceAccounts()
+		if err != nil {
+			hLog.Error("failed-to-get-service-accounts", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(accounts)
+		if err != nil {
+			hLog.Error("failed-to-encode-service-accounts", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package serviceaccountserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/skymarshal/token"
+)
+
+func (s *Server) CreateServiceAccountToken(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accountName := r.FormValue(":service_account_name")
+
+		hLog := s.logger.Session("create-service-account-token", lager.Data{
+			"team":            team.Name(),
+			"service-account": accountName,
+		})
+
+		var accountToken atc.ServiceAccountToken
+		err := json.NewDecoder(r.Body).Decode(&accountToken)
+		if err != nil {
+			hLog.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		err = accountToken.Validate()
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "%s", err.Error())
+			return
+		}
+
+		apiToken := token.NewAPIToken()
+
+		created, err := team.CreateServiceAccountToken(accountName, accountToken, token.HashAPIToken(apiToken))
+		if err != nil {
+			if err == db.ErrServiceAccountTokenExists {
+				w.WriteHeader(http.StatusConflict)
+				fmt.Fprintf(w, "%s", err.Error())
+				return
+			}
+
+			hLog.Error("failed-to-create-service-account-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !created {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		hLog.Info("created", lager.Data{"token": accountToken.Name})
+
+		accountToken.Token = apiToken
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		err = json.NewEncoder(w).Encode(accountToken)
+		if err != nil {
+			hLog.Error("failed-to-encode-service-account-token", err)
+		}
+	})
+}
+
+func (s *Server) RevokeServiceAccountToken(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accountName := r.FormValue(":service_account_name")
+		tokenName := r.FormValue(":token_name")
+
+		hLog := s.logger.Session("revoke-service-account-token", lager.Data{
+			"team":            team.Name(),
+			"service-account": accountName,
+			"token":           tokenName,
+		})
+
+		revoked, err := team.RevokeServiceAccountToken(accountName, tokenName)
+		if err != nil {
+			hLog.Error("failed-to-revoke-service-account-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !revoked {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		hLog.Info("revoked")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
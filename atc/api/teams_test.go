@@ -106,17 +106,20 @@ var _ = Describe("Teams API", func() {
  					{
  						"id": 5,
  						"name": "avengers",
-						"auth": { "owner":{"users":["local:username"],"groups":[]}}
+						"auth": { "owner":{"users":["local:username"],"groups":[]}},
+						"default_job_priority": 0
  					},
  					{
  						"id": 9,
  						"name": "aliens",
-						"auth": { "owner":{"users":["local:username"],"groups":[]}}
+						"auth": { "owner":{"users":["local:username"],"groups":[]}},
+						"default_job_priority": 0
 					},
  					{
  						"id": 22,
  						"name": "predators",
-						"auth": { "owner":{"users":["local:username"],"groups":[]}}
+						"auth": { "owner":{"users":["local:username"],"groups":[]}},
+						"default_job_priority": 0
 					}
  				]`))
 			})
@@ -141,12 +144,14 @@ var _ = Describe("Teams API", func() {
  					{
  						"id": 5,
  						"name": "avengers",
-						"auth": { "owner":{"users":["local:username"],"groups":[]}}
+						"auth": { "owner":{"users":["local:username"],"groups":[]}},
+						"default_job_priority": 0
  					},
  					{
  						"id": 22,
  						"name": "predators",
-						"auth": { "owner":{"users":["local:username"],"groups":[]}}
+						"auth": { "owner":{"users":["local:username"],"groups":[]}},
+						"default_job_priority": 0
  					}
  				]`))
 			})
@@ -233,7 +238,8 @@ var _ = Describe("Teams API", func() {
 								"local:username"
 							]
 						}
-					}
+					},
+					"default_job_priority": 0
 				}`))
 			})
 		})
@@ -269,7 +275,8 @@ var _ = Describe("Teams API", func() {
 								"local:username"
 							]
 						}
-					}
+					},
+					"default_job_priority": 0
 				}`))
 			})
 		})
@@ -339,6 +346,38 @@ var _ = Describe("Teams API", func() {
 						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
 					})
 				})
+
+				Context("when a default job priority is given", func() {
+					BeforeEach(func() {
+						priority := 5
+						atcTeam.DefaultJobPriority = &priority
+					})
+
+					It("updates the default job priority", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeTeam.UpdateDefaultJobPriorityCallCount()).To(Equal(1))
+						Expect(fakeTeam.UpdateDefaultJobPriorityArgsForCall(0)).To(Equal(5))
+					})
+				})
+
+				Context("when no default job priority is given", func() {
+					It("leaves the default job priority alone", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeTeam.UpdateDefaultJobPriorityCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when updating the default job priority fails", func() {
+					BeforeEach(func() {
+						priority := 5
+						atcTeam.DefaultJobPriority = &priority
+						fakeTeam.UpdateDefaultJobPriorityReturns(errors.New("nope"))
+					})
+
+					It("returns 500 Internal Server error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
 			})
 		}
 
@@ -778,4 +817,110 @@ var _ = Describe("Teams API", func() {
 			})
 		})
 	})
+
+	Describe("GET /api/v1/teams/:team_name/queue", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/queue")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when the team does not exist", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the team exists", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				})
+
+				Context("when getting the queue succeeds", func() {
+					BeforeEach(func() {
+						build1 := new(dbfakes.FakeBuild)
+						build1.IDReturns(4)
+						build1.NameReturns("2")
+						build1.JobNameReturns("hotfix")
+						build1.PipelineNameReturns("some-pipeline")
+						build1.TeamNameReturns("some-team")
+						build1.StatusReturns(db.BuildStatusPending)
+						build1.PriorityReturns(10)
+
+						build2 := new(dbfakes.FakeBuild)
+						build2.IDReturns(3)
+						build2.NameReturns("1")
+						build2.JobNameReturns("fuzz")
+						build2.PipelineNameReturns("other-pipeline")
+						build2.TeamNameReturns("some-team")
+						build2.StatusReturns(db.BuildStatusPending)
+
+						fakeTeam.PendingBuildQueueReturns([]db.Build{build1, build2}, nil)
+					})
+
+					It("returns the builds in queue order", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`[
+							{
+								"id": 4,
+								"name": "2",
+								"job_name": "hotfix",
+								"pipeline_name": "some-pipeline",
+								"team_name": "some-team",
+								"status": "pending",
+								"api_url": "/api/v1/builds/4",
+								"priority": 10
+							},
+							{
+								"id": 3,
+								"name": "1",
+								"job_name": "fuzz",
+								"pipeline_name": "other-pipeline",
+								"team_name": "some-team",
+								"status": "pending",
+								"api_url": "/api/v1/builds/3"
+							}
+						]`))
+					})
+				})
+
+				Context("when getting the queue fails", func() {
+					BeforeEach(func() {
+						fakeTeam.PendingBuildQueueReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
 })
@@ -0,0 +1,48 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+)
+
+func (s *Server) ListTeamQueue(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-team-queue")
+
+	teamName := r.FormValue(":team_name")
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		logger.Error("failed-to-find-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	builds, err := team.PendingBuildQueue()
+	if err != nil {
+		logger.Error("failed-to-get-pending-build-queue", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	presented := []atc.Build{}
+	for _, build := range builds {
+		presented = append(presented, present.Build(build))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(presented)
+	if err != nil {
+		logger.Error("failed-to-encode-builds", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -50,6 +50,15 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		if atcTeam.DefaultJobPriority != nil {
+			err = team.UpdateDefaultJobPriority(*atcTeam.DefaultJobPriority)
+			if err != nil {
+				hLog.Error("failed-to-update-team", err, lager.Data{"teamName": teamName})
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 	} else if acc.IsAdmin() {
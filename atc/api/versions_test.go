@@ -206,6 +206,40 @@ var _ = Describe("Versions API", func() {
 							]`))
 						})
 					})
+
+					Context("when filtering on fields", func() {
+						BeforeEach(func() {
+							queryParams = "?match=some:meta*"
+
+							fakeResource.FilteredVersionsReturns([]atc.ResourceVersion{
+								{
+									ID:       4,
+									Enabled:  true,
+									Version:  atc.Version{"some": "metaversion"},
+									Metadata: []atc.MetadataField{{Name: "some", Value: "metadata"}},
+								},
+								{
+									ID:       2,
+									Enabled:  true,
+									Version:  atc.Version{"other": "version"},
+									Metadata: []atc.MetadataField{{Name: "some", Value: "metadata"}},
+								},
+							}, nil)
+						})
+
+						It("leaves out versions which only match on their metadata", func() {
+							body, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(body).To(MatchJSON(`[
+								{
+									"id": 4,
+									"enabled": true,
+									"version": {"some":"metaversion"}
+								}
+							]`))
+						})
+					})
 				})
 			})
 		})
@@ -325,6 +359,59 @@ var _ = Describe("Versions API", func() {
 					})
 				})
 
+				Context("when a version filter is given", func() {
+					BeforeEach(func() {
+						queryParams = "?limit=2&match=tag:v1.*&semver=%3E%3D1.1&semver_field=tag"
+
+						fakeResource.FilteredVersionsReturns([]atc.ResourceVersion{
+							{ID: 4, Version: atc.Version{"tag": "v1.2.0"}, Enabled: true},
+						}, nil)
+					})
+
+					It("filters the versions", func() {
+						Expect(fakeResource.VersionsCallCount()).To(BeZero())
+						Expect(fakeResource.FilteredVersionsCallCount()).To(Equal(1))
+
+						filter, limit := fakeResource.FilteredVersionsArgsForCall(0)
+						Expect(filter).To(Equal(atc.VersionFilter{
+							Fields:      map[string]string{"tag": "v1.*"},
+							Semver:      ">=1.1",
+							SemverField: "tag",
+						}))
+						Expect(limit).To(Equal(2))
+					})
+
+					It("returns the matching versions", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`[{"id": 4, "version": {"tag": "v1.2.0"}, "enabled": true}]`))
+					})
+
+					Context("when the filter is invalid", func() {
+						BeforeEach(func() {
+							queryParams = "?semver=nope"
+						})
+
+						It("returns 400", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							Expect(fakeResource.FilteredVersionsCallCount()).To(BeZero())
+						})
+					})
+
+					Context("when filtering fails", func() {
+						BeforeEach(func() {
+							fakeResource.FilteredVersionsReturns(nil, errors.New("oops"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+				})
+
 				Context("when getting the versions succeeds", func() {
 					var returnedVersions []atc.ResourceVersion
 
@@ -784,6 +871,201 @@ var _ = Describe("Versions API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_version_id/labels/:label", func() {
+		var response *http.Response
+		var fakeResource *dbfakes.FakeResource
+		var label string
+
+		BeforeEach(func() {
+			label = "deployed-to-prod"
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/versions/42/labels/"+label, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when authorized", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(true)
+					fakeaccess.UserNameReturns("some-user")
+				})
+
+				Context("when finding the resource succeeds", func() {
+					BeforeEach(func() {
+						fakeResource = new(dbfakes.FakeResource)
+						fakeResource.IDReturns(1)
+						fakePipeline.ResourceReturns(fakeResource, true, nil)
+					})
+
+					It("labels the right resource config version as the user", func() {
+						Expect(fakeResource.AddVersionLabelCallCount()).To(Equal(1))
+						resourceConfigVersionID, addedLabel, setBy := fakeResource.AddVersionLabelArgsForCall(0)
+						Expect(resourceConfigVersionID).To(Equal(42))
+						Expect(addedLabel).To(Equal("deployed-to-prod"))
+						Expect(setBy).To(Equal("some-user"))
+					})
+
+					Context("when labelling the version succeeds", func() {
+						BeforeEach(func() {
+							fakeResource.AddVersionLabelReturns(true, nil)
+						})
+
+						It("returns 200", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+						})
+					})
+
+					Context("when the version does not exist", func() {
+						BeforeEach(func() {
+							fakeResource.AddVersionLabelReturns(false, nil)
+						})
+
+						It("returns 404", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+						})
+					})
+
+					Context("when labelling the version fails", func() {
+						BeforeEach(func() {
+							fakeResource.AddVersionLabelReturns(false, errors.New("welp"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+
+					Context("when the label contains whitespace", func() {
+						BeforeEach(func() {
+							label = "deployed%20to%20prod"
+						})
+
+						It("returns 400", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						})
+
+						It("does not label the version", func() {
+							Expect(fakeResource.AddVersionLabelCallCount()).To(BeZero())
+						})
+					})
+				})
+
+				Context("when the resource is not found", func() {
+					BeforeEach(func() {
+						fakePipeline.ResourceReturns(nil, false, nil)
+					})
+
+					It("returns not found", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+			})
+
+			Context("when not authorized", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns Forbidden", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_version_id/labels/:label", func() {
+		var response *http.Response
+		var fakeResource *dbfakes.FakeResource
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/versions/42/labels/deployed-to-prod", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+
+				fakeResource = new(dbfakes.FakeResource)
+				fakePipeline.ResourceReturns(fakeResource, true, nil)
+			})
+
+			It("removes the label from the right resource config version", func() {
+				Expect(fakeResource.RemoveVersionLabelCallCount()).To(Equal(1))
+				resourceConfigVersionID, removedLabel := fakeResource.RemoveVersionLabelArgsForCall(0)
+				Expect(resourceConfigVersionID).To(Equal(42))
+				Expect(removedLabel).To(Equal("deployed-to-prod"))
+			})
+
+			Context("when removing the label succeeds", func() {
+				BeforeEach(func() {
+					fakeResource.RemoveVersionLabelReturns(true, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+			})
+
+			Context("when the version does not carry the label", func() {
+				BeforeEach(func() {
+					fakeResource.RemoveVersionLabelReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when removing the label fails", func() {
+				BeforeEach(func() {
+					fakeResource.RemoveVersionLabelReturns(false, errors.New("welp"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_version_id/input_to", func() {
 		var response *http.Response
 		var stringVersionID string
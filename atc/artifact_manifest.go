@@ -0,0 +1,81 @@
+package atc
+
+import (
+	"os"
+	"sort"
+)
+
+// IncrementalArtifactContentType is the content type of an artifact upload
+// consisting of the artifact's JSON manifest followed by a zstd-compressed tar
+// of the entries missing from the base artifact.
+const IncrementalArtifactContentType = "application/vnd.concourse.incremental-artifact"
+
+// ArtifactManifest describes the contents of an artifact entry by entry, keyed
+// by their path relative to the artifact's root. It allows an upload to only
+// include what changed since a previous artifact.
+type ArtifactManifest map[string]ArtifactManifestEntry
+
+type ArtifactManifestEntry struct {
+	Mode os.FileMode `json:"mode"`
+
+	// The sha256 of a regular file's contents.
+	Digest string `json:"digest,omitempty"`
+
+	// The target of a symlink.
+	Link string `json:"link,omitempty"`
+}
+
+// ArtifactDiff lists the entries of a manifest which a previous artifact does
+// not already have.
+type ArtifactDiff struct {
+	Missing []string `json:"missing"`
+}
+
+// Unchanged returns whether the entry at path in the base manifest can be
+// reused as-is. Directories are reused as long as they still exist.
+func (manifest ArtifactManifest) Unchanged(base ArtifactManifest, path string) bool {
+	entry, found := manifest[path]
+	if !found {
+		return false
+	}
+
+	baseEntry, found := base[path]
+	if !found {
+		return false
+	}
+
+	if entry.Mode.IsDir() && baseEntry.Mode.IsDir() {
+		return true
+	}
+
+	return entry == baseEntry
+}
+
+// Missing returns the sorted paths of the entries that have to be uploaded on
+// top of the base manifest.
+func (manifest ArtifactManifest) Missing(base ArtifactManifest) []string {
+	missing := []string{}
+	for path := range manifest {
+		if !manifest.Unchanged(base, path) {
+			missing = append(missing, path)
+		}
+	}
+
+	sort.Strings(missing)
+
+	return missing
+}
+
+// Removes returns whether any entry of the base manifest is gone from the
+// manifest or changed its type, which an upload on top of the base artifact
+// cannot express.
+func (manifest ArtifactManifest) Removes(base ArtifactManifest) bool {
+	for path, baseEntry := range base {
+		entry, found := manifest[path]
+		if !found || entry.Mode.Type() != baseEntry.Mode.Type() {
+			return true
+		}
+	}
+
+	return false
+}
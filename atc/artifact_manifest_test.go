@@ -0,0 +1,91 @@
+package atc_test
+
+import (
+	"os"
+
+	. "github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ArtifactManifest", func() {
+	var base ArtifactManifest
+
+	BeforeEach(func() {
+		base = ArtifactManifest{
+			"some-dir":              {Mode: os.ModeDir | 0755},
+			"some-dir/some-file":    {Mode: 0644, Digest: "some-digest"},
+			"some-dir/changed-file": {Mode: 0644, Digest: "old-digest"},
+			"some-dir/chmodded":     {Mode: 0644, Digest: "same-digest"},
+			"some-link":             {Mode: os.ModeSymlink | 0777, Link: "some-dir"},
+			"removed-file":          {Mode: 0644, Digest: "removed-digest"},
+		}
+	})
+
+	Describe("Missing", func() {
+		It("returns the entries that are new or changed", func() {
+			manifest := ArtifactManifest{
+				"some-dir":              {Mode: os.ModeDir | 0700},
+				"some-dir/some-file":    {Mode: 0644, Digest: "some-digest"},
+				"some-dir/changed-file": {Mode: 0644, Digest: "new-digest"},
+				"some-dir/chmodded":     {Mode: 0755, Digest: "same-digest"},
+				"some-link":             {Mode: os.ModeSymlink | 0777, Link: "some-dir"},
+				"new-dir":               {Mode: os.ModeDir | 0755},
+				"new-dir/new-file":      {Mode: 0644, Digest: "new-file-digest"},
+			}
+
+			Expect(manifest.Missing(base)).To(Equal([]string{
+				"new-dir",
+				"new-dir/new-file",
+				"some-dir/changed-file",
+				"some-dir/chmodded",
+			}))
+		})
+
+		It("returns everything when there is no base", func() {
+			Expect(base.Missing(nil)).To(HaveLen(len(base)))
+		})
+	})
+
+	Describe("Unchanged", func() {
+		It("does not reuse entries that were removed", func() {
+			Expect(ArtifactManifest{}.Unchanged(base, "removed-file")).To(BeFalse())
+		})
+
+		It("does not reuse a directory that became a file", func() {
+			manifest := ArtifactManifest{"some-dir": {Mode: 0644, Digest: "some-digest"}}
+			Expect(manifest.Unchanged(base, "some-dir")).To(BeFalse())
+		})
+	})
+
+	Describe("Removes", func() {
+		var manifest ArtifactManifest
+
+		BeforeEach(func() {
+			manifest = ArtifactManifest{}
+			for path, entry := range base {
+				manifest[path] = entry
+			}
+		})
+
+		It("is false when only contents and modes change", func() {
+			manifest["some-dir/changed-file"] = ArtifactManifestEntry{Mode: 0755, Digest: "new-digest"}
+			manifest["new-file"] = ArtifactManifestEntry{Mode: 0644, Digest: "new-file-digest"}
+
+			Expect(manifest.Removes(base)).To(BeFalse())
+		})
+
+		It("is true when an entry is removed", func() {
+			delete(manifest, "removed-file")
+
+			Expect(manifest.Removes(base)).To(BeTrue())
+		})
+
+		It("is true when an entry changes its type", func() {
+			manifest["some-link"] = ArtifactManifestEntry{Mode: os.ModeDir | 0755}
+
+			Expect(manifest.Removes(base)).To(BeTrue())
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
 	_ "net/http/pprof"
@@ -42,6 +43,7 @@ import (
 	"github.com/concourse/concourse/atc/radar"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/scheduler"
+	"github.com/concourse/concourse/atc/scheduler/inputmapper"
 	"github.com/concourse/concourse/atc/syslog"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/image"
@@ -61,6 +63,7 @@ import (
 	"github.com/tedsuo/ifrit/sigmon"
 	"golang.org/x/crypto/acme"
 	"golang.org/x/crypto/acme/autocert"
+	"sigs.k8s.io/yaml"
 
 	// dynamically registered metric emitters
 	_ "github.com/concourse/concourse/atc/metric/emitter"
@@ -132,6 +135,8 @@ type RunCommand struct {
 	MaxActiveTasksPerWorker           int           `long:"max-active-tasks-per-worker" default:"0" description:"Maximum allowed number of active build tasks per worker. Has effect only when used with limit-active-tasks placement strategy. 0 means no limit."`
 	BaggageclaimResponseHeaderTimeout time.Duration `long:"baggageclaim-response-header-timeout" default:"1m" description:"How long to wait for Baggageclaim to send the response header."`
 
+	MeasureTaskOutputSizes bool `long:"measure-task-output-sizes" description:"Measure the size of each task output with du once the task exits. Tasks whose images lack du report no output sizes."`
+
 	GardenRequestTimeout time.Duration `long:"garden-request-timeout" default:"5m" description:"How long to wait for requests to Garden to complete. 0 means no timeout."`
 
 	CLIArtifactsDir flag.Dir `long:"cli-artifacts-dir" description:"Directory containing downloadable CLI binaries."`
@@ -167,6 +172,9 @@ type RunCommand struct {
 		OneOffBuildGracePeriod time.Duration `long:"one-off-grace-period" default:"5m" description:"Period after which one-off build containers will be garbage-collected."`
 		MissingGracePeriod     time.Duration `long:"missing-grace-period" default:"5m" description:"Period after which to reap containers and volumes that were created but went missing from the worker."`
 		CheckRecyclePeriod     time.Duration `long:"check-recycle-period" default:"6h" description:"Period after which to reap checks that are completed."`
+
+		MaxDebugContainers        int `long:"max-debug-containers" default:"10" description:"Maximum number of failed task containers kept around for debugging across the cluster. 0 means unlimited."`
+		MaxDebugContainersPerTeam int `long:"max-debug-containers-per-team" default:"2" description:"Maximum number of failed task containers kept around for debugging per team. 0 means unlimited."`
 	} `group:"Garbage Collection" namespace:"gc"`
 
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
@@ -179,9 +187,13 @@ type RunCommand struct {
 	DefaultDaysToRetainBuildLogs uint64 `long:"default-days-to-retain-build-logs" description:"Default days to retain build logs. 0 means unlimited"`
 	MaxDaysToRetainBuildLogs     uint64 `long:"max-days-to-retain-build-logs" description:"Maximum days to retain build logs, 0 means not specified. Will override values configured in jobs"`
 
+	MaxBuildDuration time.Duration `long:"max-build-duration" description:"Maximum duration of a build, including its hooks. 0 means unlimited. Will override longer timeouts configured in jobs"`
+
 	DefaultCpuLimit    *int    `long:"default-task-cpu-limit" description:"Default max number of cpu shares per task, 0 means unlimited"`
 	DefaultMemoryLimit *string `long:"default-task-memory-limit" description:"Default maximum memory per task, 0 means unlimited"`
 
+	TaskNetworkPolicy flag.File `long:"task-network-policy" description:"Path to a YAML file mapping team names to the network access their tasks may request. The '*' entry applies to all other teams."`
+
 	Auditor struct {
 		EnableBuildAuditLog     bool `long:"enable-build-auditing" description:"Enable auditing for all api requests connected to builds."`
 		EnableContainerAuditLog bool `long:"enable-container-auditing" description:"Enable auditing for all api requests connected to containers."`
@@ -395,7 +407,18 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 
 	atc.EnableGlobalResources = cmd.EnableGlobalResources
 
+	atc.DebugContainers = atc.DebugContainerLimits{
+		Max:        cmd.GC.MaxDebugContainers,
+		MaxPerTeam: cmd.GC.MaxDebugContainersPerTeam,
+	}
+
+	atc.NetworkPolicies, err = cmd.loadNetworkPolicies()
+	if err != nil {
+		return nil, err
+	}
+
 	radar.GlobalResourceCheckTimeout = cmd.GlobalResourceCheckTimeout
+	worker.MeasureTaskOutputSizes = cmd.MeasureTaskOutputSizes
 	//FIXME: These only need to run once for the entire binary. At the moment,
 	//they rely on state of the command.
 	db.SetupConnectionRetryingDriver(
@@ -537,6 +560,7 @@ func (cmd *RunCommand) constructAPIMembers(
 ) ([]grouper.Member, error) {
 	teamFactory := db.NewTeamFactory(dbConn, lockFactory)
 	userFactory := db.NewUserFactory(dbConn)
+	serviceAccountTokenFactory := db.NewServiceAccountTokenFactory(dbConn)
 
 	_, err := teamFactory.CreateDefaultTeamIfNotExists()
 	if err != nil {
@@ -560,6 +584,9 @@ func (cmd *RunCommand) constructAPIMembers(
 		ExternalURL: cmd.ExternalURL.String(),
 		HTTPClient:  httpClient,
 		Storage:     storage,
+
+		ServiceAccountTokenFactory: serviceAccountTokenFactory,
+		DeviceRequestFactory:       db.NewDeviceRequestFactory(dbConn),
 	})
 	if err != nil {
 		return nil, err
@@ -616,7 +643,7 @@ func (cmd *RunCommand) constructAPIMembers(
 	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod)
 	dbCheckFactory := db.NewCheckFactory(dbConn, lockFactory, secretManager, cmd.varSourcePool, cmd.GlobalResourceCheckTimeout)
 
-	accessFactory := accessor.NewAccessFactory(authHandler.PublicKey())
+	accessFactory := accessor.NewAccessFactory(authHandler.PublicKey(), serviceAccountTokenFactory)
 	customActionRoleMap := accessor.CustomActionRoleMap{}
 	err = accessor.ParseCustomActionRoleMap(cmd.ConfigRBAC, &customActionRoleMap)
 	if err != nil {
@@ -961,6 +988,7 @@ func (cmd *RunCommand) constructGCMember(
 	dbContainerRepository := db.NewContainerRepository(gcConn)
 	dbArtifactLifecycle := db.NewArtifactLifecycle(gcConn)
 	dbCheckLifecycle := db.NewCheckLifecycle(gcConn)
+	dbResourceConfigVersionLifecycle := db.NewResourceConfigVersionLifecycle(gcConn)
 	resourceConfigCheckSessionLifecycle := db.NewResourceConfigCheckSessionLifecycle(gcConn)
 	dbBuildFactory := db.NewBuildFactory(gcConn, lockFactory, cmd.GC.OneOffBuildGracePeriod)
 	resourceFactory := resource.NewResourceFactory()
@@ -1013,6 +1041,7 @@ func (cmd *RunCommand) constructGCMember(
 		atc.ComponentCollectorBuilds:            gc.NewBuildCollector(dbBuildFactory),
 		atc.ComponentCollectorWorkers:           gc.NewWorkerCollector(dbWorkerLifecycle),
 		atc.ComponentCollectorResourceConfigs:   gc.NewResourceConfigCollector(dbResourceConfigFactory),
+		atc.ComponentCollectorResourceVersions:  gc.NewResourceConfigVersionCollector(dbResourceConfigVersionLifecycle),
 		atc.ComponentCollectorResourceCaches:    gc.NewResourceCacheCollector(dbResourceCacheLifecycle),
 		atc.ComponentCollectorResourceCacheUses: gc.NewResourceCacheUseCollector(dbResourceCacheLifecycle),
 		atc.ComponentCollectorArtifacts:         gc.NewArtifactCollector(dbArtifactLifecycle),
@@ -1205,6 +1234,32 @@ func (cmd *RunCommand) parseDefaultLimits() (atc.ContainerLimits, error) {
 	})
 }
 
+func (cmd *RunCommand) loadNetworkPolicies() (atc.TaskNetworkPolicies, error) {
+	if cmd.TaskNetworkPolicy.Path() == "" {
+		return nil, nil
+	}
+
+	content, err := ioutil.ReadFile(cmd.TaskNetworkPolicy.Path())
+	if err != nil {
+		return nil, err
+	}
+
+	var policies atc.TaskNetworkPolicies
+	err = yaml.UnmarshalStrict(content, &policies)
+	if err != nil {
+		return nil, fmt.Errorf("malformed task network policy: %w", err)
+	}
+
+	for teamName, policy := range policies {
+		err = policy.Validate()
+		if err != nil {
+			return nil, fmt.Errorf("invalid task network policy for team '%s': %w", teamName, err)
+		}
+	}
+
+	return policies, nil
+}
+
 func (cmd *RunCommand) defaultBindIP() net.IP {
 	URL := cmd.BindIP.String()
 	if URL == "0.0.0.0" {
@@ -1437,6 +1492,9 @@ func (cmd *RunCommand) configureComponentIntervals(componentFactory db.Component
 			}, {
 				Name:     atc.ComponentCollectorResourceConfigs,
 				Interval: cmd.GC.Interval,
+			}, {
+				Name:     atc.ComponentCollectorResourceVersions,
+				Interval: cmd.GC.Interval,
 			}, {
 				Name:     atc.ComponentCollectorVolumes,
 				Interval: cmd.GC.Interval,
@@ -1486,7 +1544,7 @@ func (cmd *RunCommand) constructEngine(
 		cmd.EnableRedactSecrets,
 	)
 
-	return engine.NewEngine(stepBuilder)
+	return engine.NewEngine(stepBuilder, cmd.MaxBuildDuration)
 }
 
 func (cmd *RunCommand) constructHTTPHandler(
@@ -1605,6 +1663,7 @@ func (cmd *RunCommand) constructAPIHandler(
 		cmd.varSourcePool,
 		credsManagers,
 		containerserver.NewInterceptTimeoutFactory(cmd.InterceptIdleTimeout),
+		inputmapper.NewExplainer(),
 	)
 }
 
@@ -62,11 +62,14 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.BuildEvents,
 		atc.BuildResources,
 		atc.AbortBuild,
+		atc.ApproveBuild,
+		atc.RejectBuild,
 		atc.GetBuildPreparation,
 		atc.ListBuildsWithVersionAsInput,
 		atc.ListBuildsWithVersionAsOutput,
 		atc.CreateArtifact,
 		atc.GetArtifact,
+		atc.DiffArtifact,
 		atc.ListBuildArtifacts:
 		return a.EnableBuildAuditLog
 	case atc.ListContainers,
@@ -85,7 +88,9 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.PauseJob,
 		atc.UnpauseJob,
 		atc.JobBadge,
-		atc.MainJobBadge:
+		atc.MainJobBadge,
+		atc.ListJobTaskResourceUsage,
+		atc.ExplainJobInputs:
 		return a.EnableJobAuditLog
 	case atc.ListAllPipelines,
 		atc.ListPipelines,
@@ -94,6 +99,8 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.OrderPipelines,
 		atc.PausePipeline,
 		atc.UnpausePipeline,
+		atc.FreezePipeline,
+		atc.UnfreezePipeline,
 		atc.ExposePipeline,
 		atc.HidePipeline,
 		atc.RenamePipeline,
@@ -115,11 +122,14 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.EnableResourceVersion,
 		atc.DisableResourceVersion,
 		atc.PinResourceVersion,
+		atc.AddResourceVersionLabel,
+		atc.RemoveResourceVersionLabel,
 		atc.GetResourceCausality,
 		atc.GetCheck:
 		return a.EnableResourceAuditLog
 	case
 		atc.SaveConfig,
+		atc.DryRunConfig,
 		atc.GetConfig,
 		atc.GetCC,
 		atc.GetVersionsDB,
@@ -136,7 +146,15 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.RenameTeam,
 		atc.DestroyTeam,
 		atc.ListTeamBuilds,
-		atc.GetTeam:
+		atc.GetTeam,
+		atc.ListLocks,
+		atc.ListTeamQueue,
+		atc.ReleaseLock,
+		atc.ListServiceAccounts,
+		atc.CreateServiceAccount,
+		atc.DestroyServiceAccount,
+		atc.CreateServiceAccountToken,
+		atc.RevokeServiceAccountToken:
 		return a.EnableTeamAuditLog
 	case atc.RegisterWorker,
 		atc.LandWorker,
@@ -22,6 +22,38 @@ type Build struct {
 	StartTime    int64  `json:"start_time,omitempty"`
 	EndTime      int64  `json:"end_time,omitempty"`
 	ReapTime     int64  `json:"reap_time,omitempty"`
+
+	CreatedBy string        `json:"created_by,omitempty"`
+	Trigger   *BuildTrigger `json:"trigger,omitempty"`
+
+	AwaitingApproval bool           `json:"awaiting_approval,omitempty"`
+	Approval         *BuildApproval `json:"approval,omitempty"`
+
+	Priority int `json:"priority,omitempty"`
+
+	// DebugUntil is set while a failed task container of the build is being
+	// kept around for debugging.
+	DebugUntil int64 `json:"debug_until,omitempty"`
+}
+
+type BuildTriggerType string
+
+const (
+	BuildTriggerTypeManual    BuildTriggerType = "manual"
+	BuildTriggerTypeResource  BuildTriggerType = "resource"
+	BuildTriggerTypeScheduled BuildTriggerType = "scheduled"
+	BuildTriggerTypeOneOff    BuildTriggerType = "one-off"
+)
+
+// BuildTrigger describes why a build was created.
+type BuildTrigger struct {
+	Type BuildTriggerType `json:"type"`
+
+	// For builds triggered by a new version, the input whose version changed
+	// compared with the previous build.
+	Input    string  `json:"input,omitempty"`
+	Resource string  `json:"resource,omitempty"`
+	Version  Version `json:"version,omitempty"`
 }
 
 func (b Build) IsRunning() bool {
@@ -22,4 +22,13 @@ type ResourceVersion struct {
 	Metadata []MetadataField `json:"metadata,omitempty"`
 	Version  Version         `json:"version"`
 	Enabled  bool            `json:"enabled"`
+	Labels   []VersionLabel  `json:"labels,omitempty"`
+}
+
+// A VersionLabel marks a version of a resource, e.g. as deployed or approved.
+// Jobs can require their inputs to carry labels.
+type VersionLabel struct {
+	Label string `json:"label"`
+	SetBy string `json:"set_by,omitempty"`
+	SetAt int64  `json:"set_at"`
 }
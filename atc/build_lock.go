@@ -0,0 +1,19 @@
+package atc
+
+// Lock is a named lock shared by every pipeline in a team. Jobs declare the
+// locks their builds need with `locks:`.
+type Lock struct {
+	Name    string      `json:"name"`
+	Holder  *LockBuild  `json:"holder,omitempty"`
+	Waiters []LockBuild `json:"waiters"`
+}
+
+// LockBuild is a build holding or waiting for a lock. Since is when the lock
+// was acquired for the holder, and when the build was created for waiters.
+type LockBuild struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	JobName      string `json:"job_name"`
+	PipelineName string `json:"pipeline_name"`
+	Since        int64  `json:"since"`
+}
@@ -183,6 +183,23 @@ type ResourceConfig struct {
 	Tags         Tags    `json:"tags,omitempty"`
 	Version      Version `json:"version,omitempty"`
 	Icon         string  `json:"icon,omitempty"`
+
+	VersionRetention *VersionRetentionConfig `json:"version_retention,omitempty"`
+}
+
+// VersionRetentionConfig limits how much of a resource's version history is
+// kept. A version is kept if it is one of the Latest newest versions or if it
+// was saved less than Days days ago. Versions used by builds or pinned are
+// never pruned.
+type VersionRetentionConfig struct {
+	// Latest keeps the given number of most recent versions.
+	Latest int `json:"latest,omitempty"`
+
+	// Days keeps versions found within the given number of days. Versions
+	// which existed before their discovery time was recorded count as having
+	// been found when the database was migrated, so they are only pruned once
+	// that many days have passed since the upgrade.
+	Days int `json:"days,omitempty"`
 }
 
 type ResourceType struct {
@@ -235,10 +252,12 @@ type PlanSequence []PlanConfig
 
 // A VersionConfig represents the choice to include every version of a
 // resource, the latest version of a resource, or a pinned (specific) one.
+// Every and latest may be narrowed to the versions matching a filter.
 type VersionConfig struct {
 	Every  bool
 	Latest bool
 	Pinned Version
+	Filter *VersionFilter
 }
 
 func (c *VersionConfig) UnmarshalJSON(version []byte) error {
@@ -254,6 +273,23 @@ func (c *VersionConfig) UnmarshalJSON(version []byte) error {
 		c.Every = actual == "every"
 		c.Latest = actual == "latest"
 	case map[string]interface{}:
+		if _, isFilter := actual["filter"].(map[string]interface{}); isFilter && len(actual) == 1 {
+			var config struct {
+				Filter VersionFilter `json:"filter"`
+			}
+
+			err := json.Unmarshal(version, &config)
+			if err != nil {
+				return err
+			}
+
+			c.Filter = &config.Filter
+			c.Every = config.Filter.Every
+			c.Latest = !config.Filter.Every
+
+			return nil
+		}
+
 		version := Version{}
 
 		for k, v := range actual {
@@ -277,6 +313,10 @@ const VersionLatest = "latest"
 const VersionEvery = "every"
 
 func (c *VersionConfig) MarshalJSON() ([]byte, error) {
+	if c.Filter != nil {
+		return json.Marshal(map[string]*VersionFilter{"filter": c.Filter})
+	}
+
 	if c.Latest {
 		return json.Marshal(VersionLatest)
 	}
@@ -403,6 +443,10 @@ type PlanConfig struct {
 	Get string `json:"get,omitempty"`
 	// jobs that this resource must have made it through
 	Passed []string `json:"passed,omitempty"`
+	// jobs of which this resource must have made it through at least one
+	PassedAny []string `json:"passed_any,omitempty"`
+	// labels which the version of this resource must carry
+	Labeled []string `json:"labeled,omitempty"`
 	// whether to trigger based on this resource changing
 	Trigger bool `json:"trigger,omitempty"`
 
@@ -414,6 +458,8 @@ type PlanConfig struct {
 
 	// inputs to a put step either a list (e.g. [artifact-1, aritfact-2]) or all (e.g. all)
 	Inputs *InputsConfig `json:"inputs,omitempty"`
+	// labels to add to the version produced by a put step
+	AddLabels []string `json:"add_labels,omitempty"`
 
 	// corresponds to a Task plan
 	// name of 'task', e.g. unit, go1.3, go1.4
@@ -422,6 +468,8 @@ type PlanConfig struct {
 	Privileged bool `json:"privileged,omitempty"`
 	// inlined task config
 	TaskConfig *TaskConfig `json:"config,omitempty"`
+	// keep the task's container around for hijacking if it fails
+	DebugOnFailure string `json:"debug_on_failure,omitempty"`
 
 	// name of 'set_pipeline'
 	SetPipeline string   `json:"set_pipeline,omitempty"`
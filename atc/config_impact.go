@@ -0,0 +1,227 @@
+package atc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ConfigImpact describes what saving a new config for a pipeline would set in
+// motion, beyond the config itself changing.
+type ConfigImpact struct {
+	// TriggeredJobs are the jobs which may start new builds right away
+	// because their triggering inputs changed.
+	TriggeredJobs []ConfigImpactReason `json:"triggered_jobs,omitempty"`
+
+	// RecheckedResources are the resources which will be checked from
+	// scratch.
+	RecheckedResources []ConfigImpactReason `json:"rechecked_resources,omitempty"`
+
+	// ResetResources are the resources which lose some or all of their
+	// version history.
+	ResetResources []ConfigImpactReason `json:"reset_resources,omitempty"`
+}
+
+type ConfigImpactReason struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// Impact compares the config with the one replacing it. It only looks at the
+// configs, so it errs on the side of listing jobs which may not actually get
+// a new build, e.g. because the job or pipeline is paused or no version
+// satisfies the new input constraints yet.
+func (c Config) Impact(newConfig Config) ConfigImpact {
+	var impact ConfigImpact
+
+	changedTypes := changedResourceTypes(c.ResourceTypes, newConfig.ResourceTypes)
+
+	reset := map[string]string{}
+
+	for _, resource := range newConfig.Resources {
+		oldResource, found := c.Resources.Lookup(resource.Name)
+		if !found {
+			impact.RecheckedResources = append(impact.RecheckedResources, ConfigImpactReason{
+				Name:   resource.Name,
+				Reason: "resource has been added",
+			})
+
+			continue
+		}
+
+		reason := resourceResetReason(oldResource, resource, changedTypes)
+		if reason != "" {
+			reset[resource.Name] = reason
+
+			impact.ResetResources = append(impact.ResetResources, ConfigImpactReason{
+				Name:   resource.Name,
+				Reason: reason,
+			})
+
+			impact.RecheckedResources = append(impact.RecheckedResources, ConfigImpactReason{
+				Name:   resource.Name,
+				Reason: reason,
+			})
+
+			continue
+		}
+
+		if retentionTightened(oldResource.VersionRetention, resource.VersionRetention) {
+			impact.ResetResources = append(impact.ResetResources, ConfigImpactReason{
+				Name:   resource.Name,
+				Reason: "version retention will prune older versions",
+			})
+		}
+	}
+
+	for _, resource := range c.Resources {
+		if _, found := newConfig.Resources.Lookup(resource.Name); !found {
+			impact.ResetResources = append(impact.ResetResources, ConfigImpactReason{
+				Name:   resource.Name,
+				Reason: "resource has been removed",
+			})
+		}
+	}
+
+	for _, job := range newConfig.Jobs {
+		oldJob, found := c.Jobs.Lookup(job.Name)
+		if !found && job.OldName != "" {
+			oldJob, found = c.Jobs.Lookup(job.OldName)
+		}
+
+		var reasons []string
+		for _, input := range job.Inputs() {
+			if !input.Trigger {
+				continue
+			}
+
+			if !found {
+				reasons = append(reasons, fmt.Sprintf("input %s triggers the new job", input.Name))
+				continue
+			}
+
+			reason := inputTriggerReason(oldJob, input, reset)
+			if reason != "" {
+				reasons = append(reasons, reason)
+			}
+		}
+
+		if len(reasons) > 0 {
+			impact.TriggeredJobs = append(impact.TriggeredJobs, ConfigImpactReason{
+				Name:   job.Name,
+				Reason: strings.Join(reasons, "; "),
+			})
+		}
+	}
+
+	return impact
+}
+
+// changedResourceTypes returns the custom resource types whose resources get
+// new resource configs. Resource types can be based on each other, so a
+// change is inherited by the types based on the changed one.
+func changedResourceTypes(oldTypes ResourceTypes, newTypes ResourceTypes) map[string]string {
+	changed := map[string]string{}
+
+	var isChanged func(resourceType ResourceType, seen map[string]bool) string
+	isChanged = func(resourceType ResourceType, seen map[string]bool) string {
+		if reason, found := changed[resourceType.Name]; found {
+			return reason
+		}
+
+		oldType, found := oldTypes.Lookup(resourceType.Name)
+		if !found {
+			return fmt.Sprintf("resource type %s has been added", resourceType.Name)
+		}
+
+		if oldType.Type != resourceType.Type || practicallyDifferent(oldType.Source, resourceType.Source) ||
+			oldType.Privileged != resourceType.Privileged || oldType.UniqueVersionHistory != resourceType.UniqueVersionHistory {
+			return fmt.Sprintf("resource type %s has changed", resourceType.Name)
+		}
+
+		parent, found := newTypes.Lookup(resourceType.Type)
+		if !found || seen[parent.Name] {
+			return ""
+		}
+
+		seen[parent.Name] = true
+
+		return isChanged(parent, seen)
+	}
+
+	for _, resourceType := range newTypes {
+		if reason := isChanged(resourceType, map[string]bool{resourceType.Name: true}); reason != "" {
+			changed[resourceType.Name] = reason
+		}
+	}
+
+	for _, resourceType := range oldTypes {
+		if _, found := newTypes.Lookup(resourceType.Name); !found {
+			changed[resourceType.Name] = fmt.Sprintf("resource type %s has been removed", resourceType.Name)
+		}
+	}
+
+	return changed
+}
+
+func resourceResetReason(oldResource ResourceConfig, resource ResourceConfig, changedTypes map[string]string) string {
+	if oldResource.Type != resource.Type {
+		return "type has changed"
+	}
+
+	if practicallyDifferent(oldResource.Source, resource.Source) {
+		return "source has changed"
+	}
+
+	if reason, found := changedTypes[resource.Type]; found {
+		return reason
+	}
+
+	return ""
+}
+
+func retentionTightened(oldRetention *VersionRetentionConfig, retention *VersionRetentionConfig) bool {
+	if retention == nil {
+		return false
+	}
+
+	if oldRetention == nil {
+		return true
+	}
+
+	return tighterLimit(oldRetention.Latest, retention.Latest) || tighterLimit(oldRetention.Days, retention.Days)
+}
+
+// tighterLimit compares two limits where zero means no limit.
+func tighterLimit(oldLimit int, limit int) bool {
+	return limit != 0 && (oldLimit == 0 || limit < oldLimit)
+}
+
+func inputTriggerReason(oldJob JobConfig, input JobInput, reset map[string]string) string {
+	var oldInput JobInput
+	var found bool
+	for _, candidate := range oldJob.Inputs() {
+		if candidate.Name == input.Name {
+			oldInput, found = candidate, true
+			break
+		}
+	}
+
+	switch {
+	case !found:
+		return fmt.Sprintf("input %s has been added", input.Name)
+	case !oldInput.Trigger:
+		return fmt.Sprintf("input %s now triggers builds", input.Name)
+	case oldInput.Resource != input.Resource:
+		return fmt.Sprintf("input %s now uses resource %s", input.Name, input.Resource)
+	case practicallyDifferent(oldInput.Passed, input.Passed) || practicallyDifferent(oldInput.PassedAny, input.PassedAny):
+		return fmt.Sprintf("passed constraints of input %s have changed", input.Name)
+	case practicallyDifferent(oldInput.Version, input.Version) || practicallyDifferent(oldInput.Labeled, input.Labeled):
+		return fmt.Sprintf("version of input %s has changed", input.Name)
+	}
+
+	if reason, found := reset[input.Resource]; found {
+		return fmt.Sprintf("resource %s of input %s will be rechecked: %s", input.Resource, input.Name, reason)
+	}
+
+	return ""
+}
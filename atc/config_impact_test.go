@@ -0,0 +1,174 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config Impact", func() {
+	var (
+		oldConfig atc.Config
+		newConfig atc.Config
+	)
+
+	BeforeEach(func() {
+		oldConfig = atc.Config{
+			ResourceTypes: atc.ResourceTypes{
+				{Name: "base-type", Type: "registry-image", Source: atc.Source{"repository": "base"}},
+				{Name: "derived-type", Type: "base-type"},
+			},
+			Resources: atc.ResourceConfigs{
+				{Name: "some-resource", Type: "git", Source: atc.Source{"uri": "some-uri"}},
+				{Name: "custom-resource", Type: "derived-type"},
+				{Name: "removed-resource", Type: "git"},
+			},
+			Jobs: atc.JobConfigs{
+				{
+					Name: "some-job",
+					Plan: atc.PlanSequence{
+						{Get: "some-resource", Trigger: true},
+						{Get: "custom-resource"},
+					},
+				},
+				{
+					Name: "downstream-job",
+					Plan: atc.PlanSequence{
+						{Get: "some-resource", Trigger: true, Passed: []string{"some-job"}},
+					},
+				},
+			},
+		}
+
+		newConfig = oldConfig
+	})
+
+	It("has no impact when nothing changed", func() {
+		Expect(oldConfig.Impact(newConfig)).To(Equal(atc.ConfigImpact{}))
+	})
+
+	It("rechecks added resources", func() {
+		newConfig.Resources = append(newConfig.Resources, atc.ResourceConfig{Name: "new-resource", Type: "git"})
+
+		Expect(oldConfig.Impact(newConfig).RecheckedResources).To(ContainElement(atc.ConfigImpactReason{
+			Name:   "new-resource",
+			Reason: "resource has been added",
+		}))
+	})
+
+	It("resets removed resources", func() {
+		newConfig.Resources = newConfig.Resources[:2]
+
+		Expect(oldConfig.Impact(newConfig).ResetResources).To(Equal([]atc.ConfigImpactReason{
+			{Name: "removed-resource", Reason: "resource has been removed"},
+		}))
+	})
+
+	It("prunes the history of resources whose version retention is tightened", func() {
+		newConfig.Resources = atc.ResourceConfigs{
+			{Name: "some-resource", Type: "git", Source: atc.Source{"uri": "some-uri"}, VersionRetention: &atc.VersionRetentionConfig{Latest: 10}},
+			oldConfig.Resources[1],
+			oldConfig.Resources[2],
+		}
+
+		impact := oldConfig.Impact(newConfig)
+		Expect(impact.ResetResources).To(Equal([]atc.ConfigImpactReason{
+			{Name: "some-resource", Reason: "version retention will prune older versions"},
+		}))
+		Expect(impact.RecheckedResources).To(BeEmpty())
+		Expect(impact.TriggeredJobs).To(BeEmpty())
+	})
+
+	Context("when a resource's source changes", func() {
+		BeforeEach(func() {
+			newConfig.Resources = atc.ResourceConfigs{
+				{Name: "some-resource", Type: "git", Source: atc.Source{"uri": "other-uri"}},
+				oldConfig.Resources[1],
+				oldConfig.Resources[2],
+			}
+		})
+
+		It("resets and rechecks the resource and triggers the jobs using it", func() {
+			Expect(oldConfig.Impact(newConfig)).To(Equal(atc.ConfigImpact{
+				RecheckedResources: []atc.ConfigImpactReason{
+					{Name: "some-resource", Reason: "source has changed"},
+				},
+				ResetResources: []atc.ConfigImpactReason{
+					{Name: "some-resource", Reason: "source has changed"},
+				},
+				TriggeredJobs: []atc.ConfigImpactReason{
+					{Name: "some-job", Reason: "resource some-resource of input some-resource will be rechecked: source has changed"},
+					{Name: "downstream-job", Reason: "resource some-resource of input some-resource will be rechecked: source has changed"},
+				},
+			}))
+		})
+	})
+
+	Context("when a resource type the resource is based on changes", func() {
+		BeforeEach(func() {
+			newConfig.ResourceTypes = atc.ResourceTypes{
+				{Name: "base-type", Type: "registry-image", Source: atc.Source{"repository": "base", "tag": "2"}},
+				oldConfig.ResourceTypes[1],
+			}
+		})
+
+		It("resets the resource without triggering jobs which do not trigger on it", func() {
+			Expect(oldConfig.Impact(newConfig)).To(Equal(atc.ConfigImpact{
+				RecheckedResources: []atc.ConfigImpactReason{
+					{Name: "custom-resource", Reason: "resource type base-type has changed"},
+				},
+				ResetResources: []atc.ConfigImpactReason{
+					{Name: "custom-resource", Reason: "resource type base-type has changed"},
+				},
+			}))
+		})
+	})
+
+	Describe("jobs", func() {
+		It("triggers new jobs with triggering inputs", func() {
+			newConfig.Jobs = append(newConfig.Jobs, atc.JobConfig{
+				Name: "new-job",
+				Plan: atc.PlanSequence{{Get: "some-resource", Trigger: true}},
+			}, atc.JobConfig{
+				Name: "manual-job",
+				Plan: atc.PlanSequence{{Get: "some-resource"}},
+			})
+
+			Expect(oldConfig.Impact(newConfig).TriggeredJobs).To(Equal([]atc.ConfigImpactReason{
+				{Name: "new-job", Reason: "input some-resource triggers the new job"},
+			}))
+		})
+
+		It("does not trigger renamed jobs", func() {
+			newConfig.Jobs = atc.JobConfigs{oldConfig.Jobs[0], oldConfig.Jobs[1]}
+			newConfig.Jobs[0].Name = "renamed-job"
+			newConfig.Jobs[0].OldName = "some-job"
+
+			Expect(oldConfig.Impact(newConfig).TriggeredJobs).To(BeEmpty())
+		})
+
+		It("triggers jobs whose triggering inputs changed", func() {
+			newConfig.Jobs = atc.JobConfigs{
+				{
+					Name: "some-job",
+					Plan: atc.PlanSequence{
+						{Get: "some-resource", Trigger: true},
+						{Get: "custom-resource", Trigger: true},
+					},
+				},
+				{
+					Name: "downstream-job",
+					Plan: atc.PlanSequence{
+						{Get: "some-resource", Trigger: true},
+					},
+				},
+			}
+
+			Expect(oldConfig.Impact(newConfig).TriggeredJobs).To(Equal([]atc.ConfigImpactReason{
+				{Name: "some-job", Reason: "input custom-resource now triggers builds"},
+				{Name: "downstream-job", Reason: "passed constraints of input some-resource have changed"},
+			}))
+		})
+	})
+})
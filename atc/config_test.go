@@ -39,6 +39,58 @@ var _ = Describe("Config", func() {
 				})
 			})
 		})
+
+		Context("when unmarshaling a filter from JSON", func() {
+			It("produces a filtered version config", func() {
+				var versionConfig VersionConfig
+				bs := []byte(`{ "filter": { "fields": { "tag": "v1.*" }, "semver": ">=1.2" } }`)
+				err := json.Unmarshal(bs, &versionConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(versionConfig).To(Equal(VersionConfig{
+					Latest: true,
+					Filter: &VersionFilter{
+						Fields: map[string]string{"tag": "v1.*"},
+						Semver: ">=1.2",
+					},
+				}))
+			})
+
+			It("uses every matching version when asked to", func() {
+				var versionConfig VersionConfig
+				bs := []byte(`{ "filter": { "fields": { "tag": "v1.*" }, "every": true } }`)
+				err := json.Unmarshal(bs, &versionConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(versionConfig.Every).To(BeTrue())
+				Expect(versionConfig.Latest).To(BeFalse())
+			})
+
+			It("round-trips through JSON", func() {
+				versionConfig := &VersionConfig{
+					Latest: true,
+					Filter: &VersionFilter{Fields: map[string]string{"branch": "main"}},
+				}
+
+				bs, err := json.Marshal(versionConfig)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bs).To(MatchJSON(`{ "filter": { "fields": { "branch": "main" } } }`))
+
+				var unmarshaled VersionConfig
+				Expect(json.Unmarshal(bs, &unmarshaled)).To(Succeed())
+				Expect(&unmarshaled).To(Equal(versionConfig))
+			})
+
+			Context("when a pinned version has a field named filter", func() {
+				It("treats it as a pinned version", func() {
+					var versionConfig VersionConfig
+					bs := []byte(`{ "filter": "some-value" }`)
+					err := json.Unmarshal(bs, &versionConfig)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(versionConfig.Pinned).To(Equal(Version{"filter": "some-value"}))
+				})
+			})
+		})
 	})
 
 	Describe("VarSourceConfigs.OrderByDependency", func() {
@@ -55,6 +55,32 @@ func Validate(c Config) ([]ConfigWarning, []string) {
 	return warnings, errorMessages
 }
 
+// ValidateNetworkPolicy checks the network access requested by the config's
+// embedded task configs against a team's network policy. Tasks loaded from a
+// file are checked when they run.
+func ValidateNetworkPolicy(c Config, policy TaskNetworkPolicy) []string {
+	var errorMessages []string
+
+	for _, job := range c.Jobs {
+		for _, plan := range job.Plans() {
+			if plan.Task == "" || plan.TaskConfig == nil {
+				continue
+			}
+
+			err := policy.Check(plan.TaskConfig.Network)
+			if err != nil {
+				errorMessages = append(errorMessages, fmt.Sprintf("jobs.%s.task.%s %s", job.Name, plan.Task, err))
+			}
+		}
+	}
+
+	if len(errorMessages) > 0 {
+		return []string{formatErr("network policy", compositeErr(errorMessages))}
+	}
+
+	return nil
+}
+
 func validateGroups(c Config) error {
 	var errorMessages []string
 
@@ -140,6 +166,14 @@ func validateResources(c Config) error {
 		if resource.Type == "" {
 			errorMessages = append(errorMessages, identifier+" has no type")
 		}
+
+		if retention := resource.VersionRetention; retention != nil {
+			if retention.Latest < 0 || retention.Days < 0 {
+				errorMessages = append(errorMessages, identifier+".version_retention cannot be negative")
+			} else if retention.Latest == 0 && retention.Days == 0 {
+				errorMessages = append(errorMessages, identifier+".version_retention must specify latest or days")
+			}
+		}
 	}
 
 	errorMessages = append(errorMessages, validateResourcesUnused(c)...)
@@ -276,6 +310,69 @@ func validateJobs(c Config) ([]ConfigWarning, error) {
 			}
 		}
 
+		if job.Timeout != "" {
+			if duration, err := time.ParseDuration(job.Timeout); err != nil {
+				errorMessages = append(
+					errorMessages,
+					identifier+fmt.Sprintf(".timeout refers to a duration that could not be parsed ('%s')", job.Timeout),
+				)
+			} else if duration <= 0 {
+				errorMessages = append(
+					errorMessages,
+					identifier+fmt.Sprintf(".timeout must be a positive duration ('%s')", job.Timeout),
+				)
+			}
+		}
+
+		if job.DefaultStepTimeout != "" {
+			if duration, err := time.ParseDuration(job.DefaultStepTimeout); err != nil {
+				errorMessages = append(
+					errorMessages,
+					identifier+fmt.Sprintf(".default_step_timeout refers to a duration that could not be parsed ('%s')", job.DefaultStepTimeout),
+				)
+			} else if duration <= 0 {
+				errorMessages = append(
+					errorMessages,
+					identifier+fmt.Sprintf(".default_step_timeout must be a positive duration ('%s')", job.DefaultStepTimeout),
+				)
+			}
+		}
+
+		if job.DebugOnFailure != "" {
+			if _, err := time.ParseDuration(job.DebugOnFailure); err != nil {
+				errorMessages = append(
+					errorMessages,
+					identifier+fmt.Sprintf(".debug_on_failure refers to a duration that could not be parsed ('%s')", job.DebugOnFailure),
+				)
+			}
+		}
+
+		if job.Schedule != nil {
+			if err := job.Schedule.Validate(); err != nil {
+				errorMessages = append(errorMessages, identifier+fmt.Sprintf(".schedule is invalid: %s", err))
+			}
+		}
+
+		if job.RequiresApproval != nil {
+			if err := job.RequiresApproval.Validate(); err != nil {
+				errorMessages = append(errorMessages, identifier+fmt.Sprintf(".requires_approval is invalid: %s", err))
+			}
+		}
+
+		seenLocks := map[string]bool{}
+		for i, lock := range job.Locks {
+			if lock == "" {
+				errorMessages = append(errorMessages, identifier+fmt.Sprintf(".locks[%d] has no name", i))
+				continue
+			}
+
+			if seenLocks[lock] {
+				errorMessages = append(errorMessages, identifier+fmt.Sprintf(".locks has duplicate lock '%s'", lock))
+			}
+
+			seenLocks[lock] = true
+		}
+
 		planWarnings, planErrMessages := validatePlan(c, identifier+".plan", PlanConfig{Do: &job.Plan})
 		warnings = append(warnings, planWarnings...)
 		errorMessages = append(errorMessages, planErrMessages...)
@@ -438,7 +535,7 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		identifier = fmt.Sprintf("%s.get.%s", identifier, plan.Get)
 
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"privileged", "config", "file"},
+			[]string{"privileged", "config", "file", "add_labels", "debug_on_failure"},
 			plan, identifier)...,
 		)
 
@@ -467,56 +564,31 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 			}
 		}
 
-		for _, job := range plan.Passed {
-			jobConfig, found := c.Jobs.Lookup(job)
-			if !found {
-				errorMessages = append(
-					errorMessages,
-					fmt.Sprintf(
-						"%s.passed references an unknown job ('%s')",
-						identifier,
-						job,
-					),
-				)
-			} else {
-				foundResource := false
-
-				for _, input := range jobConfig.Inputs() {
-					if input.Resource == plan.ResourceName() {
-						foundResource = true
-						break
-					}
-				}
+		if plan.Version != nil && plan.Version.Filter != nil {
+			if err := plan.Version.Filter.Validate(); err != nil {
+				errorMessages = append(errorMessages, identifier+fmt.Sprintf(".version.filter is invalid: %s", err))
+			}
+		}
 
-				for _, output := range jobConfig.Outputs() {
-					if output.Resource == plan.ResourceName() {
-						foundResource = true
-						break
-					}
-				}
+		errorMessages = append(errorMessages, validatePassedJobs(c, identifier+".passed", plan.Passed, plan)...)
+		errorMessages = append(errorMessages, validatePassedJobs(c, identifier+".passed_any", plan.PassedAny, plan)...)
 
-				if !foundResource {
-					errorMessages = append(
-						errorMessages,
-						fmt.Sprintf(
-							"%s.passed references a job ('%s') which doesn't interact with the resource ('%s')",
-							identifier,
-							job,
-							plan.Get,
-						),
-					)
-				}
-			}
+		if len(plan.PassedAny) == 1 {
+			errorMessages = append(errorMessages, identifier+".passed_any must list at least two jobs; use passed for a single job")
 		}
 
+		errorMessages = append(errorMessages, validateLabels(identifier+".labeled", plan.Labeled)...)
+
 	case plan.Put != "":
 		identifier = fmt.Sprintf("%s.put.%s", identifier, plan.Put)
 
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"passed", "trigger", "privileged", "config", "file"},
+			[]string{"passed", "passed_any", "labeled", "trigger", "privileged", "config", "file", "debug_on_failure"},
 			plan, identifier)...,
 		)
 
+		errorMessages = append(errorMessages, validateLabels(identifier+".add_labels", plan.AddLabels)...)
+
 		if plan.Resource != "" {
 			_, found := c.Resources.Lookup(plan.Resource)
 			if !found {
@@ -570,10 +642,16 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		}
 
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"resource", "passed", "trigger"},
+			[]string{"resource", "passed", "passed_any", "labeled", "add_labels", "trigger"},
 			plan, identifier)...,
 		)
 
+		if plan.DebugOnFailure != "" {
+			if _, err := time.ParseDuration(plan.DebugOnFailure); err != nil {
+				errorMessages = append(errorMessages, identifier+fmt.Sprintf(".debug_on_failure refers to a duration that could not be parsed ('%s')", plan.DebugOnFailure))
+			}
+		}
+
 	case plan.SetPipeline != "":
 		identifier = fmt.Sprintf("%s.set_pipeline.%s", identifier, plan.SetPipeline)
 
@@ -639,6 +717,66 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 	return warnings, errorMessages
 }
 
+func validatePassedJobs(c Config, identifier string, jobs []string, plan PlanConfig) []string {
+	var errorMessages []string
+
+	for _, job := range jobs {
+		jobConfig, found := c.Jobs.Lookup(job)
+		if !found {
+			errorMessages = append(
+				errorMessages,
+				fmt.Sprintf(
+					"%s references an unknown job ('%s')",
+					identifier,
+					job,
+				),
+			)
+		} else {
+			foundResource := false
+
+			for _, input := range jobConfig.Inputs() {
+				if input.Resource == plan.ResourceName() {
+					foundResource = true
+					break
+				}
+			}
+
+			for _, output := range jobConfig.Outputs() {
+				if output.Resource == plan.ResourceName() {
+					foundResource = true
+					break
+				}
+			}
+
+			if !foundResource {
+				errorMessages = append(
+					errorMessages,
+					fmt.Sprintf(
+						"%s references a job ('%s') which doesn't interact with the resource ('%s')",
+						identifier,
+						job,
+						plan.Get,
+					),
+				)
+			}
+		}
+	}
+
+	return errorMessages
+}
+
+func validateLabels(identifier string, labels []string) []string {
+	var errorMessages []string
+
+	for _, label := range labels {
+		if label == "" || strings.ContainsAny(label, " \t\n") {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s contains an invalid label '%s'", identifier, label))
+		}
+	}
+
+	return errorMessages
+}
+
 func validateInapplicableFields(inapplicableFields []string, plan PlanConfig, identifier string) []string {
 	var errorMessages []string
 	var foundInapplicableFields []string
@@ -653,6 +791,18 @@ func validateInapplicableFields(inapplicableFields []string, plan PlanConfig, id
 			if len(plan.Passed) != 0 {
 				foundInapplicableFields = append(foundInapplicableFields, field)
 			}
+		case "passed_any":
+			if len(plan.PassedAny) != 0 {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
+		case "labeled":
+			if len(plan.Labeled) != 0 {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
+		case "add_labels":
+			if len(plan.AddLabels) != 0 {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
 		case "trigger":
 			if plan.Trigger {
 				foundInapplicableFields = append(foundInapplicableFields, field)
@@ -669,6 +819,10 @@ func validateInapplicableFields(inapplicableFields []string, plan PlanConfig, id
 			if plan.ConfigPath != "" {
 				foundInapplicableFields = append(foundInapplicableFields, field)
 			}
+		case "debug_on_failure":
+			if plan.DebugOnFailure != "" {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
 		}
 	}
 
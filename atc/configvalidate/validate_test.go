@@ -372,6 +372,40 @@ var _ = Describe("ValidateConfig", func() {
 				))
 			})
 		})
+
+		Context("when a resource has a version retention policy", func() {
+			BeforeEach(func() {
+				config.Resources[0].VersionRetention = &VersionRetentionConfig{Latest: 100}
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+
+			Context("when the policy is empty", func() {
+				BeforeEach(func() {
+					config.Resources[0].VersionRetention = &VersionRetentionConfig{}
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid resources:"))
+					Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource.version_retention must specify latest or days"))
+				})
+			})
+
+			Context("when the policy is negative", func() {
+				BeforeEach(func() {
+					config.Resources[0].VersionRetention = &VersionRetentionConfig{Latest: 10, Days: -1}
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid resources:"))
+					Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource.version_retention cannot be negative"))
+				})
+			})
+		})
 	})
 
 	Describe("unused resources", func() {
@@ -641,6 +675,168 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a job has an invalid timeout", func() {
+			BeforeEach(func() {
+				job.Timeout = "forever"
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.timeout refers to a duration that could not be parsed ('forever')"))
+			})
+		})
+
+		Context("when a job has an invalid default_step_timeout", func() {
+			BeforeEach(func() {
+				job.DefaultStepTimeout = "1 hour"
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.default_step_timeout refers to a duration that could not be parsed ('1 hour')"))
+			})
+		})
+
+		Context("when a job has a negative timeout", func() {
+			BeforeEach(func() {
+				job.Timeout = "-5m"
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.timeout must be a positive duration ('-5m')"))
+			})
+		})
+
+		Context("when a job has a zero default_step_timeout", func() {
+			BeforeEach(func() {
+				job.DefaultStepTimeout = "0s"
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.default_step_timeout must be a positive duration ('0s')"))
+			})
+		})
+
+		Context("when a job has an invalid debug_on_failure", func() {
+			BeforeEach(func() {
+				job.DebugOnFailure = "a while"
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.debug_on_failure refers to a duration that could not be parsed ('a while')"))
+			})
+		})
+
+		Context("when a task step has an invalid debug_on_failure", func() {
+			BeforeEach(func() {
+				job.Plan = append(job.Plan, PlanConfig{
+					Task:           "some-task",
+					ConfigPath:     "some-task.yml",
+					DebugOnFailure: "a while",
+				})
+
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].task.some-task.debug_on_failure refers to a duration that could not be parsed ('a while')"))
+			})
+		})
+
+		Context("when a get step has debug_on_failure", func() {
+			BeforeEach(func() {
+				job.Plan = append(job.Plan, PlanConfig{
+					Get:            "some-resource",
+					DebugOnFailure: "30m",
+				})
+
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource has invalid fields specified (debug_on_failure)"))
+			})
+		})
+
+		Context("when a job has an invalid schedule", func() {
+			BeforeEach(func() {
+				job.Schedule = &ScheduleConfig{Cron: "nightly"}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.schedule is invalid: invalid cron expression 'nightly'"))
+			})
+		})
+
+		Context("when a job requires approval from an unknown role", func() {
+			BeforeEach(func() {
+				job.RequiresApproval = &ApprovalConfig{Roles: []string{"owner", "release-manager"}}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.requires_approval is invalid: unknown role 'release-manager'"))
+			})
+		})
+
+		Context("when a job declares the same lock twice", func() {
+			BeforeEach(func() {
+				job.Locks = []string{"staging-env", "staging-env"}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.locks has duplicate lock 'staging-env'"))
+			})
+		})
+
+		Context("when a job declares a lock without a name", func() {
+			BeforeEach(func() {
+				job.Locks = []string{"staging-env", ""}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.locks[1] has no name"))
+			})
+		})
+
+		Context("when a job has valid timeouts", func() {
+			BeforeEach(func() {
+				job.Timeout = "2h"
+				job.DefaultStepTimeout = "30m"
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
 		Context("when a job has a negative build_logs_to_retain", func() {
 			BeforeEach(func() {
 				job.BuildLogsToRetain = -1
@@ -1001,6 +1197,23 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a get plan has an invalid version filter", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:     "some-resource",
+						Version: &VersionConfig{Latest: true, Filter: &VersionFilter{Semver: "not-a-range"}},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.version.filter is invalid: invalid semver range 'not-a-range'"))
+				})
+			})
+
 			Context("when a get plan has a custom name but refers to a resource that does not exist", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -1453,6 +1666,109 @@ var _ = Describe("ValidateConfig", func() {
 					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.passed references a job ('some-empty-job') which doesn't interact with the resource ('some-resource')"))
 				})
 			})
+
+			Context("when a job's input's passed_any constraints reference valid jobs that have the resource as an input", func() {
+				BeforeEach(func() {
+					config.Jobs[0].Plan = append(config.Jobs[0].Plan, PlanConfig{
+						Get: "some-resource",
+					})
+
+					config.Jobs = append(config.Jobs, JobConfig{
+						Name: "some-third-job",
+						Plan: PlanSequence{{Get: "some-resource"}},
+					})
+
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:       "some-resource",
+						PassedAny: []string{"some-job", "some-third-job"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
+			Context("when a job's input's passed_any constraints reference a bogus job", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:       "some-resource",
+						PassedAny: []string{"some-job", "bogus-job"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.passed_any references an unknown job ('bogus-job')"))
+				})
+			})
+
+			Context("when a job's input's passed_any constraints list a single job", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:       "some-resource",
+						PassedAny: []string{"some-job"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.passed_any must list at least two jobs; use passed for a single job"))
+				})
+			})
+
+			Context("when a job's input requires an invalid label", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:     "some-resource",
+						Labeled: []string{"deployed to prod"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.labeled contains an invalid label 'deployed to prod'"))
+				})
+			})
+
+			Context("when a get step adds labels", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:       "some-resource",
+						AddLabels: []string{"deployed-to-prod"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource has invalid fields specified (add_labels)"))
+				})
+			})
+
+			Context("when a put step adds labels", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:       "some-resource",
+						AddLabels: []string{"deployed-to-prod"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
 		})
 
 		Context("when two jobs have the same name", func() {
@@ -1508,3 +1824,92 @@ var _ = Describe("ValidateConfig", func() {
 		})
 	})
 })
+
+var _ = Describe("ValidateNetworkPolicy", func() {
+	var (
+		config Config
+		policy TaskNetworkPolicy
+
+		errorMessages []string
+	)
+
+	BeforeEach(func() {
+		config = Config{
+			Jobs: JobConfigs{
+				{
+					Name: "some-job",
+					Plan: PlanSequence{
+						{
+							Task: "some-task",
+							TaskConfig: &TaskConfig{
+								Platform: "linux",
+								Run:      TaskRunConfig{Path: "ls"},
+								Network: &TaskNetworkConfig{
+									Egress: "none",
+									Allow:  []string{"10.1.0.0/16:443"},
+								},
+							},
+						},
+						{
+							Task:       "some-file-task",
+							ConfigPath: "some/task.yml",
+						},
+					},
+				},
+			},
+		}
+
+		policy = TaskNetworkPolicy{}
+	})
+
+	JustBeforeEach(func() {
+		errorMessages = configvalidate.ValidateNetworkPolicy(config, policy)
+	})
+
+	Context("when the policy is empty", func() {
+		It("returns no errors", func() {
+			Expect(errorMessages).To(BeEmpty())
+		})
+	})
+
+	Context("when the policy permits the allowed destinations", func() {
+		BeforeEach(func() {
+			policy.AllowedNetworks = []string{"10.0.0.0/8"}
+		})
+
+		It("returns no errors", func() {
+			Expect(errorMessages).To(BeEmpty())
+		})
+	})
+
+	Context("when the policy does not permit an allowed destination", func() {
+		BeforeEach(func() {
+			policy.AllowedNetworks = []string{"10.1.0.0/16:80"}
+		})
+
+		It("returns an error", func() {
+			Expect(errorMessages).To(HaveLen(1))
+			Expect(errorMessages[0]).To(ContainSubstring("invalid network policy:"))
+			Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job.task.some-task network policy does not permit access to 10.1.0.0/16:443"))
+		})
+	})
+
+	Context("when the policy requires restricted egress", func() {
+		BeforeEach(func() {
+			policy.RequireRestrictedEgress = true
+			config.Jobs[0].Ensure = &PlanConfig{
+				Task: "some-unrestricted-task",
+				TaskConfig: &TaskConfig{
+					Platform: "linux",
+					Run:      TaskRunConfig{Path: "ls"},
+				},
+			}
+		})
+
+		It("returns an error for embedded tasks which do not restrict egress", func() {
+			Expect(errorMessages).To(HaveLen(1))
+			Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job.task.some-unrestricted-task network policy requires tasks to set 'egress: none'"))
+			Expect(errorMessages[0]).ToNot(ContainSubstring("some-file-task"))
+		})
+	})
+})
@@ -26,6 +26,10 @@ type Container struct {
 	WorkingDirectory string `json:"working_directory,omitempty"`
 
 	ExpiresIn string `json:"expires_in,omitempty"`
+
+	// DebugUntil is set on containers of failed tasks which are being kept
+	// around for debugging.
+	DebugUntil int64 `json:"debug_until,omitempty"`
 }
 
 const (
@@ -34,3 +38,13 @@ const (
 	ContainerStateDestroying = "destroying"
 	ContainerStateFailed     = "failed"
 )
+
+// DebugContainerLimits caps how many failed task containers may be kept
+// around for debugging at once. Zero means no limit.
+type DebugContainerLimits struct {
+	Max        int
+	MaxPerTeam int
+}
+
+// DebugContainers is configured by the ATC at startup.
+var DebugContainers DebugContainerLimits
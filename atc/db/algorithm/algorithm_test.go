@@ -36,6 +36,193 @@ var _ = DescribeTable("Input resolving",
 		},
 	}),
 
+	Entry("can fan-in from any of a group of jobs", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				// pass a only
+				{Job: "simple-a", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+
+				// pass b only
+				{Job: "simple-b", BuildID: 2, Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:      "resource-x",
+				Resource:  "resource-x",
+				PassedAny: []string{"simple-a", "simple-b"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv2",
+			},
+		},
+	}),
+
+	Entry("fails when a version has passed none of a passed_any group", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+			},
+			BuildOutputs: []DBRow{
+				{Job: "simple-c", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:      "resource-x",
+				Resource:  "resource-x",
+				PassedAny: []string{"simple-a", "simple-b"},
+			},
+		},
+
+		Result: Result{
+			OK:     false,
+			Values: map[string]string{},
+		},
+	}),
+
+	Entry("combines passed with passed_any", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				// pass shared and a
+				{Job: "shared-job", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Job: "simple-a", BuildID: 2, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+
+				// pass shared but neither a nor b
+				{Job: "shared-job", BuildID: 3, Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+
+				// pass b but not shared
+				{Job: "simple-b", BuildID: 4, Resource: "resource-x", Version: "rxv3", CheckOrder: 3},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:      "resource-x",
+				Resource:  "resource-x",
+				Passed:    []string{"shared-job"},
+				PassedAny: []string{"simple-a", "simple-b"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv1",
+			},
+		},
+	}),
+
+	Entry("does not require passed_any jobs to agree on a build with other inputs", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				{Job: "simple-a", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Job: "simple-a", BuildID: 2, Resource: "resource-y", Version: "ryv1", CheckOrder: 1},
+			},
+		},
+
+		Inputs: Inputs{
+			{Name: "resource-x", Resource: "resource-x", PassedAny: []string{"simple-a", "simple-b"}},
+			{Name: "resource-y", Resource: "resource-y", PassedAny: []string{"simple-a", "simple-b"}},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv1",
+				"resource-y": "ryv1",
+			},
+		},
+	}),
+
+	Entry("only uses versions carrying every required label", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+				{Resource: "resource-x", Version: "rxv3", CheckOrder: 3},
+			},
+			Labels: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", Label: "approved"},
+				{Resource: "resource-x", Version: "rxv1", Label: "deployed"},
+				{Resource: "resource-x", Version: "rxv2", Label: "approved"},
+				{Resource: "resource-x", Version: "rxv2", Label: "deployed"},
+				{Resource: "resource-x", Version: "rxv3", Label: "approved"},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Labeled:  []string{"approved", "deployed"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv2",
+			},
+		},
+	}),
+
+	Entry("combines required labels with passed constraints", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				{Job: "simple-a", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Job: "simple-a", BuildID: 2, Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+			},
+			Labels: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", Label: "approved"},
+				{Resource: "resource-x", Version: "rxv3", Label: "approved"},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Passed:   []string{"simple-a"},
+				Labeled:  []string{"approved"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv1",
+			},
+		},
+	}),
+
+	Entry("does not resolve when no version carries the required labels", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Labeled:  []string{"approved"},
+			},
+		},
+
+		Result: Result{
+			OK:     false,
+			Values: map[string]string{},
+		},
+	}),
+
 	Entry("propagates resources together", Example{
 		DB: DB{
 			BuildOutputs: []DBRow{
@@ -819,4 +1006,76 @@ var _ = DescribeTable("Input resolving",
 			},
 		},
 	}),
+
+	Entry("resolves the latest version matching the filter", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+				{Resource: "resource-x", Version: "rxv3", CheckOrder: 3},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Version:  Version{Filter: []string{"rxv1", "rxv2"}},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv2",
+			},
+		},
+	}),
+
+	Entry("does not resolve a version when no version matches the filter", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Version:  Version{Filter: []string{}},
+			},
+		},
+
+		Result: Result{
+			OK:     false,
+			Values: map[string]string{},
+		},
+	}),
+
+	Entry("applies the filter to versions that passed the constraints", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				{Job: "j1", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Job: "j1", BuildID: 2, Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+				{Job: "j1", BuildID: 3, Resource: "resource-x", Version: "rxv3", CheckOrder: 3},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Passed:   []string{"j1"},
+				Version:  Version{Filter: []string{"rxv1"}},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv1",
+			},
+		},
+	}),
 )
@@ -4,6 +4,7 @@ type VersionsDB struct {
 	ResourceVersions []ResourceVersion
 	BuildOutputs     []BuildOutput
 	BuildInputs      []BuildInput
+	VersionLabels    []VersionLabel
 	JobIDs           map[string]int
 	ResourceIDs      map[string]int
 }
@@ -27,6 +28,12 @@ type BuildInput struct {
 	InputName string
 }
 
+type VersionLabel struct {
+	ResourceID int
+	VersionID  int
+	Label      string
+}
+
 func (db VersionsDB) IsVersionFirstOccurrence(versionID int, jobID int, inputName string) bool {
 	for _, buildInput := range db.BuildInputs {
 		if buildInput.VersionID == versionID &&
@@ -38,10 +45,10 @@ func (db VersionsDB) IsVersionFirstOccurrence(versionID int, jobID int, inputNam
 	return true
 }
 
-func (db VersionsDB) AllVersionsOfResource(resourceID int) VersionCandidates {
+func (db VersionsDB) AllVersionsOfResource(resourceID int, filter VersionFilter) VersionCandidates {
 	candidates := VersionCandidates{}
 	for _, output := range db.ResourceVersions {
-		if output.ResourceID == resourceID {
+		if output.ResourceID == resourceID && filter.Allows(output.VersionID) {
 			candidates.Add(VersionCandidate{
 				VersionID:  output.VersionID,
 				CheckOrder: output.CheckOrder,
@@ -52,12 +59,12 @@ func (db VersionsDB) AllVersionsOfResource(resourceID int) VersionCandidates {
 	return candidates
 }
 
-func (db VersionsDB) LatestVersionOfResource(resourceID int) (VersionCandidate, bool) {
+func (db VersionsDB) LatestVersionOfResource(resourceID int, filter VersionFilter) (VersionCandidate, bool) {
 	var candidate VersionCandidate
 	var found bool
 
 	for _, v := range db.ResourceVersions {
-		if v.ResourceID == resourceID && v.CheckOrder > candidate.CheckOrder {
+		if v.ResourceID == resourceID && v.CheckOrder > candidate.CheckOrder && filter.Allows(v.VersionID) {
 			candidate = VersionCandidate{
 				VersionID:  v.VersionID,
 				CheckOrder: v.CheckOrder,
@@ -88,7 +95,7 @@ func (db VersionsDB) FindVersionOfResource(resourceID int, versionID int) (Versi
 	return candidate, found
 }
 
-func (db VersionsDB) VersionsOfResourcePassedJobs(resourceID int, passed JobSet) VersionCandidates {
+func (db VersionsDB) VersionsOfResourcePassedJobs(resourceID int, passed JobSet, filter VersionFilter) VersionCandidates {
 	candidates := VersionCandidates{}
 
 	firstTick := true
@@ -96,7 +103,7 @@ func (db VersionsDB) VersionsOfResourcePassedJobs(resourceID int, passed JobSet)
 		versions := VersionCandidates{}
 
 		for _, output := range db.BuildOutputs {
-			if output.ResourceID == resourceID && output.JobID == jobID {
+			if output.ResourceID == resourceID && output.JobID == jobID && filter.Allows(output.VersionID) {
 				versions.Add(VersionCandidate{
 					VersionID:  output.VersionID,
 					CheckOrder: output.CheckOrder,
@@ -116,3 +123,57 @@ func (db VersionsDB) VersionsOfResourcePassedJobs(resourceID int, passed JobSet)
 
 	return candidates
 }
+
+// VersionsOfResourcePassedAnyJob returns the versions of the resource which
+// have passed through at least one of the given jobs. The candidates are not
+// tied to the builds they came from, so they never constrain the builds chosen
+// for other inputs.
+func (db VersionsDB) VersionsOfResourcePassedAnyJob(resourceID int, passedAny JobSet, filter VersionFilter) VersionCandidates {
+	candidates := VersionCandidates{}
+
+	for _, output := range db.BuildOutputs {
+		if output.ResourceID == resourceID && passedAny.Contains(output.JobID) && filter.Allows(output.VersionID) {
+			candidates.Add(VersionCandidate{
+				VersionID:  output.VersionID,
+				CheckOrder: output.CheckOrder,
+			})
+		}
+	}
+
+	return candidates
+}
+
+// VersionsOfResourceLabeled returns a filter allowing only the versions of the
+// resource which carry every one of the labels.
+func (db VersionsDB) VersionsOfResourceLabeled(resourceID int, labels []string) VersionFilter {
+	counts := map[int]int{}
+	for _, label := range db.VersionLabels {
+		if label.ResourceID != resourceID {
+			continue
+		}
+
+		for _, wanted := range labels {
+			if label.Label == wanted {
+				counts[label.VersionID]++
+				break
+			}
+		}
+	}
+
+	filter := VersionFilter{}
+	for versionID, count := range counts {
+		if count == len(labels) {
+			filter[versionID] = struct{}{}
+		}
+	}
+
+	return filter
+}
+
+func (db VersionsDB) versionFilter(config InputConfig) VersionFilter {
+	if len(config.Labeled) == 0 {
+		return config.VersionFilter
+	}
+
+	return config.VersionFilter.Intersect(db.VersionsOfResourceLabeled(config.ResourceID, config.Labeled))
+}
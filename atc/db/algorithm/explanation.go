@@ -0,0 +1,289 @@
+package algorithm
+
+import "sort"
+
+// ExplainCandidateLimit bounds the number of candidate versions listed for
+// each input in an Explanation.
+const ExplainCandidateLimit = 10
+
+// Explanation describes how a set of inputs were resolved, or why they could
+// not be.
+type Explanation struct {
+	Resolved bool
+
+	Inputs    []InputExplanation
+	Conflicts []ConflictExplanation
+}
+
+type InputExplanation struct {
+	Name            string
+	ResourceID      int
+	Passed          JobSet
+	PassedAny       JobSet
+	Labeled         []string
+	PinnedVersionID int
+
+	// The newest versions of the resource, each annotated with the passed
+	// jobs that rule it out.
+	Candidates []CandidateExplanation
+
+	// The version chosen for the input if the inputs were resolved.
+	VersionID int
+}
+
+type CandidateExplanation struct {
+	VersionID    int
+	EliminatedBy []int
+}
+
+// ConflictExplanation identifies a job that several inputs must have passed
+// through, but for which no single build used a remaining candidate version
+// of each input.
+type ConflictExplanation struct {
+	JobID  int
+	Inputs []string
+}
+
+func (configs InputConfigs) Explain(db *VersionsDB) Explanation {
+	mapping, resolved := configs.Resolve(db)
+
+	index := newExplanationIndex(db)
+
+	explanation := Explanation{Resolved: resolved}
+
+	allSatisfiable := true
+	for _, config := range configs {
+		input := InputExplanation{
+			Name:            config.Name,
+			ResourceID:      config.ResourceID,
+			Passed:          config.Passed,
+			PassedAny:       config.PassedAny,
+			Labeled:         config.Labeled,
+			PinnedVersionID: config.PinnedVersionID,
+			Candidates:      index.explainCandidates(config),
+		}
+
+		if resolved {
+			input.VersionID = mapping[config.Name].VersionID
+		}
+
+		if len(index.satisfyingVersions(config)) == 0 {
+			allSatisfiable = false
+		}
+
+		explanation.Inputs = append(explanation.Inputs, input)
+	}
+
+	if !resolved && allSatisfiable {
+		explanation.Conflicts = configs.conflicts(index)
+	}
+
+	return explanation
+}
+
+func (configs InputConfigs) conflicts(index *explanationIndex) []ConflictExplanation {
+	inputsByJob := map[int][]InputConfig{}
+	for _, config := range configs {
+		for jobID := range config.Passed {
+			inputsByJob[jobID] = append(inputsByJob[jobID], config)
+		}
+	}
+
+	conflicts := []ConflictExplanation{}
+	for jobID, inputs := range inputsByJob {
+		if len(inputs) < 2 {
+			continue
+		}
+
+		var common BuildSet
+		for _, input := range inputs {
+			outputs := index.outputs[jobResource{jobID, input.ResourceID}]
+
+			builds := BuildSet{}
+			for versionID := range index.satisfyingVersions(input) {
+				for buildID := range outputs[versionID] {
+					builds[buildID] = struct{}{}
+				}
+			}
+
+			if common == nil {
+				common = builds
+			} else {
+				common = common.Intersect(builds)
+			}
+		}
+
+		if len(common) > 0 {
+			continue
+		}
+
+		names := []string{}
+		for _, input := range inputs {
+			names = append(names, input.Name)
+		}
+
+		sort.Strings(names)
+
+		conflicts = append(conflicts, ConflictExplanation{
+			JobID:  jobID,
+			Inputs: names,
+		})
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].JobID < conflicts[j].JobID
+	})
+
+	return conflicts
+}
+
+type jobResource struct {
+	JobID      int
+	ResourceID int
+}
+
+// explanationIndex indexes a VersionsDB once per explanation so that the
+// builds which output each version don't have to be searched for again for
+// every version of every input.
+type explanationIndex struct {
+	db *VersionsDB
+
+	// The versions of each resource, newest first.
+	versions map[int][]ResourceVersion
+
+	// The builds of each job which output each version of a resource.
+	outputs map[jobResource]map[int]BuildSet
+
+	// The satisfying versions of each input, by name.
+	satisfying map[string]map[int]struct{}
+}
+
+func newExplanationIndex(db *VersionsDB) *explanationIndex {
+	index := &explanationIndex{
+		db:         db,
+		versions:   map[int][]ResourceVersion{},
+		outputs:    map[jobResource]map[int]BuildSet{},
+		satisfying: map[string]map[int]struct{}{},
+	}
+
+	for _, v := range db.ResourceVersions {
+		index.versions[v.ResourceID] = append(index.versions[v.ResourceID], v)
+	}
+
+	for _, versions := range index.versions {
+		sort.SliceStable(versions, func(i, j int) bool {
+			return versions[i].CheckOrder > versions[j].CheckOrder
+		})
+	}
+
+	for _, output := range db.BuildOutputs {
+		key := jobResource{output.JobID, output.ResourceID}
+
+		versions, found := index.outputs[key]
+		if !found {
+			versions = map[int]BuildSet{}
+			index.outputs[key] = versions
+		}
+
+		builds, found := versions[output.VersionID]
+		if !found {
+			builds = BuildSet{}
+			versions[output.VersionID] = builds
+		}
+
+		builds[output.BuildID] = struct{}{}
+	}
+
+	return index
+}
+
+// allowedVersions returns the versions of the input's resource which its
+// pinned version and filters allow, newest first.
+func (index *explanationIndex) allowedVersions(config InputConfig) []ResourceVersion {
+	filter := index.db.versionFilter(config)
+
+	versions := []ResourceVersion{}
+	for _, v := range index.versions[config.ResourceID] {
+		if config.PinnedVersionID != 0 && v.VersionID != config.PinnedVersionID {
+			continue
+		}
+
+		if !filter.Allows(v.VersionID) {
+			continue
+		}
+
+		versions = append(versions, v)
+	}
+
+	return versions
+}
+
+func (index *explanationIndex) explainCandidates(config InputConfig) []CandidateExplanation {
+	versions := index.allowedVersions(config)
+	if len(versions) > ExplainCandidateLimit {
+		versions = versions[:ExplainCandidateLimit]
+	}
+
+	candidates := []CandidateExplanation{}
+	for _, v := range versions {
+		candidates = append(candidates, CandidateExplanation{
+			VersionID:    v.VersionID,
+			EliminatedBy: index.eliminatingJobs(config, v.VersionID),
+		})
+	}
+
+	return candidates
+}
+
+// satisfyingVersions returns the versions of the input's resource which have
+// passed through every job in its passed constraints.
+func (index *explanationIndex) satisfyingVersions(config InputConfig) map[int]struct{} {
+	if versions, found := index.satisfying[config.Name]; found {
+		return versions
+	}
+
+	versions := map[int]struct{}{}
+	for _, v := range index.allowedVersions(config) {
+		if len(index.eliminatingJobs(config, v.VersionID)) == 0 {
+			versions[v.VersionID] = struct{}{}
+		}
+	}
+
+	index.satisfying[config.Name] = versions
+
+	return versions
+}
+
+func (index *explanationIndex) eliminatingJobs(config InputConfig, versionID int) []int {
+	eliminatedBy := []int{}
+
+	for jobID := range config.Passed {
+		if !index.passed(jobID, config.ResourceID, versionID) {
+			eliminatedBy = append(eliminatedBy, jobID)
+		}
+	}
+
+	if len(config.PassedAny) != 0 {
+		passedAny := false
+		for jobID := range config.PassedAny {
+			if index.passed(jobID, config.ResourceID, versionID) {
+				passedAny = true
+				break
+			}
+		}
+
+		if !passedAny {
+			for jobID := range config.PassedAny {
+				eliminatedBy = append(eliminatedBy, jobID)
+			}
+		}
+	}
+
+	sort.Ints(eliminatedBy)
+
+	return eliminatedBy
+}
+
+func (index *explanationIndex) passed(jobID int, resourceID int, versionID int) bool {
+	return len(index.outputs[jobResource{jobID, resourceID}][versionID]) > 0
+}
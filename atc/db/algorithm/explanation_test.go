@@ -0,0 +1,176 @@
+package algorithm_test
+
+import (
+	"github.com/concourse/concourse/atc/db/algorithm"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Explain", func() {
+	const (
+		jobA = 1
+		jobB = 2
+
+		currentJob = 3
+
+		resourceX = 10
+		resourceY = 11
+	)
+
+	var (
+		db      *algorithm.VersionsDB
+		configs algorithm.InputConfigs
+
+		explanation algorithm.Explanation
+	)
+
+	version := func(resourceID, versionID, checkOrder int) algorithm.ResourceVersion {
+		return algorithm.ResourceVersion{
+			ResourceID: resourceID,
+			VersionID:  versionID,
+			CheckOrder: checkOrder,
+		}
+	}
+
+	output := func(jobID, buildID int, v algorithm.ResourceVersion) algorithm.BuildOutput {
+		return algorithm.BuildOutput{ResourceVersion: v, JobID: jobID, BuildID: buildID}
+	}
+
+	BeforeEach(func() {
+		db = &algorithm.VersionsDB{
+			ResourceVersions: []algorithm.ResourceVersion{
+				version(resourceX, 100, 1),
+				version(resourceX, 101, 2),
+				version(resourceY, 200, 1),
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		explanation = configs.Explain(db)
+	})
+
+	Context("when an input has no passed constraints", func() {
+		BeforeEach(func() {
+			configs = algorithm.InputConfigs{
+				{Name: "x", ResourceID: resourceX, JobID: currentJob},
+			}
+		})
+
+		It("resolves to the latest version", func() {
+			Expect(explanation.Resolved).To(BeTrue())
+			Expect(explanation.Inputs).To(HaveLen(1))
+			Expect(explanation.Inputs[0].VersionID).To(Equal(101))
+		})
+
+		It("lists the candidates newest first without eliminations", func() {
+			Expect(explanation.Inputs[0].Candidates).To(Equal([]algorithm.CandidateExplanation{
+				{VersionID: 101, EliminatedBy: []int{}},
+				{VersionID: 100, EliminatedBy: []int{}},
+			}))
+		})
+	})
+
+	Context("when a passed job has not used the newest version", func() {
+		BeforeEach(func() {
+			db.BuildOutputs = []algorithm.BuildOutput{
+				output(jobA, 1, version(resourceX, 100, 1)),
+				output(jobB, 2, version(resourceX, 100, 1)),
+				output(jobA, 3, version(resourceX, 101, 2)),
+			}
+
+			configs = algorithm.InputConfigs{
+				{Name: "x", ResourceID: resourceX, JobID: currentJob, Passed: algorithm.JobSet{jobA: {}, jobB: {}}},
+			}
+		})
+
+		It("reports which job eliminated it", func() {
+			Expect(explanation.Resolved).To(BeTrue())
+			Expect(explanation.Inputs[0].VersionID).To(Equal(100))
+			Expect(explanation.Inputs[0].Candidates).To(Equal([]algorithm.CandidateExplanation{
+				{VersionID: 101, EliminatedBy: []int{jobB}},
+				{VersionID: 100, EliminatedBy: []int{}},
+			}))
+		})
+	})
+
+	Context("when a version has passed none of the passed_any jobs", func() {
+		BeforeEach(func() {
+			db.BuildOutputs = []algorithm.BuildOutput{
+				output(jobB, 1, version(resourceX, 100, 1)),
+			}
+
+			configs = algorithm.InputConfigs{
+				{Name: "x", ResourceID: resourceX, JobID: currentJob, PassedAny: algorithm.JobSet{jobA: {}, jobB: {}}},
+			}
+		})
+
+		It("reports every job in the group as eliminating it", func() {
+			Expect(explanation.Resolved).To(BeTrue())
+			Expect(explanation.Inputs[0].VersionID).To(Equal(100))
+			Expect(explanation.Inputs[0].PassedAny).To(Equal(algorithm.JobSet{jobA: {}, jobB: {}}))
+			Expect(explanation.Inputs[0].Candidates).To(Equal([]algorithm.CandidateExplanation{
+				{VersionID: 101, EliminatedBy: []int{jobA, jobB}},
+				{VersionID: 100, EliminatedBy: []int{}},
+			}))
+		})
+	})
+
+	Context("when no version satisfies the passed constraints", func() {
+		BeforeEach(func() {
+			db.BuildOutputs = []algorithm.BuildOutput{
+				output(jobA, 1, version(resourceX, 100, 1)),
+			}
+
+			configs = algorithm.InputConfigs{
+				{Name: "x", ResourceID: resourceX, JobID: currentJob, Passed: algorithm.JobSet{jobB: {}}},
+			}
+		})
+
+		It("is unresolved without reporting a conflict", func() {
+			Expect(explanation.Resolved).To(BeFalse())
+			Expect(explanation.Conflicts).To(BeEmpty())
+			Expect(explanation.Inputs[0].Candidates).To(Equal([]algorithm.CandidateExplanation{
+				{VersionID: 101, EliminatedBy: []int{jobB}},
+				{VersionID: 100, EliminatedBy: []int{jobB}},
+			}))
+		})
+	})
+
+	Context("when inputs sharing a passed job never went through the same build", func() {
+		BeforeEach(func() {
+			db.BuildOutputs = []algorithm.BuildOutput{
+				output(jobA, 1, version(resourceX, 100, 1)),
+				output(jobA, 2, version(resourceY, 200, 1)),
+			}
+
+			configs = algorithm.InputConfigs{
+				{Name: "x", ResourceID: resourceX, JobID: currentJob, Passed: algorithm.JobSet{jobA: {}}},
+				{Name: "y", ResourceID: resourceY, JobID: currentJob, Passed: algorithm.JobSet{jobA: {}}},
+			}
+		})
+
+		It("reports the conflicting job and inputs", func() {
+			Expect(explanation.Resolved).To(BeFalse())
+			Expect(explanation.Conflicts).To(Equal([]algorithm.ConflictExplanation{
+				{JobID: jobA, Inputs: []string{"x", "y"}},
+			}))
+		})
+	})
+
+	Context("when the input is pinned", func() {
+		BeforeEach(func() {
+			configs = algorithm.InputConfigs{
+				{Name: "x", ResourceID: resourceX, JobID: currentJob, PinnedVersionID: 100},
+			}
+		})
+
+		It("only lists the pinned version", func() {
+			Expect(explanation.Resolved).To(BeTrue())
+			Expect(explanation.Inputs[0].Candidates).To(Equal([]algorithm.CandidateExplanation{
+				{VersionID: 100, EliminatedBy: []int{}},
+			}))
+		})
+	})
+})
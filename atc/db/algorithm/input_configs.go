@@ -7,9 +7,20 @@ type InputConfig struct {
 	JobName         string
 	Passed          JobSet
 	UseEveryVersion bool
+
+	// Jobs of which the version must have passed through at least one. Unlike
+	// Passed, the jobs do not have to agree with the other inputs on a build.
+	PassedAny JobSet
+
+	// Labels that the version must carry.
+	Labeled []string
+
 	PinnedVersionID int
 	ResourceID      int
 	JobID           int
+
+	// Restricts the versions of the resource which may be used.
+	VersionFilter VersionFilter
 }
 
 func (configs InputConfigs) Resolve(db *VersionsDB) (InputMapping, bool) {
@@ -18,10 +29,11 @@ func (configs InputConfigs) Resolve(db *VersionsDB) (InputMapping, bool) {
 
 	for _, inputConfig := range configs {
 		versionCandidates := VersionCandidates{}
+		filter := db.versionFilter(inputConfig)
 
-		if len(inputConfig.Passed) == 0 {
+		if len(inputConfig.Passed) == 0 && len(inputConfig.PassedAny) == 0 {
 			if inputConfig.UseEveryVersion {
-				versionCandidates = db.AllVersionsOfResource(inputConfig.ResourceID)
+				versionCandidates = db.AllVersionsOfResource(inputConfig.ResourceID, filter)
 			} else {
 				var versionCandidate VersionCandidate
 				var found bool
@@ -29,7 +41,7 @@ func (configs InputConfigs) Resolve(db *VersionsDB) (InputMapping, bool) {
 				if inputConfig.PinnedVersionID != 0 {
 					versionCandidate, found = db.FindVersionOfResource(inputConfig.ResourceID, inputConfig.PinnedVersionID)
 				} else {
-					versionCandidate, found = db.LatestVersionOfResource(inputConfig.ResourceID)
+					versionCandidate, found = db.LatestVersionOfResource(inputConfig.ResourceID, filter)
 				}
 
 				if found {
@@ -41,12 +53,29 @@ func (configs InputConfigs) Resolve(db *VersionsDB) (InputMapping, bool) {
 				return nil, false
 			}
 		} else {
-			jobs = jobs.Union(inputConfig.Passed)
+			if len(inputConfig.Passed) != 0 {
+				jobs = jobs.Union(inputConfig.Passed)
+
+				versionCandidates = db.VersionsOfResourcePassedJobs(
+					inputConfig.ResourceID,
+					inputConfig.Passed,
+					filter,
+				)
+			}
+
+			if len(inputConfig.PassedAny) != 0 {
+				passedAnyCandidates := db.VersionsOfResourcePassedAnyJob(
+					inputConfig.ResourceID,
+					inputConfig.PassedAny,
+					filter,
+				)
 
-			versionCandidates = db.VersionsOfResourcePassedJobs(
-				inputConfig.ResourceID,
-				inputConfig.Passed,
-			)
+				if len(inputConfig.Passed) != 0 {
+					versionCandidates = versionCandidates.IntersectByVersion(passedAnyCandidates)
+				} else {
+					versionCandidates = passedAnyCandidates
+				}
+			}
 
 			if versionCandidates.IsEmpty() {
 				return nil, false
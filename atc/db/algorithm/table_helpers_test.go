@@ -14,6 +14,7 @@ type DB struct {
 	BuildInputs  []DBRow
 	BuildOutputs []DBRow
 	Resources    []DBRow
+	Labels       []DBRow
 }
 
 type DBRow struct {
@@ -23,6 +24,7 @@ type DBRow struct {
 	Version    string
 	CheckOrder int
 	VersionID  int
+	Label      string
 }
 
 type Example struct {
@@ -35,16 +37,19 @@ type Example struct {
 type Inputs []Input
 
 type Input struct {
-	Name     string
-	Resource string
-	Passed   []string
-	Version  Version
+	Name      string
+	Resource  string
+	Passed    []string
+	PassedAny []string
+	Labeled   []string
+	Version   Version
 }
 
 type Version struct {
 	Every  bool
 	Latest bool
 	Pinned string
+	Filter []string
 }
 
 type Result struct {
@@ -138,6 +143,13 @@ func (example Example) Run() {
 				JobID:           jobIDs.ID(row.Job),
 			})
 		}
+		for _, row := range example.DB.Labels {
+			db.VersionLabels = append(db.VersionLabels, algorithm.VersionLabel{
+				ResourceID: resourceIDs.ID(row.Resource),
+				VersionID:  versionIDs.ID(row.Version),
+				Label:      row.Label,
+			})
+		}
 	}
 
 	inputConfigs := make(algorithm.InputConfigs, len(example.Inputs))
@@ -147,17 +159,36 @@ func (example Example) Run() {
 			passed[jobIDs.ID(jobName)] = struct{}{}
 		}
 
+		var passedAny algorithm.JobSet
+		if input.PassedAny != nil {
+			passedAny = algorithm.JobSet{}
+			for _, jobName := range input.PassedAny {
+				passedAny[jobIDs.ID(jobName)] = struct{}{}
+			}
+		}
+
 		var versionID int
 		if input.Version.Pinned != "" {
 			versionID = versionIDs.ID(input.Version.Pinned)
 		}
 
+		var filter algorithm.VersionFilter
+		if input.Version.Filter != nil {
+			filter = algorithm.VersionFilter{}
+			for _, version := range input.Version.Filter {
+				filter[versionIDs.ID(version)] = struct{}{}
+			}
+		}
+
 		inputConfigs[i] = algorithm.InputConfig{
 			Name:            input.Name,
 			Passed:          passed,
+			PassedAny:       passedAny,
+			Labeled:         input.Labeled,
 			ResourceID:      resourceIDs.ID(input.Resource),
 			UseEveryVersion: input.Version.Every,
 			PinnedVersionID: versionID,
+			VersionFilter:   filter,
 			JobID:           jobIDs.ID(CurrentJobName),
 		}
 	}
@@ -0,0 +1,35 @@
+package algorithm
+
+// A VersionFilter restricts an input to the versions it contains. A nil
+// filter allows every version.
+type VersionFilter map[int]struct{}
+
+func (filter VersionFilter) Allows(versionID int) bool {
+	if filter == nil {
+		return true
+	}
+
+	_, found := filter[versionID]
+	return found
+}
+
+// Intersect returns a filter allowing only the versions allowed by both
+// filters.
+func (filter VersionFilter) Intersect(other VersionFilter) VersionFilter {
+	if filter == nil {
+		return other
+	}
+
+	if other == nil {
+		return filter
+	}
+
+	result := VersionFilter{}
+	for versionID := range filter {
+		if other.Allows(versionID) {
+			result[versionID] = struct{}{}
+		}
+	}
+
+	return result
+}
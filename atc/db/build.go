@@ -51,6 +51,10 @@ var buildsQuery = psql.Select(`
 		b.team_id,
 		b.status,
 		b.manually_triggered,
+		b.created_by,
+		b.trigger,
+		b.awaiting_approval,
+		b.approval,
 		b.scheduled,
 		b.schema,
 		b.private_plan,
@@ -66,7 +70,10 @@ var buildsQuery = psql.Select(`
 		b.nonce,
 		b.drained,
 		b.aborted,
-		b.completed
+		b.completed,
+		COALESCE(j.priority, t.default_job_priority, 0),
+		(SELECT max(c.debug_until) FROM containers c WHERE c.build_id = b.id AND c.debug_until > now()),
+		b.timeout
 	`).
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
@@ -97,6 +104,13 @@ type Build interface {
 	EndTime() time.Time
 	ReapTime() time.Time
 	IsManuallyTriggered() bool
+	CreatedBy() string
+	Trigger() *atc.BuildTrigger
+	AwaitingApproval() bool
+	Approval() *atc.BuildApproval
+	Priority() int
+	DebugUntil() time.Time
+	Timeout() time.Duration
 	IsScheduled() bool
 	IsRunning() bool
 	IsCompleted() bool
@@ -112,6 +126,7 @@ type Build interface {
 	Finish(BuildStatus) error
 
 	SetInterceptible(bool) error
+	HoldContainerForDebugging(logger lager.Logger, planID atc.PlanID, until time.Time, limits atc.DebugContainerLimits) (bool, error)
 
 	Events(uint) (EventSource, error)
 	SaveEvent(event atc.Event) error
@@ -120,10 +135,12 @@ type Build interface {
 	Artifact(artifactID int) (WorkerArtifact, error)
 
 	SaveOutput(string, atc.Source, atc.VersionedResourceTypes, atc.Version, ResourceConfigMetadataFields, string, string) error
+	LabelOutput(resourceName string, version atc.Version, labels []string) error
 	UseInputs(inputs []BuildInput) error
 
 	Resources() ([]BuildInput, []BuildOutput, error)
 	SaveImageResourceVersion(UsedResourceCache) error
+	SaveTaskResourceUsage(planID atc.PlanID, stepName string, usage atc.TaskResourceUsage) error
 
 	Delete() (bool, error)
 	MarkAsAborted() error
@@ -131,6 +148,13 @@ type Build interface {
 	AbortNotifier() (Notifier, error)
 	Schedule() (bool, error)
 
+	RequestApproval(inputs []BuildInput) (bool, error)
+	ApprovalInputs() ([]BuildInput, error)
+	AcquireLocks(names []string) (bool, error)
+	ReleaseLocks() error
+	Approve(approver string, comment string) (bool, error)
+	Reject(approver string, comment string) (bool, error)
+
 	IsDrained() bool
 	SetDrained(bool) error
 }
@@ -150,6 +174,13 @@ type build struct {
 	jobName string
 
 	isManuallyTriggered bool
+	createdBy           string
+	trigger             *atc.BuildTrigger
+
+	awaitingApproval bool
+	approval         *atc.BuildApproval
+
+	priority int
 
 	schema      string
 	privatePlan atc.Plan
@@ -159,6 +190,10 @@ type build struct {
 	startTime  time.Time
 	endTime    time.Time
 	reapTime   time.Time
+	debugUntil time.Time
+
+	// timeout is the job's timeout at the time the build started
+	timeout time.Duration
 
 	drained   bool
 	aborted   bool
@@ -189,6 +224,11 @@ func (b *build) JobName() string              { return b.jobName }
 func (b *build) TeamID() int                  { return b.teamID }
 func (b *build) TeamName() string             { return b.teamName }
 func (b *build) IsManuallyTriggered() bool    { return b.isManuallyTriggered }
+func (b *build) CreatedBy() string            { return b.createdBy }
+func (b *build) Trigger() *atc.BuildTrigger   { return b.trigger }
+func (b *build) AwaitingApproval() bool       { return b.awaitingApproval }
+func (b *build) Approval() *atc.BuildApproval { return b.approval }
+func (b *build) Priority() int                { return b.priority }
 func (b *build) Schema() string               { return b.schema }
 func (b *build) PrivatePlan() atc.Plan        { return b.privatePlan }
 func (b *build) PublicPlan() *json.RawMessage { return b.publicPlan }
@@ -196,15 +236,17 @@ func (b *build) HasPlan() bool                { return string(*b.publicPlan) !=
 func (b *build) IsNewerThanLastCheckOf(input Resource) bool {
 	return b.createTime.After(input.LastCheckEndTime())
 }
-func (b *build) StartTime() time.Time { return b.startTime }
-func (b *build) EndTime() time.Time   { return b.endTime }
-func (b *build) ReapTime() time.Time  { return b.reapTime }
-func (b *build) Status() BuildStatus  { return b.status }
-func (b *build) IsScheduled() bool    { return b.scheduled }
-func (b *build) IsDrained() bool      { return b.drained }
-func (b *build) IsRunning() bool      { return !b.completed }
-func (b *build) IsAborted() bool      { return b.aborted }
-func (b *build) IsCompleted() bool    { return b.completed }
+func (b *build) StartTime() time.Time   { return b.startTime }
+func (b *build) EndTime() time.Time     { return b.endTime }
+func (b *build) ReapTime() time.Time    { return b.reapTime }
+func (b *build) DebugUntil() time.Time  { return b.debugUntil }
+func (b *build) Timeout() time.Duration { return b.timeout }
+func (b *build) Status() BuildStatus    { return b.status }
+func (b *build) IsScheduled() bool      { return b.scheduled }
+func (b *build) IsDrained() bool        { return b.drained }
+func (b *build) IsRunning() bool        { return !b.completed }
+func (b *build) IsAborted() bool        { return b.aborted }
+func (b *build) IsCompleted() bool      { return b.completed }
 
 func (b *build) Reload() (bool, error) {
 	row := buildsQuery.Where(sq.Eq{"b.id": b.id}).
@@ -264,6 +306,90 @@ func (b *build) SetInterceptible(i bool) error {
 	return nil
 }
 
+// debugContainersLockTimeout bounds how long a failed step waits for other
+// steps to finish holding their containers before giving up on its own.
+const debugContainersLockTimeout = time.Second
+
+// HoldContainerForDebugging keeps the container of the given step out of
+// garbage collection until the given time, provided that doing so does not
+// exceed the limits. It returns false if the limits have been reached, the
+// container no longer exists, or other holds kept it waiting for longer than
+// debugContainersLockTimeout.
+func (b *build) HoldContainerForDebugging(logger lager.Logger, planID atc.PlanID, until time.Time, limits atc.DebugContainerLimits) (bool, error) {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	// serialize holds so that concurrent failures can't exceed the limits;
+	// the lock is released along with the transaction
+	_, err = tx.Exec(fmt.Sprintf(`SET LOCAL lock_timeout = %d`, debugContainersLockTimeout.Milliseconds()))
+	if err != nil {
+		return false, err
+	}
+
+	_, err = tx.Exec(`SELECT pg_advisory_xact_lock($1)`, lock.LockTypeDebugContainers)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqLockNotAvailableErrCode {
+			logger.Info("timed-out-waiting-for-debug-containers-lock")
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	var held, heldByTeam int
+	err = psql.Select("COUNT(*)").
+		Column(sq.Expr("COUNT(*) FILTER (WHERE team_id = ?)", b.teamID)).
+		From("containers").
+		Where(sq.Expr("debug_until > now()")).
+		RunWith(tx).
+		QueryRow().
+		Scan(&held, &heldByTeam)
+	if err != nil {
+		return false, err
+	}
+
+	if limits.Max > 0 && held >= limits.Max {
+		return false, nil
+	}
+
+	if limits.MaxPerTeam > 0 && heldByTeam >= limits.MaxPerTeam {
+		return false, nil
+	}
+
+	result, err := psql.Update("containers").
+		Set("debug_until", until).
+		Where(sq.Eq{
+			"build_id": b.id,
+			"plan_id":  string(planID),
+			"state":    atc.ContainerStateCreated,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if affected == 0 {
+		return false, nil
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (b *build) Start(plan atc.Plan) (bool, error) {
 	tx, err := b.conn.Begin()
 	if err != nil {
@@ -282,6 +408,14 @@ func (b *build) Start(plan atc.Plan) (bool, error) {
 		return false, err
 	}
 
+	var timeout sql.NullString
+	if b.jobID != 0 {
+		timeout, err = b.jobTimeout(tx)
+		if err != nil {
+			return false, err
+		}
+	}
+
 	var startTime time.Time
 
 	err = psql.Update("builds").
@@ -291,6 +425,7 @@ func (b *build) Start(plan atc.Plan) (bool, error) {
 		Set("private_plan", encryptedPlan).
 		Set("public_plan", plan.Public()).
 		Set("nonce", nonce).
+		Set("timeout", timeout).
 		Where(sq.Eq{
 			"id":      b.id,
 			"status":  "pending",
@@ -335,6 +470,43 @@ func (b *build) Start(plan atc.Plan) (bool, error) {
 	return true, nil
 }
 
+// jobTimeout returns the timeout configured for the build's job, so that a
+// build keeps the timeout it started with when the job is reconfigured.
+func (b *build) jobTimeout(tx Tx) (sql.NullString, error) {
+	var (
+		configBlob []byte
+		nonce      sql.NullString
+	)
+
+	err := psql.Select("config", "nonce").
+		From("jobs").
+		Where(sq.Eq{"id": b.jobID}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&configBlob, &nonce)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decryptedConfig, err := b.conn.EncryptionStrategy().Decrypt(string(configBlob), noncense)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	var config atc.JobConfig
+	err = json.Unmarshal(decryptedConfig, &config)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	return sql.NullString{String: config.Timeout, Valid: config.Timeout != ""}, nil
+}
+
 func (b *build) Finish(status BuildStatus) error {
 	tx, err := b.conn.Begin()
 	if err != nil {
@@ -375,6 +547,14 @@ func (b *build) Finish(status BuildStatus) error {
 		return err
 	}
 
+	_, err = psql.Delete("build_locks").
+		Where(sq.Eq{"build_id": b.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
 	if b.jobID != 0 && status == BuildStatusSucceeded {
 		_, err = psql.Delete("build_image_resource_caches birc USING builds b").
 			Where(sq.Expr("birc.build_id = b.id")).
@@ -512,6 +692,320 @@ func (b *build) Schedule() (bool, error) {
 	return rows == 1, nil
 }
 
+// RequestApproval marks a pending build as awaiting approval of the given
+// inputs. It returns false if the build has already been approved, rejected,
+// or is already awaiting approval.
+//
+// The inputs are kept apart from the inputs of started builds so that they
+// don't count as used until the build is approved and started with them.
+func (b *build) RequestApproval(inputs []BuildInput) (bool, error) {
+	inputsJSON, err := json.Marshal(inputs)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := psql.Update("builds").
+		Set("awaiting_approval", true).
+		Set("approval_inputs", inputsJSON).
+		Where(sq.Eq{
+			"id":                b.id,
+			"status":            BuildStatusPending,
+			"awaiting_approval": false,
+			"approval":          nil,
+		}).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if rows == 1 {
+		b.awaitingApproval = true
+	}
+
+	return rows == 1, nil
+}
+
+// ApprovalInputs returns the inputs the build awaited approval of.
+func (b *build) ApprovalInputs() ([]BuildInput, error) {
+	var inputsJSON sql.NullString
+	err := psql.Select("approval_inputs").
+		From("builds").
+		Where(sq.Eq{"id": b.id}).
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&inputsJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := []BuildInput{}
+	if inputsJSON.Valid {
+		err = json.Unmarshal([]byte(inputsJSON.String), &inputs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return inputs, nil
+}
+
+// Approve releases a build awaiting approval so that it may be started with
+// the inputs it was awaiting approval with.
+func (b *build) Approve(approver string, comment string) (bool, error) {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	decided, err := b.decideApproval(tx, true, approver, comment)
+	if err != nil {
+		return false, err
+	}
+
+	if !decided {
+		return false, nil
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Reject cancels a build awaiting approval. The build will be aborted the next
+// time its job is scheduled.
+func (b *build) Reject(approver string, comment string) (bool, error) {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	decided, err := b.decideApproval(tx, false, approver, comment)
+	if err != nil {
+		return false, err
+	}
+
+	if !decided {
+		return false, nil
+	}
+
+	_, err = psql.Update("builds").
+		Set("aborted", true).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	b.aborted = true
+
+	return true, b.conn.Bus().Notify(buildAbortChannel(b.id))
+}
+
+func (b *build) decideApproval(tx Tx, approved bool, approver string, comment string) (bool, error) {
+	approval := &atc.BuildApproval{
+		Approved: approved,
+		By:       approver,
+		Comment:  comment,
+		Time:     time.Now().Unix(),
+	}
+
+	approvalJSON, err := json.Marshal(approval)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := psql.Update("builds").
+		Set("awaiting_approval", false).
+		Set("approval", approvalJSON).
+		Where(sq.Eq{
+			"id":                b.id,
+			"awaiting_approval": true,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if rows != 1 {
+		return false, nil
+	}
+
+	b.awaitingApproval = false
+	b.approval = approval
+
+	return true, nil
+}
+
+// AcquireLocks queues the build for each of the named team locks and
+// acquires all of them if none are held by another build and no build created
+// before this one is waiting for them. The build stays queued until it
+// acquires the locks or ReleaseLocks is called, and locks are released when
+// the build finishes.
+func (b *build) AcquireLocks(names []string) (bool, error) {
+	err := b.queueForLocks(names)
+	if err != nil {
+		return false, err
+	}
+
+	for attempt := 0; attempt < maxLockAttempts; attempt++ {
+		acquired, err := b.tryAcquireLocks(names)
+		if err == nil {
+			return acquired, nil
+		}
+
+		if pqErr, ok := err.(*pq.Error); !ok || pqErr.Code.Name() != pqUniqueViolationErrCode {
+			return false, err
+		}
+
+		// another build acquired one of the locks concurrently; try again so
+		// that it shows up as the holder
+	}
+
+	return false, nil
+}
+
+const maxLockAttempts = 3
+
+// queueForLocks records the build as waiting for the named locks in its own
+// transaction, so that the build keeps its place in the queue however the
+// attempt to acquire them goes.
+func (b *build) queueForLocks(names []string) error {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Delete("build_locks").
+		Where(sq.Eq{"build_id": b.id}).
+		Where(sq.NotEq{"name": names}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		_, err = psql.Insert("build_locks").
+			Columns("team_id", "name", "build_id").
+			Values(b.teamID, name, b.id).
+			Suffix("ON CONFLICT DO NOTHING").
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (b *build) tryAcquireLocks(names []string) (bool, error) {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	var held int
+	err = psql.Select("COUNT(*)").
+		From("build_locks").
+		Where(sq.Eq{
+			"build_id": b.id,
+			"held":     true,
+		}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&held)
+	if err != nil {
+		return false, err
+	}
+
+	if held == len(names) {
+		return true, nil
+	}
+
+	var blocked bool
+	err = tx.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1
+			FROM build_locks l
+			JOIN builds b ON b.id = l.build_id
+			WHERE l.team_id = $1
+			AND l.name = ANY($2)
+			AND l.build_id != $3
+			AND (
+				l.held
+				OR (b.create_time, b.id) < (SELECT create_time, id FROM builds WHERE id = $3)
+			)
+		)
+	`, b.teamID, pq.Array(names), b.id).Scan(&blocked)
+	if err != nil {
+		return false, err
+	}
+
+	if blocked {
+		return false, nil
+	}
+
+	_, err = psql.Update("build_locks").
+		Set("held", true).
+		Set("acquired_at", sq.Expr("now()")).
+		Where(sq.Eq{
+			"build_id": b.id,
+			"held":     false,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ReleaseLocks removes the build from the queues of any locks it holds or
+// waits for, e.g. because it is not going to start after all.
+func (b *build) ReleaseLocks() error {
+	_, err := psql.Delete("build_locks").
+		Where(sq.Eq{"build_id": b.id}).
+		RunWith(b.conn).
+		Exec()
+	return err
+}
+
 func (b *build) SaveImageResourceVersion(rc UsedResourceCache) error {
 	_, err := psql.Insert("build_image_resource_caches").
 		Columns("resource_cache_id", "build_id").
@@ -529,6 +1023,30 @@ func (b *build) SaveImageResourceVersion(rc UsedResourceCache) error {
 	return nil
 }
 
+func (b *build) SaveTaskResourceUsage(planID atc.PlanID, stepName string, usage atc.TaskResourceUsage) error {
+	outputSizes, err := json.Marshal(usage.OutputSizes)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("task_resource_usages").
+		Columns("build_id", "plan_id", "step_name", "cpu_time", "peak_memory", "average_memory", "peak_disk", "output_sizes").
+		Values(b.id, string(planID), stepName, usage.CPUTime, usage.PeakMemory, usage.AverageMemory, usage.PeakDisk, outputSizes).
+		Suffix(`
+			ON CONFLICT (build_id, plan_id) DO UPDATE SET
+				step_name = EXCLUDED.step_name,
+				cpu_time = EXCLUDED.cpu_time,
+				peak_memory = EXCLUDED.peak_memory,
+				average_memory = EXCLUDED.average_memory,
+				peak_disk = EXCLUDED.peak_disk,
+				output_sizes = EXCLUDED.output_sizes,
+				created_at = now()
+		`).
+		RunWith(b.conn).
+		Exec()
+	return err
+}
+
 func (b *build) AcquireTrackingLock(logger lager.Logger, interval time.Duration) (lock.Lock, bool, error) {
 	lock, acquired, err := b.lockFactory.Acquire(
 		logger.Session("lock", lager.Data{
@@ -679,7 +1197,7 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 				inputs[configInput.Name] = BuildPreparationStatusNotBlocking
 			} else {
 				inputs[configInput.Name] = BuildPreparationStatusBlocking
-				if len(configInput.Passed) > 0 {
+				if len(configInput.Passed) > 0 || len(configInput.PassedAny) > 0 {
 					if configInput.Version != nil && configInput.Version.Pinned != nil {
 						versionJSON, err := json.Marshal(configInput.Version.Pinned)
 						if err != nil {
@@ -830,6 +1348,71 @@ func (b *build) Artifacts() ([]WorkerArtifact, error) {
 	return artifacts, nil
 }
 
+// LabelOutput labels a version of one of the pipeline's resources, recording
+// the build as having set the labels. Labels belong to the version, so every
+// resource sharing its version history sees them.
+func (b *build) LabelOutput(resourceName string, version atc.Version, labels []string) error {
+	if b.pipelineID == 0 {
+		return ErrBuildHasNoPipeline
+	}
+
+	pipeline, found, err := b.Pipeline()
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return ErrBuildHasNoPipeline
+	}
+
+	resource, found, err := pipeline.Resource(resourceName)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return ResourceNotFoundInPipeline{resourceName, b.pipelineName}
+	}
+
+	versionBytes, err := json.Marshal(version)
+	if err != nil {
+		return err
+	}
+
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	setBy := fmt.Sprintf("%s/%s/%s", b.pipelineName, b.jobName, b.name)
+
+	for _, label := range labels {
+		_, err = tx.Exec(`
+			INSERT INTO resource_version_labels (resource_config_scope_id, version_md5, label, set_by, build_id)
+			SELECT rcv.resource_config_scope_id, rcv.version_md5, $3, $4, $5
+			FROM resource_config_versions rcv
+			JOIN resources r ON r.resource_config_scope_id = rcv.resource_config_scope_id
+			WHERE r.id = $1 AND rcv.version_md5 = md5($2)
+			ON CONFLICT (resource_config_scope_id, version_md5, label) DO UPDATE SET
+				set_by = EXCLUDED.set_by,
+				build_id = EXCLUDED.build_id,
+				created_at = now()
+			`, resource.ID(), string(versionBytes), label, setBy, b.id)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return bumpCacheIndexForPipelinesUsingResourceConfigScope(b.conn, resource.ResourceConfigScopeID())
+}
+
 func (b *build) SaveOutput(
 	resourceType string,
 	source atc.Source,
@@ -1120,8 +1703,8 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	var (
 		jobID, pipelineID                                      sql.NullInt64
 		schema, privatePlan, jobName, pipelineName, publicPlan sql.NullString
-		createTime, startTime, endTime, reapTime               pq.NullTime
-		nonce                                                  sql.NullString
+		createTime, startTime, endTime, reapTime, debugUntil   pq.NullTime
+		nonce, createdBy, trigger, approval, timeout           sql.NullString
 		drained, aborted, completed                            bool
 		status                                                 string
 	)
@@ -1133,6 +1716,10 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		&b.teamID,
 		&status,
 		&b.isManuallyTriggered,
+		&createdBy,
+		&trigger,
+		&b.awaitingApproval,
+		&approval,
 		&b.scheduled,
 		&schema,
 		&privatePlan,
@@ -1149,6 +1736,9 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		&drained,
 		&aborted,
 		&completed,
+		&b.priority,
+		&debugUntil,
+		&timeout,
 	)
 	if err != nil {
 		return err
@@ -1160,14 +1750,24 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.pipelineName = pipelineName.String
 	b.pipelineID = int(pipelineID.Int64)
 	b.schema = schema.String
+	b.createdBy = createdBy.String
 	b.createTime = createTime.Time
 	b.startTime = startTime.Time
 	b.endTime = endTime.Time
 	b.reapTime = reapTime.Time
+	b.debugUntil = debugUntil.Time
 	b.drained = drained
 	b.aborted = aborted
 	b.completed = completed
 
+	b.timeout = 0
+	if timeout.Valid {
+		b.timeout, err = time.ParseDuration(timeout.String)
+		if err != nil {
+			return err
+		}
+	}
+
 	var (
 		noncense      *string
 		decryptedPlan []byte
@@ -1197,6 +1797,22 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		}
 	}
 
+	b.trigger = nil
+	if trigger.Valid {
+		err = json.Unmarshal([]byte(trigger.String), &b.trigger)
+		if err != nil {
+			return err
+		}
+	}
+
+	b.approval = nil
+	if approval.Valid {
+		err = json.Unmarshal([]byte(approval.String), &b.approval)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
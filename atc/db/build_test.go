@@ -3,10 +3,12 @@ package db_test
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/algorithm"
+	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/event"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -71,6 +73,149 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("HoldContainerForDebugging", func() {
+		var (
+			build     db.Build
+			container db.CreatedContainer
+			limits    atc.DebugContainerLimits
+			until     time.Time
+
+			held    bool
+			holdErr error
+		)
+
+		createTaskContainer := func(build db.Build, planID atc.PlanID) db.CreatedContainer {
+			creating, err := defaultWorker.CreateContainer(
+				db.NewBuildStepContainerOwner(build.ID(), planID, build.TeamID()),
+				db.ContainerMetadata{Type: db.ContainerTypeTask},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			created, err := creating.Created()
+			Expect(err).NotTo(HaveOccurred())
+
+			return created
+		}
+
+		BeforeEach(func() {
+			var err error
+			build, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			container = createTaskContainer(build, "some-plan")
+
+			limits = atc.DebugContainerLimits{}
+			until = time.Now().Add(time.Hour)
+		})
+
+		JustBeforeEach(func() {
+			held, holdErr = build.HoldContainerForDebugging(logger, "some-plan", until, limits)
+		})
+
+		It("keeps the container until the given time", func() {
+			Expect(holdErr).NotTo(HaveOccurred())
+			Expect(held).To(BeTrue())
+
+			_, created, err := defaultWorker.FindContainer(db.NewBuildStepContainerOwner(build.ID(), "some-plan", build.TeamID()))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.Handle()).To(Equal(container.Handle()))
+			Expect(created.DebugUntil()).To(BeTemporally("~", until, time.Second))
+		})
+
+		It("shows on the build", func() {
+			found, err := build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.DebugUntil()).To(BeTemporally("~", until, time.Second))
+		})
+
+		Context("when the container does not exist", func() {
+			BeforeEach(func() {
+				_, err := container.Destroying()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not hold anything", func() {
+				Expect(holdErr).NotTo(HaveOccurred())
+				Expect(held).To(BeFalse())
+			})
+		})
+
+		Context("when the team has reached its limit", func() {
+			BeforeEach(func() {
+				limits.MaxPerTeam = 1
+
+				otherBuild, err := team.CreateOneOffBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				createTaskContainer(otherBuild, "some-plan")
+
+				held, err := otherBuild.HoldContainerForDebugging(logger, "some-plan", until, limits)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(held).To(BeTrue())
+			})
+
+			It("does not hold the container", func() {
+				Expect(holdErr).NotTo(HaveOccurred())
+				Expect(held).To(BeFalse())
+			})
+
+			Context("when the other container's window has passed", func() {
+				BeforeEach(func() {
+					_, err := dbConn.Exec(`UPDATE containers SET debug_until = now() - interval '1 second' WHERE debug_until IS NOT NULL`)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("holds the container", func() {
+					Expect(holdErr).NotTo(HaveOccurred())
+					Expect(held).To(BeTrue())
+				})
+			})
+		})
+
+		Context("when another hold keeps the limits locked", func() {
+			var tx db.Tx
+
+			BeforeEach(func() {
+				var err error
+				tx, err = dbConn.Begin()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = tx.Exec(`SELECT pg_advisory_xact_lock($1)`, lock.LockTypeDebugContainers)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				Expect(tx.Rollback()).To(Succeed())
+			})
+
+			It("gives up without holding the container", func() {
+				Expect(holdErr).NotTo(HaveOccurred())
+				Expect(held).To(BeFalse())
+			})
+		})
+
+		Context("when the cluster has reached its limit", func() {
+			BeforeEach(func() {
+				limits.Max = 1
+
+				otherBuild, err := defaultTeam.CreateOneOffBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				createTaskContainer(otherBuild, "some-plan")
+
+				held, err := otherBuild.HoldContainerForDebugging(logger, "some-plan", until, limits)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(held).To(BeTrue())
+			})
+
+			It("does not hold the container", func() {
+				Expect(holdErr).NotTo(HaveOccurred())
+				Expect(held).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("Start", func() {
 		var err error
 		var started bool
@@ -166,6 +311,46 @@ var _ = Describe("Build", func() {
 				Expect(build.HasPlan()).To(BeTrue())
 				Expect(build.PublicPlan()).To(Equal(plan.Public()))
 			})
+
+			It("has no timeout", func() {
+				found, err := build.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(build.Timeout()).To(BeZero())
+			})
+		})
+
+		Context("when the build belongs to a job with a timeout", func() {
+			var pipeline db.Pipeline
+
+			BeforeEach(func() {
+				config := atc.Config{
+					Jobs: atc.JobConfigs{{Name: "some-job", Timeout: "1h"}},
+				}
+
+				var err error
+				pipeline, _, err = team.SavePipeline("timeout-pipeline", config, db.ConfigVersion(0), false)
+				Expect(err).NotTo(HaveOccurred())
+
+				job, found, err := pipeline.Job("some-job")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				build, err = job.CreateBuild("some-user")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("keeps the timeout the job had when the build started", func() {
+				_, _, err := team.SavePipeline("timeout-pipeline", atc.Config{
+					Jobs: atc.JobConfigs{{Name: "some-job", Timeout: "1m"}},
+				}, pipeline.ConfigVersion(), false)
+				Expect(err).NotTo(HaveOccurred())
+
+				found, err := build.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(build.Timeout()).To(Equal(time.Hour))
+			})
 		})
 	})
 
@@ -420,7 +605,7 @@ var _ = Describe("Build", func() {
 
 		Context("when the version does not exist", func() {
 			It("can save a build's output", func() {
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				err = build.SaveOutput("some-type", atc.Source{"some": "explicit-source"}, atc.VersionedResourceTypes{}, atc.Version{"some": "version"}, []db.ResourceConfigMetadataField{
@@ -461,7 +646,7 @@ var _ = Describe("Build", func() {
 			})
 
 			It("does not increment the check order", func() {
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				err = build.SaveOutput("some-type", atc.Source{"some": "explicit-source"}, atc.VersionedResourceTypes{}, atc.Version{"some": "version"}, []db.ResourceConfigMetadataField{
@@ -484,6 +669,30 @@ var _ = Describe("Build", func() {
 			})
 		})
 
+		Context("when the output is labeled", func() {
+			It("labels the version as set by the build", func() {
+				build, err := job.CreateBuild("some-user")
+				Expect(err).ToNot(HaveOccurred())
+
+				err = build.SaveOutput("some-type", atc.Source{"some": "explicit-source"}, atc.VersionedResourceTypes{}, atc.Version{"some": "version"}, nil, "output-name", "some-explicit-resource")
+				Expect(err).ToNot(HaveOccurred())
+
+				err = build.LabelOutput("some-explicit-resource", atc.Version{"some": "version"}, []string{"deployed-to-prod"})
+				Expect(err).ToNot(HaveOccurred())
+
+				resource, found, err := pipeline.Resource("some-explicit-resource")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				versions, _, found, err := resource.Versions(db.Page{Limit: 1}, atc.Version{"some": "version"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(versions[0].Labels).To(HaveLen(1))
+				Expect(versions[0].Labels[0].Label).To(Equal("deployed-to-prod"))
+				Expect(versions[0].Labels[0].SetBy).To(Equal("some-pipeline/some-job/" + build.Name()))
+			})
+		})
+
 		Context("when global resources is enabled", func() {
 			BeforeEach(func() {
 				atc.EnableGlobalResources = true
@@ -521,7 +730,7 @@ var _ = Describe("Build", func() {
 						})
 
 						It("saves the output", func() {
-							build, err := job.CreateBuild()
+							build, err := job.CreateBuild("some-user")
 							Expect(err).ToNot(HaveOccurred())
 
 							err = build.SaveOutput(
@@ -621,7 +830,7 @@ var _ = Describe("Build", func() {
 		})
 
 		It("returns build inputs and outputs", func() {
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			// save a normal 'get'
@@ -695,7 +904,7 @@ var _ = Describe("Build", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err = job.CreateBuild()
+				build, err = job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -803,7 +1012,7 @@ var _ = Describe("Build", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err = job.CreateBuild()
+				build, err = job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				expectedBuildPrep.BuildID = build.ID()
@@ -1150,7 +1359,7 @@ var _ = Describe("Build", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err = job.CreateBuild()
+				build, err = job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(build.IsScheduled()).To(BeFalse())
 			})
@@ -1186,6 +1395,234 @@ var _ = Describe("Build", func() {
 				})
 			})
 		})
+
+		Describe("AcquireLocks", func() {
+			var firstBuild, secondBuild db.Build
+
+			BeforeEach(func() {
+				for i, pipelineName := range []string{"first-pipeline", "second-pipeline"} {
+					pipeline, _, err := team.SavePipeline(pipelineName, atc.Config{
+						Jobs: atc.JobConfigs{
+							{
+								Name:  "deploy",
+								Locks: []string{"staging-env"},
+							},
+						},
+					}, db.ConfigVersion(1), false)
+					Expect(err).ToNot(HaveOccurred())
+
+					job, found, err := pipeline.Job("deploy")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					build, err := job.CreateBuild("some-user")
+					Expect(err).ToNot(HaveOccurred())
+
+					if i == 0 {
+						firstBuild = build
+					} else {
+						secondBuild = build
+					}
+				}
+			})
+
+			Context("when a later build asks for the lock first", func() {
+				It("does not jump the queue", func() {
+					acquired, err := firstBuild.AcquireLocks([]string{"staging-env"})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(acquired).To(BeTrue())
+
+					acquired, err = secondBuild.AcquireLocks([]string{"staging-env"})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(acquired).To(BeFalse())
+				})
+			})
+
+			Context("when an earlier build is waiting", func() {
+				BeforeEach(func() {
+					_, err := psql.Insert("build_locks").
+						Columns("team_id", "name", "build_id").
+						Values(team.ID(), "staging-env", firstBuild.ID()).
+						RunWith(dbConn).
+						Exec()
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("waits for the earlier build", func() {
+					acquired, err := secondBuild.AcquireLocks([]string{"staging-env"})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(acquired).To(BeFalse())
+				})
+
+				Context("when the earlier build releases its place in the queue", func() {
+					BeforeEach(func() {
+						Expect(firstBuild.ReleaseLocks()).To(Succeed())
+					})
+
+					It("lets the later build acquire the lock", func() {
+						acquired, err := secondBuild.AcquireLocks([]string{"staging-env"})
+						Expect(err).ToNot(HaveOccurred())
+						Expect(acquired).To(BeTrue())
+					})
+				})
+			})
+
+			Context("when the lock is held", func() {
+				BeforeEach(func() {
+					acquired, err := firstBuild.AcquireLocks([]string{"staging-env"})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(acquired).To(BeTrue())
+
+					acquired, err = secondBuild.AcquireLocks([]string{"staging-env"})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(acquired).To(BeFalse())
+				})
+
+				It("lists the holder and waiters", func() {
+					locks, err := team.Locks()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(locks).To(HaveLen(1))
+					Expect(locks[0].Name).To(Equal("staging-env"))
+					Expect(locks[0].Holder).ToNot(BeNil())
+					Expect(locks[0].Holder.ID).To(Equal(firstBuild.ID()))
+					Expect(locks[0].Holder.PipelineName).To(Equal("first-pipeline"))
+					Expect(locks[0].Waiters).To(HaveLen(1))
+					Expect(locks[0].Waiters[0].ID).To(Equal(secondBuild.ID()))
+				})
+
+				It("is still held when the holder asks again", func() {
+					acquired, err := firstBuild.AcquireLocks([]string{"staging-env"})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(acquired).To(BeTrue())
+				})
+
+				Context("when the holder finishes", func() {
+					BeforeEach(func() {
+						err := firstBuild.Finish(db.BuildStatusSucceeded)
+						Expect(err).ToNot(HaveOccurred())
+					})
+
+					It("lets the next waiter acquire the lock", func() {
+						acquired, err := secondBuild.AcquireLocks([]string{"staging-env"})
+						Expect(err).ToNot(HaveOccurred())
+						Expect(acquired).To(BeTrue())
+					})
+				})
+
+				Context("when the lock is force-released", func() {
+					BeforeEach(func() {
+						released, err := team.ReleaseLock("staging-env")
+						Expect(err).ToNot(HaveOccurred())
+						Expect(released).To(BeTrue())
+					})
+
+					It("lets the next waiter acquire the lock", func() {
+						acquired, err := secondBuild.AcquireLocks([]string{"staging-env"})
+						Expect(err).ToNot(HaveOccurred())
+						Expect(acquired).To(BeTrue())
+					})
+				})
+			})
+		})
+
+		Describe("Approval", func() {
+			var build db.Build
+
+			BeforeEach(func() {
+				pipeline, _, err := team.SavePipeline("some-pipeline", atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name:             "some-job",
+							RequiresApproval: &atc.ApprovalConfig{},
+						},
+					},
+				}, db.ConfigVersion(1), false)
+				Expect(err).ToNot(HaveOccurred())
+
+				job, found, err := pipeline.Job("some-job")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				build, err = job.CreateBuild("some-user")
+				Expect(err).ToNot(HaveOccurred())
+
+				requested, err := build.RequestApproval([]db.BuildInput{
+					{Name: "some-input", ResourceID: 1, Version: atc.Version{"ref": "abc"}, FirstOccurrence: true},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(requested).To(BeTrue())
+			})
+
+			It("keeps the inputs awaiting approval apart from the build's inputs", func() {
+				inputs, err := build.ApprovalInputs()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(inputs).To(Equal([]db.BuildInput{
+					{Name: "some-input", ResourceID: 1, Version: atc.Version{"ref": "abc"}, FirstOccurrence: true},
+				}))
+
+				buildInputs, _, err := build.Resources()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(buildInputs).To(BeEmpty())
+			})
+
+			It("marks the build as awaiting approval", func() {
+				found, err := build.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(build.AwaitingApproval()).To(BeTrue())
+				Expect(build.Approval()).To(BeNil())
+			})
+
+			It("does not request approval twice", func() {
+				requested, err := build.RequestApproval(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(requested).To(BeFalse())
+			})
+
+			Context("when the build is approved", func() {
+				BeforeEach(func() {
+					approved, err := build.Approve("some-approver", "ship it")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(approved).To(BeTrue())
+				})
+
+				It("records the approver and comment", func() {
+					found, err := build.Reload()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(build.AwaitingApproval()).To(BeFalse())
+					Expect(build.IsAborted()).To(BeFalse())
+					Expect(build.Approval()).ToNot(BeNil())
+					Expect(build.Approval().Approved).To(BeTrue())
+					Expect(build.Approval().By).To(Equal("some-approver"))
+					Expect(build.Approval().Comment).To(Equal("ship it"))
+				})
+
+				It("cannot be decided again", func() {
+					rejected, err := build.Reject("someone-else", "")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(rejected).To(BeFalse())
+				})
+			})
+
+			Context("when the build is rejected", func() {
+				BeforeEach(func() {
+					rejected, err := build.Reject("some-approver", "not today")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(rejected).To(BeTrue())
+				})
+
+				It("records the rejection and aborts the build", func() {
+					found, err := build.Reload()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(build.AwaitingApproval()).To(BeFalse())
+					Expect(build.IsAborted()).To(BeTrue())
+					Expect(build.Approval().Approved).To(BeFalse())
+					Expect(build.Approval().Comment).To(Equal("not today"))
+				})
+			})
+		})
 	})
 
 	Describe("UseInputs", func() {
@@ -1226,7 +1663,7 @@ var _ = Describe("Build", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err = job.CreateBuild()
+			build, err = job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			setupTx, err := dbConn.Begin()
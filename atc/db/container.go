@@ -3,6 +3,7 @@ package db
 import (
 	"database/sql"
 	"errors"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
@@ -92,6 +93,7 @@ func (container *creatingContainer) Created() (CreatedContainer, error) {
 		container.workerName,
 		container.metadata,
 		false,
+		time.Time{},
 		container.conn,
 	), nil
 }
@@ -139,6 +141,10 @@ type CreatedContainer interface {
 	Destroying() (DestroyingContainer, error)
 	IsHijacked() bool
 	MarkAsHijacked() error
+
+	// DebugUntil returns the time until which the container of a failed
+	// task is kept around for debugging, if at all.
+	DebugUntil() time.Time
 }
 
 type createdContainer struct {
@@ -147,7 +153,8 @@ type createdContainer struct {
 	workerName string
 	metadata   ContainerMetadata
 
-	hijacked bool
+	hijacked   bool
+	debugUntil time.Time
 
 	conn Conn
 }
@@ -158,6 +165,7 @@ func newCreatedContainer(
 	workerName string,
 	metadata ContainerMetadata,
 	hijacked bool,
+	debugUntil time.Time,
 	conn Conn,
 ) *createdContainer {
 	return &createdContainer{
@@ -166,6 +174,7 @@ func newCreatedContainer(
 		workerName: workerName,
 		metadata:   metadata,
 		hijacked:   hijacked,
+		debugUntil: debugUntil,
 		conn:       conn,
 	}
 }
@@ -176,7 +185,8 @@ func (container *createdContainer) Handle() string              { return contain
 func (container *createdContainer) WorkerName() string          { return container.workerName }
 func (container *createdContainer) Metadata() ContainerMetadata { return container.metadata }
 
-func (container *createdContainer) IsHijacked() bool { return container.hijacked }
+func (container *createdContainer) IsHijacked() bool      { return container.hijacked }
+func (container *createdContainer) DebugUntil() time.Time { return container.debugUntil }
 
 func (container *createdContainer) Destroying() (DestroyingContainer, error) {
 	var isDiscontinued bool
@@ -6,6 +6,7 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
 )
 
 //go:generate counterfeiter . ContainerRepository
@@ -223,6 +224,10 @@ func (repository *containerRepository) FindOrphanedContainers() ([]CreatingConta
 		LeftJoin("builds b ON b.id = c.build_id").
 		LeftJoin("containers icc ON icc.id = c.image_check_container_id").
 		LeftJoin("containers igc ON igc.id = c.image_get_container_id").
+		Where(sq.Or{
+			sq.Eq{"c.debug_until": nil},
+			sq.Expr("c.debug_until <= now()"),
+		}).
 		Where(sq.Or{
 			sq.Eq{
 				"c.build_id":                         nil,
@@ -293,7 +298,7 @@ func (repository *containerRepository) FindOrphanedContainers() ([]CreatingConta
 }
 
 func selectContainers(asOptional ...string) sq.SelectBuilder {
-	columns := []string{"id", "handle", "worker_name", "hijacked", "discontinued", "state"}
+	columns := []string{"id", "handle", "worker_name", "hijacked", "discontinued", "state", "debug_until"}
 	columns = append(columns, containerMetadataColumns...)
 
 	table := "containers"
@@ -317,11 +322,12 @@ func scanContainer(row sq.RowScanner, conn Conn) (CreatingContainer, CreatedCont
 		isDiscontinued bool
 		isHijacked     bool
 		state          string
+		debugUntil     pq.NullTime
 
 		metadata ContainerMetadata
 	)
 
-	columns := []interface{}{&id, &handle, &workerName, &isHijacked, &isDiscontinued, &state}
+	columns := []interface{}{&id, &handle, &workerName, &isHijacked, &isDiscontinued, &state, &debugUntil}
 	columns = append(columns, metadata.ScanTargets()...)
 
 	err := row.Scan(columns...)
@@ -345,6 +351,7 @@ func scanContainer(row sq.RowScanner, conn Conn) (CreatingContainer, CreatedCont
 			workerName,
 			metadata,
 			isHijacked,
+			debugUntil.Time,
 			conn,
 		), nil, nil, nil
 	case atc.ContainerStateDestroying:
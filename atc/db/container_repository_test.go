@@ -206,7 +206,7 @@ var _ = Describe("ContainerRepository", func() {
 
 			BeforeEach(func() {
 				var err error
-				build, err = defaultJob.CreateBuild()
+				build, err = defaultJob.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				creatingContainer, err = defaultWorker.CreateContainer(
@@ -265,6 +265,45 @@ var _ = Describe("ContainerRepository", func() {
 						Expect(createdContainers[0].Handle()).To(Equal(creatingContainer.Handle()))
 						Expect(destroyingContainers).To(BeEmpty())
 					})
+
+					Context("when the container is held for debugging", func() {
+						var until time.Time
+
+						JustBeforeEach(func() {
+							held, err := build.HoldContainerForDebugging(logger, "simple-plan", until, atc.DebugContainerLimits{})
+							Expect(err).NotTo(HaveOccurred())
+							Expect(held).To(BeTrue())
+						})
+
+						Context("until later", func() {
+							BeforeEach(func() {
+								until = time.Now().Add(time.Hour)
+							})
+
+							It("does not find container for deletion", func() {
+								creatingContainers, createdContainers, destroyingContainers, err := containerRepository.FindOrphanedContainers()
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(creatingContainers).To(BeEmpty())
+								Expect(createdContainers).To(BeEmpty())
+								Expect(destroyingContainers).To(BeEmpty())
+							})
+						})
+
+						Context("until a time which has passed", func() {
+							BeforeEach(func() {
+								until = time.Now().Add(-time.Minute)
+							})
+
+							It("finds container for deletion", func() {
+								_, createdContainers, _, err := containerRepository.FindOrphanedContainers()
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(createdContainers).To(HaveLen(1))
+								Expect(createdContainers[0].Handle()).To(Equal(creatingContainer.Handle()))
+							})
+						})
+					})
 				})
 
 				Context("when the container is destroying", func() {
@@ -314,7 +353,7 @@ var _ = Describe("ContainerRepository", func() {
 
 			BeforeEach(func() {
 				var err error
-				build, err = defaultJob.CreateBuild()
+				build, err = defaultJob.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				creatingTaskContainer, err = defaultWorker.CreateContainer(
@@ -382,7 +421,7 @@ var _ = Describe("ContainerRepository", func() {
 
 			BeforeEach(func() {
 				var err error
-				build, err = defaultJob.CreateBuild()
+				build, err = defaultJob.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				creatingTaskContainer, err = defaultWorker.CreateContainer(
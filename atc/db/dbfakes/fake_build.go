@@ -25,6 +25,19 @@ type FakeBuild struct {
 		result1 db.Notifier
 		result2 error
 	}
+	AcquireLocksStub        func([]string) (bool, error)
+	acquireLocksMutex       sync.RWMutex
+	acquireLocksArgsForCall []struct {
+		arg1 []string
+	}
+	acquireLocksReturns struct {
+		result1 bool
+		result2 error
+	}
+	acquireLocksReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	AcquireTrackingLockStub        func(lager.Logger, time.Duration) (lock.Lock, bool, error)
 	acquireTrackingLockMutex       sync.RWMutex
 	acquireTrackingLockArgsForCall []struct {
@@ -41,6 +54,42 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	ApprovalStub        func() *atc.BuildApproval
+	approvalMutex       sync.RWMutex
+	approvalArgsForCall []struct {
+	}
+	approvalReturns struct {
+		result1 *atc.BuildApproval
+	}
+	approvalReturnsOnCall map[int]struct {
+		result1 *atc.BuildApproval
+	}
+	ApprovalInputsStub        func() ([]db.BuildInput, error)
+	approvalInputsMutex       sync.RWMutex
+	approvalInputsArgsForCall []struct {
+	}
+	approvalInputsReturns struct {
+		result1 []db.BuildInput
+		result2 error
+	}
+	approvalInputsReturnsOnCall map[int]struct {
+		result1 []db.BuildInput
+		result2 error
+	}
+	ApproveStub        func(string, string) (bool, error)
+	approveMutex       sync.RWMutex
+	approveArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	approveReturns struct {
+		result1 bool
+		result2 error
+	}
+	approveReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ArtifactStub        func(int) (db.WorkerArtifact, error)
 	artifactMutex       sync.RWMutex
 	artifactArgsForCall []struct {
@@ -66,6 +115,36 @@ type FakeBuild struct {
 		result1 []db.WorkerArtifact
 		result2 error
 	}
+	AwaitingApprovalStub        func() bool
+	awaitingApprovalMutex       sync.RWMutex
+	awaitingApprovalArgsForCall []struct {
+	}
+	awaitingApprovalReturns struct {
+		result1 bool
+	}
+	awaitingApprovalReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	CreatedByStub        func() string
+	createdByMutex       sync.RWMutex
+	createdByArgsForCall []struct {
+	}
+	createdByReturns struct {
+		result1 string
+	}
+	createdByReturnsOnCall map[int]struct {
+		result1 string
+	}
+	DebugUntilStub        func() time.Time
+	debugUntilMutex       sync.RWMutex
+	debugUntilArgsForCall []struct {
+	}
+	debugUntilReturns struct {
+		result1 time.Time
+	}
+	debugUntilReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	DeleteStub        func() (bool, error)
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -122,6 +201,22 @@ type FakeBuild struct {
 	hasPlanReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	HoldContainerForDebuggingStub        func(lager.Logger, atc.PlanID, time.Time, atc.DebugContainerLimits) (bool, error)
+	holdContainerForDebuggingMutex       sync.RWMutex
+	holdContainerForDebuggingArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 atc.PlanID
+		arg3 time.Time
+		arg4 atc.DebugContainerLimits
+	}
+	holdContainerForDebuggingReturns struct {
+		result1 bool
+		result2 error
+	}
+	holdContainerForDebuggingReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	IDStub        func() int
 	iDMutex       sync.RWMutex
 	iDArgsForCall []struct {
@@ -235,6 +330,19 @@ type FakeBuild struct {
 	jobNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	LabelOutputStub        func(string, atc.Version, []string) error
+	labelOutputMutex       sync.RWMutex
+	labelOutputArgsForCall []struct {
+		arg1 string
+		arg2 atc.Version
+		arg3 []string
+	}
+	labelOutputReturns struct {
+		result1 error
+	}
+	labelOutputReturnsOnCall map[int]struct {
+		result1 error
+	}
 	MarkAsAbortedStub        func() error
 	markAsAbortedMutex       sync.RWMutex
 	markAsAbortedArgsForCall []struct {
@@ -303,6 +411,16 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	PriorityStub        func() int
+	priorityMutex       sync.RWMutex
+	priorityArgsForCall []struct {
+	}
+	priorityReturns struct {
+		result1 int
+	}
+	priorityReturnsOnCall map[int]struct {
+		result1 int
+	}
 	PrivatePlanStub        func() atc.Plan
 	privatePlanMutex       sync.RWMutex
 	privatePlanArgsForCall []struct {
@@ -333,6 +451,30 @@ type FakeBuild struct {
 	reapTimeReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	RejectStub        func(string, string) (bool, error)
+	rejectMutex       sync.RWMutex
+	rejectArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	rejectReturns struct {
+		result1 bool
+		result2 error
+	}
+	rejectReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ReleaseLocksStub        func() error
+	releaseLocksMutex       sync.RWMutex
+	releaseLocksArgsForCall []struct {
+	}
+	releaseLocksReturns struct {
+		result1 error
+	}
+	releaseLocksReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ReloadStub        func() (bool, error)
 	reloadMutex       sync.RWMutex
 	reloadArgsForCall []struct {
@@ -345,6 +487,19 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	RequestApprovalStub        func([]db.BuildInput) (bool, error)
+	requestApprovalMutex       sync.RWMutex
+	requestApprovalArgsForCall []struct {
+		arg1 []db.BuildInput
+	}
+	requestApprovalReturns struct {
+		result1 bool
+		result2 error
+	}
+	requestApprovalReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ResourcesStub        func() ([]db.BuildInput, []db.BuildOutput, error)
 	resourcesMutex       sync.RWMutex
 	resourcesArgsForCall []struct {
@@ -398,6 +553,19 @@ type FakeBuild struct {
 	saveOutputReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SaveTaskResourceUsageStub        func(atc.PlanID, string, atc.TaskResourceUsage) error
+	saveTaskResourceUsageMutex       sync.RWMutex
+	saveTaskResourceUsageArgsForCall []struct {
+		arg1 atc.PlanID
+		arg2 string
+		arg3 atc.TaskResourceUsage
+	}
+	saveTaskResourceUsageReturns struct {
+		result1 error
+	}
+	saveTaskResourceUsageReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ScheduleStub        func() (bool, error)
 	scheduleMutex       sync.RWMutex
 	scheduleArgsForCall []struct {
@@ -495,6 +663,26 @@ type FakeBuild struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TimeoutStub        func() time.Duration
+	timeoutMutex       sync.RWMutex
+	timeoutArgsForCall []struct {
+	}
+	timeoutReturns struct {
+		result1 time.Duration
+	}
+	timeoutReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
+	TriggerStub        func() *atc.BuildTrigger
+	triggerMutex       sync.RWMutex
+	triggerArgsForCall []struct {
+	}
+	triggerReturns struct {
+		result1 *atc.BuildTrigger
+	}
+	triggerReturnsOnCall map[int]struct {
+		result1 *atc.BuildTrigger
+	}
 	UseInputsStub        func([]db.BuildInput) error
 	useInputsMutex       sync.RWMutex
 	useInputsArgsForCall []struct {
@@ -565,6 +753,74 @@ func (fake *FakeBuild) AbortNotifierReturnsOnCall(i int, result1 db.Notifier, re
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) AcquireLocks(arg1 []string) (bool, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.acquireLocksMutex.Lock()
+	ret, specificReturn := fake.acquireLocksReturnsOnCall[len(fake.acquireLocksArgsForCall)]
+	fake.acquireLocksArgsForCall = append(fake.acquireLocksArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	fake.recordInvocation("AcquireLocks", []interface{}{arg1Copy})
+	fake.acquireLocksMutex.Unlock()
+	if fake.AcquireLocksStub != nil {
+		return fake.AcquireLocksStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.acquireLocksReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) AcquireLocksCallCount() int {
+	fake.acquireLocksMutex.RLock()
+	defer fake.acquireLocksMutex.RUnlock()
+	return len(fake.acquireLocksArgsForCall)
+}
+
+func (fake *FakeBuild) AcquireLocksCalls(stub func([]string) (bool, error)) {
+	fake.acquireLocksMutex.Lock()
+	defer fake.acquireLocksMutex.Unlock()
+	fake.AcquireLocksStub = stub
+}
+
+func (fake *FakeBuild) AcquireLocksArgsForCall(i int) []string {
+	fake.acquireLocksMutex.RLock()
+	defer fake.acquireLocksMutex.RUnlock()
+	argsForCall := fake.acquireLocksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) AcquireLocksReturns(result1 bool, result2 error) {
+	fake.acquireLocksMutex.Lock()
+	defer fake.acquireLocksMutex.Unlock()
+	fake.AcquireLocksStub = nil
+	fake.acquireLocksReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) AcquireLocksReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.acquireLocksMutex.Lock()
+	defer fake.acquireLocksMutex.Unlock()
+	fake.AcquireLocksStub = nil
+	if fake.acquireLocksReturnsOnCall == nil {
+		fake.acquireLocksReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.acquireLocksReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) AcquireTrackingLock(arg1 lager.Logger, arg2 time.Duration) (lock.Lock, bool, error) {
 	fake.acquireTrackingLockMutex.Lock()
 	ret, specificReturn := fake.acquireTrackingLockReturnsOnCall[len(fake.acquireTrackingLockArgsForCall)]
@@ -632,6 +888,177 @@ func (fake *FakeBuild) AcquireTrackingLockReturnsOnCall(i int, result1 lock.Lock
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) Approval() *atc.BuildApproval {
+	fake.approvalMutex.Lock()
+	ret, specificReturn := fake.approvalReturnsOnCall[len(fake.approvalArgsForCall)]
+	fake.approvalArgsForCall = append(fake.approvalArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Approval", []interface{}{})
+	fake.approvalMutex.Unlock()
+	if fake.ApprovalStub != nil {
+		return fake.ApprovalStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.approvalReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) ApprovalCallCount() int {
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	return len(fake.approvalArgsForCall)
+}
+
+func (fake *FakeBuild) ApprovalCalls(stub func() *atc.BuildApproval) {
+	fake.approvalMutex.Lock()
+	defer fake.approvalMutex.Unlock()
+	fake.ApprovalStub = stub
+}
+
+func (fake *FakeBuild) ApprovalReturns(result1 *atc.BuildApproval) {
+	fake.approvalMutex.Lock()
+	defer fake.approvalMutex.Unlock()
+	fake.ApprovalStub = nil
+	fake.approvalReturns = struct {
+		result1 *atc.BuildApproval
+	}{result1}
+}
+
+func (fake *FakeBuild) ApprovalReturnsOnCall(i int, result1 *atc.BuildApproval) {
+	fake.approvalMutex.Lock()
+	defer fake.approvalMutex.Unlock()
+	fake.ApprovalStub = nil
+	if fake.approvalReturnsOnCall == nil {
+		fake.approvalReturnsOnCall = make(map[int]struct {
+			result1 *atc.BuildApproval
+		})
+	}
+	fake.approvalReturnsOnCall[i] = struct {
+		result1 *atc.BuildApproval
+	}{result1}
+}
+
+func (fake *FakeBuild) ApprovalInputs() ([]db.BuildInput, error) {
+	fake.approvalInputsMutex.Lock()
+	ret, specificReturn := fake.approvalInputsReturnsOnCall[len(fake.approvalInputsArgsForCall)]
+	fake.approvalInputsArgsForCall = append(fake.approvalInputsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ApprovalInputs", []interface{}{})
+	fake.approvalInputsMutex.Unlock()
+	if fake.ApprovalInputsStub != nil {
+		return fake.ApprovalInputsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.approvalInputsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) ApprovalInputsCallCount() int {
+	fake.approvalInputsMutex.RLock()
+	defer fake.approvalInputsMutex.RUnlock()
+	return len(fake.approvalInputsArgsForCall)
+}
+
+func (fake *FakeBuild) ApprovalInputsCalls(stub func() ([]db.BuildInput, error)) {
+	fake.approvalInputsMutex.Lock()
+	defer fake.approvalInputsMutex.Unlock()
+	fake.ApprovalInputsStub = stub
+}
+
+func (fake *FakeBuild) ApprovalInputsReturns(result1 []db.BuildInput, result2 error) {
+	fake.approvalInputsMutex.Lock()
+	defer fake.approvalInputsMutex.Unlock()
+	fake.ApprovalInputsStub = nil
+	fake.approvalInputsReturns = struct {
+		result1 []db.BuildInput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ApprovalInputsReturnsOnCall(i int, result1 []db.BuildInput, result2 error) {
+	fake.approvalInputsMutex.Lock()
+	defer fake.approvalInputsMutex.Unlock()
+	fake.ApprovalInputsStub = nil
+	if fake.approvalInputsReturnsOnCall == nil {
+		fake.approvalInputsReturnsOnCall = make(map[int]struct {
+			result1 []db.BuildInput
+			result2 error
+		})
+	}
+	fake.approvalInputsReturnsOnCall[i] = struct {
+		result1 []db.BuildInput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) Approve(arg1 string, arg2 string) (bool, error) {
+	fake.approveMutex.Lock()
+	ret, specificReturn := fake.approveReturnsOnCall[len(fake.approveArgsForCall)]
+	fake.approveArgsForCall = append(fake.approveArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Approve", []interface{}{arg1, arg2})
+	fake.approveMutex.Unlock()
+	if fake.ApproveStub != nil {
+		return fake.ApproveStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.approveReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) ApproveCallCount() int {
+	fake.approveMutex.RLock()
+	defer fake.approveMutex.RUnlock()
+	return len(fake.approveArgsForCall)
+}
+
+func (fake *FakeBuild) ApproveCalls(stub func(string, string) (bool, error)) {
+	fake.approveMutex.Lock()
+	defer fake.approveMutex.Unlock()
+	fake.ApproveStub = stub
+}
+
+func (fake *FakeBuild) ApproveArgsForCall(i int) (string, string) {
+	fake.approveMutex.RLock()
+	defer fake.approveMutex.RUnlock()
+	argsForCall := fake.approveArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuild) ApproveReturns(result1 bool, result2 error) {
+	fake.approveMutex.Lock()
+	defer fake.approveMutex.Unlock()
+	fake.ApproveStub = nil
+	fake.approveReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ApproveReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.approveMutex.Lock()
+	defer fake.approveMutex.Unlock()
+	fake.ApproveStub = nil
+	if fake.approveReturnsOnCall == nil {
+		fake.approveReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.approveReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Artifact(arg1 int) (db.WorkerArtifact, error) {
 	fake.artifactMutex.Lock()
 	ret, specificReturn := fake.artifactReturnsOnCall[len(fake.artifactArgsForCall)]
@@ -750,6 +1177,162 @@ func (fake *FakeBuild) ArtifactsReturnsOnCall(i int, result1 []db.WorkerArtifact
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) AwaitingApproval() bool {
+	fake.awaitingApprovalMutex.Lock()
+	ret, specificReturn := fake.awaitingApprovalReturnsOnCall[len(fake.awaitingApprovalArgsForCall)]
+	fake.awaitingApprovalArgsForCall = append(fake.awaitingApprovalArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AwaitingApproval", []interface{}{})
+	fake.awaitingApprovalMutex.Unlock()
+	if fake.AwaitingApprovalStub != nil {
+		return fake.AwaitingApprovalStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.awaitingApprovalReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) AwaitingApprovalCallCount() int {
+	fake.awaitingApprovalMutex.RLock()
+	defer fake.awaitingApprovalMutex.RUnlock()
+	return len(fake.awaitingApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) AwaitingApprovalCalls(stub func() bool) {
+	fake.awaitingApprovalMutex.Lock()
+	defer fake.awaitingApprovalMutex.Unlock()
+	fake.AwaitingApprovalStub = stub
+}
+
+func (fake *FakeBuild) AwaitingApprovalReturns(result1 bool) {
+	fake.awaitingApprovalMutex.Lock()
+	defer fake.awaitingApprovalMutex.Unlock()
+	fake.AwaitingApprovalStub = nil
+	fake.awaitingApprovalReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeBuild) AwaitingApprovalReturnsOnCall(i int, result1 bool) {
+	fake.awaitingApprovalMutex.Lock()
+	defer fake.awaitingApprovalMutex.Unlock()
+	fake.AwaitingApprovalStub = nil
+	if fake.awaitingApprovalReturnsOnCall == nil {
+		fake.awaitingApprovalReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.awaitingApprovalReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeBuild) CreatedBy() string {
+	fake.createdByMutex.Lock()
+	ret, specificReturn := fake.createdByReturnsOnCall[len(fake.createdByArgsForCall)]
+	fake.createdByArgsForCall = append(fake.createdByArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CreatedBy", []interface{}{})
+	fake.createdByMutex.Unlock()
+	if fake.CreatedByStub != nil {
+		return fake.CreatedByStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.createdByReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) CreatedByCallCount() int {
+	fake.createdByMutex.RLock()
+	defer fake.createdByMutex.RUnlock()
+	return len(fake.createdByArgsForCall)
+}
+
+func (fake *FakeBuild) CreatedByCalls(stub func() string) {
+	fake.createdByMutex.Lock()
+	defer fake.createdByMutex.Unlock()
+	fake.CreatedByStub = stub
+}
+
+func (fake *FakeBuild) CreatedByReturns(result1 string) {
+	fake.createdByMutex.Lock()
+	defer fake.createdByMutex.Unlock()
+	fake.CreatedByStub = nil
+	fake.createdByReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) CreatedByReturnsOnCall(i int, result1 string) {
+	fake.createdByMutex.Lock()
+	defer fake.createdByMutex.Unlock()
+	fake.CreatedByStub = nil
+	if fake.createdByReturnsOnCall == nil {
+		fake.createdByReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.createdByReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) DebugUntil() time.Time {
+	fake.debugUntilMutex.Lock()
+	ret, specificReturn := fake.debugUntilReturnsOnCall[len(fake.debugUntilArgsForCall)]
+	fake.debugUntilArgsForCall = append(fake.debugUntilArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DebugUntil", []interface{}{})
+	fake.debugUntilMutex.Unlock()
+	if fake.DebugUntilStub != nil {
+		return fake.DebugUntilStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.debugUntilReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) DebugUntilCallCount() int {
+	fake.debugUntilMutex.RLock()
+	defer fake.debugUntilMutex.RUnlock()
+	return len(fake.debugUntilArgsForCall)
+}
+
+func (fake *FakeBuild) DebugUntilCalls(stub func() time.Time) {
+	fake.debugUntilMutex.Lock()
+	defer fake.debugUntilMutex.Unlock()
+	fake.DebugUntilStub = stub
+}
+
+func (fake *FakeBuild) DebugUntilReturns(result1 time.Time) {
+	fake.debugUntilMutex.Lock()
+	defer fake.debugUntilMutex.Unlock()
+	fake.DebugUntilStub = nil
+	fake.debugUntilReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeBuild) DebugUntilReturnsOnCall(i int, result1 time.Time) {
+	fake.debugUntilMutex.Lock()
+	defer fake.debugUntilMutex.Unlock()
+	fake.DebugUntilStub = nil
+	if fake.debugUntilReturnsOnCall == nil {
+		fake.debugUntilReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.debugUntilReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeBuild) Delete() (bool, error) {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -1032,6 +1615,72 @@ func (fake *FakeBuild) HasPlanReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeBuild) HoldContainerForDebugging(arg1 lager.Logger, arg2 atc.PlanID, arg3 time.Time, arg4 atc.DebugContainerLimits) (bool, error) {
+	fake.holdContainerForDebuggingMutex.Lock()
+	ret, specificReturn := fake.holdContainerForDebuggingReturnsOnCall[len(fake.holdContainerForDebuggingArgsForCall)]
+	fake.holdContainerForDebuggingArgsForCall = append(fake.holdContainerForDebuggingArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 atc.PlanID
+		arg3 time.Time
+		arg4 atc.DebugContainerLimits
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("HoldContainerForDebugging", []interface{}{arg1, arg2, arg3, arg4})
+	fake.holdContainerForDebuggingMutex.Unlock()
+	if fake.HoldContainerForDebuggingStub != nil {
+		return fake.HoldContainerForDebuggingStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.holdContainerForDebuggingReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) HoldContainerForDebuggingCallCount() int {
+	fake.holdContainerForDebuggingMutex.RLock()
+	defer fake.holdContainerForDebuggingMutex.RUnlock()
+	return len(fake.holdContainerForDebuggingArgsForCall)
+}
+
+func (fake *FakeBuild) HoldContainerForDebuggingCalls(stub func(lager.Logger, atc.PlanID, time.Time, atc.DebugContainerLimits) (bool, error)) {
+	fake.holdContainerForDebuggingMutex.Lock()
+	defer fake.holdContainerForDebuggingMutex.Unlock()
+	fake.HoldContainerForDebuggingStub = stub
+}
+
+func (fake *FakeBuild) HoldContainerForDebuggingArgsForCall(i int) (lager.Logger, atc.PlanID, time.Time, atc.DebugContainerLimits) {
+	fake.holdContainerForDebuggingMutex.RLock()
+	defer fake.holdContainerForDebuggingMutex.RUnlock()
+	argsForCall := fake.holdContainerForDebuggingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeBuild) HoldContainerForDebuggingReturns(result1 bool, result2 error) {
+	fake.holdContainerForDebuggingMutex.Lock()
+	defer fake.holdContainerForDebuggingMutex.Unlock()
+	fake.HoldContainerForDebuggingStub = nil
+	fake.holdContainerForDebuggingReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) HoldContainerForDebuggingReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.holdContainerForDebuggingMutex.Lock()
+	defer fake.holdContainerForDebuggingMutex.Unlock()
+	fake.HoldContainerForDebuggingStub = nil
+	if fake.holdContainerForDebuggingReturnsOnCall == nil {
+		fake.holdContainerForDebuggingReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.holdContainerForDebuggingReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) ID() int {
 	fake.iDMutex.Lock()
 	ret, specificReturn := fake.iDReturnsOnCall[len(fake.iDArgsForCall)]
@@ -1615,6 +2264,73 @@ func (fake *FakeBuild) JobNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) LabelOutput(arg1 string, arg2 atc.Version, arg3 []string) error {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.labelOutputMutex.Lock()
+	ret, specificReturn := fake.labelOutputReturnsOnCall[len(fake.labelOutputArgsForCall)]
+	fake.labelOutputArgsForCall = append(fake.labelOutputArgsForCall, struct {
+		arg1 string
+		arg2 atc.Version
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("LabelOutput", []interface{}{arg1, arg2, arg3Copy})
+	fake.labelOutputMutex.Unlock()
+	if fake.LabelOutputStub != nil {
+		return fake.LabelOutputStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.labelOutputReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) LabelOutputCallCount() int {
+	fake.labelOutputMutex.RLock()
+	defer fake.labelOutputMutex.RUnlock()
+	return len(fake.labelOutputArgsForCall)
+}
+
+func (fake *FakeBuild) LabelOutputCalls(stub func(string, atc.Version, []string) error) {
+	fake.labelOutputMutex.Lock()
+	defer fake.labelOutputMutex.Unlock()
+	fake.LabelOutputStub = stub
+}
+
+func (fake *FakeBuild) LabelOutputArgsForCall(i int) (string, atc.Version, []string) {
+	fake.labelOutputMutex.RLock()
+	defer fake.labelOutputMutex.RUnlock()
+	argsForCall := fake.labelOutputArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBuild) LabelOutputReturns(result1 error) {
+	fake.labelOutputMutex.Lock()
+	defer fake.labelOutputMutex.Unlock()
+	fake.LabelOutputStub = nil
+	fake.labelOutputReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) LabelOutputReturnsOnCall(i int, result1 error) {
+	fake.labelOutputMutex.Lock()
+	defer fake.labelOutputMutex.Unlock()
+	fake.LabelOutputStub = nil
+	if fake.labelOutputReturnsOnCall == nil {
+		fake.labelOutputReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.labelOutputReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) MarkAsAborted() error {
 	fake.markAsAbortedMutex.Lock()
 	ret, specificReturn := fake.markAsAbortedReturnsOnCall[len(fake.markAsAbortedArgsForCall)]
@@ -1939,6 +2655,58 @@ func (fake *FakeBuild) PreparationReturnsOnCall(i int, result1 db.BuildPreparati
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) Priority() int {
+	fake.priorityMutex.Lock()
+	ret, specificReturn := fake.priorityReturnsOnCall[len(fake.priorityArgsForCall)]
+	fake.priorityArgsForCall = append(fake.priorityArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Priority", []interface{}{})
+	fake.priorityMutex.Unlock()
+	if fake.PriorityStub != nil {
+		return fake.PriorityStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.priorityReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) PriorityCallCount() int {
+	fake.priorityMutex.RLock()
+	defer fake.priorityMutex.RUnlock()
+	return len(fake.priorityArgsForCall)
+}
+
+func (fake *FakeBuild) PriorityCalls(stub func() int) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = stub
+}
+
+func (fake *FakeBuild) PriorityReturns(result1 int) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = nil
+	fake.priorityReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) PriorityReturnsOnCall(i int, result1 int) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = nil
+	if fake.priorityReturnsOnCall == nil {
+		fake.priorityReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.priorityReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeBuild) PrivatePlan() atc.Plan {
 	fake.privatePlanMutex.Lock()
 	ret, specificReturn := fake.privatePlanReturnsOnCall[len(fake.privatePlanArgsForCall)]
@@ -2095,6 +2863,122 @@ func (fake *FakeBuild) ReapTimeReturnsOnCall(i int, result1 time.Time) {
 	}{result1}
 }
 
+func (fake *FakeBuild) Reject(arg1 string, arg2 string) (bool, error) {
+	fake.rejectMutex.Lock()
+	ret, specificReturn := fake.rejectReturnsOnCall[len(fake.rejectArgsForCall)]
+	fake.rejectArgsForCall = append(fake.rejectArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Reject", []interface{}{arg1, arg2})
+	fake.rejectMutex.Unlock()
+	if fake.RejectStub != nil {
+		return fake.RejectStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.rejectReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) RejectCallCount() int {
+	fake.rejectMutex.RLock()
+	defer fake.rejectMutex.RUnlock()
+	return len(fake.rejectArgsForCall)
+}
+
+func (fake *FakeBuild) RejectCalls(stub func(string, string) (bool, error)) {
+	fake.rejectMutex.Lock()
+	defer fake.rejectMutex.Unlock()
+	fake.RejectStub = stub
+}
+
+func (fake *FakeBuild) RejectArgsForCall(i int) (string, string) {
+	fake.rejectMutex.RLock()
+	defer fake.rejectMutex.RUnlock()
+	argsForCall := fake.rejectArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuild) RejectReturns(result1 bool, result2 error) {
+	fake.rejectMutex.Lock()
+	defer fake.rejectMutex.Unlock()
+	fake.RejectStub = nil
+	fake.rejectReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) RejectReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.rejectMutex.Lock()
+	defer fake.rejectMutex.Unlock()
+	fake.RejectStub = nil
+	if fake.rejectReturnsOnCall == nil {
+		fake.rejectReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.rejectReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ReleaseLocks() error {
+	fake.releaseLocksMutex.Lock()
+	ret, specificReturn := fake.releaseLocksReturnsOnCall[len(fake.releaseLocksArgsForCall)]
+	fake.releaseLocksArgsForCall = append(fake.releaseLocksArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ReleaseLocks", []interface{}{})
+	fake.releaseLocksMutex.Unlock()
+	if fake.ReleaseLocksStub != nil {
+		return fake.ReleaseLocksStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.releaseLocksReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) ReleaseLocksCallCount() int {
+	fake.releaseLocksMutex.RLock()
+	defer fake.releaseLocksMutex.RUnlock()
+	return len(fake.releaseLocksArgsForCall)
+}
+
+func (fake *FakeBuild) ReleaseLocksCalls(stub func() error) {
+	fake.releaseLocksMutex.Lock()
+	defer fake.releaseLocksMutex.Unlock()
+	fake.ReleaseLocksStub = stub
+}
+
+func (fake *FakeBuild) ReleaseLocksReturns(result1 error) {
+	fake.releaseLocksMutex.Lock()
+	defer fake.releaseLocksMutex.Unlock()
+	fake.ReleaseLocksStub = nil
+	fake.releaseLocksReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) ReleaseLocksReturnsOnCall(i int, result1 error) {
+	fake.releaseLocksMutex.Lock()
+	defer fake.releaseLocksMutex.Unlock()
+	fake.ReleaseLocksStub = nil
+	if fake.releaseLocksReturnsOnCall == nil {
+		fake.releaseLocksReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.releaseLocksReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Reload() (bool, error) {
 	fake.reloadMutex.Lock()
 	ret, specificReturn := fake.reloadReturnsOnCall[len(fake.reloadArgsForCall)]
@@ -2150,6 +3034,74 @@ func (fake *FakeBuild) ReloadReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) RequestApproval(arg1 []db.BuildInput) (bool, error) {
+	var arg1Copy []db.BuildInput
+	if arg1 != nil {
+		arg1Copy = make([]db.BuildInput, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.requestApprovalMutex.Lock()
+	ret, specificReturn := fake.requestApprovalReturnsOnCall[len(fake.requestApprovalArgsForCall)]
+	fake.requestApprovalArgsForCall = append(fake.requestApprovalArgsForCall, struct {
+		arg1 []db.BuildInput
+	}{arg1Copy})
+	fake.recordInvocation("RequestApproval", []interface{}{arg1Copy})
+	fake.requestApprovalMutex.Unlock()
+	if fake.RequestApprovalStub != nil {
+		return fake.RequestApprovalStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.requestApprovalReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) RequestApprovalCallCount() int {
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
+	return len(fake.requestApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) RequestApprovalCalls(stub func([]db.BuildInput) (bool, error)) {
+	fake.requestApprovalMutex.Lock()
+	defer fake.requestApprovalMutex.Unlock()
+	fake.RequestApprovalStub = stub
+}
+
+func (fake *FakeBuild) RequestApprovalArgsForCall(i int) []db.BuildInput {
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
+	argsForCall := fake.requestApprovalArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) RequestApprovalReturns(result1 bool, result2 error) {
+	fake.requestApprovalMutex.Lock()
+	defer fake.requestApprovalMutex.Unlock()
+	fake.RequestApprovalStub = nil
+	fake.requestApprovalReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) RequestApprovalReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.requestApprovalMutex.Lock()
+	defer fake.requestApprovalMutex.Unlock()
+	fake.RequestApprovalStub = nil
+	if fake.requestApprovalReturnsOnCall == nil {
+		fake.requestApprovalReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.requestApprovalReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Resources() ([]db.BuildInput, []db.BuildOutput, error) {
 	fake.resourcesMutex.Lock()
 	ret, specificReturn := fake.resourcesReturnsOnCall[len(fake.resourcesArgsForCall)]
@@ -2394,6 +3346,68 @@ func (fake *FakeBuild) SaveOutputReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SaveTaskResourceUsage(arg1 atc.PlanID, arg2 string, arg3 atc.TaskResourceUsage) error {
+	fake.saveTaskResourceUsageMutex.Lock()
+	ret, specificReturn := fake.saveTaskResourceUsageReturnsOnCall[len(fake.saveTaskResourceUsageArgsForCall)]
+	fake.saveTaskResourceUsageArgsForCall = append(fake.saveTaskResourceUsageArgsForCall, struct {
+		arg1 atc.PlanID
+		arg2 string
+		arg3 atc.TaskResourceUsage
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SaveTaskResourceUsage", []interface{}{arg1, arg2, arg3})
+	fake.saveTaskResourceUsageMutex.Unlock()
+	if fake.SaveTaskResourceUsageStub != nil {
+		return fake.SaveTaskResourceUsageStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveTaskResourceUsageReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveTaskResourceUsageCallCount() int {
+	fake.saveTaskResourceUsageMutex.RLock()
+	defer fake.saveTaskResourceUsageMutex.RUnlock()
+	return len(fake.saveTaskResourceUsageArgsForCall)
+}
+
+func (fake *FakeBuild) SaveTaskResourceUsageCalls(stub func(atc.PlanID, string, atc.TaskResourceUsage) error) {
+	fake.saveTaskResourceUsageMutex.Lock()
+	defer fake.saveTaskResourceUsageMutex.Unlock()
+	fake.SaveTaskResourceUsageStub = stub
+}
+
+func (fake *FakeBuild) SaveTaskResourceUsageArgsForCall(i int) (atc.PlanID, string, atc.TaskResourceUsage) {
+	fake.saveTaskResourceUsageMutex.RLock()
+	defer fake.saveTaskResourceUsageMutex.RUnlock()
+	argsForCall := fake.saveTaskResourceUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBuild) SaveTaskResourceUsageReturns(result1 error) {
+	fake.saveTaskResourceUsageMutex.Lock()
+	defer fake.saveTaskResourceUsageMutex.Unlock()
+	fake.SaveTaskResourceUsageStub = nil
+	fake.saveTaskResourceUsageReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveTaskResourceUsageReturnsOnCall(i int, result1 error) {
+	fake.saveTaskResourceUsageMutex.Lock()
+	defer fake.saveTaskResourceUsageMutex.Unlock()
+	fake.SaveTaskResourceUsageStub = nil
+	if fake.saveTaskResourceUsageReturnsOnCall == nil {
+		fake.saveTaskResourceUsageReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveTaskResourceUsageReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Schedule() (bool, error) {
 	fake.scheduleMutex.Lock()
 	ret, specificReturn := fake.scheduleReturnsOnCall[len(fake.scheduleArgsForCall)]
@@ -2892,6 +3906,110 @@ func (fake *FakeBuild) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) Timeout() time.Duration {
+	fake.timeoutMutex.Lock()
+	ret, specificReturn := fake.timeoutReturnsOnCall[len(fake.timeoutArgsForCall)]
+	fake.timeoutArgsForCall = append(fake.timeoutArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Timeout", []interface{}{})
+	fake.timeoutMutex.Unlock()
+	if fake.TimeoutStub != nil {
+		return fake.TimeoutStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.timeoutReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) TimeoutCallCount() int {
+	fake.timeoutMutex.RLock()
+	defer fake.timeoutMutex.RUnlock()
+	return len(fake.timeoutArgsForCall)
+}
+
+func (fake *FakeBuild) TimeoutCalls(stub func() time.Duration) {
+	fake.timeoutMutex.Lock()
+	defer fake.timeoutMutex.Unlock()
+	fake.TimeoutStub = stub
+}
+
+func (fake *FakeBuild) TimeoutReturns(result1 time.Duration) {
+	fake.timeoutMutex.Lock()
+	defer fake.timeoutMutex.Unlock()
+	fake.TimeoutStub = nil
+	fake.timeoutReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeBuild) TimeoutReturnsOnCall(i int, result1 time.Duration) {
+	fake.timeoutMutex.Lock()
+	defer fake.timeoutMutex.Unlock()
+	fake.TimeoutStub = nil
+	if fake.timeoutReturnsOnCall == nil {
+		fake.timeoutReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.timeoutReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeBuild) Trigger() *atc.BuildTrigger {
+	fake.triggerMutex.Lock()
+	ret, specificReturn := fake.triggerReturnsOnCall[len(fake.triggerArgsForCall)]
+	fake.triggerArgsForCall = append(fake.triggerArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Trigger", []interface{}{})
+	fake.triggerMutex.Unlock()
+	if fake.TriggerStub != nil {
+		return fake.TriggerStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.triggerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) TriggerCallCount() int {
+	fake.triggerMutex.RLock()
+	defer fake.triggerMutex.RUnlock()
+	return len(fake.triggerArgsForCall)
+}
+
+func (fake *FakeBuild) TriggerCalls(stub func() *atc.BuildTrigger) {
+	fake.triggerMutex.Lock()
+	defer fake.triggerMutex.Unlock()
+	fake.TriggerStub = stub
+}
+
+func (fake *FakeBuild) TriggerReturns(result1 *atc.BuildTrigger) {
+	fake.triggerMutex.Lock()
+	defer fake.triggerMutex.Unlock()
+	fake.TriggerStub = nil
+	fake.triggerReturns = struct {
+		result1 *atc.BuildTrigger
+	}{result1}
+}
+
+func (fake *FakeBuild) TriggerReturnsOnCall(i int, result1 *atc.BuildTrigger) {
+	fake.triggerMutex.Lock()
+	defer fake.triggerMutex.Unlock()
+	fake.TriggerStub = nil
+	if fake.triggerReturnsOnCall == nil {
+		fake.triggerReturnsOnCall = make(map[int]struct {
+			result1 *atc.BuildTrigger
+		})
+	}
+	fake.triggerReturnsOnCall[i] = struct {
+		result1 *atc.BuildTrigger
+	}{result1}
+}
+
 func (fake *FakeBuild) UseInputs(arg1 []db.BuildInput) error {
 	var arg1Copy []db.BuildInput
 	if arg1 != nil {
@@ -2962,12 +4080,26 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.abortNotifierMutex.RLock()
 	defer fake.abortNotifierMutex.RUnlock()
+	fake.acquireLocksMutex.RLock()
+	defer fake.acquireLocksMutex.RUnlock()
 	fake.acquireTrackingLockMutex.RLock()
 	defer fake.acquireTrackingLockMutex.RUnlock()
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	fake.approvalInputsMutex.RLock()
+	defer fake.approvalInputsMutex.RUnlock()
+	fake.approveMutex.RLock()
+	defer fake.approveMutex.RUnlock()
 	fake.artifactMutex.RLock()
 	defer fake.artifactMutex.RUnlock()
 	fake.artifactsMutex.RLock()
 	defer fake.artifactsMutex.RUnlock()
+	fake.awaitingApprovalMutex.RLock()
+	defer fake.awaitingApprovalMutex.RUnlock()
+	fake.createdByMutex.RLock()
+	defer fake.createdByMutex.RUnlock()
+	fake.debugUntilMutex.RLock()
+	defer fake.debugUntilMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
 	fake.endTimeMutex.RLock()
@@ -2978,6 +4110,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.finishMutex.RUnlock()
 	fake.hasPlanMutex.RLock()
 	defer fake.hasPlanMutex.RUnlock()
+	fake.holdContainerForDebuggingMutex.RLock()
+	defer fake.holdContainerForDebuggingMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
 	fake.interceptibleMutex.RLock()
@@ -3000,6 +4134,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.jobIDMutex.RUnlock()
 	fake.jobNameMutex.RLock()
 	defer fake.jobNameMutex.RUnlock()
+	fake.labelOutputMutex.RLock()
+	defer fake.labelOutputMutex.RUnlock()
 	fake.markAsAbortedMutex.RLock()
 	defer fake.markAsAbortedMutex.RUnlock()
 	fake.nameMutex.RLock()
@@ -3012,14 +4148,22 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.pipelineNameMutex.RUnlock()
 	fake.preparationMutex.RLock()
 	defer fake.preparationMutex.RUnlock()
+	fake.priorityMutex.RLock()
+	defer fake.priorityMutex.RUnlock()
 	fake.privatePlanMutex.RLock()
 	defer fake.privatePlanMutex.RUnlock()
 	fake.publicPlanMutex.RLock()
 	defer fake.publicPlanMutex.RUnlock()
 	fake.reapTimeMutex.RLock()
 	defer fake.reapTimeMutex.RUnlock()
+	fake.rejectMutex.RLock()
+	defer fake.rejectMutex.RUnlock()
+	fake.releaseLocksMutex.RLock()
+	defer fake.releaseLocksMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
 	fake.resourcesMutex.RLock()
 	defer fake.resourcesMutex.RUnlock()
 	fake.saveEventMutex.RLock()
@@ -3028,6 +4172,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.saveImageResourceVersionMutex.RUnlock()
 	fake.saveOutputMutex.RLock()
 	defer fake.saveOutputMutex.RUnlock()
+	fake.saveTaskResourceUsageMutex.RLock()
+	defer fake.saveTaskResourceUsageMutex.RUnlock()
 	fake.scheduleMutex.RLock()
 	defer fake.scheduleMutex.RUnlock()
 	fake.schemaMutex.RLock()
@@ -3046,6 +4192,10 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.timeoutMutex.RLock()
+	defer fake.timeoutMutex.RUnlock()
+	fake.triggerMutex.RLock()
+	defer fake.triggerMutex.RUnlock()
 	fake.useInputsMutex.RLock()
 	defer fake.useInputsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
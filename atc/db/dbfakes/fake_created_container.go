@@ -3,11 +3,22 @@ package dbfakes
 
 import (
 	"sync"
+	"time"
 
 	"github.com/concourse/concourse/atc/db"
 )
 
 type FakeCreatedContainer struct {
+	DebugUntilStub        func() time.Time
+	debugUntilMutex       sync.RWMutex
+	debugUntilArgsForCall []struct {
+	}
+	debugUntilReturns struct {
+		result1 time.Time
+	}
+	debugUntilReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	DestroyingStub        func() (db.DestroyingContainer, error)
 	destroyingMutex       sync.RWMutex
 	destroyingArgsForCall []struct {
@@ -106,6 +117,58 @@ type FakeCreatedContainer struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeCreatedContainer) DebugUntil() time.Time {
+	fake.debugUntilMutex.Lock()
+	ret, specificReturn := fake.debugUntilReturnsOnCall[len(fake.debugUntilArgsForCall)]
+	fake.debugUntilArgsForCall = append(fake.debugUntilArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DebugUntil", []interface{}{})
+	fake.debugUntilMutex.Unlock()
+	if fake.DebugUntilStub != nil {
+		return fake.DebugUntilStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.debugUntilReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCreatedContainer) DebugUntilCallCount() int {
+	fake.debugUntilMutex.RLock()
+	defer fake.debugUntilMutex.RUnlock()
+	return len(fake.debugUntilArgsForCall)
+}
+
+func (fake *FakeCreatedContainer) DebugUntilCalls(stub func() time.Time) {
+	fake.debugUntilMutex.Lock()
+	defer fake.debugUntilMutex.Unlock()
+	fake.DebugUntilStub = stub
+}
+
+func (fake *FakeCreatedContainer) DebugUntilReturns(result1 time.Time) {
+	fake.debugUntilMutex.Lock()
+	defer fake.debugUntilMutex.Unlock()
+	fake.DebugUntilStub = nil
+	fake.debugUntilReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeCreatedContainer) DebugUntilReturnsOnCall(i int, result1 time.Time) {
+	fake.debugUntilMutex.Lock()
+	defer fake.debugUntilMutex.Unlock()
+	fake.DebugUntilStub = nil
+	if fake.debugUntilReturnsOnCall == nil {
+		fake.debugUntilReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.debugUntilReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeCreatedContainer) Destroying() (db.DestroyingContainer, error) {
 	fake.destroyingMutex.Lock()
 	ret, specificReturn := fake.destroyingReturnsOnCall[len(fake.destroyingArgsForCall)]
@@ -583,6 +646,8 @@ func (fake *FakeCreatedContainer) WorkerNameReturnsOnCall(i int, result1 string)
 func (fake *FakeCreatedContainer) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.debugUntilMutex.RLock()
+	defer fake.debugUntilMutex.RUnlock()
 	fake.destroyingMutex.RLock()
 	defer fake.destroyingMutex.RUnlock()
 	fake.discontinueMutex.RLock()
@@ -30,6 +30,18 @@ type FakeCreatedVolume struct {
 	containerHandleReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CreateChildForArtifactStub        func() (db.CreatingVolume, error)
+	createChildForArtifactMutex       sync.RWMutex
+	createChildForArtifactArgsForCall []struct {
+	}
+	createChildForArtifactReturns struct {
+		result1 db.CreatingVolume
+		result2 error
+	}
+	createChildForArtifactReturnsOnCall map[int]struct {
+		result1 db.CreatingVolume
+		result2 error
+	}
 	CreateChildForContainerStub        func(db.CreatingContainer, string) (db.CreatingVolume, error)
 	createChildForContainerMutex       sync.RWMutex
 	createChildForContainerArgsForCall []struct {
@@ -303,6 +315,61 @@ func (fake *FakeCreatedVolume) ContainerHandleReturnsOnCall(i int, result1 strin
 	}{result1}
 }
 
+func (fake *FakeCreatedVolume) CreateChildForArtifact() (db.CreatingVolume, error) {
+	fake.createChildForArtifactMutex.Lock()
+	ret, specificReturn := fake.createChildForArtifactReturnsOnCall[len(fake.createChildForArtifactArgsForCall)]
+	fake.createChildForArtifactArgsForCall = append(fake.createChildForArtifactArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CreateChildForArtifact", []interface{}{})
+	fake.createChildForArtifactMutex.Unlock()
+	if fake.CreateChildForArtifactStub != nil {
+		return fake.CreateChildForArtifactStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createChildForArtifactReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCreatedVolume) CreateChildForArtifactCallCount() int {
+	fake.createChildForArtifactMutex.RLock()
+	defer fake.createChildForArtifactMutex.RUnlock()
+	return len(fake.createChildForArtifactArgsForCall)
+}
+
+func (fake *FakeCreatedVolume) CreateChildForArtifactCalls(stub func() (db.CreatingVolume, error)) {
+	fake.createChildForArtifactMutex.Lock()
+	defer fake.createChildForArtifactMutex.Unlock()
+	fake.CreateChildForArtifactStub = stub
+}
+
+func (fake *FakeCreatedVolume) CreateChildForArtifactReturns(result1 db.CreatingVolume, result2 error) {
+	fake.createChildForArtifactMutex.Lock()
+	defer fake.createChildForArtifactMutex.Unlock()
+	fake.CreateChildForArtifactStub = nil
+	fake.createChildForArtifactReturns = struct {
+		result1 db.CreatingVolume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreatedVolume) CreateChildForArtifactReturnsOnCall(i int, result1 db.CreatingVolume, result2 error) {
+	fake.createChildForArtifactMutex.Lock()
+	defer fake.createChildForArtifactMutex.Unlock()
+	fake.CreateChildForArtifactStub = nil
+	if fake.createChildForArtifactReturnsOnCall == nil {
+		fake.createChildForArtifactReturnsOnCall = make(map[int]struct {
+			result1 db.CreatingVolume
+			result2 error
+		})
+	}
+	fake.createChildForArtifactReturnsOnCall[i] = struct {
+		result1 db.CreatingVolume
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCreatedVolume) CreateChildForContainer(arg1 db.CreatingContainer, arg2 string) (db.CreatingVolume, error) {
 	fake.createChildForContainerMutex.Lock()
 	ret, specificReturn := fake.createChildForContainerReturnsOnCall[len(fake.createChildForContainerArgsForCall)]
@@ -1095,6 +1162,8 @@ func (fake *FakeCreatedVolume) Invocations() map[string][][]interface{} {
 	defer fake.baseResourceTypeMutex.RUnlock()
 	fake.containerHandleMutex.RLock()
 	defer fake.containerHandleMutex.RUnlock()
+	fake.createChildForArtifactMutex.RLock()
+	defer fake.createChildForArtifactMutex.RUnlock()
 	fake.createChildForContainerMutex.RLock()
 	defer fake.createChildForContainerMutex.RUnlock()
 	fake.destroyingMutex.RLock()
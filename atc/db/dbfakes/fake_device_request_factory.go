@@ -0,0 +1,439 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeDeviceRequestFactory struct {
+	ApproveDeviceRequestStub        func(string, string, string, time.Time) (bool, error)
+	approveDeviceRequestMutex       sync.RWMutex
+	approveDeviceRequestArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 time.Time
+	}
+	approveDeviceRequestReturns struct {
+		result1 bool
+		result2 error
+	}
+	approveDeviceRequestReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	CreateDeviceRequestStub        func(db.DeviceRequest) error
+	createDeviceRequestMutex       sync.RWMutex
+	createDeviceRequestArgsForCall []struct {
+		arg1 db.DeviceRequest
+	}
+	createDeviceRequestReturns struct {
+		result1 error
+	}
+	createDeviceRequestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteDeviceRequestStub        func(string) error
+	deleteDeviceRequestMutex       sync.RWMutex
+	deleteDeviceRequestArgsForCall []struct {
+		arg1 string
+	}
+	deleteDeviceRequestReturns struct {
+		result1 error
+	}
+	deleteDeviceRequestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	FindDeviceRequestByUserCodeStub        func(string) (db.DeviceRequest, bool, error)
+	findDeviceRequestByUserCodeMutex       sync.RWMutex
+	findDeviceRequestByUserCodeArgsForCall []struct {
+		arg1 string
+	}
+	findDeviceRequestByUserCodeReturns struct {
+		result1 db.DeviceRequest
+		result2 bool
+		result3 error
+	}
+	findDeviceRequestByUserCodeReturnsOnCall map[int]struct {
+		result1 db.DeviceRequest
+		result2 bool
+		result3 error
+	}
+	PollDeviceRequestStub        func(string) (db.DeviceRequest, bool, bool, error)
+	pollDeviceRequestMutex       sync.RWMutex
+	pollDeviceRequestArgsForCall []struct {
+		arg1 string
+	}
+	pollDeviceRequestReturns struct {
+		result1 db.DeviceRequest
+		result2 bool
+		result3 bool
+		result4 error
+	}
+	pollDeviceRequestReturnsOnCall map[int]struct {
+		result1 db.DeviceRequest
+		result2 bool
+		result3 bool
+		result4 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDeviceRequestFactory) ApproveDeviceRequest(arg1 string, arg2 string, arg3 string, arg4 time.Time) (bool, error) {
+	fake.approveDeviceRequestMutex.Lock()
+	ret, specificReturn := fake.approveDeviceRequestReturnsOnCall[len(fake.approveDeviceRequestArgsForCall)]
+	fake.approveDeviceRequestArgsForCall = append(fake.approveDeviceRequestArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("ApproveDeviceRequest", []interface{}{arg1, arg2, arg3, arg4})
+	fake.approveDeviceRequestMutex.Unlock()
+	if fake.ApproveDeviceRequestStub != nil {
+		return fake.ApproveDeviceRequestStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.approveDeviceRequestReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDeviceRequestFactory) ApproveDeviceRequestCallCount() int {
+	fake.approveDeviceRequestMutex.RLock()
+	defer fake.approveDeviceRequestMutex.RUnlock()
+	return len(fake.approveDeviceRequestArgsForCall)
+}
+
+func (fake *FakeDeviceRequestFactory) ApproveDeviceRequestCalls(stub func(string, string, string, time.Time) (bool, error)) {
+	fake.approveDeviceRequestMutex.Lock()
+	defer fake.approveDeviceRequestMutex.Unlock()
+	fake.ApproveDeviceRequestStub = stub
+}
+
+func (fake *FakeDeviceRequestFactory) ApproveDeviceRequestArgsForCall(i int) (string, string, string, time.Time) {
+	fake.approveDeviceRequestMutex.RLock()
+	defer fake.approveDeviceRequestMutex.RUnlock()
+	argsForCall := fake.approveDeviceRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDeviceRequestFactory) ApproveDeviceRequestReturns(result1 bool, result2 error) {
+	fake.approveDeviceRequestMutex.Lock()
+	defer fake.approveDeviceRequestMutex.Unlock()
+	fake.ApproveDeviceRequestStub = nil
+	fake.approveDeviceRequestReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeviceRequestFactory) ApproveDeviceRequestReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.approveDeviceRequestMutex.Lock()
+	defer fake.approveDeviceRequestMutex.Unlock()
+	fake.ApproveDeviceRequestStub = nil
+	if fake.approveDeviceRequestReturnsOnCall == nil {
+		fake.approveDeviceRequestReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.approveDeviceRequestReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeviceRequestFactory) CreateDeviceRequest(arg1 db.DeviceRequest) error {
+	fake.createDeviceRequestMutex.Lock()
+	ret, specificReturn := fake.createDeviceRequestReturnsOnCall[len(fake.createDeviceRequestArgsForCall)]
+	fake.createDeviceRequestArgsForCall = append(fake.createDeviceRequestArgsForCall, struct {
+		arg1 db.DeviceRequest
+	}{arg1})
+	fake.recordInvocation("CreateDeviceRequest", []interface{}{arg1})
+	fake.createDeviceRequestMutex.Unlock()
+	if fake.CreateDeviceRequestStub != nil {
+		return fake.CreateDeviceRequestStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.createDeviceRequestReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeDeviceRequestFactory) CreateDeviceRequestCallCount() int {
+	fake.createDeviceRequestMutex.RLock()
+	defer fake.createDeviceRequestMutex.RUnlock()
+	return len(fake.createDeviceRequestArgsForCall)
+}
+
+func (fake *FakeDeviceRequestFactory) CreateDeviceRequestCalls(stub func(db.DeviceRequest) error) {
+	fake.createDeviceRequestMutex.Lock()
+	defer fake.createDeviceRequestMutex.Unlock()
+	fake.CreateDeviceRequestStub = stub
+}
+
+func (fake *FakeDeviceRequestFactory) CreateDeviceRequestArgsForCall(i int) db.DeviceRequest {
+	fake.createDeviceRequestMutex.RLock()
+	defer fake.createDeviceRequestMutex.RUnlock()
+	argsForCall := fake.createDeviceRequestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDeviceRequestFactory) CreateDeviceRequestReturns(result1 error) {
+	fake.createDeviceRequestMutex.Lock()
+	defer fake.createDeviceRequestMutex.Unlock()
+	fake.CreateDeviceRequestStub = nil
+	fake.createDeviceRequestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDeviceRequestFactory) CreateDeviceRequestReturnsOnCall(i int, result1 error) {
+	fake.createDeviceRequestMutex.Lock()
+	defer fake.createDeviceRequestMutex.Unlock()
+	fake.CreateDeviceRequestStub = nil
+	if fake.createDeviceRequestReturnsOnCall == nil {
+		fake.createDeviceRequestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createDeviceRequestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDeviceRequestFactory) DeleteDeviceRequest(arg1 string) error {
+	fake.deleteDeviceRequestMutex.Lock()
+	ret, specificReturn := fake.deleteDeviceRequestReturnsOnCall[len(fake.deleteDeviceRequestArgsForCall)]
+	fake.deleteDeviceRequestArgsForCall = append(fake.deleteDeviceRequestArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteDeviceRequest", []interface{}{arg1})
+	fake.deleteDeviceRequestMutex.Unlock()
+	if fake.DeleteDeviceRequestStub != nil {
+		return fake.DeleteDeviceRequestStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deleteDeviceRequestReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeDeviceRequestFactory) DeleteDeviceRequestCallCount() int {
+	fake.deleteDeviceRequestMutex.RLock()
+	defer fake.deleteDeviceRequestMutex.RUnlock()
+	return len(fake.deleteDeviceRequestArgsForCall)
+}
+
+func (fake *FakeDeviceRequestFactory) DeleteDeviceRequestCalls(stub func(string) error) {
+	fake.deleteDeviceRequestMutex.Lock()
+	defer fake.deleteDeviceRequestMutex.Unlock()
+	fake.DeleteDeviceRequestStub = stub
+}
+
+func (fake *FakeDeviceRequestFactory) DeleteDeviceRequestArgsForCall(i int) string {
+	fake.deleteDeviceRequestMutex.RLock()
+	defer fake.deleteDeviceRequestMutex.RUnlock()
+	argsForCall := fake.deleteDeviceRequestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDeviceRequestFactory) DeleteDeviceRequestReturns(result1 error) {
+	fake.deleteDeviceRequestMutex.Lock()
+	defer fake.deleteDeviceRequestMutex.Unlock()
+	fake.DeleteDeviceRequestStub = nil
+	fake.deleteDeviceRequestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDeviceRequestFactory) DeleteDeviceRequestReturnsOnCall(i int, result1 error) {
+	fake.deleteDeviceRequestMutex.Lock()
+	defer fake.deleteDeviceRequestMutex.Unlock()
+	fake.DeleteDeviceRequestStub = nil
+	if fake.deleteDeviceRequestReturnsOnCall == nil {
+		fake.deleteDeviceRequestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteDeviceRequestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDeviceRequestFactory) FindDeviceRequestByUserCode(arg1 string) (db.DeviceRequest, bool, error) {
+	fake.findDeviceRequestByUserCodeMutex.Lock()
+	ret, specificReturn := fake.findDeviceRequestByUserCodeReturnsOnCall[len(fake.findDeviceRequestByUserCodeArgsForCall)]
+	fake.findDeviceRequestByUserCodeArgsForCall = append(fake.findDeviceRequestByUserCodeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("FindDeviceRequestByUserCode", []interface{}{arg1})
+	fake.findDeviceRequestByUserCodeMutex.Unlock()
+	if fake.FindDeviceRequestByUserCodeStub != nil {
+		return fake.FindDeviceRequestByUserCodeStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.findDeviceRequestByUserCodeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDeviceRequestFactory) FindDeviceRequestByUserCodeCallCount() int {
+	fake.findDeviceRequestByUserCodeMutex.RLock()
+	defer fake.findDeviceRequestByUserCodeMutex.RUnlock()
+	return len(fake.findDeviceRequestByUserCodeArgsForCall)
+}
+
+func (fake *FakeDeviceRequestFactory) FindDeviceRequestByUserCodeCalls(stub func(string) (db.DeviceRequest, bool, error)) {
+	fake.findDeviceRequestByUserCodeMutex.Lock()
+	defer fake.findDeviceRequestByUserCodeMutex.Unlock()
+	fake.FindDeviceRequestByUserCodeStub = stub
+}
+
+func (fake *FakeDeviceRequestFactory) FindDeviceRequestByUserCodeArgsForCall(i int) string {
+	fake.findDeviceRequestByUserCodeMutex.RLock()
+	defer fake.findDeviceRequestByUserCodeMutex.RUnlock()
+	argsForCall := fake.findDeviceRequestByUserCodeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDeviceRequestFactory) FindDeviceRequestByUserCodeReturns(result1 db.DeviceRequest, result2 bool, result3 error) {
+	fake.findDeviceRequestByUserCodeMutex.Lock()
+	defer fake.findDeviceRequestByUserCodeMutex.Unlock()
+	fake.FindDeviceRequestByUserCodeStub = nil
+	fake.findDeviceRequestByUserCodeReturns = struct {
+		result1 db.DeviceRequest
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeviceRequestFactory) FindDeviceRequestByUserCodeReturnsOnCall(i int, result1 db.DeviceRequest, result2 bool, result3 error) {
+	fake.findDeviceRequestByUserCodeMutex.Lock()
+	defer fake.findDeviceRequestByUserCodeMutex.Unlock()
+	fake.FindDeviceRequestByUserCodeStub = nil
+	if fake.findDeviceRequestByUserCodeReturnsOnCall == nil {
+		fake.findDeviceRequestByUserCodeReturnsOnCall = make(map[int]struct {
+			result1 db.DeviceRequest
+			result2 bool
+			result3 error
+		})
+	}
+	fake.findDeviceRequestByUserCodeReturnsOnCall[i] = struct {
+		result1 db.DeviceRequest
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeviceRequestFactory) PollDeviceRequest(arg1 string) (db.DeviceRequest, bool, bool, error) {
+	fake.pollDeviceRequestMutex.Lock()
+	ret, specificReturn := fake.pollDeviceRequestReturnsOnCall[len(fake.pollDeviceRequestArgsForCall)]
+	fake.pollDeviceRequestArgsForCall = append(fake.pollDeviceRequestArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("PollDeviceRequest", []interface{}{arg1})
+	fake.pollDeviceRequestMutex.Unlock()
+	if fake.PollDeviceRequestStub != nil {
+		return fake.PollDeviceRequestStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	fakeReturns := fake.pollDeviceRequestReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeDeviceRequestFactory) PollDeviceRequestCallCount() int {
+	fake.pollDeviceRequestMutex.RLock()
+	defer fake.pollDeviceRequestMutex.RUnlock()
+	return len(fake.pollDeviceRequestArgsForCall)
+}
+
+func (fake *FakeDeviceRequestFactory) PollDeviceRequestCalls(stub func(string) (db.DeviceRequest, bool, bool, error)) {
+	fake.pollDeviceRequestMutex.Lock()
+	defer fake.pollDeviceRequestMutex.Unlock()
+	fake.PollDeviceRequestStub = stub
+}
+
+func (fake *FakeDeviceRequestFactory) PollDeviceRequestArgsForCall(i int) string {
+	fake.pollDeviceRequestMutex.RLock()
+	defer fake.pollDeviceRequestMutex.RUnlock()
+	argsForCall := fake.pollDeviceRequestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDeviceRequestFactory) PollDeviceRequestReturns(result1 db.DeviceRequest, result2 bool, result3 bool, result4 error) {
+	fake.pollDeviceRequestMutex.Lock()
+	defer fake.pollDeviceRequestMutex.Unlock()
+	fake.PollDeviceRequestStub = nil
+	fake.pollDeviceRequestReturns = struct {
+		result1 db.DeviceRequest
+		result2 bool
+		result3 bool
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeDeviceRequestFactory) PollDeviceRequestReturnsOnCall(i int, result1 db.DeviceRequest, result2 bool, result3 bool, result4 error) {
+	fake.pollDeviceRequestMutex.Lock()
+	defer fake.pollDeviceRequestMutex.Unlock()
+	fake.PollDeviceRequestStub = nil
+	if fake.pollDeviceRequestReturnsOnCall == nil {
+		fake.pollDeviceRequestReturnsOnCall = make(map[int]struct {
+			result1 db.DeviceRequest
+			result2 bool
+			result3 bool
+			result4 error
+		})
+	}
+	fake.pollDeviceRequestReturnsOnCall[i] = struct {
+		result1 db.DeviceRequest
+		result2 bool
+		result3 bool
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeDeviceRequestFactory) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.approveDeviceRequestMutex.RLock()
+	defer fake.approveDeviceRequestMutex.RUnlock()
+	fake.createDeviceRequestMutex.RLock()
+	defer fake.createDeviceRequestMutex.RUnlock()
+	fake.deleteDeviceRequestMutex.RLock()
+	defer fake.deleteDeviceRequestMutex.RUnlock()
+	fake.findDeviceRequestByUserCodeMutex.RLock()
+	defer fake.findDeviceRequestByUserCodeMutex.RUnlock()
+	fake.pollDeviceRequestMutex.RLock()
+	defer fake.pollDeviceRequestMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDeviceRequestFactory) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.DeviceRequestFactory = new(FakeDeviceRequestFactory)
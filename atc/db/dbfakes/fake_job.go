@@ -3,6 +3,7 @@ package dbfakes
 
 import (
 	"sync"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -79,9 +80,10 @@ type FakeJob struct {
 	configReturnsOnCall map[int]struct {
 		result1 atc.JobConfig
 	}
-	CreateBuildStub        func() (db.Build, error)
+	CreateBuildStub        func(string) (db.Build, error)
 	createBuildMutex       sync.RWMutex
 	createBuildArgsForCall []struct {
+		arg1 string
 	}
 	createBuildReturns struct {
 		result1 db.Build
@@ -101,9 +103,10 @@ type FakeJob struct {
 	deleteNextInputMappingReturnsOnCall map[int]struct {
 		result1 error
 	}
-	EnsurePendingBuildExistsStub        func() error
+	EnsurePendingBuildExistsStub        func(atc.BuildTrigger) error
 	ensurePendingBuildExistsMutex       sync.RWMutex
 	ensurePendingBuildExistsArgsForCall []struct {
+		arg1 atc.BuildTrigger
 	}
 	ensurePendingBuildExistsReturns struct {
 		result1 error
@@ -111,6 +114,17 @@ type FakeJob struct {
 	ensurePendingBuildExistsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	EnsureScheduledBuildExistsStub        func(time.Time) error
+	ensureScheduledBuildExistsMutex       sync.RWMutex
+	ensureScheduledBuildExistsArgsForCall []struct {
+		arg1 time.Time
+	}
+	ensureScheduledBuildExistsReturns struct {
+		result1 error
+	}
+	ensureScheduledBuildExistsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	FinishedAndNextBuildStub        func() (db.Build, db.Build, error)
 	finishedAndNextBuildMutex       sync.RWMutex
 	finishedAndNextBuildArgsForCall []struct {
@@ -221,6 +235,16 @@ type FakeJob struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	LastScheduledStub        func() time.Time
+	lastScheduledMutex       sync.RWMutex
+	lastScheduledArgsForCall []struct {
+	}
+	lastScheduledReturns struct {
+		result1 time.Time
+	}
+	lastScheduledReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -285,6 +309,16 @@ type FakeJob struct {
 	pipelineNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PriorityStub        func() int
+	priorityMutex       sync.RWMutex
+	priorityArgsForCall []struct {
+	}
+	priorityReturns struct {
+		result1 int
+	}
+	priorityReturnsOnCall map[int]struct {
+		result1 int
+	}
 	PublicStub        func() bool
 	publicMutex       sync.RWMutex
 	publicArgsForCall []struct {
@@ -340,6 +374,17 @@ type FakeJob struct {
 	setHasNewInputsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetLastScheduledStub        func(time.Time) error
+	setLastScheduledMutex       sync.RWMutex
+	setLastScheduledArgsForCall []struct {
+		arg1 time.Time
+	}
+	setLastScheduledReturns struct {
+		result1 error
+	}
+	setLastScheduledReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetMaxInFlightReachedStub        func(bool) error
 	setMaxInFlightReachedMutex       sync.RWMutex
 	setMaxInFlightReachedArgsForCall []struct {
@@ -361,6 +406,19 @@ type FakeJob struct {
 	tagsReturnsOnCall map[int]struct {
 		result1 []string
 	}
+	TaskResourceUsageStub        func(int) ([]atc.BuildTaskResourceUsage, error)
+	taskResourceUsageMutex       sync.RWMutex
+	taskResourceUsageArgsForCall []struct {
+		arg1 int
+	}
+	taskResourceUsageReturns struct {
+		result1 []atc.BuildTaskResourceUsage
+		result2 error
+	}
+	taskResourceUsageReturnsOnCall map[int]struct {
+		result1 []atc.BuildTaskResourceUsage
+		result2 error
+	}
 	TeamIDStub        func() int
 	teamIDMutex       sync.RWMutex
 	teamIDArgsForCall []struct {
@@ -720,15 +778,16 @@ func (fake *FakeJob) ConfigReturnsOnCall(i int, result1 atc.JobConfig) {
 	}{result1}
 }
 
-func (fake *FakeJob) CreateBuild() (db.Build, error) {
+func (fake *FakeJob) CreateBuild(arg1 string) (db.Build, error) {
 	fake.createBuildMutex.Lock()
 	ret, specificReturn := fake.createBuildReturnsOnCall[len(fake.createBuildArgsForCall)]
 	fake.createBuildArgsForCall = append(fake.createBuildArgsForCall, struct {
-	}{})
-	fake.recordInvocation("CreateBuild", []interface{}{})
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("CreateBuild", []interface{}{arg1})
 	fake.createBuildMutex.Unlock()
 	if fake.CreateBuildStub != nil {
-		return fake.CreateBuildStub()
+		return fake.CreateBuildStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -743,12 +802,19 @@ func (fake *FakeJob) CreateBuildCallCount() int {
 	return len(fake.createBuildArgsForCall)
 }
 
-func (fake *FakeJob) CreateBuildCalls(stub func() (db.Build, error)) {
+func (fake *FakeJob) CreateBuildCalls(stub func(string) (db.Build, error)) {
 	fake.createBuildMutex.Lock()
 	defer fake.createBuildMutex.Unlock()
 	fake.CreateBuildStub = stub
 }
 
+func (fake *FakeJob) CreateBuildArgsForCall(i int) string {
+	fake.createBuildMutex.RLock()
+	defer fake.createBuildMutex.RUnlock()
+	argsForCall := fake.createBuildArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeJob) CreateBuildReturns(result1 db.Build, result2 error) {
 	fake.createBuildMutex.Lock()
 	defer fake.createBuildMutex.Unlock()
@@ -827,15 +893,16 @@ func (fake *FakeJob) DeleteNextInputMappingReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeJob) EnsurePendingBuildExists() error {
+func (fake *FakeJob) EnsurePendingBuildExists(arg1 atc.BuildTrigger) error {
 	fake.ensurePendingBuildExistsMutex.Lock()
 	ret, specificReturn := fake.ensurePendingBuildExistsReturnsOnCall[len(fake.ensurePendingBuildExistsArgsForCall)]
 	fake.ensurePendingBuildExistsArgsForCall = append(fake.ensurePendingBuildExistsArgsForCall, struct {
-	}{})
-	fake.recordInvocation("EnsurePendingBuildExists", []interface{}{})
+		arg1 atc.BuildTrigger
+	}{arg1})
+	fake.recordInvocation("EnsurePendingBuildExists", []interface{}{arg1})
 	fake.ensurePendingBuildExistsMutex.Unlock()
 	if fake.EnsurePendingBuildExistsStub != nil {
-		return fake.EnsurePendingBuildExistsStub()
+		return fake.EnsurePendingBuildExistsStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
@@ -850,12 +917,19 @@ func (fake *FakeJob) EnsurePendingBuildExistsCallCount() int {
 	return len(fake.ensurePendingBuildExistsArgsForCall)
 }
 
-func (fake *FakeJob) EnsurePendingBuildExistsCalls(stub func() error) {
+func (fake *FakeJob) EnsurePendingBuildExistsCalls(stub func(atc.BuildTrigger) error) {
 	fake.ensurePendingBuildExistsMutex.Lock()
 	defer fake.ensurePendingBuildExistsMutex.Unlock()
 	fake.EnsurePendingBuildExistsStub = stub
 }
 
+func (fake *FakeJob) EnsurePendingBuildExistsArgsForCall(i int) atc.BuildTrigger {
+	fake.ensurePendingBuildExistsMutex.RLock()
+	defer fake.ensurePendingBuildExistsMutex.RUnlock()
+	argsForCall := fake.ensurePendingBuildExistsArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeJob) EnsurePendingBuildExistsReturns(result1 error) {
 	fake.ensurePendingBuildExistsMutex.Lock()
 	defer fake.ensurePendingBuildExistsMutex.Unlock()
@@ -879,6 +953,66 @@ func (fake *FakeJob) EnsurePendingBuildExistsReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeJob) EnsureScheduledBuildExists(arg1 time.Time) error {
+	fake.ensureScheduledBuildExistsMutex.Lock()
+	ret, specificReturn := fake.ensureScheduledBuildExistsReturnsOnCall[len(fake.ensureScheduledBuildExistsArgsForCall)]
+	fake.ensureScheduledBuildExistsArgsForCall = append(fake.ensureScheduledBuildExistsArgsForCall, struct {
+		arg1 time.Time
+	}{arg1})
+	fake.recordInvocation("EnsureScheduledBuildExists", []interface{}{arg1})
+	fake.ensureScheduledBuildExistsMutex.Unlock()
+	if fake.EnsureScheduledBuildExistsStub != nil {
+		return fake.EnsureScheduledBuildExistsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.ensureScheduledBuildExistsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) EnsureScheduledBuildExistsCallCount() int {
+	fake.ensureScheduledBuildExistsMutex.RLock()
+	defer fake.ensureScheduledBuildExistsMutex.RUnlock()
+	return len(fake.ensureScheduledBuildExistsArgsForCall)
+}
+
+func (fake *FakeJob) EnsureScheduledBuildExistsCalls(stub func(time.Time) error) {
+	fake.ensureScheduledBuildExistsMutex.Lock()
+	defer fake.ensureScheduledBuildExistsMutex.Unlock()
+	fake.EnsureScheduledBuildExistsStub = stub
+}
+
+func (fake *FakeJob) EnsureScheduledBuildExistsArgsForCall(i int) time.Time {
+	fake.ensureScheduledBuildExistsMutex.RLock()
+	defer fake.ensureScheduledBuildExistsMutex.RUnlock()
+	argsForCall := fake.ensureScheduledBuildExistsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) EnsureScheduledBuildExistsReturns(result1 error) {
+	fake.ensureScheduledBuildExistsMutex.Lock()
+	defer fake.ensureScheduledBuildExistsMutex.Unlock()
+	fake.EnsureScheduledBuildExistsStub = nil
+	fake.ensureScheduledBuildExistsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeJob) EnsureScheduledBuildExistsReturnsOnCall(i int, result1 error) {
+	fake.ensureScheduledBuildExistsMutex.Lock()
+	defer fake.ensureScheduledBuildExistsMutex.Unlock()
+	fake.EnsureScheduledBuildExistsStub = nil
+	if fake.ensureScheduledBuildExistsReturnsOnCall == nil {
+		fake.ensureScheduledBuildExistsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.ensureScheduledBuildExistsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeJob) FinishedAndNextBuild() (db.Build, db.Build, error) {
 	fake.finishedAndNextBuildMutex.Lock()
 	ret, specificReturn := fake.finishedAndNextBuildReturnsOnCall[len(fake.finishedAndNextBuildArgsForCall)]
@@ -1400,6 +1534,58 @@ func (fake *FakeJob) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeJob) LastScheduled() time.Time {
+	fake.lastScheduledMutex.Lock()
+	ret, specificReturn := fake.lastScheduledReturnsOnCall[len(fake.lastScheduledArgsForCall)]
+	fake.lastScheduledArgsForCall = append(fake.lastScheduledArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LastScheduled", []interface{}{})
+	fake.lastScheduledMutex.Unlock()
+	if fake.LastScheduledStub != nil {
+		return fake.LastScheduledStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.lastScheduledReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) LastScheduledCallCount() int {
+	fake.lastScheduledMutex.RLock()
+	defer fake.lastScheduledMutex.RUnlock()
+	return len(fake.lastScheduledArgsForCall)
+}
+
+func (fake *FakeJob) LastScheduledCalls(stub func() time.Time) {
+	fake.lastScheduledMutex.Lock()
+	defer fake.lastScheduledMutex.Unlock()
+	fake.LastScheduledStub = stub
+}
+
+func (fake *FakeJob) LastScheduledReturns(result1 time.Time) {
+	fake.lastScheduledMutex.Lock()
+	defer fake.lastScheduledMutex.Unlock()
+	fake.LastScheduledStub = nil
+	fake.lastScheduledReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeJob) LastScheduledReturnsOnCall(i int, result1 time.Time) {
+	fake.lastScheduledMutex.Lock()
+	defer fake.lastScheduledMutex.Unlock()
+	fake.LastScheduledStub = nil
+	if fake.lastScheduledReturnsOnCall == nil {
+		fake.lastScheduledReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.lastScheduledReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeJob) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1718,6 +1904,58 @@ func (fake *FakeJob) PipelineNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeJob) Priority() int {
+	fake.priorityMutex.Lock()
+	ret, specificReturn := fake.priorityReturnsOnCall[len(fake.priorityArgsForCall)]
+	fake.priorityArgsForCall = append(fake.priorityArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Priority", []interface{}{})
+	fake.priorityMutex.Unlock()
+	if fake.PriorityStub != nil {
+		return fake.PriorityStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.priorityReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) PriorityCallCount() int {
+	fake.priorityMutex.RLock()
+	defer fake.priorityMutex.RUnlock()
+	return len(fake.priorityArgsForCall)
+}
+
+func (fake *FakeJob) PriorityCalls(stub func() int) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = stub
+}
+
+func (fake *FakeJob) PriorityReturns(result1 int) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = nil
+	fake.priorityReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeJob) PriorityReturnsOnCall(i int, result1 int) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = nil
+	if fake.priorityReturnsOnCall == nil {
+		fake.priorityReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.priorityReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeJob) Public() bool {
 	fake.publicMutex.Lock()
 	ret, specificReturn := fake.publicReturnsOnCall[len(fake.publicArgsForCall)]
@@ -2005,6 +2243,66 @@ func (fake *FakeJob) SetHasNewInputsReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeJob) SetLastScheduled(arg1 time.Time) error {
+	fake.setLastScheduledMutex.Lock()
+	ret, specificReturn := fake.setLastScheduledReturnsOnCall[len(fake.setLastScheduledArgsForCall)]
+	fake.setLastScheduledArgsForCall = append(fake.setLastScheduledArgsForCall, struct {
+		arg1 time.Time
+	}{arg1})
+	fake.recordInvocation("SetLastScheduled", []interface{}{arg1})
+	fake.setLastScheduledMutex.Unlock()
+	if fake.SetLastScheduledStub != nil {
+		return fake.SetLastScheduledStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setLastScheduledReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) SetLastScheduledCallCount() int {
+	fake.setLastScheduledMutex.RLock()
+	defer fake.setLastScheduledMutex.RUnlock()
+	return len(fake.setLastScheduledArgsForCall)
+}
+
+func (fake *FakeJob) SetLastScheduledCalls(stub func(time.Time) error) {
+	fake.setLastScheduledMutex.Lock()
+	defer fake.setLastScheduledMutex.Unlock()
+	fake.SetLastScheduledStub = stub
+}
+
+func (fake *FakeJob) SetLastScheduledArgsForCall(i int) time.Time {
+	fake.setLastScheduledMutex.RLock()
+	defer fake.setLastScheduledMutex.RUnlock()
+	argsForCall := fake.setLastScheduledArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) SetLastScheduledReturns(result1 error) {
+	fake.setLastScheduledMutex.Lock()
+	defer fake.setLastScheduledMutex.Unlock()
+	fake.SetLastScheduledStub = nil
+	fake.setLastScheduledReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeJob) SetLastScheduledReturnsOnCall(i int, result1 error) {
+	fake.setLastScheduledMutex.Lock()
+	defer fake.setLastScheduledMutex.Unlock()
+	fake.SetLastScheduledStub = nil
+	if fake.setLastScheduledReturnsOnCall == nil {
+		fake.setLastScheduledReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setLastScheduledReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeJob) SetMaxInFlightReached(arg1 bool) error {
 	fake.setMaxInFlightReachedMutex.Lock()
 	ret, specificReturn := fake.setMaxInFlightReachedReturnsOnCall[len(fake.setMaxInFlightReachedArgsForCall)]
@@ -2117,6 +2415,69 @@ func (fake *FakeJob) TagsReturnsOnCall(i int, result1 []string) {
 	}{result1}
 }
 
+func (fake *FakeJob) TaskResourceUsage(arg1 int) ([]atc.BuildTaskResourceUsage, error) {
+	fake.taskResourceUsageMutex.Lock()
+	ret, specificReturn := fake.taskResourceUsageReturnsOnCall[len(fake.taskResourceUsageArgsForCall)]
+	fake.taskResourceUsageArgsForCall = append(fake.taskResourceUsageArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("TaskResourceUsage", []interface{}{arg1})
+	fake.taskResourceUsageMutex.Unlock()
+	if fake.TaskResourceUsageStub != nil {
+		return fake.TaskResourceUsageStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.taskResourceUsageReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) TaskResourceUsageCallCount() int {
+	fake.taskResourceUsageMutex.RLock()
+	defer fake.taskResourceUsageMutex.RUnlock()
+	return len(fake.taskResourceUsageArgsForCall)
+}
+
+func (fake *FakeJob) TaskResourceUsageCalls(stub func(int) ([]atc.BuildTaskResourceUsage, error)) {
+	fake.taskResourceUsageMutex.Lock()
+	defer fake.taskResourceUsageMutex.Unlock()
+	fake.TaskResourceUsageStub = stub
+}
+
+func (fake *FakeJob) TaskResourceUsageArgsForCall(i int) int {
+	fake.taskResourceUsageMutex.RLock()
+	defer fake.taskResourceUsageMutex.RUnlock()
+	argsForCall := fake.taskResourceUsageArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) TaskResourceUsageReturns(result1 []atc.BuildTaskResourceUsage, result2 error) {
+	fake.taskResourceUsageMutex.Lock()
+	defer fake.taskResourceUsageMutex.Unlock()
+	fake.TaskResourceUsageStub = nil
+	fake.taskResourceUsageReturns = struct {
+		result1 []atc.BuildTaskResourceUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) TaskResourceUsageReturnsOnCall(i int, result1 []atc.BuildTaskResourceUsage, result2 error) {
+	fake.taskResourceUsageMutex.Lock()
+	defer fake.taskResourceUsageMutex.Unlock()
+	fake.TaskResourceUsageStub = nil
+	if fake.taskResourceUsageReturnsOnCall == nil {
+		fake.taskResourceUsageReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildTaskResourceUsage
+			result2 error
+		})
+	}
+	fake.taskResourceUsageReturnsOnCall[i] = struct {
+		result1 []atc.BuildTaskResourceUsage
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) TeamID() int {
 	fake.teamIDMutex.Lock()
 	ret, specificReturn := fake.teamIDReturnsOnCall[len(fake.teamIDArgsForCall)]
@@ -2352,6 +2713,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.deleteNextInputMappingMutex.RUnlock()
 	fake.ensurePendingBuildExistsMutex.RLock()
 	defer fake.ensurePendingBuildExistsMutex.RUnlock()
+	fake.ensureScheduledBuildExistsMutex.RLock()
+	defer fake.ensureScheduledBuildExistsMutex.RUnlock()
 	fake.finishedAndNextBuildMutex.RLock()
 	defer fake.finishedAndNextBuildMutex.RUnlock()
 	fake.firstLoggedBuildIDMutex.RLock()
@@ -2370,6 +2733,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.hasNewInputsMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.lastScheduledMutex.RLock()
+	defer fake.lastScheduledMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.pauseMutex.RLock()
@@ -2382,6 +2747,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.pipelineIDMutex.RUnlock()
 	fake.pipelineNameMutex.RLock()
 	defer fake.pipelineNameMutex.RUnlock()
+	fake.priorityMutex.RLock()
+	defer fake.priorityMutex.RUnlock()
 	fake.publicMutex.RLock()
 	defer fake.publicMutex.RUnlock()
 	fake.reloadMutex.RLock()
@@ -2392,10 +2759,14 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.saveNextInputMappingMutex.RUnlock()
 	fake.setHasNewInputsMutex.RLock()
 	defer fake.setHasNewInputsMutex.RUnlock()
+	fake.setLastScheduledMutex.RLock()
+	defer fake.setLastScheduledMutex.RUnlock()
 	fake.setMaxInFlightReachedMutex.RLock()
 	defer fake.setMaxInFlightReachedMutex.RUnlock()
 	fake.tagsMutex.RLock()
 	defer fake.tagsMutex.RUnlock()
+	fake.taskResourceUsageMutex.RLock()
+	defer fake.taskResourceUsageMutex.RUnlock()
 	fake.teamIDMutex.RLock()
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
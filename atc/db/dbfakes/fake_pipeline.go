@@ -120,10 +120,11 @@ type FakePipeline struct {
 		result1 db.Build
 		result2 error
 	}
-	CreateStartedBuildStub        func(atc.Plan) (db.Build, error)
+	CreateStartedBuildStub        func(atc.Plan, string) (db.Build, error)
 	createStartedBuildMutex       sync.RWMutex
 	createStartedBuildArgsForCall []struct {
 		arg1 atc.Plan
+		arg2 string
 	}
 	createStartedBuildReturns struct {
 		result1 db.Build
@@ -176,6 +177,22 @@ type FakePipeline struct {
 	exposeReturnsOnCall map[int]struct {
 		result1 error
 	}
+	FreezeStub        func(int, string) ([]atc.FrozenResource, bool, error)
+	freezeMutex       sync.RWMutex
+	freezeArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	freezeReturns struct {
+		result1 []atc.FrozenResource
+		result2 bool
+		result3 error
+	}
+	freezeReturnsOnCall map[int]struct {
+		result1 []atc.FrozenResource
+		result2 bool
+		result3 error
+	}
 	GetAllPendingBuildsStub        func() (map[string][]db.Build, error)
 	getAllPendingBuildsMutex       sync.RWMutex
 	getAllPendingBuildsArgsForCall []struct {
@@ -467,6 +484,18 @@ type FakePipeline struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UnfreezeStub        func() (bool, error)
+	unfreezeMutex       sync.RWMutex
+	unfreezeArgsForCall []struct {
+	}
+	unfreezeReturns struct {
+		result1 bool
+		result2 error
+	}
+	unfreezeReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	UnpauseStub        func() error
 	unpauseMutex       sync.RWMutex
 	unpauseArgsForCall []struct {
@@ -985,16 +1014,17 @@ func (fake *FakePipeline) CreateOneOffBuildReturnsOnCall(i int, result1 db.Build
 	}{result1, result2}
 }
 
-func (fake *FakePipeline) CreateStartedBuild(arg1 atc.Plan) (db.Build, error) {
+func (fake *FakePipeline) CreateStartedBuild(arg1 atc.Plan, arg2 string) (db.Build, error) {
 	fake.createStartedBuildMutex.Lock()
 	ret, specificReturn := fake.createStartedBuildReturnsOnCall[len(fake.createStartedBuildArgsForCall)]
 	fake.createStartedBuildArgsForCall = append(fake.createStartedBuildArgsForCall, struct {
 		arg1 atc.Plan
-	}{arg1})
-	fake.recordInvocation("CreateStartedBuild", []interface{}{arg1})
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("CreateStartedBuild", []interface{}{arg1, arg2})
 	fake.createStartedBuildMutex.Unlock()
 	if fake.CreateStartedBuildStub != nil {
-		return fake.CreateStartedBuildStub(arg1)
+		return fake.CreateStartedBuildStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1009,17 +1039,17 @@ func (fake *FakePipeline) CreateStartedBuildCallCount() int {
 	return len(fake.createStartedBuildArgsForCall)
 }
 
-func (fake *FakePipeline) CreateStartedBuildCalls(stub func(atc.Plan) (db.Build, error)) {
+func (fake *FakePipeline) CreateStartedBuildCalls(stub func(atc.Plan, string) (db.Build, error)) {
 	fake.createStartedBuildMutex.Lock()
 	defer fake.createStartedBuildMutex.Unlock()
 	fake.CreateStartedBuildStub = stub
 }
 
-func (fake *FakePipeline) CreateStartedBuildArgsForCall(i int) atc.Plan {
+func (fake *FakePipeline) CreateStartedBuildArgsForCall(i int) (atc.Plan, string) {
 	fake.createStartedBuildMutex.RLock()
 	defer fake.createStartedBuildMutex.RUnlock()
 	argsForCall := fake.createStartedBuildArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakePipeline) CreateStartedBuildReturns(result1 db.Build, result2 error) {
@@ -1272,6 +1302,73 @@ func (fake *FakePipeline) ExposeReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakePipeline) Freeze(arg1 int, arg2 string) ([]atc.FrozenResource, bool, error) {
+	fake.freezeMutex.Lock()
+	ret, specificReturn := fake.freezeReturnsOnCall[len(fake.freezeArgsForCall)]
+	fake.freezeArgsForCall = append(fake.freezeArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Freeze", []interface{}{arg1, arg2})
+	fake.freezeMutex.Unlock()
+	if fake.FreezeStub != nil {
+		return fake.FreezeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.freezeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePipeline) FreezeCallCount() int {
+	fake.freezeMutex.RLock()
+	defer fake.freezeMutex.RUnlock()
+	return len(fake.freezeArgsForCall)
+}
+
+func (fake *FakePipeline) FreezeCalls(stub func(int, string) ([]atc.FrozenResource, bool, error)) {
+	fake.freezeMutex.Lock()
+	defer fake.freezeMutex.Unlock()
+	fake.FreezeStub = stub
+}
+
+func (fake *FakePipeline) FreezeArgsForCall(i int) (int, string) {
+	fake.freezeMutex.RLock()
+	defer fake.freezeMutex.RUnlock()
+	argsForCall := fake.freezeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePipeline) FreezeReturns(result1 []atc.FrozenResource, result2 bool, result3 error) {
+	fake.freezeMutex.Lock()
+	defer fake.freezeMutex.Unlock()
+	fake.FreezeStub = nil
+	fake.freezeReturns = struct {
+		result1 []atc.FrozenResource
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePipeline) FreezeReturnsOnCall(i int, result1 []atc.FrozenResource, result2 bool, result3 error) {
+	fake.freezeMutex.Lock()
+	defer fake.freezeMutex.Unlock()
+	fake.FreezeStub = nil
+	if fake.freezeReturnsOnCall == nil {
+		fake.freezeReturnsOnCall = make(map[int]struct {
+			result1 []atc.FrozenResource
+			result2 bool
+			result3 error
+		})
+	}
+	fake.freezeReturnsOnCall[i] = struct {
+		result1 []atc.FrozenResource
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakePipeline) GetAllPendingBuilds() (map[string][]db.Build, error) {
 	fake.getAllPendingBuildsMutex.Lock()
 	ret, specificReturn := fake.getAllPendingBuildsReturnsOnCall[len(fake.getAllPendingBuildsArgsForCall)]
@@ -2654,6 +2751,61 @@ func (fake *FakePipeline) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakePipeline) Unfreeze() (bool, error) {
+	fake.unfreezeMutex.Lock()
+	ret, specificReturn := fake.unfreezeReturnsOnCall[len(fake.unfreezeArgsForCall)]
+	fake.unfreezeArgsForCall = append(fake.unfreezeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Unfreeze", []interface{}{})
+	fake.unfreezeMutex.Unlock()
+	if fake.UnfreezeStub != nil {
+		return fake.UnfreezeStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.unfreezeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) UnfreezeCallCount() int {
+	fake.unfreezeMutex.RLock()
+	defer fake.unfreezeMutex.RUnlock()
+	return len(fake.unfreezeArgsForCall)
+}
+
+func (fake *FakePipeline) UnfreezeCalls(stub func() (bool, error)) {
+	fake.unfreezeMutex.Lock()
+	defer fake.unfreezeMutex.Unlock()
+	fake.UnfreezeStub = stub
+}
+
+func (fake *FakePipeline) UnfreezeReturns(result1 bool, result2 error) {
+	fake.unfreezeMutex.Lock()
+	defer fake.unfreezeMutex.Unlock()
+	fake.UnfreezeStub = nil
+	fake.unfreezeReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) UnfreezeReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.unfreezeMutex.Lock()
+	defer fake.unfreezeMutex.Unlock()
+	fake.UnfreezeStub = nil
+	if fake.unfreezeReturnsOnCall == nil {
+		fake.unfreezeReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.unfreezeReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePipeline) Unpause() error {
 	fake.unpauseMutex.Lock()
 	ret, specificReturn := fake.unpauseReturnsOnCall[len(fake.unpauseArgsForCall)]
@@ -2852,6 +3004,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.destroyMutex.RUnlock()
 	fake.exposeMutex.RLock()
 	defer fake.exposeMutex.RUnlock()
+	fake.freezeMutex.RLock()
+	defer fake.freezeMutex.RUnlock()
 	fake.getAllPendingBuildsMutex.RLock()
 	defer fake.getAllPendingBuildsMutex.RUnlock()
 	fake.getBuildsWithVersionAsInputMutex.RLock()
@@ -2900,6 +3054,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.unfreezeMutex.RLock()
+	defer fake.unfreezeMutex.RUnlock()
 	fake.unpauseMutex.RLock()
 	defer fake.unpauseMutex.RUnlock()
 	fake.varSourcesMutex.RLock()
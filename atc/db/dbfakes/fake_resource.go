@@ -20,6 +20,21 @@ type FakeResource struct {
 	aPIPinnedVersionReturnsOnCall map[int]struct {
 		result1 atc.Version
 	}
+	AddVersionLabelStub        func(int, string, string) (bool, error)
+	addVersionLabelMutex       sync.RWMutex
+	addVersionLabelArgsForCall []struct {
+		arg1 int
+		arg2 string
+		arg3 string
+	}
+	addVersionLabelReturns struct {
+		result1 bool
+		result2 error
+	}
+	addVersionLabelReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	CheckErrorStub        func() error
 	checkErrorMutex       sync.RWMutex
 	checkErrorArgsForCall []struct {
@@ -102,6 +117,33 @@ type FakeResource struct {
 	enableVersionReturnsOnCall map[int]struct {
 		result1 error
 	}
+	FilteredVersionIDsStub        func(atc.VersionFilter) ([]int, error)
+	filteredVersionIDsMutex       sync.RWMutex
+	filteredVersionIDsArgsForCall []struct {
+		arg1 atc.VersionFilter
+	}
+	filteredVersionIDsReturns struct {
+		result1 []int
+		result2 error
+	}
+	filteredVersionIDsReturnsOnCall map[int]struct {
+		result1 []int
+		result2 error
+	}
+	FilteredVersionsStub        func(atc.VersionFilter, int) ([]atc.ResourceVersion, error)
+	filteredVersionsMutex       sync.RWMutex
+	filteredVersionsArgsForCall []struct {
+		arg1 atc.VersionFilter
+		arg2 int
+	}
+	filteredVersionsReturns struct {
+		result1 []atc.ResourceVersion
+		result2 error
+	}
+	filteredVersionsReturnsOnCall map[int]struct {
+		result1 []atc.ResourceVersion
+		result2 error
+	}
 	IDStub        func() int
 	iDMutex       sync.RWMutex
 	iDArgsForCall []struct {
@@ -241,6 +283,20 @@ type FakeResource struct {
 		result1 bool
 		result2 error
 	}
+	RemoveVersionLabelStub        func(int, string) (bool, error)
+	removeVersionLabelMutex       sync.RWMutex
+	removeVersionLabelArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	removeVersionLabelReturns struct {
+		result1 bool
+		result2 error
+	}
+	removeVersionLabelReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ResourceConfigIDStub        func() int
 	resourceConfigIDMutex       sync.RWMutex
 	resourceConfigIDArgsForCall []struct {
@@ -486,6 +542,71 @@ func (fake *FakeResource) APIPinnedVersionReturnsOnCall(i int, result1 atc.Versi
 	}{result1}
 }
 
+func (fake *FakeResource) AddVersionLabel(arg1 int, arg2 string, arg3 string) (bool, error) {
+	fake.addVersionLabelMutex.Lock()
+	ret, specificReturn := fake.addVersionLabelReturnsOnCall[len(fake.addVersionLabelArgsForCall)]
+	fake.addVersionLabelArgsForCall = append(fake.addVersionLabelArgsForCall, struct {
+		arg1 int
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AddVersionLabel", []interface{}{arg1, arg2, arg3})
+	fake.addVersionLabelMutex.Unlock()
+	if fake.AddVersionLabelStub != nil {
+		return fake.AddVersionLabelStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.addVersionLabelReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) AddVersionLabelCallCount() int {
+	fake.addVersionLabelMutex.RLock()
+	defer fake.addVersionLabelMutex.RUnlock()
+	return len(fake.addVersionLabelArgsForCall)
+}
+
+func (fake *FakeResource) AddVersionLabelCalls(stub func(int, string, string) (bool, error)) {
+	fake.addVersionLabelMutex.Lock()
+	defer fake.addVersionLabelMutex.Unlock()
+	fake.AddVersionLabelStub = stub
+}
+
+func (fake *FakeResource) AddVersionLabelArgsForCall(i int) (int, string, string) {
+	fake.addVersionLabelMutex.RLock()
+	defer fake.addVersionLabelMutex.RUnlock()
+	argsForCall := fake.addVersionLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeResource) AddVersionLabelReturns(result1 bool, result2 error) {
+	fake.addVersionLabelMutex.Lock()
+	defer fake.addVersionLabelMutex.Unlock()
+	fake.AddVersionLabelStub = nil
+	fake.addVersionLabelReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) AddVersionLabelReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.addVersionLabelMutex.Lock()
+	defer fake.addVersionLabelMutex.Unlock()
+	fake.AddVersionLabelStub = nil
+	if fake.addVersionLabelReturnsOnCall == nil {
+		fake.addVersionLabelReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.addVersionLabelReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResource) CheckError() error {
 	fake.checkErrorMutex.Lock()
 	ret, specificReturn := fake.checkErrorReturnsOnCall[len(fake.checkErrorArgsForCall)]
@@ -918,6 +1039,133 @@ func (fake *FakeResource) EnableVersionReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeResource) FilteredVersionIDs(arg1 atc.VersionFilter) ([]int, error) {
+	fake.filteredVersionIDsMutex.Lock()
+	ret, specificReturn := fake.filteredVersionIDsReturnsOnCall[len(fake.filteredVersionIDsArgsForCall)]
+	fake.filteredVersionIDsArgsForCall = append(fake.filteredVersionIDsArgsForCall, struct {
+		arg1 atc.VersionFilter
+	}{arg1})
+	fake.recordInvocation("FilteredVersionIDs", []interface{}{arg1})
+	fake.filteredVersionIDsMutex.Unlock()
+	if fake.FilteredVersionIDsStub != nil {
+		return fake.FilteredVersionIDsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.filteredVersionIDsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) FilteredVersionIDsCallCount() int {
+	fake.filteredVersionIDsMutex.RLock()
+	defer fake.filteredVersionIDsMutex.RUnlock()
+	return len(fake.filteredVersionIDsArgsForCall)
+}
+
+func (fake *FakeResource) FilteredVersionIDsCalls(stub func(atc.VersionFilter) ([]int, error)) {
+	fake.filteredVersionIDsMutex.Lock()
+	defer fake.filteredVersionIDsMutex.Unlock()
+	fake.FilteredVersionIDsStub = stub
+}
+
+func (fake *FakeResource) FilteredVersionIDsArgsForCall(i int) atc.VersionFilter {
+	fake.filteredVersionIDsMutex.RLock()
+	defer fake.filteredVersionIDsMutex.RUnlock()
+	argsForCall := fake.filteredVersionIDsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) FilteredVersionIDsReturns(result1 []int, result2 error) {
+	fake.filteredVersionIDsMutex.Lock()
+	defer fake.filteredVersionIDsMutex.Unlock()
+	fake.FilteredVersionIDsStub = nil
+	fake.filteredVersionIDsReturns = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) FilteredVersionIDsReturnsOnCall(i int, result1 []int, result2 error) {
+	fake.filteredVersionIDsMutex.Lock()
+	defer fake.filteredVersionIDsMutex.Unlock()
+	fake.FilteredVersionIDsStub = nil
+	if fake.filteredVersionIDsReturnsOnCall == nil {
+		fake.filteredVersionIDsReturnsOnCall = make(map[int]struct {
+			result1 []int
+			result2 error
+		})
+	}
+	fake.filteredVersionIDsReturnsOnCall[i] = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) FilteredVersions(arg1 atc.VersionFilter, arg2 int) ([]atc.ResourceVersion, error) {
+	fake.filteredVersionsMutex.Lock()
+	ret, specificReturn := fake.filteredVersionsReturnsOnCall[len(fake.filteredVersionsArgsForCall)]
+	fake.filteredVersionsArgsForCall = append(fake.filteredVersionsArgsForCall, struct {
+		arg1 atc.VersionFilter
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("FilteredVersions", []interface{}{arg1, arg2})
+	fake.filteredVersionsMutex.Unlock()
+	if fake.FilteredVersionsStub != nil {
+		return fake.FilteredVersionsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.filteredVersionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) FilteredVersionsCallCount() int {
+	fake.filteredVersionsMutex.RLock()
+	defer fake.filteredVersionsMutex.RUnlock()
+	return len(fake.filteredVersionsArgsForCall)
+}
+
+func (fake *FakeResource) FilteredVersionsCalls(stub func(atc.VersionFilter, int) ([]atc.ResourceVersion, error)) {
+	fake.filteredVersionsMutex.Lock()
+	defer fake.filteredVersionsMutex.Unlock()
+	fake.FilteredVersionsStub = stub
+}
+
+func (fake *FakeResource) FilteredVersionsArgsForCall(i int) (atc.VersionFilter, int) {
+	fake.filteredVersionsMutex.RLock()
+	defer fake.filteredVersionsMutex.RUnlock()
+	argsForCall := fake.filteredVersionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResource) FilteredVersionsReturns(result1 []atc.ResourceVersion, result2 error) {
+	fake.filteredVersionsMutex.Lock()
+	defer fake.filteredVersionsMutex.Unlock()
+	fake.FilteredVersionsStub = nil
+	fake.filteredVersionsReturns = struct {
+		result1 []atc.ResourceVersion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) FilteredVersionsReturnsOnCall(i int, result1 []atc.ResourceVersion, result2 error) {
+	fake.filteredVersionsMutex.Lock()
+	defer fake.filteredVersionsMutex.Unlock()
+	fake.FilteredVersionsStub = nil
+	if fake.filteredVersionsReturnsOnCall == nil {
+		fake.filteredVersionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.ResourceVersion
+			result2 error
+		})
+	}
+	fake.filteredVersionsReturnsOnCall[i] = struct {
+		result1 []atc.ResourceVersion
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResource) ID() int {
 	fake.iDMutex.Lock()
 	ret, specificReturn := fake.iDReturnsOnCall[len(fake.iDArgsForCall)]
@@ -1614,6 +1862,70 @@ func (fake *FakeResource) ReloadReturnsOnCall(i int, result1 bool, result2 error
 	}{result1, result2}
 }
 
+func (fake *FakeResource) RemoveVersionLabel(arg1 int, arg2 string) (bool, error) {
+	fake.removeVersionLabelMutex.Lock()
+	ret, specificReturn := fake.removeVersionLabelReturnsOnCall[len(fake.removeVersionLabelArgsForCall)]
+	fake.removeVersionLabelArgsForCall = append(fake.removeVersionLabelArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RemoveVersionLabel", []interface{}{arg1, arg2})
+	fake.removeVersionLabelMutex.Unlock()
+	if fake.RemoveVersionLabelStub != nil {
+		return fake.RemoveVersionLabelStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeVersionLabelReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) RemoveVersionLabelCallCount() int {
+	fake.removeVersionLabelMutex.RLock()
+	defer fake.removeVersionLabelMutex.RUnlock()
+	return len(fake.removeVersionLabelArgsForCall)
+}
+
+func (fake *FakeResource) RemoveVersionLabelCalls(stub func(int, string) (bool, error)) {
+	fake.removeVersionLabelMutex.Lock()
+	defer fake.removeVersionLabelMutex.Unlock()
+	fake.RemoveVersionLabelStub = stub
+}
+
+func (fake *FakeResource) RemoveVersionLabelArgsForCall(i int) (int, string) {
+	fake.removeVersionLabelMutex.RLock()
+	defer fake.removeVersionLabelMutex.RUnlock()
+	argsForCall := fake.removeVersionLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResource) RemoveVersionLabelReturns(result1 bool, result2 error) {
+	fake.removeVersionLabelMutex.Lock()
+	defer fake.removeVersionLabelMutex.Unlock()
+	fake.RemoveVersionLabelStub = nil
+	fake.removeVersionLabelReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) RemoveVersionLabelReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.removeVersionLabelMutex.Lock()
+	defer fake.removeVersionLabelMutex.Unlock()
+	fake.RemoveVersionLabelStub = nil
+	if fake.removeVersionLabelReturnsOnCall == nil {
+		fake.removeVersionLabelReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.removeVersionLabelReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResource) ResourceConfigID() int {
 	fake.resourceConfigIDMutex.Lock()
 	ret, specificReturn := fake.resourceConfigIDReturnsOnCall[len(fake.resourceConfigIDArgsForCall)]
@@ -2537,6 +2849,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.aPIPinnedVersionMutex.RLock()
 	defer fake.aPIPinnedVersionMutex.RUnlock()
+	fake.addVersionLabelMutex.RLock()
+	defer fake.addVersionLabelMutex.RUnlock()
 	fake.checkErrorMutex.RLock()
 	defer fake.checkErrorMutex.RUnlock()
 	fake.checkEveryMutex.RLock()
@@ -2553,6 +2867,10 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.disableVersionMutex.RUnlock()
 	fake.enableVersionMutex.RLock()
 	defer fake.enableVersionMutex.RUnlock()
+	fake.filteredVersionIDsMutex.RLock()
+	defer fake.filteredVersionIDsMutex.RUnlock()
+	fake.filteredVersionsMutex.RLock()
+	defer fake.filteredVersionsMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
 	fake.iconMutex.RLock()
@@ -2579,6 +2897,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.publicMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.removeVersionLabelMutex.RLock()
+	defer fake.removeVersionLabelMutex.RUnlock()
 	fake.resourceConfigIDMutex.RLock()
 	defer fake.resourceConfigIDMutex.RUnlock()
 	fake.resourceConfigScopeIDMutex.RLock()
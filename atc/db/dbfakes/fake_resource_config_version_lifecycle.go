@@ -0,0 +1,106 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeResourceConfigVersionLifecycle struct {
+	RemoveExpiredVersionsStub        func() (int, error)
+	removeExpiredVersionsMutex       sync.RWMutex
+	removeExpiredVersionsArgsForCall []struct {
+	}
+	removeExpiredVersionsReturns struct {
+		result1 int
+		result2 error
+	}
+	removeExpiredVersionsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) RemoveExpiredVersions() (int, error) {
+	fake.removeExpiredVersionsMutex.Lock()
+	ret, specificReturn := fake.removeExpiredVersionsReturnsOnCall[len(fake.removeExpiredVersionsArgsForCall)]
+	fake.removeExpiredVersionsArgsForCall = append(fake.removeExpiredVersionsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RemoveExpiredVersions", []interface{}{})
+	fake.removeExpiredVersionsMutex.Unlock()
+	if fake.RemoveExpiredVersionsStub != nil {
+		return fake.RemoveExpiredVersionsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeExpiredVersionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) RemoveExpiredVersionsCallCount() int {
+	fake.removeExpiredVersionsMutex.RLock()
+	defer fake.removeExpiredVersionsMutex.RUnlock()
+	return len(fake.removeExpiredVersionsArgsForCall)
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) RemoveExpiredVersionsCalls(stub func() (int, error)) {
+	fake.removeExpiredVersionsMutex.Lock()
+	defer fake.removeExpiredVersionsMutex.Unlock()
+	fake.RemoveExpiredVersionsStub = stub
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) RemoveExpiredVersionsReturns(result1 int, result2 error) {
+	fake.removeExpiredVersionsMutex.Lock()
+	defer fake.removeExpiredVersionsMutex.Unlock()
+	fake.RemoveExpiredVersionsStub = nil
+	fake.removeExpiredVersionsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) RemoveExpiredVersionsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.removeExpiredVersionsMutex.Lock()
+	defer fake.removeExpiredVersionsMutex.Unlock()
+	fake.RemoveExpiredVersionsStub = nil
+	if fake.removeExpiredVersionsReturnsOnCall == nil {
+		fake.removeExpiredVersionsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.removeExpiredVersionsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.removeExpiredVersionsMutex.RLock()
+	defer fake.removeExpiredVersionsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.ResourceConfigVersionLifecycle = new(FakeResourceConfigVersionLifecycle)
@@ -0,0 +1,120 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeServiceAccountTokenFactory struct {
+	UseTokenStub        func(string) (db.ServiceAccountAccess, bool, error)
+	useTokenMutex       sync.RWMutex
+	useTokenArgsForCall []struct {
+		arg1 string
+	}
+	useTokenReturns struct {
+		result1 db.ServiceAccountAccess
+		result2 bool
+		result3 error
+	}
+	useTokenReturnsOnCall map[int]struct {
+		result1 db.ServiceAccountAccess
+		result2 bool
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeServiceAccountTokenFactory) UseToken(arg1 string) (db.ServiceAccountAccess, bool, error) {
+	fake.useTokenMutex.Lock()
+	ret, specificReturn := fake.useTokenReturnsOnCall[len(fake.useTokenArgsForCall)]
+	fake.useTokenArgsForCall = append(fake.useTokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("UseToken", []interface{}{arg1})
+	fake.useTokenMutex.Unlock()
+	if fake.UseTokenStub != nil {
+		return fake.UseTokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.useTokenReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeServiceAccountTokenFactory) UseTokenCallCount() int {
+	fake.useTokenMutex.RLock()
+	defer fake.useTokenMutex.RUnlock()
+	return len(fake.useTokenArgsForCall)
+}
+
+func (fake *FakeServiceAccountTokenFactory) UseTokenCalls(stub func(string) (db.ServiceAccountAccess, bool, error)) {
+	fake.useTokenMutex.Lock()
+	defer fake.useTokenMutex.Unlock()
+	fake.UseTokenStub = stub
+}
+
+func (fake *FakeServiceAccountTokenFactory) UseTokenArgsForCall(i int) string {
+	fake.useTokenMutex.RLock()
+	defer fake.useTokenMutex.RUnlock()
+	argsForCall := fake.useTokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeServiceAccountTokenFactory) UseTokenReturns(result1 db.ServiceAccountAccess, result2 bool, result3 error) {
+	fake.useTokenMutex.Lock()
+	defer fake.useTokenMutex.Unlock()
+	fake.UseTokenStub = nil
+	fake.useTokenReturns = struct {
+		result1 db.ServiceAccountAccess
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceAccountTokenFactory) UseTokenReturnsOnCall(i int, result1 db.ServiceAccountAccess, result2 bool, result3 error) {
+	fake.useTokenMutex.Lock()
+	defer fake.useTokenMutex.Unlock()
+	fake.UseTokenStub = nil
+	if fake.useTokenReturnsOnCall == nil {
+		fake.useTokenReturnsOnCall = make(map[int]struct {
+			result1 db.ServiceAccountAccess
+			result2 bool
+			result3 error
+		})
+	}
+	fake.useTokenReturnsOnCall[i] = struct {
+		result1 db.ServiceAccountAccess
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceAccountTokenFactory) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.useTokenMutex.RLock()
+	defer fake.useTokenMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeServiceAccountTokenFactory) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.ServiceAccountTokenFactory = new(FakeServiceAccountTokenFactory)
@@ -86,10 +86,39 @@ type FakeTeam struct {
 		result1 db.Build
 		result2 error
 	}
-	CreateStartedBuildStub        func(atc.Plan) (db.Build, error)
+	CreateServiceAccountStub        func(string) (bool, error)
+	createServiceAccountMutex       sync.RWMutex
+	createServiceAccountArgsForCall []struct {
+		arg1 string
+	}
+	createServiceAccountReturns struct {
+		result1 bool
+		result2 error
+	}
+	createServiceAccountReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	CreateServiceAccountTokenStub        func(string, atc.ServiceAccountToken, string) (bool, error)
+	createServiceAccountTokenMutex       sync.RWMutex
+	createServiceAccountTokenArgsForCall []struct {
+		arg1 string
+		arg2 atc.ServiceAccountToken
+		arg3 string
+	}
+	createServiceAccountTokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	createServiceAccountTokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	CreateStartedBuildStub        func(atc.Plan, string) (db.Build, error)
 	createStartedBuildMutex       sync.RWMutex
 	createStartedBuildArgsForCall []struct {
 		arg1 atc.Plan
+		arg2 string
 	}
 	createStartedBuildReturns struct {
 		result1 db.Build
@@ -99,6 +128,16 @@ type FakeTeam struct {
 		result1 db.Build
 		result2 error
 	}
+	DefaultJobPriorityStub        func() int
+	defaultJobPriorityMutex       sync.RWMutex
+	defaultJobPriorityArgsForCall []struct {
+	}
+	defaultJobPriorityReturns struct {
+		result1 int
+	}
+	defaultJobPriorityReturnsOnCall map[int]struct {
+		result1 int
+	}
 	DeleteStub        func() error
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -109,6 +148,19 @@ type FakeTeam struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DestroyServiceAccountStub        func(string) (bool, error)
+	destroyServiceAccountMutex       sync.RWMutex
+	destroyServiceAccountArgsForCall []struct {
+		arg1 string
+	}
+	destroyServiceAccountReturns struct {
+		result1 bool
+		result2 error
+	}
+	destroyServiceAccountReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	FindCheckContainersStub        func(lager.Logger, string, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)
 	findCheckContainersMutex       sync.RWMutex
 	findCheckContainersArgsForCall []struct {
@@ -186,6 +238,21 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	FindWorkerArtifactStub        func(int) (db.WorkerArtifact, bool, error)
+	findWorkerArtifactMutex       sync.RWMutex
+	findWorkerArtifactArgsForCall []struct {
+		arg1 int
+	}
+	findWorkerArtifactReturns struct {
+		result1 db.WorkerArtifact
+		result2 bool
+		result3 error
+	}
+	findWorkerArtifactReturnsOnCall map[int]struct {
+		result1 db.WorkerArtifact
+		result2 bool
+		result3 error
+	}
 	FindWorkerForContainerStub        func(string) (db.Worker, bool, error)
 	findWorkerForContainerMutex       sync.RWMutex
 	findWorkerForContainerArgsForCall []struct {
@@ -253,6 +320,18 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	LocksStub        func() ([]atc.Lock, error)
+	locksMutex       sync.RWMutex
+	locksArgsForCall []struct {
+	}
+	locksReturns struct {
+		result1 []atc.Lock
+		result2 error
+	}
+	locksReturnsOnCall map[int]struct {
+		result1 []atc.Lock
+		result2 error
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -274,6 +353,18 @@ type FakeTeam struct {
 	orderPipelinesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	PendingBuildQueueStub        func() ([]db.Build, error)
+	pendingBuildQueueMutex       sync.RWMutex
+	pendingBuildQueueArgsForCall []struct {
+	}
+	pendingBuildQueueReturns struct {
+		result1 []db.Build
+		result2 error
+	}
+	pendingBuildQueueReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 error
+	}
 	PipelineStub        func(string) (db.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
@@ -328,6 +419,19 @@ type FakeTeam struct {
 		result1 []db.Pipeline
 		result2 error
 	}
+	ReleaseLockStub        func(string) (bool, error)
+	releaseLockMutex       sync.RWMutex
+	releaseLockArgsForCall []struct {
+		arg1 string
+	}
+	releaseLockReturns struct {
+		result1 bool
+		result2 error
+	}
+	releaseLockReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	RenameStub        func(string) error
 	renameMutex       sync.RWMutex
 	renameArgsForCall []struct {
@@ -339,6 +443,20 @@ type FakeTeam struct {
 	renameReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RevokeServiceAccountTokenStub        func(string, string) (bool, error)
+	revokeServiceAccountTokenMutex       sync.RWMutex
+	revokeServiceAccountTokenArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	revokeServiceAccountTokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	revokeServiceAccountTokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	SavePipelineStub        func(string, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)
 	savePipelineMutex       sync.RWMutex
 	savePipelineArgsForCall []struct {
@@ -371,6 +489,29 @@ type FakeTeam struct {
 		result1 db.Worker
 		result2 error
 	}
+	ServiceAccountsStub        func() ([]atc.ServiceAccount, error)
+	serviceAccountsMutex       sync.RWMutex
+	serviceAccountsArgsForCall []struct {
+	}
+	serviceAccountsReturns struct {
+		result1 []atc.ServiceAccount
+		result2 error
+	}
+	serviceAccountsReturnsOnCall map[int]struct {
+		result1 []atc.ServiceAccount
+		result2 error
+	}
+	UpdateDefaultJobPriorityStub        func(int) error
+	updateDefaultJobPriorityMutex       sync.RWMutex
+	updateDefaultJobPriorityArgsForCall []struct {
+		arg1 int
+	}
+	updateDefaultJobPriorityReturns struct {
+		result1 error
+	}
+	updateDefaultJobPriorityReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateProviderAuthStub        func(atc.TeamAuth) error
 	updateProviderAuthMutex       sync.RWMutex
 	updateProviderAuthArgsForCall []struct {
@@ -744,16 +885,145 @@ func (fake *FakeTeam) CreateOneOffBuildReturnsOnCall(i int, result1 db.Build, re
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) CreateStartedBuild(arg1 atc.Plan) (db.Build, error) {
+func (fake *FakeTeam) CreateServiceAccount(arg1 string) (bool, error) {
+	fake.createServiceAccountMutex.Lock()
+	ret, specificReturn := fake.createServiceAccountReturnsOnCall[len(fake.createServiceAccountArgsForCall)]
+	fake.createServiceAccountArgsForCall = append(fake.createServiceAccountArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("CreateServiceAccount", []interface{}{arg1})
+	fake.createServiceAccountMutex.Unlock()
+	if fake.CreateServiceAccountStub != nil {
+		return fake.CreateServiceAccountStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createServiceAccountReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) CreateServiceAccountCallCount() int {
+	fake.createServiceAccountMutex.RLock()
+	defer fake.createServiceAccountMutex.RUnlock()
+	return len(fake.createServiceAccountArgsForCall)
+}
+
+func (fake *FakeTeam) CreateServiceAccountCalls(stub func(string) (bool, error)) {
+	fake.createServiceAccountMutex.Lock()
+	defer fake.createServiceAccountMutex.Unlock()
+	fake.CreateServiceAccountStub = stub
+}
+
+func (fake *FakeTeam) CreateServiceAccountArgsForCall(i int) string {
+	fake.createServiceAccountMutex.RLock()
+	defer fake.createServiceAccountMutex.RUnlock()
+	argsForCall := fake.createServiceAccountArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) CreateServiceAccountReturns(result1 bool, result2 error) {
+	fake.createServiceAccountMutex.Lock()
+	defer fake.createServiceAccountMutex.Unlock()
+	fake.CreateServiceAccountStub = nil
+	fake.createServiceAccountReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateServiceAccountReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.createServiceAccountMutex.Lock()
+	defer fake.createServiceAccountMutex.Unlock()
+	fake.CreateServiceAccountStub = nil
+	if fake.createServiceAccountReturnsOnCall == nil {
+		fake.createServiceAccountReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.createServiceAccountReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateServiceAccountToken(arg1 string, arg2 atc.ServiceAccountToken, arg3 string) (bool, error) {
+	fake.createServiceAccountTokenMutex.Lock()
+	ret, specificReturn := fake.createServiceAccountTokenReturnsOnCall[len(fake.createServiceAccountTokenArgsForCall)]
+	fake.createServiceAccountTokenArgsForCall = append(fake.createServiceAccountTokenArgsForCall, struct {
+		arg1 string
+		arg2 atc.ServiceAccountToken
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateServiceAccountToken", []interface{}{arg1, arg2, arg3})
+	fake.createServiceAccountTokenMutex.Unlock()
+	if fake.CreateServiceAccountTokenStub != nil {
+		return fake.CreateServiceAccountTokenStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createServiceAccountTokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenCallCount() int {
+	fake.createServiceAccountTokenMutex.RLock()
+	defer fake.createServiceAccountTokenMutex.RUnlock()
+	return len(fake.createServiceAccountTokenArgsForCall)
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenCalls(stub func(string, atc.ServiceAccountToken, string) (bool, error)) {
+	fake.createServiceAccountTokenMutex.Lock()
+	defer fake.createServiceAccountTokenMutex.Unlock()
+	fake.CreateServiceAccountTokenStub = stub
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenArgsForCall(i int) (string, atc.ServiceAccountToken, string) {
+	fake.createServiceAccountTokenMutex.RLock()
+	defer fake.createServiceAccountTokenMutex.RUnlock()
+	argsForCall := fake.createServiceAccountTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenReturns(result1 bool, result2 error) {
+	fake.createServiceAccountTokenMutex.Lock()
+	defer fake.createServiceAccountTokenMutex.Unlock()
+	fake.CreateServiceAccountTokenStub = nil
+	fake.createServiceAccountTokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.createServiceAccountTokenMutex.Lock()
+	defer fake.createServiceAccountTokenMutex.Unlock()
+	fake.CreateServiceAccountTokenStub = nil
+	if fake.createServiceAccountTokenReturnsOnCall == nil {
+		fake.createServiceAccountTokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.createServiceAccountTokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateStartedBuild(arg1 atc.Plan, arg2 string) (db.Build, error) {
 	fake.createStartedBuildMutex.Lock()
 	ret, specificReturn := fake.createStartedBuildReturnsOnCall[len(fake.createStartedBuildArgsForCall)]
 	fake.createStartedBuildArgsForCall = append(fake.createStartedBuildArgsForCall, struct {
 		arg1 atc.Plan
-	}{arg1})
-	fake.recordInvocation("CreateStartedBuild", []interface{}{arg1})
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("CreateStartedBuild", []interface{}{arg1, arg2})
 	fake.createStartedBuildMutex.Unlock()
 	if fake.CreateStartedBuildStub != nil {
-		return fake.CreateStartedBuildStub(arg1)
+		return fake.CreateStartedBuildStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -768,17 +1038,17 @@ func (fake *FakeTeam) CreateStartedBuildCallCount() int {
 	return len(fake.createStartedBuildArgsForCall)
 }
 
-func (fake *FakeTeam) CreateStartedBuildCalls(stub func(atc.Plan) (db.Build, error)) {
+func (fake *FakeTeam) CreateStartedBuildCalls(stub func(atc.Plan, string) (db.Build, error)) {
 	fake.createStartedBuildMutex.Lock()
 	defer fake.createStartedBuildMutex.Unlock()
 	fake.CreateStartedBuildStub = stub
 }
 
-func (fake *FakeTeam) CreateStartedBuildArgsForCall(i int) atc.Plan {
+func (fake *FakeTeam) CreateStartedBuildArgsForCall(i int) (atc.Plan, string) {
 	fake.createStartedBuildMutex.RLock()
 	defer fake.createStartedBuildMutex.RUnlock()
 	argsForCall := fake.createStartedBuildArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeTeam) CreateStartedBuildReturns(result1 db.Build, result2 error) {
@@ -807,6 +1077,58 @@ func (fake *FakeTeam) CreateStartedBuildReturnsOnCall(i int, result1 db.Build, r
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) DefaultJobPriority() int {
+	fake.defaultJobPriorityMutex.Lock()
+	ret, specificReturn := fake.defaultJobPriorityReturnsOnCall[len(fake.defaultJobPriorityArgsForCall)]
+	fake.defaultJobPriorityArgsForCall = append(fake.defaultJobPriorityArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DefaultJobPriority", []interface{}{})
+	fake.defaultJobPriorityMutex.Unlock()
+	if fake.DefaultJobPriorityStub != nil {
+		return fake.DefaultJobPriorityStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.defaultJobPriorityReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) DefaultJobPriorityCallCount() int {
+	fake.defaultJobPriorityMutex.RLock()
+	defer fake.defaultJobPriorityMutex.RUnlock()
+	return len(fake.defaultJobPriorityArgsForCall)
+}
+
+func (fake *FakeTeam) DefaultJobPriorityCalls(stub func() int) {
+	fake.defaultJobPriorityMutex.Lock()
+	defer fake.defaultJobPriorityMutex.Unlock()
+	fake.DefaultJobPriorityStub = stub
+}
+
+func (fake *FakeTeam) DefaultJobPriorityReturns(result1 int) {
+	fake.defaultJobPriorityMutex.Lock()
+	defer fake.defaultJobPriorityMutex.Unlock()
+	fake.DefaultJobPriorityStub = nil
+	fake.defaultJobPriorityReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeTeam) DefaultJobPriorityReturnsOnCall(i int, result1 int) {
+	fake.defaultJobPriorityMutex.Lock()
+	defer fake.defaultJobPriorityMutex.Unlock()
+	fake.DefaultJobPriorityStub = nil
+	if fake.defaultJobPriorityReturnsOnCall == nil {
+		fake.defaultJobPriorityReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.defaultJobPriorityReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeTeam) Delete() error {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -859,6 +1181,69 @@ func (fake *FakeTeam) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) DestroyServiceAccount(arg1 string) (bool, error) {
+	fake.destroyServiceAccountMutex.Lock()
+	ret, specificReturn := fake.destroyServiceAccountReturnsOnCall[len(fake.destroyServiceAccountArgsForCall)]
+	fake.destroyServiceAccountArgsForCall = append(fake.destroyServiceAccountArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DestroyServiceAccount", []interface{}{arg1})
+	fake.destroyServiceAccountMutex.Unlock()
+	if fake.DestroyServiceAccountStub != nil {
+		return fake.DestroyServiceAccountStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.destroyServiceAccountReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DestroyServiceAccountCallCount() int {
+	fake.destroyServiceAccountMutex.RLock()
+	defer fake.destroyServiceAccountMutex.RUnlock()
+	return len(fake.destroyServiceAccountArgsForCall)
+}
+
+func (fake *FakeTeam) DestroyServiceAccountCalls(stub func(string) (bool, error)) {
+	fake.destroyServiceAccountMutex.Lock()
+	defer fake.destroyServiceAccountMutex.Unlock()
+	fake.DestroyServiceAccountStub = stub
+}
+
+func (fake *FakeTeam) DestroyServiceAccountArgsForCall(i int) string {
+	fake.destroyServiceAccountMutex.RLock()
+	defer fake.destroyServiceAccountMutex.RUnlock()
+	argsForCall := fake.destroyServiceAccountArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DestroyServiceAccountReturns(result1 bool, result2 error) {
+	fake.destroyServiceAccountMutex.Lock()
+	defer fake.destroyServiceAccountMutex.Unlock()
+	fake.DestroyServiceAccountStub = nil
+	fake.destroyServiceAccountReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DestroyServiceAccountReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.destroyServiceAccountMutex.Lock()
+	defer fake.destroyServiceAccountMutex.Unlock()
+	fake.DestroyServiceAccountStub = nil
+	if fake.destroyServiceAccountReturnsOnCall == nil {
+		fake.destroyServiceAccountReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.destroyServiceAccountReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) FindCheckContainers(arg1 lager.Logger, arg2 string, arg3 string, arg4 creds.Secrets, arg5 creds.VarSourcePool) ([]db.Container, map[int]time.Time, error) {
 	fake.findCheckContainersMutex.Lock()
 	ret, specificReturn := fake.findCheckContainersReturnsOnCall[len(fake.findCheckContainersArgsForCall)]
@@ -1190,6 +1575,72 @@ func (fake *FakeTeam) FindVolumeForWorkerArtifactReturnsOnCall(i int, result1 db
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) FindWorkerArtifact(arg1 int) (db.WorkerArtifact, bool, error) {
+	fake.findWorkerArtifactMutex.Lock()
+	ret, specificReturn := fake.findWorkerArtifactReturnsOnCall[len(fake.findWorkerArtifactArgsForCall)]
+	fake.findWorkerArtifactArgsForCall = append(fake.findWorkerArtifactArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("FindWorkerArtifact", []interface{}{arg1})
+	fake.findWorkerArtifactMutex.Unlock()
+	if fake.FindWorkerArtifactStub != nil {
+		return fake.FindWorkerArtifactStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.findWorkerArtifactReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) FindWorkerArtifactCallCount() int {
+	fake.findWorkerArtifactMutex.RLock()
+	defer fake.findWorkerArtifactMutex.RUnlock()
+	return len(fake.findWorkerArtifactArgsForCall)
+}
+
+func (fake *FakeTeam) FindWorkerArtifactCalls(stub func(int) (db.WorkerArtifact, bool, error)) {
+	fake.findWorkerArtifactMutex.Lock()
+	defer fake.findWorkerArtifactMutex.Unlock()
+	fake.FindWorkerArtifactStub = stub
+}
+
+func (fake *FakeTeam) FindWorkerArtifactArgsForCall(i int) int {
+	fake.findWorkerArtifactMutex.RLock()
+	defer fake.findWorkerArtifactMutex.RUnlock()
+	argsForCall := fake.findWorkerArtifactArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) FindWorkerArtifactReturns(result1 db.WorkerArtifact, result2 bool, result3 error) {
+	fake.findWorkerArtifactMutex.Lock()
+	defer fake.findWorkerArtifactMutex.Unlock()
+	fake.FindWorkerArtifactStub = nil
+	fake.findWorkerArtifactReturns = struct {
+		result1 db.WorkerArtifact
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) FindWorkerArtifactReturnsOnCall(i int, result1 db.WorkerArtifact, result2 bool, result3 error) {
+	fake.findWorkerArtifactMutex.Lock()
+	defer fake.findWorkerArtifactMutex.Unlock()
+	fake.FindWorkerArtifactStub = nil
+	if fake.findWorkerArtifactReturnsOnCall == nil {
+		fake.findWorkerArtifactReturnsOnCall = make(map[int]struct {
+			result1 db.WorkerArtifact
+			result2 bool
+			result3 error
+		})
+	}
+	fake.findWorkerArtifactReturnsOnCall[i] = struct {
+		result1 db.WorkerArtifact
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) FindWorkerForContainer(arg1 string) (db.Worker, bool, error) {
 	fake.findWorkerForContainerMutex.Lock()
 	ret, specificReturn := fake.findWorkerForContainerReturnsOnCall[len(fake.findWorkerForContainerArgsForCall)]
@@ -1501,6 +1952,61 @@ func (fake *FakeTeam) IsContainerWithinTeamReturnsOnCall(i int, result1 bool, re
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) Locks() ([]atc.Lock, error) {
+	fake.locksMutex.Lock()
+	ret, specificReturn := fake.locksReturnsOnCall[len(fake.locksArgsForCall)]
+	fake.locksArgsForCall = append(fake.locksArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Locks", []interface{}{})
+	fake.locksMutex.Unlock()
+	if fake.LocksStub != nil {
+		return fake.LocksStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.locksReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) LocksCallCount() int {
+	fake.locksMutex.RLock()
+	defer fake.locksMutex.RUnlock()
+	return len(fake.locksArgsForCall)
+}
+
+func (fake *FakeTeam) LocksCalls(stub func() ([]atc.Lock, error)) {
+	fake.locksMutex.Lock()
+	defer fake.locksMutex.Unlock()
+	fake.LocksStub = stub
+}
+
+func (fake *FakeTeam) LocksReturns(result1 []atc.Lock, result2 error) {
+	fake.locksMutex.Lock()
+	defer fake.locksMutex.Unlock()
+	fake.LocksStub = nil
+	fake.locksReturns = struct {
+		result1 []atc.Lock
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) LocksReturnsOnCall(i int, result1 []atc.Lock, result2 error) {
+	fake.locksMutex.Lock()
+	defer fake.locksMutex.Unlock()
+	fake.LocksStub = nil
+	if fake.locksReturnsOnCall == nil {
+		fake.locksReturnsOnCall = make(map[int]struct {
+			result1 []atc.Lock
+			result2 error
+		})
+	}
+	fake.locksReturnsOnCall[i] = struct {
+		result1 []atc.Lock
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1618,6 +2124,61 @@ func (fake *FakeTeam) OrderPipelinesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) PendingBuildQueue() ([]db.Build, error) {
+	fake.pendingBuildQueueMutex.Lock()
+	ret, specificReturn := fake.pendingBuildQueueReturnsOnCall[len(fake.pendingBuildQueueArgsForCall)]
+	fake.pendingBuildQueueArgsForCall = append(fake.pendingBuildQueueArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PendingBuildQueue", []interface{}{})
+	fake.pendingBuildQueueMutex.Unlock()
+	if fake.PendingBuildQueueStub != nil {
+		return fake.PendingBuildQueueStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pendingBuildQueueReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) PendingBuildQueueCallCount() int {
+	fake.pendingBuildQueueMutex.RLock()
+	defer fake.pendingBuildQueueMutex.RUnlock()
+	return len(fake.pendingBuildQueueArgsForCall)
+}
+
+func (fake *FakeTeam) PendingBuildQueueCalls(stub func() ([]db.Build, error)) {
+	fake.pendingBuildQueueMutex.Lock()
+	defer fake.pendingBuildQueueMutex.Unlock()
+	fake.PendingBuildQueueStub = stub
+}
+
+func (fake *FakeTeam) PendingBuildQueueReturns(result1 []db.Build, result2 error) {
+	fake.pendingBuildQueueMutex.Lock()
+	defer fake.pendingBuildQueueMutex.Unlock()
+	fake.PendingBuildQueueStub = nil
+	fake.pendingBuildQueueReturns = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) PendingBuildQueueReturnsOnCall(i int, result1 []db.Build, result2 error) {
+	fake.pendingBuildQueueMutex.Lock()
+	defer fake.pendingBuildQueueMutex.Unlock()
+	fake.PendingBuildQueueStub = nil
+	if fake.pendingBuildQueueReturnsOnCall == nil {
+		fake.pendingBuildQueueReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 error
+		})
+	}
+	fake.pendingBuildQueueReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Pipeline(arg1 string) (db.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
@@ -1860,6 +2421,69 @@ func (fake *FakeTeam) PublicPipelinesReturnsOnCall(i int, result1 []db.Pipeline,
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) ReleaseLock(arg1 string) (bool, error) {
+	fake.releaseLockMutex.Lock()
+	ret, specificReturn := fake.releaseLockReturnsOnCall[len(fake.releaseLockArgsForCall)]
+	fake.releaseLockArgsForCall = append(fake.releaseLockArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ReleaseLock", []interface{}{arg1})
+	fake.releaseLockMutex.Unlock()
+	if fake.ReleaseLockStub != nil {
+		return fake.ReleaseLockStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.releaseLockReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ReleaseLockCallCount() int {
+	fake.releaseLockMutex.RLock()
+	defer fake.releaseLockMutex.RUnlock()
+	return len(fake.releaseLockArgsForCall)
+}
+
+func (fake *FakeTeam) ReleaseLockCalls(stub func(string) (bool, error)) {
+	fake.releaseLockMutex.Lock()
+	defer fake.releaseLockMutex.Unlock()
+	fake.ReleaseLockStub = stub
+}
+
+func (fake *FakeTeam) ReleaseLockArgsForCall(i int) string {
+	fake.releaseLockMutex.RLock()
+	defer fake.releaseLockMutex.RUnlock()
+	argsForCall := fake.releaseLockArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) ReleaseLockReturns(result1 bool, result2 error) {
+	fake.releaseLockMutex.Lock()
+	defer fake.releaseLockMutex.Unlock()
+	fake.ReleaseLockStub = nil
+	fake.releaseLockReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ReleaseLockReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.releaseLockMutex.Lock()
+	defer fake.releaseLockMutex.Unlock()
+	fake.ReleaseLockStub = nil
+	if fake.releaseLockReturnsOnCall == nil {
+		fake.releaseLockReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.releaseLockReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Rename(arg1 string) error {
 	fake.renameMutex.Lock()
 	ret, specificReturn := fake.renameReturnsOnCall[len(fake.renameArgsForCall)]
@@ -1920,6 +2544,70 @@ func (fake *FakeTeam) RenameReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) RevokeServiceAccountToken(arg1 string, arg2 string) (bool, error) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	ret, specificReturn := fake.revokeServiceAccountTokenReturnsOnCall[len(fake.revokeServiceAccountTokenArgsForCall)]
+	fake.revokeServiceAccountTokenArgsForCall = append(fake.revokeServiceAccountTokenArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RevokeServiceAccountToken", []interface{}{arg1, arg2})
+	fake.revokeServiceAccountTokenMutex.Unlock()
+	if fake.RevokeServiceAccountTokenStub != nil {
+		return fake.RevokeServiceAccountTokenStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.revokeServiceAccountTokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenCallCount() int {
+	fake.revokeServiceAccountTokenMutex.RLock()
+	defer fake.revokeServiceAccountTokenMutex.RUnlock()
+	return len(fake.revokeServiceAccountTokenArgsForCall)
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenCalls(stub func(string, string) (bool, error)) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	defer fake.revokeServiceAccountTokenMutex.Unlock()
+	fake.RevokeServiceAccountTokenStub = stub
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenArgsForCall(i int) (string, string) {
+	fake.revokeServiceAccountTokenMutex.RLock()
+	defer fake.revokeServiceAccountTokenMutex.RUnlock()
+	argsForCall := fake.revokeServiceAccountTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenReturns(result1 bool, result2 error) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	defer fake.revokeServiceAccountTokenMutex.Unlock()
+	fake.RevokeServiceAccountTokenStub = nil
+	fake.revokeServiceAccountTokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	defer fake.revokeServiceAccountTokenMutex.Unlock()
+	fake.RevokeServiceAccountTokenStub = nil
+	if fake.revokeServiceAccountTokenReturnsOnCall == nil {
+		fake.revokeServiceAccountTokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.revokeServiceAccountTokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) SavePipeline(arg1 string, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool) (db.Pipeline, bool, error) {
 	fake.savePipelineMutex.Lock()
 	ret, specificReturn := fake.savePipelineReturnsOnCall[len(fake.savePipelineArgsForCall)]
@@ -2053,6 +2741,121 @@ func (fake *FakeTeam) SaveWorkerReturnsOnCall(i int, result1 db.Worker, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) ServiceAccounts() ([]atc.ServiceAccount, error) {
+	fake.serviceAccountsMutex.Lock()
+	ret, specificReturn := fake.serviceAccountsReturnsOnCall[len(fake.serviceAccountsArgsForCall)]
+	fake.serviceAccountsArgsForCall = append(fake.serviceAccountsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ServiceAccounts", []interface{}{})
+	fake.serviceAccountsMutex.Unlock()
+	if fake.ServiceAccountsStub != nil {
+		return fake.ServiceAccountsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.serviceAccountsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ServiceAccountsCallCount() int {
+	fake.serviceAccountsMutex.RLock()
+	defer fake.serviceAccountsMutex.RUnlock()
+	return len(fake.serviceAccountsArgsForCall)
+}
+
+func (fake *FakeTeam) ServiceAccountsCalls(stub func() ([]atc.ServiceAccount, error)) {
+	fake.serviceAccountsMutex.Lock()
+	defer fake.serviceAccountsMutex.Unlock()
+	fake.ServiceAccountsStub = stub
+}
+
+func (fake *FakeTeam) ServiceAccountsReturns(result1 []atc.ServiceAccount, result2 error) {
+	fake.serviceAccountsMutex.Lock()
+	defer fake.serviceAccountsMutex.Unlock()
+	fake.ServiceAccountsStub = nil
+	fake.serviceAccountsReturns = struct {
+		result1 []atc.ServiceAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ServiceAccountsReturnsOnCall(i int, result1 []atc.ServiceAccount, result2 error) {
+	fake.serviceAccountsMutex.Lock()
+	defer fake.serviceAccountsMutex.Unlock()
+	fake.ServiceAccountsStub = nil
+	if fake.serviceAccountsReturnsOnCall == nil {
+		fake.serviceAccountsReturnsOnCall = make(map[int]struct {
+			result1 []atc.ServiceAccount
+			result2 error
+		})
+	}
+	fake.serviceAccountsReturnsOnCall[i] = struct {
+		result1 []atc.ServiceAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) UpdateDefaultJobPriority(arg1 int) error {
+	fake.updateDefaultJobPriorityMutex.Lock()
+	ret, specificReturn := fake.updateDefaultJobPriorityReturnsOnCall[len(fake.updateDefaultJobPriorityArgsForCall)]
+	fake.updateDefaultJobPriorityArgsForCall = append(fake.updateDefaultJobPriorityArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("UpdateDefaultJobPriority", []interface{}{arg1})
+	fake.updateDefaultJobPriorityMutex.Unlock()
+	if fake.UpdateDefaultJobPriorityStub != nil {
+		return fake.UpdateDefaultJobPriorityStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateDefaultJobPriorityReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateDefaultJobPriorityCallCount() int {
+	fake.updateDefaultJobPriorityMutex.RLock()
+	defer fake.updateDefaultJobPriorityMutex.RUnlock()
+	return len(fake.updateDefaultJobPriorityArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateDefaultJobPriorityCalls(stub func(int) error) {
+	fake.updateDefaultJobPriorityMutex.Lock()
+	defer fake.updateDefaultJobPriorityMutex.Unlock()
+	fake.UpdateDefaultJobPriorityStub = stub
+}
+
+func (fake *FakeTeam) UpdateDefaultJobPriorityArgsForCall(i int) int {
+	fake.updateDefaultJobPriorityMutex.RLock()
+	defer fake.updateDefaultJobPriorityMutex.RUnlock()
+	argsForCall := fake.updateDefaultJobPriorityArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateDefaultJobPriorityReturns(result1 error) {
+	fake.updateDefaultJobPriorityMutex.Lock()
+	defer fake.updateDefaultJobPriorityMutex.Unlock()
+	fake.UpdateDefaultJobPriorityStub = nil
+	fake.updateDefaultJobPriorityReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateDefaultJobPriorityReturnsOnCall(i int, result1 error) {
+	fake.updateDefaultJobPriorityMutex.Lock()
+	defer fake.updateDefaultJobPriorityMutex.Unlock()
+	fake.UpdateDefaultJobPriorityStub = nil
+	if fake.updateDefaultJobPriorityReturnsOnCall == nil {
+		fake.updateDefaultJobPriorityReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateDefaultJobPriorityReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) UpdateProviderAuth(arg1 atc.TeamAuth) error {
 	fake.updateProviderAuthMutex.Lock()
 	ret, specificReturn := fake.updateProviderAuthReturnsOnCall[len(fake.updateProviderAuthArgsForCall)]
@@ -2183,10 +2986,18 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.containersMutex.RUnlock()
 	fake.createOneOffBuildMutex.RLock()
 	defer fake.createOneOffBuildMutex.RUnlock()
+	fake.createServiceAccountMutex.RLock()
+	defer fake.createServiceAccountMutex.RUnlock()
+	fake.createServiceAccountTokenMutex.RLock()
+	defer fake.createServiceAccountTokenMutex.RUnlock()
 	fake.createStartedBuildMutex.RLock()
 	defer fake.createStartedBuildMutex.RUnlock()
+	fake.defaultJobPriorityMutex.RLock()
+	defer fake.defaultJobPriorityMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.destroyServiceAccountMutex.RLock()
+	defer fake.destroyServiceAccountMutex.RUnlock()
 	fake.findCheckContainersMutex.RLock()
 	defer fake.findCheckContainersMutex.RUnlock()
 	fake.findContainerByHandleMutex.RLock()
@@ -2197,6 +3008,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.findCreatedContainerByHandleMutex.RUnlock()
 	fake.findVolumeForWorkerArtifactMutex.RLock()
 	defer fake.findVolumeForWorkerArtifactMutex.RUnlock()
+	fake.findWorkerArtifactMutex.RLock()
+	defer fake.findWorkerArtifactMutex.RUnlock()
 	fake.findWorkerForContainerMutex.RLock()
 	defer fake.findWorkerForContainerMutex.RUnlock()
 	fake.findWorkerForVolumeMutex.RLock()
@@ -2207,10 +3020,14 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.isCheckContainerMutex.RUnlock()
 	fake.isContainerWithinTeamMutex.RLock()
 	defer fake.isContainerWithinTeamMutex.RUnlock()
+	fake.locksMutex.RLock()
+	defer fake.locksMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.orderPipelinesMutex.RLock()
 	defer fake.orderPipelinesMutex.RUnlock()
+	fake.pendingBuildQueueMutex.RLock()
+	defer fake.pendingBuildQueueMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	fake.pipelinesMutex.RLock()
@@ -2219,12 +3036,20 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.privateAndPublicBuildsMutex.RUnlock()
 	fake.publicPipelinesMutex.RLock()
 	defer fake.publicPipelinesMutex.RUnlock()
+	fake.releaseLockMutex.RLock()
+	defer fake.releaseLockMutex.RUnlock()
 	fake.renameMutex.RLock()
 	defer fake.renameMutex.RUnlock()
+	fake.revokeServiceAccountTokenMutex.RLock()
+	defer fake.revokeServiceAccountTokenMutex.RUnlock()
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.serviceAccountsMutex.RLock()
+	defer fake.serviceAccountsMutex.RUnlock()
+	fake.updateDefaultJobPriorityMutex.RLock()
+	defer fake.updateDefaultJobPriorityMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
 	fake.workersMutex.RLock()
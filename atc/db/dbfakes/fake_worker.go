@@ -96,6 +96,16 @@ type FakeWorker struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	EnforcesNetworkRulesStub        func() bool
+	enforcesNetworkRulesMutex       sync.RWMutex
+	enforcesNetworkRulesArgsForCall []struct {
+	}
+	enforcesNetworkRulesReturns struct {
+		result1 bool
+	}
+	enforcesNetworkRulesReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	EphemeralStub        func() bool
 	ephemeralMutex       sync.RWMutex
 	ephemeralArgsForCall []struct {
@@ -762,6 +772,58 @@ func (fake *FakeWorker) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) EnforcesNetworkRules() bool {
+	fake.enforcesNetworkRulesMutex.Lock()
+	ret, specificReturn := fake.enforcesNetworkRulesReturnsOnCall[len(fake.enforcesNetworkRulesArgsForCall)]
+	fake.enforcesNetworkRulesArgsForCall = append(fake.enforcesNetworkRulesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("EnforcesNetworkRules", []interface{}{})
+	fake.enforcesNetworkRulesMutex.Unlock()
+	if fake.EnforcesNetworkRulesStub != nil {
+		return fake.EnforcesNetworkRulesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.enforcesNetworkRulesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) EnforcesNetworkRulesCallCount() int {
+	fake.enforcesNetworkRulesMutex.RLock()
+	defer fake.enforcesNetworkRulesMutex.RUnlock()
+	return len(fake.enforcesNetworkRulesArgsForCall)
+}
+
+func (fake *FakeWorker) EnforcesNetworkRulesCalls(stub func() bool) {
+	fake.enforcesNetworkRulesMutex.Lock()
+	defer fake.enforcesNetworkRulesMutex.Unlock()
+	fake.EnforcesNetworkRulesStub = stub
+}
+
+func (fake *FakeWorker) EnforcesNetworkRulesReturns(result1 bool) {
+	fake.enforcesNetworkRulesMutex.Lock()
+	defer fake.enforcesNetworkRulesMutex.Unlock()
+	fake.EnforcesNetworkRulesStub = nil
+	fake.enforcesNetworkRulesReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorker) EnforcesNetworkRulesReturnsOnCall(i int, result1 bool) {
+	fake.enforcesNetworkRulesMutex.Lock()
+	defer fake.enforcesNetworkRulesMutex.Unlock()
+	fake.EnforcesNetworkRulesStub = nil
+	if fake.enforcesNetworkRulesReturnsOnCall == nil {
+		fake.enforcesNetworkRulesReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.enforcesNetworkRulesReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeWorker) Ephemeral() bool {
 	fake.ephemeralMutex.Lock()
 	ret, specificReturn := fake.ephemeralReturnsOnCall[len(fake.ephemeralArgsForCall)]
@@ -1948,6 +2010,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.decreaseActiveTasksMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.enforcesNetworkRulesMutex.RLock()
+	defer fake.enforcesNetworkRulesMutex.RUnlock()
 	fake.ephemeralMutex.RLock()
 	defer fake.ephemeralMutex.RUnlock()
 	fake.expiresAtMutex.RLock()
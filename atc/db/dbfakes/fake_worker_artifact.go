@@ -5,6 +5,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -29,6 +30,16 @@ type FakeWorkerArtifact struct {
 	createdAtReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	GenerationStub        func() int
+	generationMutex       sync.RWMutex
+	generationArgsForCall []struct {
+	}
+	generationReturns struct {
+		result1 int
+	}
+	generationReturnsOnCall map[int]struct {
+		result1 int
+	}
 	IDStub        func() int
 	iDMutex       sync.RWMutex
 	iDArgsForCall []struct {
@@ -39,6 +50,20 @@ type FakeWorkerArtifact struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	ManifestStub        func() (atc.ArtifactManifest, bool, error)
+	manifestMutex       sync.RWMutex
+	manifestArgsForCall []struct {
+	}
+	manifestReturns struct {
+		result1 atc.ArtifactManifest
+		result2 bool
+		result3 error
+	}
+	manifestReturnsOnCall map[int]struct {
+		result1 atc.ArtifactManifest
+		result2 bool
+		result3 error
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -49,6 +74,18 @@ type FakeWorkerArtifact struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	SetManifestStub        func(atc.ArtifactManifest, int) error
+	setManifestMutex       sync.RWMutex
+	setManifestArgsForCall []struct {
+		arg1 atc.ArtifactManifest
+		arg2 int
+	}
+	setManifestReturns struct {
+		result1 error
+	}
+	setManifestReturnsOnCall map[int]struct {
+		result1 error
+	}
 	VolumeStub        func(int) (db.CreatedVolume, bool, error)
 	volumeMutex       sync.RWMutex
 	volumeArgsForCall []struct {
@@ -172,6 +209,58 @@ func (fake *FakeWorkerArtifact) CreatedAtReturnsOnCall(i int, result1 time.Time)
 	}{result1}
 }
 
+func (fake *FakeWorkerArtifact) Generation() int {
+	fake.generationMutex.Lock()
+	ret, specificReturn := fake.generationReturnsOnCall[len(fake.generationArgsForCall)]
+	fake.generationArgsForCall = append(fake.generationArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Generation", []interface{}{})
+	fake.generationMutex.Unlock()
+	if fake.GenerationStub != nil {
+		return fake.GenerationStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.generationReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorkerArtifact) GenerationCallCount() int {
+	fake.generationMutex.RLock()
+	defer fake.generationMutex.RUnlock()
+	return len(fake.generationArgsForCall)
+}
+
+func (fake *FakeWorkerArtifact) GenerationCalls(stub func() int) {
+	fake.generationMutex.Lock()
+	defer fake.generationMutex.Unlock()
+	fake.GenerationStub = stub
+}
+
+func (fake *FakeWorkerArtifact) GenerationReturns(result1 int) {
+	fake.generationMutex.Lock()
+	defer fake.generationMutex.Unlock()
+	fake.GenerationStub = nil
+	fake.generationReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeWorkerArtifact) GenerationReturnsOnCall(i int, result1 int) {
+	fake.generationMutex.Lock()
+	defer fake.generationMutex.Unlock()
+	fake.GenerationStub = nil
+	if fake.generationReturnsOnCall == nil {
+		fake.generationReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.generationReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeWorkerArtifact) ID() int {
 	fake.iDMutex.Lock()
 	ret, specificReturn := fake.iDReturnsOnCall[len(fake.iDArgsForCall)]
@@ -224,6 +313,64 @@ func (fake *FakeWorkerArtifact) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeWorkerArtifact) Manifest() (atc.ArtifactManifest, bool, error) {
+	fake.manifestMutex.Lock()
+	ret, specificReturn := fake.manifestReturnsOnCall[len(fake.manifestArgsForCall)]
+	fake.manifestArgsForCall = append(fake.manifestArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Manifest", []interface{}{})
+	fake.manifestMutex.Unlock()
+	if fake.ManifestStub != nil {
+		return fake.ManifestStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.manifestReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWorkerArtifact) ManifestCallCount() int {
+	fake.manifestMutex.RLock()
+	defer fake.manifestMutex.RUnlock()
+	return len(fake.manifestArgsForCall)
+}
+
+func (fake *FakeWorkerArtifact) ManifestCalls(stub func() (atc.ArtifactManifest, bool, error)) {
+	fake.manifestMutex.Lock()
+	defer fake.manifestMutex.Unlock()
+	fake.ManifestStub = stub
+}
+
+func (fake *FakeWorkerArtifact) ManifestReturns(result1 atc.ArtifactManifest, result2 bool, result3 error) {
+	fake.manifestMutex.Lock()
+	defer fake.manifestMutex.Unlock()
+	fake.ManifestStub = nil
+	fake.manifestReturns = struct {
+		result1 atc.ArtifactManifest
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorkerArtifact) ManifestReturnsOnCall(i int, result1 atc.ArtifactManifest, result2 bool, result3 error) {
+	fake.manifestMutex.Lock()
+	defer fake.manifestMutex.Unlock()
+	fake.ManifestStub = nil
+	if fake.manifestReturnsOnCall == nil {
+		fake.manifestReturnsOnCall = make(map[int]struct {
+			result1 atc.ArtifactManifest
+			result2 bool
+			result3 error
+		})
+	}
+	fake.manifestReturnsOnCall[i] = struct {
+		result1 atc.ArtifactManifest
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeWorkerArtifact) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -276,6 +423,67 @@ func (fake *FakeWorkerArtifact) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeWorkerArtifact) SetManifest(arg1 atc.ArtifactManifest, arg2 int) error {
+	fake.setManifestMutex.Lock()
+	ret, specificReturn := fake.setManifestReturnsOnCall[len(fake.setManifestArgsForCall)]
+	fake.setManifestArgsForCall = append(fake.setManifestArgsForCall, struct {
+		arg1 atc.ArtifactManifest
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("SetManifest", []interface{}{arg1, arg2})
+	fake.setManifestMutex.Unlock()
+	if fake.SetManifestStub != nil {
+		return fake.SetManifestStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setManifestReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorkerArtifact) SetManifestCallCount() int {
+	fake.setManifestMutex.RLock()
+	defer fake.setManifestMutex.RUnlock()
+	return len(fake.setManifestArgsForCall)
+}
+
+func (fake *FakeWorkerArtifact) SetManifestCalls(stub func(atc.ArtifactManifest, int) error) {
+	fake.setManifestMutex.Lock()
+	defer fake.setManifestMutex.Unlock()
+	fake.SetManifestStub = stub
+}
+
+func (fake *FakeWorkerArtifact) SetManifestArgsForCall(i int) (atc.ArtifactManifest, int) {
+	fake.setManifestMutex.RLock()
+	defer fake.setManifestMutex.RUnlock()
+	argsForCall := fake.setManifestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWorkerArtifact) SetManifestReturns(result1 error) {
+	fake.setManifestMutex.Lock()
+	defer fake.setManifestMutex.Unlock()
+	fake.SetManifestStub = nil
+	fake.setManifestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorkerArtifact) SetManifestReturnsOnCall(i int, result1 error) {
+	fake.setManifestMutex.Lock()
+	defer fake.setManifestMutex.Unlock()
+	fake.SetManifestStub = nil
+	if fake.setManifestReturnsOnCall == nil {
+		fake.setManifestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setManifestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorkerArtifact) Volume(arg1 int) (db.CreatedVolume, bool, error) {
 	fake.volumeMutex.Lock()
 	ret, specificReturn := fake.volumeReturnsOnCall[len(fake.volumeArgsForCall)]
@@ -349,10 +557,16 @@ func (fake *FakeWorkerArtifact) Invocations() map[string][][]interface{} {
 	defer fake.buildIDMutex.RUnlock()
 	fake.createdAtMutex.RLock()
 	defer fake.createdAtMutex.RUnlock()
+	fake.generationMutex.RLock()
+	defer fake.generationMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.manifestMutex.RLock()
+	defer fake.manifestMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.setManifestMutex.RLock()
+	defer fake.setManifestMutex.RUnlock()
 	fake.volumeMutex.RLock()
 	defer fake.volumeMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
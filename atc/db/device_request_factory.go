@@ -0,0 +1,213 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// DeviceRequest tracks an OAuth 2.0 device authorization grant. The device
+// polls with the DeviceCode while the user approves the UserCode in a
+// browser, at which point the user's token is attached to the request.
+type DeviceRequest struct {
+	DeviceCode string
+	UserCode   string
+	Expiry     time.Time
+
+	// How long the device has to wait between polls.
+	Interval time.Duration
+
+	TokenType   string
+	AccessToken string
+	TokenExpiry time.Time
+}
+
+func (request DeviceRequest) Approved() bool {
+	return request.AccessToken != ""
+}
+
+// DeviceRequestSlowDownInterval is how much a device's polling interval is
+// raised by each time it polls too early, as required by RFC 8628.
+const DeviceRequestSlowDownInterval = 5 * time.Second
+
+//go:generate counterfeiter . DeviceRequestFactory
+
+type DeviceRequestFactory interface {
+	CreateDeviceRequest(request DeviceRequest) error
+	FindDeviceRequestByUserCode(userCode string) (DeviceRequest, bool, error)
+	ApproveDeviceRequest(userCode string, tokenType string, accessToken string, tokenExpiry time.Time) (bool, error)
+	PollDeviceRequest(deviceCode string) (DeviceRequest, bool, bool, error)
+	DeleteDeviceRequest(deviceCode string) error
+}
+
+type deviceRequestFactory struct {
+	conn Conn
+}
+
+func NewDeviceRequestFactory(conn Conn) DeviceRequestFactory {
+	return &deviceRequestFactory{
+		conn: conn,
+	}
+}
+
+// CreateDeviceRequest also deletes any expired requests, so that abandoned
+// logins do not accumulate.
+func (factory *deviceRequestFactory) CreateDeviceRequest(request DeviceRequest) error {
+	tx, err := factory.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = tx.Exec(`DELETE FROM device_requests WHERE expires_at < now()`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO device_requests (device_code, user_code, expires_at, poll_interval)
+		VALUES ($1, $2, $3, $4)
+	`, request.DeviceCode, request.UserCode, request.Expiry, int(request.Interval.Seconds()))
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (factory *deviceRequestFactory) FindDeviceRequestByUserCode(userCode string) (DeviceRequest, bool, error) {
+	return factory.findDeviceRequest(factory.conn, "user_code", userCode, "")
+}
+
+// ApproveDeviceRequest returns false if there is no pending request for the
+// user code. The access token is encrypted like any other credential.
+func (factory *deviceRequestFactory) ApproveDeviceRequest(userCode string, tokenType string, accessToken string, tokenExpiry time.Time) (bool, error) {
+	encryptedToken, nonce, err := factory.conn.EncryptionStrategy().Encrypt([]byte(accessToken))
+	if err != nil {
+		return false, err
+	}
+
+	result, err := factory.conn.Exec(`
+		UPDATE device_requests
+		SET token_type = $2, access_token = $3, nonce = $4, token_expires_at = $5
+		WHERE user_code = $1
+		AND expires_at > now()
+		AND access_token IS NULL
+	`, userCode, tokenType, encryptedToken, nonce, tokenExpiry)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows == 1, nil
+}
+
+// PollDeviceRequest records that the device polled for its request. When the
+// device polls again before its interval has passed, the interval is raised
+// and true is returned so that it can be told to slow down.
+func (factory *deviceRequestFactory) PollDeviceRequest(deviceCode string) (DeviceRequest, bool, bool, error) {
+	tx, err := factory.conn.Begin()
+	if err != nil {
+		return DeviceRequest{}, false, false, err
+	}
+
+	defer Rollback(tx)
+
+	request, found, err := factory.findDeviceRequest(tx, "device_code", deviceCode, "FOR UPDATE")
+	if err != nil {
+		return DeviceRequest{}, false, false, err
+	}
+
+	if !found {
+		return DeviceRequest{}, false, false, nil
+	}
+
+	var (
+		tooEarly bool
+		interval int
+	)
+
+	err = tx.QueryRow(`
+		UPDATE device_requests r
+		SET last_polled_at = now(),
+			poll_interval = CASE WHEN p.too_early THEN r.poll_interval + $2 ELSE r.poll_interval END
+		FROM (
+			SELECT last_polled_at IS NOT NULL AND last_polled_at + poll_interval * interval '1 second' > now() AS too_early
+			FROM device_requests
+			WHERE device_code = $1
+		) p
+		WHERE r.device_code = $1
+		RETURNING p.too_early, r.poll_interval
+	`, deviceCode, int(DeviceRequestSlowDownInterval.Seconds())).Scan(&tooEarly, &interval)
+	if err != nil {
+		return DeviceRequest{}, false, false, err
+	}
+
+	request.Interval = time.Duration(interval) * time.Second
+
+	err = tx.Commit()
+	if err != nil {
+		return DeviceRequest{}, false, false, err
+	}
+
+	return request, true, tooEarly, nil
+}
+
+func (factory *deviceRequestFactory) DeleteDeviceRequest(deviceCode string) error {
+	_, err := factory.conn.Exec(`DELETE FROM device_requests WHERE device_code = $1`, deviceCode)
+	return err
+}
+
+func (factory *deviceRequestFactory) findDeviceRequest(runner sq.QueryRower, column string, code string, suffix string) (DeviceRequest, bool, error) {
+	var (
+		request        DeviceRequest
+		interval       int
+		tokenType      sql.NullString
+		encryptedToken sql.NullString
+		nonce          sql.NullString
+		tokenExpiry    *time.Time
+	)
+
+	err := runner.QueryRow(`
+		SELECT device_code, user_code, expires_at, poll_interval, token_type, access_token, nonce, token_expires_at
+		FROM device_requests
+		WHERE `+column+` = $1
+		AND expires_at > now()
+		`+suffix, code).Scan(&request.DeviceCode, &request.UserCode, &request.Expiry, &interval, &tokenType, &encryptedToken, &nonce, &tokenExpiry)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return DeviceRequest{}, false, nil
+		}
+
+		return DeviceRequest{}, false, err
+	}
+
+	request.Interval = time.Duration(interval) * time.Second
+	request.TokenType = tokenType.String
+
+	if encryptedToken.Valid {
+		var noncePtr *string
+		if nonce.Valid {
+			noncePtr = &nonce.String
+		}
+
+		accessToken, err := factory.conn.EncryptionStrategy().Decrypt(encryptedToken.String, noncePtr)
+		if err != nil {
+			return DeviceRequest{}, false, err
+		}
+
+		request.AccessToken = string(accessToken)
+	}
+
+	if tokenExpiry != nil {
+		request.TokenExpiry = *tokenExpiry
+	}
+
+	return request, true, nil
+}
@@ -0,0 +1,132 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DeviceRequestFactory", func() {
+	var requestFactory db.DeviceRequestFactory
+
+	BeforeEach(func() {
+		requestFactory = db.NewDeviceRequestFactory(dbConn)
+
+		err := requestFactory.CreateDeviceRequest(db.DeviceRequest{
+			DeviceCode: "some-device-code",
+			UserCode:   "BCDF-GHJK",
+			Expiry:     time.Now().Add(time.Minute),
+			Interval:   5 * time.Second,
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("finds pending requests by their user code", func() {
+		request, found, err := requestFactory.FindDeviceRequestByUserCode("BCDF-GHJK")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(request.DeviceCode).To(Equal("some-device-code"))
+		Expect(request.Interval).To(Equal(5 * time.Second))
+		Expect(request.Approved()).To(BeFalse())
+	})
+
+	It("does not find expired requests", func() {
+		err := requestFactory.CreateDeviceRequest(db.DeviceRequest{
+			DeviceCode: "expired-device-code",
+			UserCode:   "LMNP-QRST",
+			Expiry:     time.Now().Add(-time.Minute),
+			Interval:   5 * time.Second,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, found, err := requestFactory.FindDeviceRequestByUserCode("LMNP-QRST")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+
+		_, found, _, err = requestFactory.PollDeviceRequest("expired-device-code")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	Describe("ApproveDeviceRequest", func() {
+		var tokenExpiry time.Time
+
+		BeforeEach(func() {
+			tokenExpiry = time.Now().Add(time.Hour).Truncate(time.Second)
+
+			approved, err := requestFactory.ApproveDeviceRequest("BCDF-GHJK", "bearer", "some-access-token", tokenExpiry)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(approved).To(BeTrue())
+		})
+
+		It("attaches the token to the request", func() {
+			request, found, _, err := requestFactory.PollDeviceRequest("some-device-code")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(request.Approved()).To(BeTrue())
+			Expect(request.TokenType).To(Equal("bearer"))
+			Expect(request.AccessToken).To(Equal("some-access-token"))
+			Expect(request.TokenExpiry).To(BeTemporally("==", tokenExpiry))
+		})
+
+		It("encrypts the token", func() {
+			var accessToken string
+			var nonce *string
+			err := dbConn.QueryRow(`SELECT access_token, nonce FROM device_requests WHERE device_code = 'some-device-code'`).Scan(&accessToken, &nonce)
+			Expect(err).ToNot(HaveOccurred())
+
+			decrypted, err := dbConn.EncryptionStrategy().Decrypt(accessToken, nonce)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(decrypted)).To(Equal("some-access-token"))
+		})
+
+		It("does not approve a request twice", func() {
+			approved, err := requestFactory.ApproveDeviceRequest("BCDF-GHJK", "bearer", "other-access-token", tokenExpiry)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(approved).To(BeFalse())
+		})
+	})
+
+	Describe("PollDeviceRequest", func() {
+		It("lets the first poll through", func() {
+			_, found, tooEarly, err := requestFactory.PollDeviceRequest("some-device-code")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(tooEarly).To(BeFalse())
+		})
+
+		It("raises the interval of devices polling too early", func() {
+			_, _, _, err := requestFactory.PollDeviceRequest("some-device-code")
+			Expect(err).ToNot(HaveOccurred())
+
+			request, found, tooEarly, err := requestFactory.PollDeviceRequest("some-device-code")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(tooEarly).To(BeTrue())
+			Expect(request.Interval).To(Equal(10 * time.Second))
+		})
+
+		It("lets polls through once the interval has passed", func() {
+			_, err := dbConn.Exec(`UPDATE device_requests SET last_polled_at = now() - interval '6 seconds'`)
+			Expect(err).ToNot(HaveOccurred())
+
+			request, _, tooEarly, err := requestFactory.PollDeviceRequest("some-device-code")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tooEarly).To(BeFalse())
+			Expect(request.Interval).To(Equal(5 * time.Second))
+		})
+	})
+
+	Describe("DeleteDeviceRequest", func() {
+		It("deletes the request", func() {
+			err := requestFactory.DeleteDeviceRequest("some-device-code")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, found, err := requestFactory.FindDeviceRequestByUserCode("BCDF-GHJK")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
@@ -32,15 +33,20 @@ type Job interface {
 	Pause() error
 	Unpause() error
 
-	CreateBuild() (Build, error)
+	CreateBuild(createdBy string) (Build, error)
 	Builds(page Page) ([]Build, Pagination, error)
 	BuildsWithTime(page Page) ([]Build, Pagination, error)
 	Build(name string) (Build, bool, error)
 	FinishedAndNextBuild() (Build, Build, error)
 	UpdateFirstLoggedBuildID(newFirstLoggedBuildID int) error
-	EnsurePendingBuildExists() error
+	EnsurePendingBuildExists(trigger atc.BuildTrigger) error
 	GetPendingBuilds() ([]Build, error)
 
+	LastScheduled() time.Time
+	Priority() int
+	SetLastScheduled(time.Time) error
+	EnsureScheduledBuildExists(scheduledAt time.Time) error
+
 	GetIndependentBuildInputs() ([]BuildInput, error)
 	GetNextBuildInputs() ([]BuildInput, bool, error)
 	SaveNextInputMapping(inputMapping algorithm.InputMapping) error
@@ -53,11 +59,13 @@ type Job interface {
 
 	ClearTaskCache(string, string) (int64, error)
 
+	TaskResourceUsage(limit int) ([]atc.BuildTaskResourceUsage, error)
+
 	SetHasNewInputs(bool) error
 	HasNewInputs() bool
 }
 
-var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs").
+var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs", "j.last_scheduled", "COALESCE(j.priority, t.default_job_priority, 0)").
 	From("jobs j, pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id").
 	Where(sq.Expr("j.pipeline_id = p.id"))
@@ -84,6 +92,8 @@ type job struct {
 	config             atc.JobConfig
 	tags               []string
 	hasNewInputs       bool
+	lastScheduled      time.Time
+	priority           int
 }
 
 func newEmptyJob(conn Conn, lockFactory lock.LockFactory) *job {
@@ -124,16 +134,21 @@ func (jobs Jobs) Configs() atc.JobConfigs {
 	return configs
 }
 
-func (j *job) ID() int                 { return j.id }
-func (j *job) Name() string            { return j.name }
-func (j *job) Paused() bool            { return j.paused }
-func (j *job) FirstLoggedBuildID() int { return j.firstLoggedBuildID }
-func (j *job) TeamID() int             { return j.teamID }
-func (j *job) TeamName() string        { return j.teamName }
-func (j *job) Config() atc.JobConfig   { return j.config }
-func (j *job) Tags() []string          { return j.tags }
-func (j *job) Public() bool            { return j.Config().Public }
-func (j *job) HasNewInputs() bool      { return j.hasNewInputs }
+func (j *job) ID() int                  { return j.id }
+func (j *job) Name() string             { return j.name }
+func (j *job) Paused() bool             { return j.paused }
+func (j *job) FirstLoggedBuildID() int  { return j.firstLoggedBuildID }
+func (j *job) TeamID() int              { return j.teamID }
+func (j *job) TeamName() string         { return j.teamName }
+func (j *job) Config() atc.JobConfig    { return j.config }
+func (j *job) Tags() []string           { return j.tags }
+func (j *job) Public() bool             { return j.Config().Public }
+func (j *job) HasNewInputs() bool       { return j.hasNewInputs }
+func (j *job) LastScheduled() time.Time { return j.lastScheduled }
+
+// Priority is the job's configured priority, or its team's default job
+// priority if it does not configure one.
+func (j *job) Priority() int { return j.priority }
 
 func (j *job) Reload() (bool, error) {
 	row := jobsQuery.Where(sq.Eq{"j.id": j.id}).
@@ -436,7 +451,7 @@ func (j *job) DeleteNextInputMapping() error {
 	return tx.Commit()
 }
 
-func (j *job) EnsurePendingBuildExists() error {
+func (j *job) EnsurePendingBuildExists(trigger atc.BuildTrigger) error {
 	tx, err := j.conn.Begin()
 	if err != nil {
 		return err
@@ -444,44 +459,67 @@ func (j *job) EnsurePendingBuildExists() error {
 
 	defer Rollback(tx)
 
-	buildName, err := j.getNewBuildName(tx)
+	err = j.ensurePendingBuildExists(tx, trigger)
 	if err != nil {
 		return err
 	}
 
-	rows, err := tx.Query(`
-		INSERT INTO builds (name, job_id, pipeline_id, team_id, status)
-		SELECT $1, $2, $3, $4, 'pending'
-		WHERE NOT EXISTS
-			(SELECT id FROM builds WHERE job_id = $2 AND status = 'pending')
-		RETURNING id
-	`, buildName, j.id, j.pipelineID, j.teamID)
+	return tx.Commit()
+}
+
+func (j *job) SetLastScheduled(scheduledAt time.Time) error {
+	result, err := psql.Update("jobs").
+		Set("last_scheduled", scheduledAt).
+		Where(sq.Eq{"id": j.id}).
+		RunWith(j.conn).
+		Exec()
 	if err != nil {
 		return err
 	}
 
-	defer Close(rows)
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
 
-	if rows.Next() {
-		var buildID int
-		err := rows.Scan(&buildID)
-		if err != nil {
-			return err
-		}
+	if rowsAffected != 1 {
+		return nonOneRowAffectedError{rowsAffected}
+	}
 
-		err = rows.Close()
-		if err != nil {
-			return err
-		}
+	j.lastScheduled = scheduledAt
 
-		err = createBuildEventSeq(tx, buildID)
-		if err != nil {
-			return err
-		}
+	return nil
+}
 
-		return tx.Commit()
+func (j *job) EnsureScheduledBuildExists(scheduledAt time.Time) error {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return err
 	}
 
+	defer Rollback(tx)
+
+	_, err = psql.Update("jobs").
+		Set("last_scheduled", scheduledAt).
+		Where(sq.Eq{"id": j.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	err = j.ensurePendingBuildExists(tx, atc.BuildTrigger{Type: atc.BuildTriggerTypeScheduled})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	j.lastScheduled = scheduledAt
+
 	return nil
 }
 
@@ -527,7 +565,7 @@ func (j *job) GetPendingBuilds() ([]Build, error) {
 	return builds, nil
 }
 
-func (j *job) CreateBuild() (Build, error) {
+func (j *job) CreateBuild(createdBy string) (Build, error) {
 	tx, err := j.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -540,6 +578,11 @@ func (j *job) CreateBuild() (Build, error) {
 		return nil, err
 	}
 
+	trigger, err := json.Marshal(atc.BuildTrigger{Type: atc.BuildTriggerTypeManual})
+	if err != nil {
+		return nil, err
+	}
+
 	build := newEmptyBuild(j.conn, j.lockFactory)
 	err = createBuild(tx, build, map[string]interface{}{
 		"name":               buildName,
@@ -548,6 +591,8 @@ func (j *job) CreateBuild() (Build, error) {
 		"team_id":            j.teamID,
 		"status":             BuildStatusPending,
 		"manually_triggered": true,
+		"created_by":         createdBy,
+		"trigger":            trigger,
 	})
 	if err != nil {
 		return nil, err
@@ -601,6 +646,57 @@ func (j *job) ClearTaskCache(stepName string, cachePath string) (int64, error) {
 	return rowsDeleted, tx.Commit()
 }
 
+// TaskResourceUsage returns the usage of every task of the job's most recent
+// builds which recorded any, limited to the given number of builds.
+func (j *job) TaskResourceUsage(limit int) ([]atc.BuildTaskResourceUsage, error) {
+	rows, err := psql.Select("b.id", "b.name", "u.step_name", "u.created_at", "u.cpu_time", "u.peak_memory", "u.average_memory", "u.peak_disk", "u.output_sizes").
+		From("task_resource_usages u").
+		Join("builds b ON b.id = u.build_id").
+		Where(sq.Expr(`u.build_id IN (
+			SELECT DISTINCT lu.build_id
+			FROM task_resource_usages lu
+			JOIN builds lb ON lb.id = lu.build_id
+			WHERE lb.job_id = ?
+			ORDER BY lu.build_id DESC
+			LIMIT ?
+		)`, j.id, limit)).
+		OrderBy("b.id DESC", "u.plan_id").
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	usages := []atc.BuildTaskResourceUsage{}
+	for rows.Next() {
+		var (
+			usage       atc.BuildTaskResourceUsage
+			createdAt   time.Time
+			outputSizes []byte
+		)
+
+		err = rows.Scan(&usage.BuildID, &usage.BuildName, &usage.StepName, &createdAt, &usage.Usage.CPUTime, &usage.Usage.PeakMemory, &usage.Usage.AverageMemory, &usage.Usage.PeakDisk, &outputSizes)
+		if err != nil {
+			return nil, err
+		}
+
+		if outputSizes != nil {
+			err = json.Unmarshal(outputSizes, &usage.Usage.OutputSizes)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		usage.Time = createdAt.Unix()
+
+		usages = append(usages, usage)
+	}
+
+	return usages, nil
+}
+
 func (j *job) updateSerialGroups(serialGroups []string) error {
 	tx, err := j.conn.Begin()
 	if err != nil {
@@ -699,6 +795,48 @@ func (j *job) getBuildInputs(table string) ([]BuildInput, error) {
 	return buildInputs, nil
 }
 
+func (j *job) ensurePendingBuildExists(tx Tx, trigger atc.BuildTrigger) error {
+	buildName, err := j.getNewBuildName(tx)
+	if err != nil {
+		return err
+	}
+
+	triggerJSON, err := json.Marshal(trigger)
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.Query(`
+		INSERT INTO builds (name, job_id, pipeline_id, team_id, status, trigger)
+		SELECT $1, $2, $3, $4, 'pending', $5
+		WHERE NOT EXISTS
+			(SELECT id FROM builds WHERE job_id = $2 AND status = 'pending')
+		RETURNING id
+	`, buildName, j.id, j.pipelineID, j.teamID, triggerJSON)
+	if err != nil {
+		return err
+	}
+
+	defer Close(rows)
+
+	if !rows.Next() {
+		return nil
+	}
+
+	var buildID int
+	err = rows.Scan(&buildID)
+	if err != nil {
+		return err
+	}
+
+	err = rows.Close()
+	if err != nil {
+		return err
+	}
+
+	return createBuildEventSeq(tx, buildID)
+}
+
 func (j *job) getNewBuildName(tx Tx) (string, error) {
 	var buildName string
 	err := psql.Update("jobs").
@@ -836,15 +974,18 @@ func (j *job) finishedBuild(tx Tx) (Build, error) {
 
 func scanJob(j *job, row scannable) error {
 	var (
-		configBlob []byte
-		nonce      sql.NullString
+		configBlob    []byte
+		nonce         sql.NullString
+		lastScheduled pq.NullTime
 	)
 
-	err := row.Scan(&j.id, &j.name, &configBlob, &j.paused, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs)
+	err := row.Scan(&j.id, &j.name, &configBlob, &j.paused, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &lastScheduled, &j.priority)
 	if err != nil {
 		return err
 	}
 
+	j.lastScheduled = lastScheduled.Time
+
 	es := j.conn.EncryptionStrategy()
 
 	var noncense *string
@@ -47,13 +47,13 @@ var _ = Describe("Job Factory", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			transitionBuild, err := job.CreateBuild()
+			transitionBuild, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			err = transitionBuild.Finish(db.BuildStatusSucceeded)
 			Expect(err).ToNot(HaveOccurred())
 
-			finishedBuild, err := job.CreateBuild()
+			finishedBuild, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			err = finishedBuild.Finish(db.BuildStatusSucceeded)
@@ -63,7 +63,7 @@ var _ = Describe("Job Factory", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			nextBuild, err := job.CreateBuild()
+			nextBuild, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			visibleJobs, err := jobFactory.VisibleJobs([]string{"default-team"})
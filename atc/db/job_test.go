@@ -178,13 +178,13 @@ var _ = Describe("Job", func() {
 			Expect(next).To(BeNil())
 			Expect(finished).To(BeNil())
 
-			finishedBuild, err := job.CreateBuild()
+			finishedBuild, err := job.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			err = finishedBuild.Finish(db.BuildStatusSucceeded)
 			Expect(err).NotTo(HaveOccurred())
 
-			otherFinishedBuild, err := otherJob.CreateBuild()
+			otherFinishedBuild, err := otherJob.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			err = otherFinishedBuild.Finish(db.BuildStatusSucceeded)
@@ -196,14 +196,14 @@ var _ = Describe("Job", func() {
 			Expect(next).To(BeNil())
 			Expect(finished.ID()).To(Equal(finishedBuild.ID()))
 
-			nextBuild, err := job.CreateBuild()
+			nextBuild, err := job.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			started, err := nextBuild.Start(atc.Plan{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(started).To(BeTrue())
 
-			otherNextBuild, err := otherJob.CreateBuild()
+			otherNextBuild, err := otherJob.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			otherStarted, err := otherNextBuild.Start(atc.Plan{})
@@ -216,7 +216,7 @@ var _ = Describe("Job", func() {
 			Expect(next.ID()).To(Equal(nextBuild.ID()))
 			Expect(finished.ID()).To(Equal(finishedBuild.ID()))
 
-			anotherRunningBuild, err := job.CreateBuild()
+			anotherRunningBuild, err := job.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			finished, next, err = job.FinishedAndNextBuild()
@@ -293,10 +293,10 @@ var _ = Describe("Job", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err := someJob.CreateBuild()
+				build, err := someJob.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
-				_, err = someOtherJob.CreateBuild()
+				_, err = someOtherJob.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				builds[i] = build
@@ -395,7 +395,7 @@ var _ = Describe("Job", func() {
 			Expect(found).To(BeTrue())
 
 			for i := range builds {
-				builds[i], err = job.CreateBuild()
+				builds[i], err = job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				buildStart := time.Date(2020, 11, i+1, 0, 0, 0, 0, time.UTC)
@@ -474,12 +474,12 @@ var _ = Describe("Job", func() {
 		Context("when a build exists", func() {
 			BeforeEach(func() {
 				var err error
-				firstBuild, err = job.CreateBuild()
+				firstBuild, err = job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 			})
 
 			It("finds the latest build", func() {
-				secondBuild, err := job.CreateBuild()
+				secondBuild, err := job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				build, found, err := job.Build("latest")
@@ -521,17 +521,17 @@ var _ = Describe("Job", func() {
 
 			BeforeEach(func() {
 				var err error
-				_, err = job.CreateBuild()
+				_, err = job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
-				startedBuild, err = job.CreateBuild()
+				startedBuild, err = job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 				_, err = startedBuild.Schedule()
 				Expect(err).NotTo(HaveOccurred())
 				_, err = startedBuild.Start(atc.Plan{})
 				Expect(err).NotTo(HaveOccurred())
 
-				scheduledBuild, err = job.CreateBuild()
+				scheduledBuild, err = job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				scheduled, err := scheduledBuild.Schedule()
@@ -539,7 +539,7 @@ var _ = Describe("Job", func() {
 				Expect(scheduled).To(BeTrue())
 
 				for _, s := range []db.BuildStatus{db.BuildStatusSucceeded, db.BuildStatusFailed, db.BuildStatusErrored, db.BuildStatusAborted} {
-					finishedBuild, err := job.CreateBuild()
+					finishedBuild, err := job.CreateBuild("some-user")
 					Expect(err).NotTo(HaveOccurred())
 
 					scheduled, err = finishedBuild.Schedule()
@@ -554,7 +554,7 @@ var _ = Describe("Job", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				_, err = otherJob.CreateBuild()
+				_, err = otherJob.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -576,14 +576,14 @@ var _ = Describe("Job", func() {
 
 			BeforeEach(func() {
 				var err error
-				_, err = job.CreateBuild()
+				_, err = job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				otherSerialJob, found, err := pipeline.Job("other-serial-group-job")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				serialGroupBuild, err = otherSerialJob.CreateBuild()
+				serialGroupBuild, err = otherSerialJob.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				scheduled, err := serialGroupBuild.Schedule()
@@ -594,7 +594,7 @@ var _ = Describe("Job", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				differentSerialGroupBuild, err := differentSerialJob.CreateBuild()
+				differentSerialGroupBuild, err := differentSerialJob.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				scheduled, err = differentSerialGroupBuild.Schedule()
@@ -631,10 +631,10 @@ var _ = Describe("Job", func() {
 			var actualBuild db.Build
 
 			BeforeEach(func() {
-				_, err := job1.CreateBuild()
+				_, err := job1.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
-				actualBuild, err = job2.CreateBuild()
+				actualBuild, err = job2.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				err = job2.SaveNextInputMapping(nil)
@@ -650,13 +650,13 @@ var _ = Describe("Job", func() {
 		})
 
 		It("should return the next most pending build in a group of jobs", func() {
-			buildOne, err := job1.CreateBuild()
+			buildOne, err := job1.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
-			buildTwo, err := job1.CreateBuild()
+			buildTwo, err := job1.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
-			buildThree, err := job2.CreateBuild()
+			buildThree, err := job2.CreateBuild("some-user")
 			Expect(err).NotTo(HaveOccurred())
 
 			err = job1.SaveNextInputMapping(nil)
@@ -1123,7 +1123,7 @@ var _ = Describe("Job", func() {
 			otherPipeline, _, err = team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
-			build1DB, err = job.CreateBuild()
+			build1DB, err = job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(build1DB.ID()).NotTo(BeZero())
@@ -1146,6 +1146,11 @@ var _ = Describe("Job", func() {
 			Expect(nextPendings[0].ID()).To(Equal(build1DB.ID()))
 		})
 
+		It("records who created it and why", func() {
+			Expect(build1DB.CreatedBy()).To(Equal("some-user"))
+			Expect(build1DB.Trigger()).To(Equal(&atc.BuildTrigger{Type: atc.BuildTriggerTypeManual}))
+		})
+
 		It("is in the list of pending builds", func() {
 			nextPendingBuilds, err := pipeline.GetAllPendingBuilds()
 			Expect(err).NotTo(HaveOccurred())
@@ -1155,7 +1160,7 @@ var _ = Describe("Job", func() {
 
 		Context("and another build for a different pipeline is created with the same job name", func() {
 			BeforeEach(func() {
-				otherBuild, err := otherJob.CreateBuild()
+				otherBuild, err := otherJob.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(otherBuild.ID()).NotTo(BeZero())
@@ -1247,7 +1252,7 @@ var _ = Describe("Job", func() {
 
 			BeforeEach(func() {
 				var err error
-				build2DB, err = job.CreateBuild()
+				build2DB, err = job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(build2DB.ID()).NotTo(BeZero())
@@ -1276,9 +1281,20 @@ var _ = Describe("Job", func() {
 	})
 
 	Describe("EnsurePendingBuildExists", func() {
+		var trigger atc.BuildTrigger
+
+		BeforeEach(func() {
+			trigger = atc.BuildTrigger{
+				Type:     atc.BuildTriggerTypeResource,
+				Input:    "some-input",
+				Resource: "some-resource",
+				Version:  atc.Version{"some": "version"},
+			}
+		})
+
 		Context("when only a started build exists", func() {
 			BeforeEach(func() {
-				build1, err := job.CreateBuild()
+				build1, err := job.CreateBuild("some-user")
 				Expect(err).NotTo(HaveOccurred())
 
 				started, err := build1.Start(atc.Plan{})
@@ -1287,19 +1303,20 @@ var _ = Describe("Job", func() {
 			})
 
 			It("creates a build", func() {
-				err := job.EnsurePendingBuildExists()
+				err := job.EnsurePendingBuildExists(trigger)
 				Expect(err).NotTo(HaveOccurred())
 
 				pendingBuilds, err := job.GetPendingBuilds()
 				Expect(err).NotTo(HaveOccurred())
 				Expect(pendingBuilds).To(HaveLen(1))
+				Expect(pendingBuilds[0].Trigger()).To(Equal(&trigger))
 			})
 
 			It("doesn't create another build the second time it's called", func() {
-				err := job.EnsurePendingBuildExists()
+				err := job.EnsurePendingBuildExists(trigger)
 				Expect(err).NotTo(HaveOccurred())
 
-				err = job.EnsurePendingBuildExists()
+				err = job.EnsurePendingBuildExists(trigger)
 				Expect(err).NotTo(HaveOccurred())
 
 				builds2, err := job.GetPendingBuilds()
@@ -1317,6 +1334,40 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("EnsureScheduledBuildExists", func() {
+		var scheduledAt time.Time
+
+		BeforeEach(func() {
+			scheduledAt = time.Now().Truncate(time.Second)
+
+			err := job.EnsureScheduledBuildExists(scheduledAt)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("creates a pending build with a scheduled trigger", func() {
+			pendingBuilds, err := job.GetPendingBuilds()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pendingBuilds).To(HaveLen(1))
+			Expect(pendingBuilds[0].Trigger()).To(Equal(&atc.BuildTrigger{Type: atc.BuildTriggerTypeScheduled}))
+		})
+
+		It("records when the job was last scheduled", func() {
+			found, err := job.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(job.LastScheduled()).To(BeTemporally("==", scheduledAt))
+		})
+
+		It("doesn't create another build while one is pending", func() {
+			err := job.EnsureScheduledBuildExists(scheduledAt.Add(time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+
+			pendingBuilds, err := job.GetPendingBuilds()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pendingBuilds).To(HaveLen(1))
+		})
+	})
+
 	Describe("Clear task cache", func() {
 		Context("when task cache exists", func() {
 			var (
@@ -1480,4 +1531,104 @@ var _ = Describe("Job", func() {
 		})
 
 	})
+
+	Describe("TaskResourceUsage", func() {
+		var (
+			firstBuild  db.Build
+			secondBuild db.Build
+		)
+
+		BeforeEach(func() {
+			var err error
+			firstBuild, err = job.CreateBuild("some-user")
+			Expect(err).ToNot(HaveOccurred())
+
+			secondBuild, err = job.CreateBuild("some-user")
+			Expect(err).ToNot(HaveOccurred())
+
+			err = firstBuild.SaveTaskResourceUsage("some-plan-id", "some-task", atc.TaskResourceUsage{
+				CPUTime:       1000,
+				PeakMemory:    2048,
+				AverageMemory: 1024,
+				PeakDisk:      4096,
+				OutputSizes:   map[string]uint64{"some-output": 512},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = secondBuild.SaveTaskResourceUsage("some-plan-id", "some-task", atc.TaskResourceUsage{
+				CPUTime:       3000,
+				PeakMemory:    4096,
+				AverageMemory: 2048,
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the usage of the most recent builds first", func() {
+			usages, err := job.TaskResourceUsage(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usages).To(HaveLen(2))
+
+			Expect(usages[0].BuildID).To(Equal(secondBuild.ID()))
+			Expect(usages[0].BuildName).To(Equal(secondBuild.Name()))
+			Expect(usages[0].StepName).To(Equal("some-task"))
+			Expect(usages[0].Usage).To(Equal(atc.TaskResourceUsage{
+				CPUTime:       3000,
+				PeakMemory:    4096,
+				AverageMemory: 2048,
+			}))
+
+			Expect(usages[1].BuildID).To(Equal(firstBuild.ID()))
+			Expect(usages[1].Usage).To(Equal(atc.TaskResourceUsage{
+				CPUTime:       1000,
+				PeakMemory:    2048,
+				AverageMemory: 1024,
+				PeakDisk:      4096,
+				OutputSizes:   map[string]uint64{"some-output": 512},
+			}))
+		})
+
+		It("respects the limit", func() {
+			usages, err := job.TaskResourceUsage(1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usages).To(HaveLen(1))
+			Expect(usages[0].BuildID).To(Equal(secondBuild.ID()))
+		})
+
+		Context("when the builds have several tasks", func() {
+			BeforeEach(func() {
+				err := firstBuild.SaveTaskResourceUsage("other-plan-id", "other-task", atc.TaskResourceUsage{CPUTime: 1})
+				Expect(err).ToNot(HaveOccurred())
+
+				err = secondBuild.SaveTaskResourceUsage("other-plan-id", "other-task", atc.TaskResourceUsage{CPUTime: 3})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("limits the number of builds rather than tasks", func() {
+				usages, err := job.TaskResourceUsage(2)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(usages).To(HaveLen(4))
+
+				Expect(usages[0].BuildID).To(Equal(secondBuild.ID()))
+				Expect(usages[1].BuildID).To(Equal(secondBuild.ID()))
+				Expect(usages[2].BuildID).To(Equal(firstBuild.ID()))
+				Expect(usages[3].BuildID).To(Equal(firstBuild.ID()))
+			})
+		})
+
+		Context("when the usage is saved again for the same step", func() {
+			BeforeEach(func() {
+				err := secondBuild.SaveTaskResourceUsage("some-plan-id", "some-task", atc.TaskResourceUsage{
+					CPUTime: 5000,
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("replaces the previous usage", func() {
+				usages, err := job.TaskResourceUsage(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(usages).To(HaveLen(2))
+				Expect(usages[0].Usage.CPUTime).To(Equal(uint64(5000)))
+			})
+		})
+	})
 })
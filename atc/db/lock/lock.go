@@ -22,6 +22,7 @@ const (
 	LockTypeDatabaseMigration
 	LockTypeActiveTasks
 	LockTypeResourceScanning
+	LockTypeDebugContainers
 )
 
 var ErrLostLock = errors.New("lock was lost while held, possibly due to connection breakage")
@@ -0,0 +1,17 @@
+BEGIN;
+
+  CREATE TABLE task_resource_usages (
+      build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+      plan_id text NOT NULL,
+      step_name text NOT NULL,
+      cpu_time bigint NOT NULL DEFAULT 0,
+      peak_memory bigint NOT NULL DEFAULT 0,
+      average_memory bigint NOT NULL DEFAULT 0,
+      peak_disk bigint NOT NULL DEFAULT 0,
+      output_sizes jsonb,
+      created_at timestamp WITH TIME ZONE NOT NULL DEFAULT now()
+  );
+
+  CREATE UNIQUE INDEX task_resource_usages_build_id_plan_id_key ON task_resource_usages (build_id, plan_id);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+  ALTER TABLE jobs ADD COLUMN last_scheduled timestamp WITH TIME ZONE;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+  ALTER TABLE builds DROP COLUMN trigger;
+
+  ALTER TABLE builds DROP COLUMN created_by;
+
+COMMIT;
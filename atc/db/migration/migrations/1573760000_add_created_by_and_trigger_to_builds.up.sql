@@ -0,0 +1,9 @@
+BEGIN;
+
+  ALTER TABLE builds ADD COLUMN created_by text;
+
+  ALTER TABLE builds ADD COLUMN trigger jsonb;
+
+  UPDATE builds SET trigger = '{"type":"manual"}' WHERE manually_triggered;
+
+COMMIT;
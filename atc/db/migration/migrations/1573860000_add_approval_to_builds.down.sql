@@ -0,0 +1,9 @@
+BEGIN;
+
+  ALTER TABLE builds DROP COLUMN approval_inputs;
+
+  ALTER TABLE builds DROP COLUMN approval;
+
+  ALTER TABLE builds DROP COLUMN awaiting_approval;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+  ALTER TABLE builds ADD COLUMN awaiting_approval boolean NOT NULL DEFAULT false;
+
+  ALTER TABLE builds ADD COLUMN approval jsonb;
+
+  ALTER TABLE builds ADD COLUMN approval_inputs jsonb;
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+  CREATE TABLE build_locks (
+      team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+      name text NOT NULL,
+      build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+      held boolean NOT NULL DEFAULT false,
+      acquired_at timestamp WITH TIME ZONE,
+      PRIMARY KEY (team_id, name, build_id)
+  );
+
+  CREATE INDEX build_locks_build_id_idx ON build_locks (build_id);
+
+  CREATE UNIQUE INDEX build_locks_holder_key ON build_locks (team_id, name) WHERE held;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+  ALTER TABLE teams DROP COLUMN default_job_priority;
+
+  ALTER TABLE jobs DROP COLUMN priority;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+  ALTER TABLE jobs ADD COLUMN priority integer;
+
+  ALTER TABLE teams ADD COLUMN default_job_priority integer NOT NULL DEFAULT 0;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+  ALTER TABLE resource_config_versions DROP COLUMN created_at;
+
+COMMIT;
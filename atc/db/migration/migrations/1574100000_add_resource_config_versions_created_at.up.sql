@@ -0,0 +1,7 @@
+BEGIN;
+
+  -- There is no record of when existing versions were found, so they are
+  -- stamped with the time of the migration and only expire by age from then.
+  ALTER TABLE resource_config_versions ADD COLUMN created_at timestamp with time zone NOT NULL DEFAULT now();
+
+COMMIT;
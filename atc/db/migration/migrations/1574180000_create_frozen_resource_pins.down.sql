@@ -0,0 +1,5 @@
+BEGIN;
+
+  DROP TABLE frozen_resource_pins;
+
+COMMIT;
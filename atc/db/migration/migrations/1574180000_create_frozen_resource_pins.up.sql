@@ -0,0 +1,10 @@
+BEGIN;
+
+  CREATE TABLE frozen_resource_pins (
+    resource_id integer NOT NULL PRIMARY KEY
+      REFERENCES resources(id) ON DELETE CASCADE,
+    version jsonb,
+    comment_text text NOT NULL DEFAULT ''
+  );
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+  DROP TABLE resource_version_labels;
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+  CREATE TABLE resource_version_labels (
+    resource_config_scope_id integer NOT NULL REFERENCES resource_config_scopes(id) ON DELETE CASCADE,
+    version_md5 text NOT NULL,
+    label text NOT NULL,
+    set_by text NOT NULL DEFAULT '',
+    build_id integer REFERENCES builds(id) ON DELETE SET NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY (resource_config_scope_id, version_md5, label)
+  );
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+  DROP TABLE service_account_tokens;
+
+  DROP TABLE service_accounts;
+
+COMMIT;
@@ -0,0 +1,24 @@
+BEGIN;
+
+  CREATE TABLE service_accounts (
+    id serial PRIMARY KEY,
+    team_id integer NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    name text NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    UNIQUE (team_id, name)
+  );
+
+  CREATE TABLE service_account_tokens (
+    id serial PRIMARY KEY,
+    service_account_id integer NOT NULL REFERENCES service_accounts(id) ON DELETE CASCADE,
+    name text NOT NULL,
+    token_hash text NOT NULL UNIQUE,
+    role text NOT NULL,
+    pipeline_id integer REFERENCES pipelines(id) ON DELETE CASCADE,
+    expires_at timestamp with time zone NOT NULL,
+    last_used_at timestamp with time zone,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    UNIQUE (service_account_id, name)
+  );
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+  ALTER TABLE containers DROP COLUMN debug_until;
+
+COMMIT;
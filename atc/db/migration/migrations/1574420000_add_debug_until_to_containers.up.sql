@@ -0,0 +1,7 @@
+BEGIN;
+
+  ALTER TABLE containers ADD COLUMN debug_until timestamp with time zone;
+
+  CREATE INDEX containers_debug_until_idx ON containers (debug_until) WHERE debug_until IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+  ALTER TABLE worker_artifacts DROP COLUMN generation;
+
+  ALTER TABLE worker_artifacts DROP COLUMN manifest;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+  ALTER TABLE worker_artifacts ADD COLUMN manifest text;
+
+  ALTER TABLE worker_artifacts ADD COLUMN generation integer NOT NULL DEFAULT 0;
+
+COMMIT;
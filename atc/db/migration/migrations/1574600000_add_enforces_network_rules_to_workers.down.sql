@@ -0,0 +1,5 @@
+BEGIN;
+
+  ALTER TABLE workers DROP COLUMN enforces_network_rules;
+
+COMMIT;
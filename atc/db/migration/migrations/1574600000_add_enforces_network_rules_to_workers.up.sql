@@ -0,0 +1,5 @@
+BEGIN;
+
+  ALTER TABLE workers ADD COLUMN enforces_network_rules boolean NOT NULL DEFAULT false;
+
+COMMIT;
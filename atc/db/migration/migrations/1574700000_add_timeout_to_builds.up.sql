@@ -0,0 +1,5 @@
+BEGIN;
+
+  ALTER TABLE builds ADD COLUMN timeout text;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+  DROP TABLE device_requests;
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+  CREATE TABLE device_requests (
+    device_code text PRIMARY KEY,
+    user_code text NOT NULL UNIQUE,
+    expires_at timestamp with time zone NOT NULL,
+    poll_interval integer NOT NULL,
+    last_polled_at timestamp with time zone,
+    token_type text,
+    access_token text,
+    nonce text,
+    token_expires_at timestamp with time zone
+  );
+
+  CREATE INDEX device_requests_expires_at_idx ON device_requests (expires_at);
+
+COMMIT;
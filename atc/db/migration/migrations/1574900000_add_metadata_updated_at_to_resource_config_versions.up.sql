@@ -0,0 +1,5 @@
+BEGIN;
+
+  ALTER TABLE resource_config_versions ADD COLUMN metadata_updated_at timestamp with time zone NOT NULL DEFAULT now();
+
+COMMIT;
@@ -129,6 +129,7 @@ var encryptedColumns = []encryptedColumn{
 	{"cert_cache", "cert", "domain"},
 	{"checks", "plan", "id"},
 	{"pipelines", "var_sources", "id"},
+	{"device_requests", "access_token", "device_code"},
 }
 
 func encryptPlaintext(logger lager.Logger, sqlDB *sql.DB, key *encryption.Key) error {
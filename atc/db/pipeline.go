@@ -58,7 +58,7 @@ type Pipeline interface {
 	Builds(page Page) ([]Build, Pagination, error)
 
 	CreateOneOffBuild() (Build, error)
-	CreateStartedBuild(plan atc.Plan) (Build, error)
+	CreateStartedBuild(plan atc.Plan, createdBy string) (Build, error)
 
 	GetAllPendingBuilds() (map[string][]Build, error)
 	BuildsWithTime(page Page) ([]Build, Pagination, error)
@@ -87,6 +87,9 @@ type Pipeline interface {
 	Pause() error
 	Unpause() error
 
+	Freeze(buildID int, comment string) ([]atc.FrozenResource, bool, error)
+	Unfreeze() (bool, error)
+
 	Destroy() error
 	Rename(string) error
 
@@ -669,6 +672,178 @@ func (p *pipeline) Unpause() error {
 	return err
 }
 
+// Freeze pins every resource used by the build's inputs and outputs to the
+// version the build used, remembering the previous pins so that Unfreeze can
+// restore them. Freezing an already frozen pipeline keeps the pins from
+// before the first freeze. It returns false if the build does not belong to
+// the pipeline.
+func (p *pipeline) Freeze(buildID int, comment string) ([]atc.FrozenResource, bool, error) {
+	tx, err := p.conn.Begin()
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer Rollback(tx)
+
+	var exists bool
+	err = tx.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1 FROM builds WHERE id = $1 AND pipeline_id = $2
+		)`, buildID, p.id).Scan(&exists)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !exists {
+		return nil, false, nil
+	}
+
+	// prefer the version a build fetched over the one it produced
+	rows, err := tx.Query(`
+		SELECT DISTINCT ON (r.name) r.id, r.name, v.version
+		FROM (
+			SELECT resource_id, version_md5, 0 AS preference
+			FROM build_resource_config_version_inputs
+			WHERE build_id = $1
+			UNION ALL
+			SELECT resource_id, version_md5, 1 AS preference
+			FROM build_resource_config_version_outputs
+			WHERE build_id = $1
+		) io
+		JOIN resources r ON r.id = io.resource_id
+		JOIN resource_config_versions v ON v.version_md5 = io.version_md5
+			AND v.resource_config_scope_id = r.resource_config_scope_id
+		WHERE r.pipeline_id = $2 AND r.active
+		ORDER BY r.name, io.preference`, buildID, p.id)
+	if err != nil {
+		return nil, false, err
+	}
+
+	resourceIDs := []int{}
+	versions := []string{}
+	frozen := []atc.FrozenResource{}
+	for rows.Next() {
+		var (
+			resourceID    int
+			resourceName  string
+			versionString string
+		)
+
+		err = rows.Scan(&resourceID, &resourceName, &versionString)
+		if err != nil {
+			Close(rows)
+			return nil, false, err
+		}
+
+		var version atc.Version
+		err = json.Unmarshal([]byte(versionString), &version)
+		if err != nil {
+			Close(rows)
+			return nil, false, err
+		}
+
+		resourceIDs = append(resourceIDs, resourceID)
+		versions = append(versions, versionString)
+		frozen = append(frozen, atc.FrozenResource{
+			Resource: resourceName,
+			Version:  version,
+		})
+	}
+
+	Close(rows)
+
+	for i, resourceID := range resourceIDs {
+		_, err = tx.Exec(`
+			INSERT INTO frozen_resource_pins (resource_id, version, comment_text)
+			SELECT r.id, rp.version, COALESCE(rp.comment_text, '')
+			FROM resources r
+			LEFT JOIN resource_pins rp ON rp.resource_id = r.id
+			WHERE r.id = $1
+			ON CONFLICT (resource_id) DO NOTHING`, resourceID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		_, err = tx.Exec(`
+			INSERT INTO resource_pins (resource_id, version, comment_text)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (resource_id) DO UPDATE SET
+				version = EXCLUDED.version,
+				comment_text = EXCLUDED.comment_text`, resourceID, versions[i], comment)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, false, err
+	}
+
+	return frozen, true, nil
+}
+
+// Unfreeze restores the pins the pipeline's resources had before it was
+// frozen. It returns false if the pipeline is not frozen.
+func (p *pipeline) Unfreeze() (bool, error) {
+	tx, err := p.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	_, err = tx.Exec(`
+		DELETE FROM resource_pins rp
+		USING frozen_resource_pins f, resources r
+		WHERE rp.resource_id = f.resource_id
+		AND r.id = f.resource_id
+		AND r.pipeline_id = $1
+		AND f.version IS NULL`, p.id)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO resource_pins (resource_id, version, comment_text)
+		SELECT f.resource_id, f.version, f.comment_text
+		FROM frozen_resource_pins f
+		JOIN resources r ON r.id = f.resource_id
+		WHERE r.pipeline_id = $1
+		AND f.version IS NOT NULL
+		ON CONFLICT (resource_id) DO UPDATE SET
+			version = EXCLUDED.version,
+			comment_text = EXCLUDED.comment_text`, p.id)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := tx.Exec(`
+		DELETE FROM frozen_resource_pins f
+		USING resources r
+		WHERE r.id = f.resource_id
+		AND r.pipeline_id = $1`, p.id)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (p *pipeline) Hide() error {
 	_, err := psql.Update("pipelines").
 		Set("public", false).
@@ -855,6 +1030,29 @@ func (p *pipeline) LoadVersionsDB() (*algorithm.VersionsDB, error) {
 		db.ResourceVersions = append(db.ResourceVersions, output)
 	}
 
+	rows, err = psql.Select("v.id, r.id, l.label").
+		From("resource_version_labels l").
+		Join("resource_config_versions v ON v.resource_config_scope_id = l.resource_config_scope_id AND v.version_md5 = l.version_md5").
+		Join("resources r ON r.resource_config_scope_id = l.resource_config_scope_id").
+		Where(sq.Eq{"r.pipeline_id": p.id}).
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var label algorithm.VersionLabel
+		err = rows.Scan(&label.VersionID, &label.ResourceID, &label.Label)
+		if err != nil {
+			return nil, err
+		}
+
+		db.VersionLabels = append(db.VersionLabels, label)
+	}
+
 	rows, err = psql.Select("j.name, j.id").
 		From("jobs j").
 		Where(sq.Eq{"j.pipeline_id": p.id}).
@@ -1029,7 +1227,7 @@ func (p *pipeline) CreateOneOffBuild() (Build, error) {
 	return build, nil
 }
 
-func (p *pipeline) CreateStartedBuild(plan atc.Plan) (Build, error) {
+func (p *pipeline) CreateStartedBuild(plan atc.Plan, createdBy string) (Build, error) {
 	tx, err := p.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -1047,6 +1245,11 @@ func (p *pipeline) CreateStartedBuild(plan atc.Plan) (Build, error) {
 		return nil, err
 	}
 
+	trigger, err := json.Marshal(atc.BuildTrigger{Type: atc.BuildTriggerTypeOneOff})
+	if err != nil {
+		return nil, err
+	}
+
 	build := newEmptyBuild(p.conn, p.lockFactory)
 	err = createBuild(tx, build, map[string]interface{}{
 		"name":         sq.Expr("nextval('one_off_name')"),
@@ -1058,6 +1261,8 @@ func (p *pipeline) CreateStartedBuild(plan atc.Plan) (Build, error) {
 		"private_plan": encryptedPlan,
 		"public_plan":  plan.Public(),
 		"nonce":        nonce,
+		"created_by":   createdBy,
+		"trigger":      trigger,
 	})
 	if err != nil {
 		return nil, err
@@ -233,6 +233,112 @@ var _ = Describe("Pipeline", func() {
 		})
 	})
 
+	Describe("Freeze", func() {
+		var (
+			resource      db.Resource
+			otherResource db.Resource
+			build         db.Build
+		)
+
+		BeforeEach(func() {
+			var found bool
+			var err error
+			resource, found, err = pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			otherResource, found, err = pipeline.Resource("some-other-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			resourceScope, err := resource.SetResourceConfig(atc.Source{"some": "source"}, atc.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = resourceScope.SaveVersions([]atc.Version{{"version": "1"}, {"version": "2"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			otherResourceScope, err := otherResource.SetResourceConfig(atc.Source{"some": "other-source"}, atc.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = otherResourceScope.SaveVersions([]atc.Version{{"version": "o1"}, {"version": "o2"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			pinnedVersion, found, err := otherResourceScope.FindVersion(atc.Version{"version": "o2"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			pinned, err := otherResource.PinVersion(pinnedVersion.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pinned).To(BeTrue())
+
+			Expect(otherResource.SetPinComment("before")).To(Succeed())
+
+			build, err = job.CreateBuild("some-user")
+			Expect(err).ToNot(HaveOccurred())
+
+			err = build.UseInputs([]db.BuildInput{{Name: "some-input", Version: atc.Version{"version": "1"}, ResourceID: resource.ID()}})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = build.SaveOutput("some-type", atc.Source{"some": "other-source"}, atc.VersionedResourceTypes{}, atc.Version{"version": "o1"}, nil, "some-output-name", "some-other-resource")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		reload := func(resource db.Resource) db.Resource {
+			found, err := resource.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			return resource
+		}
+
+		It("pins every resource to the version used by the build", func() {
+			frozen, found, err := pipeline.Freeze(build.ID(), "incident")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(frozen).To(Equal([]atc.FrozenResource{
+				{Resource: "some-other-resource", Version: atc.Version{"version": "o1"}},
+				{Resource: "some-resource", Version: atc.Version{"version": "1"}},
+			}))
+
+			Expect(reload(resource).APIPinnedVersion()).To(Equal(atc.Version{"version": "1"}))
+			Expect(resource.PinComment()).To(Equal("incident"))
+			Expect(reload(otherResource).APIPinnedVersion()).To(Equal(atc.Version{"version": "o1"}))
+			Expect(otherResource.PinComment()).To(Equal("incident"))
+		})
+
+		It("restores the previous pins when unfrozen, even if frozen twice", func() {
+			_, _, err := pipeline.Freeze(build.ID(), "incident")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, err = pipeline.Freeze(build.ID(), "still an incident")
+			Expect(err).ToNot(HaveOccurred())
+
+			unfrozen, err := pipeline.Unfreeze()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unfrozen).To(BeTrue())
+
+			Expect(reload(resource).APIPinnedVersion()).To(BeNil())
+			Expect(reload(otherResource).APIPinnedVersion()).To(Equal(atc.Version{"version": "o2"}))
+			Expect(otherResource.PinComment()).To(Equal("before"))
+		})
+
+		It("does not unfreeze a pipeline that is not frozen", func() {
+			unfrozen, err := pipeline.Unfreeze()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unfrozen).To(BeFalse())
+		})
+
+		Context("when the build belongs to another pipeline", func() {
+			It("returns false", func() {
+				otherBuild, err := defaultJob.CreateBuild("some-user")
+				Expect(err).ToNot(HaveOccurred())
+
+				_, found, err := pipeline.Freeze(otherBuild.ID(), "incident")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("Rename", func() {
 		JustBeforeEach(func() {
 			Expect(pipeline.Rename("oopsies")).To(Succeed())
@@ -575,7 +681,7 @@ var _ = Describe("Pipeline", func() {
 			}))
 
 			By("including outputs of successful builds")
-			build1DB, err := aJob.CreateBuild()
+			build1DB, err := aJob.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			err = build1DB.SaveOutput("some-type", atc.Source{"source-config": "some-value"}, atc.VersionedResourceTypes{}, atc.Version{"version": "1"}, nil, "some-output-name", "some-resource")
@@ -622,7 +728,7 @@ var _ = Describe("Pipeline", func() {
 			}))
 
 			By("not including outputs of failed builds")
-			build2DB, err := aJob.CreateBuild()
+			build2DB, err := aJob.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			err = build2DB.SaveOutput("some-type", atc.Source{"source-config": "some-value"}, atc.VersionedResourceTypes{}, atc.Version{"version": "1"}, nil, "some-output-name", "some-resource")
@@ -671,7 +777,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			otherPipelineBuild, err := anotherJob.CreateBuild()
+			otherPipelineBuild, err := anotherJob.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			err = otherPipelineBuild.SaveOutput("some-type", atc.Source{"other-source-config": "some-other-value"}, atc.VersionedResourceTypes{}, atc.Version{"version": "1"}, nil, "some-output-name", "some-other-resource")
@@ -720,7 +826,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build1DB, err = aJob.CreateBuild()
+			build1DB, err = aJob.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			err = build1DB.UseInputs([]db.BuildInput{
@@ -864,7 +970,7 @@ var _ = Describe("Pipeline", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				err = resourceConfigScope.SaveVersions([]atc.Version{
@@ -895,7 +1001,7 @@ var _ = Describe("Pipeline", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				beforeVR, found, err := resourceConfigScope.LatestVersion()
@@ -1001,7 +1107,7 @@ var _ = Describe("Pipeline", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build1, err := aJob.CreateBuild()
+				build1, err := aJob.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				err = resourceConfigScope.SaveVersions([]atc.Version{{"version": "disabled"}})
@@ -1146,7 +1252,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			By("populating build inputs")
@@ -1187,7 +1293,7 @@ var _ = Describe("Pipeline", func() {
 	Describe("GetPendingBuilds/GetAllPendingBuilds", func() {
 		Context("when a build is created", func() {
 			BeforeEach(func() {
-				_, err := job.CreateBuild()
+				_, err := job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -1240,7 +1346,7 @@ var _ = Describe("Pipeline", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err = job.CreateBuild()
+				build, err = job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				savedResource, _, err = pipeline.Resource("some-resource")
@@ -1313,7 +1419,7 @@ var _ = Describe("Pipeline", func() {
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					otherBuild, err := job.CreateBuild()
+					otherBuild, err := job.CreateBuild("some-user")
 					Expect(err).ToNot(HaveOccurred())
 
 					otherSavedResource, _, err := otherPipeline.Resource("some-other-resource")
@@ -1398,7 +1504,7 @@ var _ = Describe("Pipeline", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(created).To(BeTrue())
 
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				err = build.UseInputs([]db.BuildInput{{Name: "some-resource", Version: atc.Version{"version": "1"}, ResourceID: resource.ID()}})
@@ -1486,7 +1592,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			firstJobBuild, err := job.CreateBuild()
+			firstJobBuild, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			actualDashboard, err = pipeline.Dashboard()
@@ -1518,7 +1624,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			secondJobBuild, err := job.CreateBuild()
+			secondJobBuild, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			actualDashboard, err = pipeline.Dashboard()
@@ -1697,12 +1803,12 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild("some-user")
 
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, build)
 
-			secondBuild, err := job.CreateBuild()
+			secondBuild, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, secondBuild)
 
@@ -1710,7 +1816,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			_, err = someOtherJob.CreateBuild()
+			_, err = someOtherJob.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			dbBuild, found, err := buildFactory.Build(build.ID())
@@ -1832,11 +1938,11 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, build)
 
-			secondBuild, err = job.CreateBuild()
+			secondBuild, err = job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, secondBuild)
 
@@ -1844,7 +1950,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			_, err = someOtherJob.CreateBuild()
+			_, err = someOtherJob.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			dbBuild, found, err := buildFactory.Build(build.ID())
@@ -1944,11 +2050,11 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, build)
 
-			secondBuild, err := job.CreateBuild()
+			secondBuild, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, secondBuild)
 
@@ -1956,7 +2062,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			thirdBuild, err := someOtherJob.CreateBuild()
+			thirdBuild, err := someOtherJob.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, thirdBuild)
 		})
@@ -2001,7 +2107,7 @@ var _ = Describe("Pipeline", func() {
 				},
 			}
 
-			startedBuild, err = pipeline.CreateStartedBuild(plan)
+			startedBuild, err = pipeline.CreateStartedBuild(plan, "some-user")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -2193,7 +2299,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(found).To(BeTrue())
 
 			for i := range builds {
-				builds[i], err = job.CreateBuild()
+				builds[i], err = job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				buildStart := time.Date(2020, 11, i+1, 0, 0, 0, 0, time.UTC)
@@ -2212,7 +2318,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			_, err = otherJob.CreateBuild()
+			_, err = otherJob.CreateBuild("some-user")
 		})
 
 		Context("when not providing boundaries", func() {
@@ -4,5 +4,6 @@ import sq "github.com/Masterminds/squirrel"
 
 const pqUniqueViolationErrCode = "unique_violation"
 const pqFKeyViolationErrCode = "foreign_key_violation"
+const pqLockNotAvailableErrCode = "lock_not_available"
 
 var psql = sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
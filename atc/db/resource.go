@@ -10,6 +10,7 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/lib/pq"
+	"github.com/patrickmn/go-cache"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db/lock"
@@ -46,6 +47,8 @@ type Resource interface {
 	CurrentPinnedVersion() atc.Version
 
 	ResourceConfigVersionID(atc.Version) (int, bool, error)
+	FilteredVersionIDs(atc.VersionFilter) ([]int, error)
+	FilteredVersions(filter atc.VersionFilter, limit int) ([]atc.ResourceVersion, error)
 	Versions(page Page, versionFilter atc.Version) ([]atc.ResourceVersion, Pagination, bool, error)
 	SaveUncheckedVersion(atc.Version, ResourceConfigMetadataFields, ResourceConfig, atc.VersionedResourceTypes) (bool, error)
 	UpdateMetadata(atc.Version, ResourceConfigMetadataFields) (bool, error)
@@ -56,6 +59,9 @@ type Resource interface {
 	PinVersion(rcvID int) (bool, error)
 	UnpinVersion() error
 
+	AddVersionLabel(rcvID int, label string, setBy string) (bool, error)
+	RemoveVersionLabel(rcvID int, label string) (bool, error)
+
 	SetResourceConfig(atc.Source, atc.VersionedResourceTypes) (ResourceConfigScope, error)
 	SetCheckSetupError(error) error
 	NotifyScan() error
@@ -321,6 +327,7 @@ func (r *resource) UpdateMetadata(version atc.Version, metadata ResourceConfigMe
 
 	_, err = psql.Update("resource_config_versions").
 		Set("metadata", string(metadataJSON)).
+		Set("metadata_updated_at", sq.Expr("now()")).
 		Where(sq.Eq{
 			"resource_config_scope_id": r.ResourceConfigScopeID(),
 		}).
@@ -336,6 +343,7 @@ func (r *resource) UpdateMetadata(version atc.Version, metadata ResourceConfigMe
 		}
 		return false, err
 	}
+
 	return true, nil
 }
 
@@ -368,6 +376,198 @@ func (r *resource) ResourceConfigVersionID(version atc.Version) (int, bool, erro
 	return id, true, nil
 }
 
+// filteredVersionIDsCache remembers the versions matching each filter of a
+// resource config scope, so that the versions only have to be loaded and
+// matched again once the scope's versions change.
+var filteredVersionIDsCache = cache.New(10*time.Minute, 10*time.Minute)
+
+type filteredVersionIDs struct {
+	maxCheckOrder     int
+	count             int
+	metadataUpdatedAt time.Time
+	ids               []int
+}
+
+// FilteredVersionIDs returns the IDs of the resource's versions which match
+// the filter, newest first. The result must not be modified.
+//
+// Results are cached until a version is added to, removed from or reordered
+// within the scope, or the metadata of one of its versions changes. These are
+// all read from the database, so changes made by any ATC are picked up.
+func (r *resource) FilteredVersionIDs(filter atc.VersionFilter) ([]int, error) {
+	filterJSON, err := json.Marshal(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	key := strconv.Itoa(r.ResourceConfigScopeID()) + "/" + string(filterJSON)
+
+	var current filteredVersionIDs
+	var metadataUpdatedAt pq.NullTime
+	err = psql.Select("COALESCE(MAX(check_order), 0)", "COUNT(*)", "MAX(metadata_updated_at)").
+		From("resource_config_versions").
+		Where(sq.Eq{"resource_config_scope_id": r.ResourceConfigScopeID()}).
+		Where(sq.NotEq{"check_order": 0}).
+		RunWith(r.conn).
+		QueryRow().
+		Scan(&current.maxCheckOrder, &current.count, &metadataUpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	current.metadataUpdatedAt = metadataUpdatedAt.Time
+
+	if cached, found := filteredVersionIDsCache.Get(key); found {
+		entry := cached.(filteredVersionIDs)
+		if entry.maxCheckOrder == current.maxCheckOrder &&
+			entry.count == current.count &&
+			entry.metadataUpdatedAt.Equal(current.metadataUpdatedAt) {
+			return entry.ids, nil
+		}
+	}
+
+	matcher, err := filter.Matcher()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := psql.Select("id", "version", "metadata").
+		From("resource_config_versions").
+		Where(sq.Eq{"resource_config_scope_id": r.ResourceConfigScopeID()}).
+		Where(sq.NotEq{"check_order": 0}).
+		OrderBy("check_order DESC").
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	current.ids = []int{}
+	for rows.Next() {
+		var (
+			id            int
+			versionBytes  string
+			metadataBytes sql.NullString
+		)
+
+		err = rows.Scan(&id, &versionBytes, &metadataBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		var version atc.Version
+		err = json.Unmarshal([]byte(versionBytes), &version)
+		if err != nil {
+			return nil, err
+		}
+
+		var metadata []atc.MetadataField
+		if metadataBytes.Valid {
+			err = json.Unmarshal([]byte(metadataBytes.String), &metadata)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if matcher.Matches(version, metadata) {
+			current.ids = append(current.ids, id)
+		}
+	}
+
+	filteredVersionIDsCache.SetDefault(key, current)
+
+	return current.ids, nil
+}
+
+// FilteredVersions returns up to limit of the resource's versions which match
+// the filter, newest first.
+func (r *resource) FilteredVersions(filter atc.VersionFilter, limit int) ([]atc.ResourceVersion, error) {
+	ids, err := r.FilteredVersionIDs(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	if len(ids) == 0 {
+		return []atc.ResourceVersion{}, nil
+	}
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Rollback(tx)
+
+	rows, err := tx.Query(`
+		SELECT v.id, v.version, v.metadata,
+			NOT EXISTS (
+				SELECT 1
+				FROM resource_disabled_versions d
+				WHERE v.version_md5 = d.version_md5
+				AND r.resource_config_scope_id = v.resource_config_scope_id
+				AND r.id = d.resource_id
+			)
+		FROM resource_config_versions v, resources r
+		WHERE r.id = $1 AND r.resource_config_scope_id = v.resource_config_scope_id AND v.id = ANY($2)
+		ORDER BY v.check_order DESC
+	`, r.id, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	rvs := []atc.ResourceVersion{}
+	for rows.Next() {
+		var (
+			metadataBytes sql.NullString
+			versionBytes  string
+		)
+
+		rv := atc.ResourceVersion{}
+		err := rows.Scan(&rv.ID, &versionBytes, &metadataBytes, &rv.Enabled)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal([]byte(versionBytes), &rv.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		if metadataBytes.Valid {
+			err = json.Unmarshal([]byte(metadataBytes.String), &rv.Metadata)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		rvs = append(rvs, rv)
+	}
+
+	labels, err := r.versionLabels(tx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, rv := range rvs {
+		rvs[i].Labels = labels[rv.ID]
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return rvs, nil
+}
+
 func (r *resource) SetPinComment(comment string) error {
 	_, err := psql.Update("resource_pins").
 		Set("comment_text", comment).
@@ -530,6 +730,20 @@ func (r *resource) Versions(page Page, versionFilter atc.Version) ([]atc.Resourc
 		return nil, Pagination{}, true, nil
 	}
 
+	rcvIDs := []int{}
+	for _, rv := range rvs {
+		rcvIDs = append(rcvIDs, rv.ID)
+	}
+
+	labels, err := r.versionLabels(tx, rcvIDs)
+	if err != nil {
+		return nil, Pagination{}, false, err
+	}
+
+	for i, rv := range rvs {
+		rvs[i].Labels = labels[rv.ID]
+	}
+
 	var minCheckOrder int
 	var maxCheckOrder int
 
@@ -627,6 +841,127 @@ func (r *resource) UnpinVersion() error {
 	return nil
 }
 
+// AddVersionLabel labels a version of the resource. Labels belong to the
+// version, so every resource sharing its version history sees them.
+// Labelling a version again records who labelled it most recently. It returns
+// false if the version does not belong to the resource.
+func (r *resource) AddVersionLabel(rcvID int, label string, setBy string) (bool, error) {
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	results, err := tx.Exec(`
+		INSERT INTO resource_version_labels (resource_config_scope_id, version_md5, label, set_by)
+		SELECT rcv.resource_config_scope_id, rcv.version_md5, $3, $4
+		FROM resource_config_versions rcv
+		JOIN resources r ON r.resource_config_scope_id = rcv.resource_config_scope_id
+		WHERE r.id = $1 AND rcv.id = $2
+		ON CONFLICT (resource_config_scope_id, version_md5, label) DO UPDATE SET
+			set_by = EXCLUDED.set_by,
+			build_id = NULL,
+			created_at = now()
+		`, r.id, rcvID, label, setBy)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := results.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if rowsAffected != 1 {
+		return false, nil
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, bumpCacheIndexForPipelinesUsingResourceConfigScope(r.conn, r.ResourceConfigScopeID())
+}
+
+// RemoveVersionLabel removes a label from a version of the resource. It
+// returns false if the version did not carry the label.
+func (r *resource) RemoveVersionLabel(rcvID int, label string) (bool, error) {
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	results, err := tx.Exec(`
+		DELETE FROM resource_version_labels l
+		USING resource_config_versions rcv, resources r
+		WHERE r.id = $1
+		AND rcv.id = $2
+		AND rcv.resource_config_scope_id = r.resource_config_scope_id
+		AND l.resource_config_scope_id = rcv.resource_config_scope_id
+		AND l.version_md5 = rcv.version_md5
+		AND l.label = $3
+		`, r.id, rcvID, label)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := results.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if rowsAffected != 1 {
+		return false, nil
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, bumpCacheIndexForPipelinesUsingResourceConfigScope(r.conn, r.ResourceConfigScopeID())
+}
+
+func (r *resource) versionLabels(tx Tx, rcvIDs []int) (map[int][]atc.VersionLabel, error) {
+	rows, err := psql.Select("v.id", "l.label", "l.set_by", "l.created_at").
+		From("resource_version_labels l").
+		Join("resource_config_versions v ON v.resource_config_scope_id = l.resource_config_scope_id AND v.version_md5 = l.version_md5").
+		Join("resources r ON r.resource_config_scope_id = l.resource_config_scope_id").
+		Where(sq.Eq{"r.id": r.id}).
+		Where(sq.Expr("v.id = ANY(?)", pq.Array(rcvIDs))).
+		OrderBy("l.created_at", "l.label").
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	labels := map[int][]atc.VersionLabel{}
+	for rows.Next() {
+		var (
+			rcvID int
+			label atc.VersionLabel
+			setAt time.Time
+		)
+
+		err = rows.Scan(&rcvID, &label.Label, &label.SetBy, &setAt)
+		if err != nil {
+			return nil, err
+		}
+
+		label.SetAt = setAt.Unix()
+		labels[rcvID] = append(labels[rcvID], label)
+	}
+
+	return labels, nil
+}
+
 func (r *resource) toggleVersion(rcvID int, enable bool) error {
 	tx, err := r.conn.Begin()
 	if err != nil {
@@ -282,7 +282,12 @@ func saveResourceVersion(tx Tx, rcsID int, version atc.Version, metadata Resourc
 		INSERT INTO resource_config_versions (resource_config_scope_id, version, version_md5, metadata)
 		SELECT $1, $2, md5($3), $4
 		ON CONFLICT (resource_config_scope_id, version_md5)
-		DO UPDATE SET metadata = COALESCE(NULLIF(excluded.metadata, 'null'::jsonb), resource_config_versions.metadata)
+		DO UPDATE SET
+			metadata = COALESCE(NULLIF(excluded.metadata, 'null'::jsonb), resource_config_versions.metadata),
+			metadata_updated_at = CASE
+				WHEN COALESCE(NULLIF(excluded.metadata, 'null'::jsonb), resource_config_versions.metadata) IS DISTINCT FROM resource_config_versions.metadata THEN now()
+				ELSE resource_config_versions.metadata_updated_at
+			END
 		RETURNING check_order
 		`, rcsID, string(versionJSON), string(versionJSON), string(metadataJSON)).Scan(&checkOrder)
 	if err != nil {
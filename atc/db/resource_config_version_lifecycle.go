@@ -0,0 +1,228 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+//go:generate counterfeiter . ResourceConfigVersionLifecycle
+
+type ResourceConfigVersionLifecycle interface {
+	RemoveExpiredVersions() (int, error)
+}
+
+type resourceConfigVersionLifecycle struct {
+	conn Conn
+}
+
+func NewResourceConfigVersionLifecycle(conn Conn) *resourceConfigVersionLifecycle {
+	return &resourceConfigVersionLifecycle{
+		conn: conn,
+	}
+}
+
+type scopeRetention struct {
+	atc.VersionRetentionConfig
+
+	unlimited bool
+	pins      []string
+}
+
+// RemoveExpiredVersions deletes the versions of every resource config scope
+// that fall outside the retention policies of the resources using the scope.
+// A scope is only pruned if all of its active resources configure a policy,
+// and a version is kept if any of those policies would keep it. The newest
+// version, versions used by builds and pinned versions are never deleted.
+func (lifecycle *resourceConfigVersionLifecycle) RemoveExpiredVersions() (int, error) {
+	retentions, err := lifecycle.scopeRetentions()
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for scopeID, retention := range retentions {
+		if retention.unlimited {
+			continue
+		}
+
+		count, err := lifecycle.removeExpiredVersionsOfScope(scopeID, retention)
+		if err != nil {
+			return 0, err
+		}
+
+		deleted += count
+	}
+
+	return deleted, nil
+}
+
+func (lifecycle *resourceConfigVersionLifecycle) scopeRetentions() (map[int]*scopeRetention, error) {
+	rows, err := psql.Select("r.resource_config_scope_id", "r.config", "r.nonce", "rp.version").
+		From("resources r").
+		LeftJoin("resource_pins rp ON rp.resource_id = r.id").
+		Where(sq.And{
+			sq.Eq{"r.active": true},
+			sq.NotEq{"r.resource_config_scope_id": nil},
+		}).
+		RunWith(lifecycle.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	es := lifecycle.conn.EncryptionStrategy()
+
+	retentions := map[int]*scopeRetention{}
+	for rows.Next() {
+		var (
+			scopeID          int
+			configBlob       []byte
+			nonce, pinnedVer sql.NullString
+		)
+
+		err = rows.Scan(&scopeID, &configBlob, &nonce, &pinnedVer)
+		if err != nil {
+			return nil, err
+		}
+
+		var noncense *string
+		if nonce.Valid {
+			noncense = &nonce.String
+		}
+
+		decryptedConfig, err := es.Decrypt(string(configBlob), noncense)
+		if err != nil {
+			return nil, err
+		}
+
+		var config atc.ResourceConfig
+		err = json.Unmarshal(decryptedConfig, &config)
+		if err != nil {
+			return nil, err
+		}
+
+		retention, found := retentions[scopeID]
+		if !found {
+			retention = &scopeRetention{}
+			retentions[scopeID] = retention
+		}
+
+		if config.VersionRetention == nil {
+			retention.unlimited = true
+			continue
+		}
+
+		if config.VersionRetention.Latest > retention.Latest {
+			retention.Latest = config.VersionRetention.Latest
+		}
+
+		if config.VersionRetention.Days > retention.Days {
+			retention.Days = config.VersionRetention.Days
+		}
+
+		if config.Version != nil {
+			pin, err := json.Marshal(config.Version)
+			if err != nil {
+				return nil, err
+			}
+
+			retention.pins = append(retention.pins, string(pin))
+		}
+
+		if pinnedVer.Valid {
+			retention.pins = append(retention.pins, pinnedVer.String)
+		}
+	}
+
+	return retentions, nil
+}
+
+func (lifecycle *resourceConfigVersionLifecycle) removeExpiredVersionsOfScope(scopeID int, retention *scopeRetention) (int, error) {
+	latest := retention.Latest
+	if latest < 1 {
+		latest = 1
+	}
+
+	conditions := sq.And{
+		sq.Eq{"v.resource_config_scope_id": scopeID},
+		sq.NotEq{"v.check_order": 0},
+		sq.Expr(`v.id NOT IN (
+			SELECT id FROM resource_config_versions
+			WHERE resource_config_scope_id = ?
+			ORDER BY check_order DESC
+			LIMIT ?
+		)`, scopeID, latest),
+		sq.Expr(`NOT EXISTS (
+			SELECT 1 FROM build_resource_config_version_inputs i
+			JOIN resources r ON r.id = i.resource_id
+			WHERE r.resource_config_scope_id = v.resource_config_scope_id
+			AND i.version_md5 = v.version_md5
+		)`),
+		sq.Expr(`NOT EXISTS (
+			SELECT 1 FROM build_resource_config_version_outputs o
+			JOIN resources r ON r.id = o.resource_id
+			WHERE r.resource_config_scope_id = v.resource_config_scope_id
+			AND o.version_md5 = v.version_md5
+		)`),
+		sq.Expr(`NOT EXISTS (
+			SELECT 1 FROM next_build_inputs n
+			WHERE n.resource_config_version_id = v.id
+		)`),
+		sq.Expr(`NOT EXISTS (
+			SELECT 1 FROM independent_build_inputs n
+			WHERE n.resource_config_version_id = v.id
+		)`),
+		sq.Expr("NOT (v.version @> ANY(?::jsonb[]))", pq.Array(retention.pins)),
+	}
+
+	if retention.Days > 0 {
+		conditions = append(conditions, sq.Expr("v.created_at < now() - ?::interval", fmt.Sprintf("%d days", retention.Days)))
+	}
+
+	tx, err := lifecycle.conn.Begin()
+	if err != nil {
+		return 0, err
+	}
+
+	defer Rollback(tx)
+
+	result, err := psql.Delete("resource_config_versions v").
+		Where(conditions).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if affected > 0 {
+		// the versions DB of every pipeline using the scope must be reloaded
+		// so that the deleted versions are no longer chosen as inputs
+		_, err = psql.Update("pipelines").
+			Set("cache_index", sq.Expr("cache_index + 1")).
+			Where(sq.Expr("id IN (SELECT pipeline_id FROM resources WHERE resource_config_scope_id = ?)", scopeID)).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
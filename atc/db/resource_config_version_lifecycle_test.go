@@ -0,0 +1,153 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResourceConfigVersionLifecycle", func() {
+	var (
+		versionLifecycle db.ResourceConfigVersionLifecycle
+		retention        *atc.VersionRetentionConfig
+		pinnedVersion    atc.Version
+		resource         db.Resource
+		resourceScope    db.ResourceConfigScope
+		pipeline         db.Pipeline
+		cacheIndex       int
+		removedVersions  int
+		err              error
+	)
+
+	BeforeEach(func() {
+		versionLifecycle = db.NewResourceConfigVersionLifecycle(dbConn)
+		retention = &atc.VersionRetentionConfig{Latest: 2}
+		pinnedVersion = nil
+	})
+
+	pipelineCacheIndex := func(pipeline db.Pipeline) int {
+		var index int
+		err := dbConn.QueryRow("SELECT cache_index FROM pipelines WHERE id = $1", pipeline.ID()).Scan(&index)
+		Expect(err).NotTo(HaveOccurred())
+		return index
+	}
+
+	JustBeforeEach(func() {
+		var err error
+		pipeline, _, err = defaultTeam.SavePipeline("retention-pipeline", atc.Config{
+			Jobs: atc.JobConfigs{
+				{
+					Name: "some-job",
+					Plan: atc.PlanSequence{{Get: "some-resource"}},
+				},
+			},
+			Resources: atc.ResourceConfigs{
+				{
+					Name:             "some-resource",
+					Type:             "some-base-resource-type",
+					Source:           atc.Source{"some": "retained-source"},
+					VersionRetention: retention,
+				},
+			},
+		}, db.ConfigVersion(0), false)
+		Expect(err).NotTo(HaveOccurred())
+
+		var found bool
+		resource, found, err = pipeline.Resource("some-resource")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		resourceScope, err = resource.SetResourceConfig(atc.Source{"some": "retained-source"}, atc.VersionedResourceTypes{})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = resourceScope.SaveVersions([]atc.Version{
+			{"ref": "v1"},
+			{"ref": "v2"},
+			{"ref": "v3"},
+			{"ref": "v4"},
+			{"ref": "v5"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		if pinnedVersion != nil {
+			version, found, err := resourceScope.FindVersion(pinnedVersion)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			_, err = resource.PinVersion(version.ID())
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		cacheIndex = pipelineCacheIndex(pipeline)
+	})
+
+	remainingVersions := func() []string {
+		rows, err := dbConn.Query("SELECT version->>'ref' FROM resource_config_versions WHERE resource_config_scope_id = $1 ORDER BY check_order", resourceScope.ID())
+		Expect(err).NotTo(HaveOccurred())
+
+		defer db.Close(rows)
+
+		refs := []string{}
+		for rows.Next() {
+			var ref string
+			Expect(rows.Scan(&ref)).To(Succeed())
+			refs = append(refs, ref)
+		}
+
+		return refs
+	}
+
+	Describe("RemoveExpiredVersions", func() {
+		JustBeforeEach(func() {
+			removedVersions, err = versionLifecycle.RemoveExpiredVersions()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("keeps only the latest versions", func() {
+			Expect(removedVersions).To(Equal(3))
+			Expect(remainingVersions()).To(Equal([]string{"v4", "v5"}))
+		})
+
+		It("invalidates the versions DB of the pipeline", func() {
+			Expect(pipelineCacheIndex(pipeline)).To(BeNumerically(">", cacheIndex))
+		})
+
+		Context("when a version is pinned", func() {
+			BeforeEach(func() {
+				pinnedVersion = atc.Version{"ref": "v1"}
+			})
+
+			It("keeps the pinned version", func() {
+				Expect(removedVersions).To(Equal(2))
+				Expect(remainingVersions()).To(Equal([]string{"v1", "v4", "v5"}))
+			})
+		})
+
+		Context("when versions must also be older than a number of days", func() {
+			BeforeEach(func() {
+				retention = &atc.VersionRetentionConfig{Latest: 2, Days: 7}
+			})
+
+			It("keeps versions that are newer", func() {
+				Expect(removedVersions).To(Equal(0))
+				Expect(remainingVersions()).To(HaveLen(5))
+			})
+		})
+
+		Context("when the resource has no retention policy", func() {
+			BeforeEach(func() {
+				retention = nil
+			})
+
+			It("keeps every version", func() {
+				Expect(removedVersions).To(Equal(0))
+				Expect(remainingVersions()).To(HaveLen(5))
+			})
+
+			It("leaves the versions DB of the pipeline alone", func() {
+				Expect(pipelineCacheIndex(pipeline)).To(Equal(cacheIndex))
+			})
+		})
+	})
+})
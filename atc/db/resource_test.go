@@ -755,6 +755,156 @@ var _ = Describe("Resource", func() {
 		})
 	})
 
+	Describe("FilteredVersionIDs", func() {
+		var (
+			resource      db.Resource
+			resourceScope db.ResourceConfigScope
+			filter        atc.VersionFilter
+			ids           []int
+			filterErr     error
+		)
+
+		versionID := func(version atc.Version) int {
+			rcv, found, err := resourceScope.FindVersion(version)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			return rcv.ID()
+		}
+
+		BeforeEach(func() {
+			var err error
+			var found bool
+			resource, found, err = pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			setupTx, err := dbConn.Begin()
+			Expect(err).ToNot(HaveOccurred())
+
+			brt := db.BaseResourceType{
+				Name: "registry-image",
+			}
+
+			_, err = brt.FindOrCreate(setupTx, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(setupTx.Commit()).To(Succeed())
+
+			resourceScope, err = resource.SetResourceConfig(atc.Source{"some": "repository"}, atc.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			found, err = resource.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			err = resourceScope.SaveVersions([]atc.Version{
+				{"tag": "v1.0.0"},
+				{"tag": "v1.1.0"},
+				{"tag": "v2.0.0"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = resource.UpdateMetadata(atc.Version{"tag": "v1.0.0"}, db.ResourceConfigMetadataFields{
+				{Name: "branch", Value: "release"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			ids, filterErr = resource.FilteredVersionIDs(filter)
+		})
+
+		Context("when filtering on version fields", func() {
+			BeforeEach(func() {
+				filter = atc.VersionFilter{Fields: map[string]string{"tag": "v1.*"}}
+			})
+
+			It("returns the matching versions, newest first", func() {
+				Expect(filterErr).ToNot(HaveOccurred())
+				Expect(ids).To(Equal([]int{
+					versionID(atc.Version{"tag": "v1.1.0"}),
+					versionID(atc.Version{"tag": "v1.0.0"}),
+				}))
+			})
+
+			It("includes versions found after the last call", func() {
+				err := resourceScope.SaveVersions([]atc.Version{{"tag": "v1.2.0"}})
+				Expect(err).ToNot(HaveOccurred())
+
+				ids, err := resource.FilteredVersionIDs(filter)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ids).To(Equal([]int{
+					versionID(atc.Version{"tag": "v1.2.0"}),
+					versionID(atc.Version{"tag": "v1.1.0"}),
+					versionID(atc.Version{"tag": "v1.0.0"}),
+				}))
+			})
+		})
+
+		Context("when filtering on metadata", func() {
+			BeforeEach(func() {
+				filter = atc.VersionFilter{Fields: map[string]string{"branch": "release"}}
+			})
+
+			It("returns the matching versions", func() {
+				Expect(filterErr).ToNot(HaveOccurred())
+				Expect(ids).To(Equal([]int{versionID(atc.Version{"tag": "v1.0.0"})}))
+			})
+
+			It("includes versions whose metadata was updated after the last call", func() {
+				_, err := resource.UpdateMetadata(atc.Version{"tag": "v2.0.0"}, db.ResourceConfigMetadataFields{
+					{Name: "branch", Value: "release"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				ids, err := resource.FilteredVersionIDs(filter)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ids).To(Equal([]int{
+					versionID(atc.Version{"tag": "v2.0.0"}),
+					versionID(atc.Version{"tag": "v1.0.0"}),
+				}))
+			})
+		})
+
+		Context("when filtering on a semver range", func() {
+			BeforeEach(func() {
+				filter = atc.VersionFilter{Semver: ">=1.1"}
+			})
+
+			It("returns the matching versions", func() {
+				Expect(filterErr).ToNot(HaveOccurred())
+				Expect(ids).To(Equal([]int{
+					versionID(atc.Version{"tag": "v2.0.0"}),
+					versionID(atc.Version{"tag": "v1.1.0"}),
+				}))
+			})
+		})
+
+		Describe("FilteredVersions", func() {
+			BeforeEach(func() {
+				filter = atc.VersionFilter{Fields: map[string]string{"tag": "v1.*"}}
+			})
+
+			It("returns up to the limit of matching versions, newest first", func() {
+				err := resource.DisableVersion(versionID(atc.Version{"tag": "v1.1.0"}))
+				Expect(err).ToNot(HaveOccurred())
+
+				versions, err := resource.FilteredVersions(filter, 1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(versions).To(HaveLen(1))
+				Expect(versions[0].ID).To(Equal(versionID(atc.Version{"tag": "v1.1.0"})))
+				Expect(versions[0].Version).To(Equal(atc.Version{"tag": "v1.1.0"}))
+				Expect(versions[0].Enabled).To(BeFalse())
+			})
+
+			It("returns the metadata of the versions", func() {
+				versions, err := resource.FilteredVersions(filter, 10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(versions).To(HaveLen(2))
+				Expect(versions[1].Metadata).To(Equal([]atc.MetadataField{{Name: "branch", Value: "release"}}))
+			})
+		})
+	})
+
 	Context("Versions", func() {
 		var (
 			originalVersionSlice []atc.Version
@@ -1454,6 +1604,106 @@ var _ = Describe("Resource", func() {
 		})
 	})
 
+	Describe("AddVersionLabel/RemoveVersionLabel", func() {
+		var (
+			resource db.Resource
+			rcvID    int
+		)
+
+		BeforeEach(func() {
+			var found bool
+			var err error
+			resource, found, err = pipeline.Resource("some-other-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			setupTx, err := dbConn.Begin()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = db.BaseResourceType{Name: "git"}.FindOrCreate(setupTx, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(setupTx.Commit()).To(Succeed())
+
+			resourceScope, err := resource.SetResourceConfig(atc.Source{"some": "other-repository"}, atc.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = resourceScope.SaveVersions([]atc.Version{
+				{"version": "v1"},
+				{"version": "v2"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			rcv, found, err := resourceScope.FindVersion(atc.Version{"version": "v1"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			rcvID = rcv.ID()
+		})
+
+		labelsOf := func(version atc.Version) []string {
+			versions, _, found, err := resource.Versions(db.Page{Limit: 10}, version)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(versions).To(HaveLen(1))
+
+			labels := []string{}
+			for _, label := range versions[0].Labels {
+				labels = append(labels, label.Label)
+			}
+
+			return labels
+		}
+
+		It("labels the version with who set it", func() {
+			labeled, err := resource.AddVersionLabel(rcvID, "deployed-to-prod", "some-user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(labeled).To(BeTrue())
+
+			versions, _, found, err := resource.Versions(db.Page{Limit: 10}, atc.Version{"version": "v1"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(versions[0].Labels).To(HaveLen(1))
+			Expect(versions[0].Labels[0].Label).To(Equal("deployed-to-prod"))
+			Expect(versions[0].Labels[0].SetBy).To(Equal("some-user"))
+			Expect(versions[0].Labels[0].SetAt).ToNot(BeZero())
+
+			Expect(labelsOf(atc.Version{"version": "v2"})).To(BeEmpty())
+		})
+
+		It("includes the label in the versions db", func() {
+			_, err := resource.AddVersionLabel(rcvID, "deployed-to-prod", "some-user")
+			Expect(err).ToNot(HaveOccurred())
+
+			versionsDB, err := pipeline.LoadVersionsDB()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(versionsDB.VersionLabels).To(ConsistOf(algorithm.VersionLabel{
+				ResourceID: resource.ID(),
+				VersionID:  rcvID,
+				Label:      "deployed-to-prod",
+			}))
+		})
+
+		It("returns false when the version does not exist", func() {
+			labeled, err := resource.AddVersionLabel(-1, "deployed-to-prod", "some-user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(labeled).To(BeFalse())
+		})
+
+		It("removes the label", func() {
+			_, err := resource.AddVersionLabel(rcvID, "deployed-to-prod", "some-user")
+			Expect(err).ToNot(HaveOccurred())
+
+			removed, err := resource.RemoveVersionLabel(rcvID, "deployed-to-prod")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(BeTrue())
+
+			Expect(labelsOf(atc.Version{"version": "v1"})).To(BeEmpty())
+
+			removed, err = resource.RemoveVersionLabel(rcvID, "deployed-to-prod")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(BeFalse())
+		})
+	})
+
 	Describe("Public", func() {
 		var (
 			resource db.Resource
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"database/sql"
+)
+
+//go:generate counterfeiter . ServiceAccountTokenFactory
+
+type ServiceAccountTokenFactory interface {
+	UseToken(tokenHash string) (ServiceAccountAccess, bool, error)
+}
+
+// ServiceAccountAccess is what an API token of a service account grants.
+// An empty PipelineName grants the role for the whole team.
+type ServiceAccountAccess struct {
+	TeamName     string
+	AccountName  string
+	TokenName    string
+	Role         string
+	PipelineName string
+}
+
+type serviceAccountTokenFactory struct {
+	conn Conn
+}
+
+func NewServiceAccountTokenFactory(conn Conn) ServiceAccountTokenFactory {
+	return &serviceAccountTokenFactory{
+		conn: conn,
+	}
+}
+
+// UseToken finds the unexpired token with the given hash and records that
+// it was used. It returns false if there is no such token.
+func (factory *serviceAccountTokenFactory) UseToken(tokenHash string) (ServiceAccountAccess, bool, error) {
+	var access ServiceAccountAccess
+
+	err := factory.conn.QueryRow(`
+		UPDATE service_account_tokens t
+		SET last_used_at = now()
+		FROM service_accounts a, teams tm
+		WHERE a.id = t.service_account_id
+		AND tm.id = a.team_id
+		AND t.token_hash = $1
+		AND t.expires_at > now()
+		RETURNING tm.name, a.name, t.name, t.role, COALESCE((SELECT p.name FROM pipelines p WHERE p.id = t.pipeline_id), '')
+	`, tokenHash).Scan(&access.TeamName, &access.AccountName, &access.TokenName, &access.Role, &access.PipelineName)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ServiceAccountAccess{}, false, nil
+		}
+
+		return ServiceAccountAccess{}, false, err
+	}
+
+	return access, true, nil
+}
@@ -0,0 +1,78 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ServiceAccountTokenFactory", func() {
+	var (
+		tokenFactory db.ServiceAccountTokenFactory
+		expiresAt    time.Time
+	)
+
+	BeforeEach(func() {
+		tokenFactory = db.NewServiceAccountTokenFactory(dbConn)
+		expiresAt = time.Now().Add(time.Hour)
+	})
+
+	JustBeforeEach(func() {
+		_, err := defaultTeam.CreateServiceAccount("deploy-bot")
+		Expect(err).ToNot(HaveOccurred())
+
+		created, err := defaultTeam.CreateServiceAccountToken("deploy-bot", atc.ServiceAccountToken{
+			Name:      "ci",
+			Role:      "pipeline-operator",
+			Pipeline:  defaultPipeline.Name(),
+			ExpiresAt: expiresAt.Unix(),
+		}, "some-hash")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created).To(BeTrue())
+	})
+
+	Describe("UseToken", func() {
+		It("returns what the token grants", func() {
+			access, found, err := tokenFactory.UseToken("some-hash")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(access).To(Equal(db.ServiceAccountAccess{
+				TeamName:     defaultTeam.Name(),
+				AccountName:  "deploy-bot",
+				TokenName:    "ci",
+				Role:         "pipeline-operator",
+				PipelineName: defaultPipeline.Name(),
+			}))
+		})
+
+		It("records when the token was last used", func() {
+			_, _, err := tokenFactory.UseToken("some-hash")
+			Expect(err).ToNot(HaveOccurred())
+
+			accounts, err := defaultTeam.ServiceAccounts()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(accounts[0].Tokens[0].LastUsedAt).ToNot(BeZero())
+		})
+
+		It("does not find unknown tokens", func() {
+			_, found, err := tokenFactory.UseToken("other-hash")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when the token has expired", func() {
+			BeforeEach(func() {
+				expiresAt = time.Now().Add(-time.Minute)
+			})
+
+			It("does not find it", func() {
+				_, found, err := tokenFactory.UseToken("some-hash")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -18,6 +18,7 @@ import (
 )
 
 var ErrConfigComparisonFailed = errors.New("comparison with existing config failed during save")
+var ErrServiceAccountTokenExists = errors.New("service account already has a token with this name")
 
 //go:generate counterfeiter . Team
 
@@ -27,6 +28,7 @@ type Team interface {
 	Admin() bool
 
 	Auth() atc.TeamAuth
+	DefaultJobPriority() int
 
 	Delete() error
 	Rename(string) error
@@ -44,7 +46,7 @@ type Team interface {
 	OrderPipelines([]string) error
 
 	CreateOneOffBuild() (Build, error)
-	CreateStartedBuild(plan atc.Plan) (Build, error)
+	CreateStartedBuild(plan atc.Plan, createdBy string) (Build, error)
 
 	PrivateAndPublicBuilds(Page) ([]Build, Pagination, error)
 	Builds(page Page) ([]Build, Pagination, error)
@@ -53,6 +55,7 @@ type Team interface {
 	SaveWorker(atcWorker atc.Worker, ttl time.Duration) (Worker, error)
 	Workers() ([]Worker, error)
 	FindVolumeForWorkerArtifact(int) (CreatedVolume, bool, error)
+	FindWorkerArtifact(int) (WorkerArtifact, bool, error)
 
 	Containers() ([]Container, error)
 	IsCheckContainer(string) (bool, error)
@@ -66,6 +69,18 @@ type Team interface {
 	FindWorkerForVolume(handle string) (Worker, bool, error)
 
 	UpdateProviderAuth(auth atc.TeamAuth) error
+	UpdateDefaultJobPriority(priority int) error
+
+	Locks() ([]atc.Lock, error)
+	ReleaseLock(name string) (bool, error)
+
+	PendingBuildQueue() ([]Build, error)
+
+	ServiceAccounts() ([]atc.ServiceAccount, error)
+	CreateServiceAccount(name string) (bool, error)
+	DestroyServiceAccount(name string) (bool, error)
+	CreateServiceAccountToken(accountName string, token atc.ServiceAccountToken, tokenHash string) (bool, error)
+	RevokeServiceAccountToken(accountName string, tokenName string) (bool, error)
 }
 
 type team struct {
@@ -77,6 +92,8 @@ type team struct {
 	admin bool
 
 	auth atc.TeamAuth
+
+	defaultJobPriority int
 }
 
 func (t *team) ID() int      { return t.id }
@@ -85,6 +102,8 @@ func (t *team) Admin() bool  { return t.admin }
 
 func (t *team) Auth() atc.TeamAuth { return t.auth }
 
+func (t *team) DefaultJobPriority() int { return t.defaultJobPriority }
+
 func (t *team) Delete() error {
 	_, err := psql.Delete("teams").
 		Where(sq.Eq{
@@ -140,6 +159,41 @@ func (t *team) FindVolumeForWorkerArtifact(artifactID int) (CreatedVolume, bool,
 	return artifact.Volume(t.ID())
 }
 
+func (t *team) FindWorkerArtifact(artifactID int) (WorkerArtifact, bool, error) {
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer Rollback(tx)
+
+	artifact, found, err := getWorkerArtifact(tx, t.conn, artifactID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	// artifacts are only visible to the team owning their volume
+	_, found, err = artifact.Volume(t.ID())
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	return artifact, true, nil
+}
+
 func (t *team) FindWorkerForContainer(handle string) (Worker, bool, error) {
 	return getWorker(t.conn, workersQuery.Join("containers c ON c.worker_name = w.name").Where(sq.And{
 		sq.Eq{"c.handle": handle},
@@ -659,7 +713,7 @@ func (t *team) CreateOneOffBuild() (Build, error) {
 	return build, nil
 }
 
-func (t *team) CreateStartedBuild(plan atc.Plan) (Build, error) {
+func (t *team) CreateStartedBuild(plan atc.Plan, createdBy string) (Build, error) {
 	tx, err := t.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -677,6 +731,11 @@ func (t *team) CreateStartedBuild(plan atc.Plan) (Build, error) {
 		return nil, err
 	}
 
+	trigger, err := json.Marshal(atc.BuildTrigger{Type: atc.BuildTriggerTypeOneOff})
+	if err != nil {
+		return nil, err
+	}
+
 	build := newEmptyBuild(t.conn, t.lockFactory)
 	err = createBuild(tx, build, map[string]interface{}{
 		"name":         sq.Expr("nextval('one_off_name')"),
@@ -687,6 +746,8 @@ func (t *team) CreateStartedBuild(plan atc.Plan) (Build, error) {
 		"private_plan": encryptedPlan,
 		"public_plan":  plan.Public(),
 		"nonce":        nonce,
+		"created_by":   createdBy,
+		"trigger":      trigger,
 	})
 	if err != nil {
 		return nil, err
@@ -768,7 +829,7 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 		UPDATE teams
 		SET auth = $1, legacy_auth = NULL, nonce = NULL
 		WHERE id = $2
-		RETURNING id, name, admin, auth, nonce
+		RETURNING id, name, admin, auth, nonce, default_job_priority
 	`
 	err = t.queryTeam(tx, query, jsonEncodedProviderAuth, t.id)
 	if err != nil {
@@ -778,6 +839,307 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
+func (t *team) UpdateDefaultJobPriority(priority int) error {
+	_, err := psql.Update("teams").
+		Set("default_job_priority", priority).
+		Where(sq.Eq{"id": t.id}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	t.defaultJobPriority = priority
+
+	return nil
+}
+
+// PendingBuildQueue returns the team's pending job builds in the order the
+// scheduler prefers to start them: highest priority first, then oldest first.
+func (t *team) PendingBuildQueue() ([]Build, error) {
+	rows, err := buildsQuery.
+		Where(sq.Eq{
+			"b.status":  BuildStatusPending,
+			"j.active":  true,
+			"b.team_id": t.id,
+		}).
+		OrderBy("COALESCE(j.priority, t.default_job_priority) DESC", "b.id ASC").
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	builds := []Build{}
+	for rows.Next() {
+		build := newEmptyBuild(t.conn, t.lockFactory)
+		err = scanBuild(build, rows, t.conn.EncryptionStrategy())
+		if err != nil {
+			return nil, err
+		}
+
+		builds = append(builds, build)
+	}
+
+	return builds, nil
+}
+
+// Locks returns the team's named locks which are held or waited for, with
+// waiters in the order they will acquire the lock.
+func (t *team) Locks() ([]atc.Lock, error) {
+	rows, err := psql.Select("l.name", "l.held", "l.acquired_at", "b.id", "b.name", "b.create_time", "j.name", "p.name").
+		From("build_locks l").
+		Join("builds b ON b.id = l.build_id").
+		Join("jobs j ON j.id = b.job_id").
+		Join("pipelines p ON p.id = b.pipeline_id").
+		Where(sq.Eq{"l.team_id": t.id}).
+		OrderBy("l.name ASC", "l.held DESC", "b.create_time ASC", "b.id ASC").
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	locks := []atc.Lock{}
+	for rows.Next() {
+		var (
+			name       string
+			held       bool
+			acquiredAt pq.NullTime
+			createTime time.Time
+			build      atc.LockBuild
+		)
+
+		err = rows.Scan(&name, &held, &acquiredAt, &build.ID, &build.Name, &createTime, &build.JobName, &build.PipelineName)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(locks) == 0 || locks[len(locks)-1].Name != name {
+			locks = append(locks, atc.Lock{Name: name, Waiters: []atc.LockBuild{}})
+		}
+
+		lock := &locks[len(locks)-1]
+		if held {
+			build.Since = acquiredAt.Time.Unix()
+			lock.Holder = &build
+		} else {
+			build.Since = createTime.Unix()
+			lock.Waiters = append(lock.Waiters, build)
+		}
+	}
+
+	return locks, nil
+}
+
+// ReleaseLock forcibly releases a lock from the build holding it, letting the
+// next waiter acquire it.
+func (t *team) ReleaseLock(name string) (bool, error) {
+	result, err := psql.Delete("build_locks").
+		Where(sq.Eq{
+			"team_id": t.id,
+			"name":    name,
+			"held":    true,
+		}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows == 1, nil
+}
+
+func (t *team) ServiceAccounts() ([]atc.ServiceAccount, error) {
+	rows, err := psql.Select("a.name", "t.name", "t.role", "p.name", "t.expires_at", "t.last_used_at", "t.created_at").
+		From("service_accounts a").
+		LeftJoin("service_account_tokens t ON t.service_account_id = a.id").
+		LeftJoin("pipelines p ON p.id = t.pipeline_id").
+		Where(sq.Eq{"a.team_id": t.id}).
+		OrderBy("a.name ASC", "t.name ASC").
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	accounts := []atc.ServiceAccount{}
+	for rows.Next() {
+		var (
+			accountName                      string
+			tokenName, role, pipelineName    sql.NullString
+			expiresAt, lastUsedAt, createdAt pq.NullTime
+		)
+
+		err = rows.Scan(&accountName, &tokenName, &role, &pipelineName, &expiresAt, &lastUsedAt, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(accounts) == 0 || accounts[len(accounts)-1].Name != accountName {
+			accounts = append(accounts, atc.ServiceAccount{Name: accountName, Tokens: []atc.ServiceAccountToken{}})
+		}
+
+		if !tokenName.Valid {
+			continue
+		}
+
+		token := atc.ServiceAccountToken{
+			Name:      tokenName.String,
+			Role:      role.String,
+			Pipeline:  pipelineName.String,
+			ExpiresAt: expiresAt.Time.Unix(),
+			CreatedAt: createdAt.Time.Unix(),
+		}
+
+		if lastUsedAt.Valid {
+			token.LastUsedAt = lastUsedAt.Time.Unix()
+		}
+
+		account := &accounts[len(accounts)-1]
+		account.Tokens = append(account.Tokens, token)
+	}
+
+	return accounts, nil
+}
+
+// CreateServiceAccount returns false if the team already has a service
+// account with the given name.
+func (t *team) CreateServiceAccount(name string) (bool, error) {
+	result, err := t.conn.Exec(`
+		INSERT INTO service_accounts (team_id, name)
+		VALUES ($1, $2)
+		ON CONFLICT (team_id, name) DO NOTHING
+	`, t.id, name)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows == 1, nil
+}
+
+func (t *team) DestroyServiceAccount(name string) (bool, error) {
+	result, err := psql.Delete("service_accounts").
+		Where(sq.Eq{
+			"team_id": t.id,
+			"name":    name,
+		}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows == 1, nil
+}
+
+// CreateServiceAccountToken stores the hash of a new token for the service
+// account. It returns false if the service account, or the pipeline the
+// token is scoped to, does not exist.
+func (t *team) CreateServiceAccountToken(accountName string, token atc.ServiceAccountToken, tokenHash string) (bool, error) {
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	var accountID int
+	err = psql.Select("id").
+		From("service_accounts").
+		Where(sq.Eq{
+			"team_id": t.id,
+			"name":    accountName,
+		}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&accountID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	var pipelineID sql.NullInt64
+	if token.Pipeline != "" {
+		err = psql.Select("id").
+			From("pipelines").
+			Where(sq.Eq{
+				"team_id": t.id,
+				"name":    token.Pipeline,
+			}).
+			RunWith(tx).
+			QueryRow().
+			Scan(&pipelineID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return false, nil
+			}
+
+			return false, err
+		}
+	}
+
+	_, err = psql.Insert("service_account_tokens").
+		Columns("service_account_id", "name", "token_hash", "role", "pipeline_id", "expires_at").
+		Values(accountID, token.Name, tokenHash, token.Role, pipelineID, time.Unix(token.ExpiresAt, 0)).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqUniqueViolationErrCode {
+			return false, ErrServiceAccountTokenExists
+		}
+
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+func (t *team) RevokeServiceAccountToken(accountName string, tokenName string) (bool, error) {
+	result, err := t.conn.Exec(`
+		DELETE FROM service_account_tokens t
+		USING service_accounts a
+		WHERE a.id = t.service_account_id
+		AND a.team_id = $1
+		AND a.name = $2
+		AND t.name = $3
+	`, t.id, accountName, tokenName)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows == 1, nil
+}
+
 func (t *team) FindCheckContainers(logger lager.Logger, pipelineName string, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
 	pipeline, found, err := t.Pipeline(pipelineName)
 	if err != nil {
@@ -992,9 +1354,9 @@ func (t *team) saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string
 
 	updated, err := checkIfRowsUpdated(tx, `
 		UPDATE jobs
-		SET config = $3, interruptible = $4, active = true, nonce = $5, tags = $6
+		SET config = $3, interruptible = $4, active = true, nonce = $5, tags = $6, priority = $7
 		WHERE name = $1 AND pipeline_id = $2
-	`, job.Name, pipelineID, encryptedPayload, job.Interruptible, nonce, pq.Array(groups))
+	`, job.Name, pipelineID, encryptedPayload, job.Interruptible, nonce, pq.Array(groups), job.Priority)
 	if err != nil {
 		return err
 	}
@@ -1004,9 +1366,9 @@ func (t *team) saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string
 	}
 
 	_, err = tx.Exec(`
-		INSERT INTO jobs (name, pipeline_id, config, interruptible, active, nonce, tags)
-		VALUES ($1, $2, $3, $4, true, $5, $6)
-	`, job.Name, pipelineID, encryptedPayload, job.Interruptible, nonce, pq.Array(groups))
+		INSERT INTO jobs (name, pipeline_id, config, interruptible, active, nonce, tags, priority)
+		VALUES ($1, $2, $3, $4, true, $5, $6, $7)
+	`, job.Name, pipelineID, encryptedPayload, job.Interruptible, nonce, pq.Array(groups), job.Priority)
 
 	return swallowUniqueViolation(err)
 }
@@ -1260,6 +1622,7 @@ func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
 		&t.admin,
 		&providerAuth,
 		&nonce,
+		&t.defaultJobPriority,
 	)
 	if err != nil {
 		return err
@@ -51,10 +51,15 @@ func (factory *teamFactory) createTeam(t atc.Team, admin bool) (Team, error) {
 		return nil, err
 	}
 
+	var defaultJobPriority int
+	if t.DefaultJobPriority != nil {
+		defaultJobPriority = *t.DefaultJobPriority
+	}
+
 	row := psql.Insert("teams").
-		Columns("name, auth, admin").
-		Values(t.Name, auth, admin).
-		Suffix("RETURNING id, name, admin, auth").
+		Columns("name, auth, admin, default_job_priority").
+		Values(t.Name, auth, admin, defaultJobPriority).
+		Suffix("RETURNING id, name, admin, auth, default_job_priority").
 		RunWith(tx).
 		QueryRow()
 
@@ -90,7 +95,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 		lockFactory: factory.lockFactory,
 	}
 
-	row := psql.Select("id, name, admin, auth").
+	row := psql.Select("id, name, admin, auth, default_job_priority").
 		From("teams").
 		Where(sq.Eq{"LOWER(name)": strings.ToLower(teamName)}).
 		RunWith(factory.conn).
@@ -109,7 +114,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 }
 
 func (factory *teamFactory) GetTeams() ([]Team, error) {
-	rows, err := psql.Select("id, name, admin, auth").
+	rows, err := psql.Select("id, name, admin, auth, default_job_priority").
 		From("teams").
 		OrderBy("id ASC").
 		RunWith(factory.conn).
@@ -178,6 +183,7 @@ func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
 		&t.name,
 		&t.admin,
 		&providerAuth,
+		&t.defaultJobPriority,
 	)
 
 	if providerAuth.Valid {
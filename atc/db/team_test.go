@@ -297,7 +297,7 @@ var _ = Describe("Team", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			metaContainers = make(map[db.ContainerMetadata][]db.Container)
@@ -423,7 +423,7 @@ var _ = Describe("Team", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				firstContainerCreating, err = defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(build.ID(), atc.PlanID("some-job"), defaultTeam.ID()), db.ContainerMetadata{Type: "task", StepName: "some-task"})
@@ -672,7 +672,7 @@ var _ = Describe("Team", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 
 			creatingContainer, err := defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(build.ID(), atc.PlanID("some-job"), defaultTeam.ID()), db.ContainerMetadata{Type: "task", StepName: "some-task"})
@@ -745,6 +745,56 @@ var _ = Describe("Team", func() {
 		})
 	})
 
+	Describe("FindWorkerArtifact", func() {
+		BeforeEach(func() {
+			_, err := dbConn.Exec("INSERT INTO worker_artifacts (id, name) VALUES ($1, '')", 18)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the artifact has no volume for the team", func() {
+			It("returns not found", func() {
+				_, found, err := defaultTeam.FindWorkerArtifact(18)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the artifact has a volume for the team", func() {
+			BeforeEach(func() {
+				_, err := dbConn.Exec("INSERT INTO volumes (handle, team_id, worker_name, worker_artifact_id, state) VALUES ('some-handle', $1, $2, $3, $4)", defaultTeam.ID(), defaultWorker.Name(), 18, db.VolumeStateCreated)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns the artifact, without a manifest until one is set", func() {
+				artifact, found, err := defaultTeam.FindWorkerArtifact(18)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(artifact.ID()).To(Equal(18))
+
+				_, found, err = artifact.Manifest()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+
+				manifest := atc.ArtifactManifest{
+					"some-file": {Mode: 0644, Digest: "some-digest"},
+				}
+
+				err = artifact.SetManifest(manifest, 3)
+				Expect(err).ToNot(HaveOccurred())
+
+				savedManifest, found, err := artifact.Manifest()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(savedManifest).To(Equal(manifest))
+
+				artifact, found, err = defaultTeam.FindWorkerArtifact(18)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(artifact.Generation()).To(Equal(3))
+			})
+		})
+	})
+
 	Describe("FindWorkerForContainer", func() {
 		var containerMetadata db.ContainerMetadata
 		var defaultBuild db.Build
@@ -858,6 +908,207 @@ var _ = Describe("Team", func() {
 		})
 	})
 
+	Describe("Job priority", func() {
+		var pipeline db.Pipeline
+
+		BeforeEach(func() {
+			priority := 10
+
+			var err error
+			pipeline, _, err = team.SavePipeline("priority-pipeline", atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "hotfix", Priority: &priority},
+					{Name: "fuzz"},
+				},
+			}, db.ConfigVersion(0), false)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("defaults jobs without a priority to the team's default", func() {
+			err := team.UpdateDefaultJobPriority(3)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(team.DefaultJobPriority()).To(Equal(3))
+
+			job, found, err := pipeline.Job("fuzz")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(job.Priority()).To(Equal(3))
+
+			job, found, err = pipeline.Job("hotfix")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(job.Priority()).To(Equal(10))
+
+			reloaded, found, err := teamFactory.FindTeam(team.Name())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(reloaded.DefaultJobPriority()).To(Equal(3))
+		})
+
+		Describe("PendingBuildQueue", func() {
+			It("orders pending builds by priority and then age", func() {
+				fuzz, _, err := pipeline.Job("fuzz")
+				Expect(err).ToNot(HaveOccurred())
+
+				hotfix, _, err := pipeline.Job("hotfix")
+				Expect(err).ToNot(HaveOccurred())
+
+				fuzz1, err := fuzz.CreateBuild("someone")
+				Expect(err).ToNot(HaveOccurred())
+
+				fuzz2, err := fuzz.CreateBuild("someone")
+				Expect(err).ToNot(HaveOccurred())
+
+				hotfix1, err := hotfix.CreateBuild("someone")
+				Expect(err).ToNot(HaveOccurred())
+
+				started, err := fuzz.CreateBuild("someone")
+				Expect(err).ToNot(HaveOccurred())
+				_, err = started.Start(atc.Plan{})
+				Expect(err).ToNot(HaveOccurred())
+
+				queue, err := team.PendingBuildQueue()
+				Expect(err).ToNot(HaveOccurred())
+
+				ids := []int{}
+				for _, build := range queue {
+					ids = append(ids, build.ID())
+				}
+
+				Expect(ids).To(Equal([]int{hotfix1.ID(), fuzz1.ID(), fuzz2.ID()}))
+				Expect(queue[0].Priority()).To(Equal(10))
+				Expect(queue[1].Priority()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("Service accounts", func() {
+		expiresAt := time.Now().Add(time.Hour).Unix()
+
+		BeforeEach(func() {
+			created, err := team.CreateServiceAccount("deploy-bot")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+		})
+
+		It("does not create the same service account twice", func() {
+			created, err := team.CreateServiceAccount("deploy-bot")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeFalse())
+		})
+
+		It("lists the service accounts of the team only", func() {
+			_, err := otherTeam.CreateServiceAccount("other-bot")
+			Expect(err).ToNot(HaveOccurred())
+
+			accounts, err := team.ServiceAccounts()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(accounts).To(Equal([]atc.ServiceAccount{
+				{Name: "deploy-bot", Tokens: []atc.ServiceAccountToken{}},
+			}))
+		})
+
+		Context("when tokens are created", func() {
+			BeforeEach(func() {
+				_, _, err := team.SavePipeline("some-pipeline", atc.Config{
+					Jobs: atc.JobConfigs{{Name: "some-job"}},
+				}, db.ConfigVersion(0), false)
+				Expect(err).ToNot(HaveOccurred())
+
+				created, err := team.CreateServiceAccountToken("deploy-bot", atc.ServiceAccountToken{
+					Name:      "ci",
+					Role:      "member",
+					ExpiresAt: expiresAt,
+				}, "some-hash")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+
+				created, err = team.CreateServiceAccountToken("deploy-bot", atc.ServiceAccountToken{
+					Name:      "scoped",
+					Role:      "pipeline-operator",
+					Pipeline:  "some-pipeline",
+					ExpiresAt: expiresAt,
+				}, "some-other-hash")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+			})
+
+			It("lists the tokens without their hashes", func() {
+				accounts, err := team.ServiceAccounts()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(accounts).To(HaveLen(1))
+				Expect(accounts[0].Tokens).To(HaveLen(2))
+
+				Expect(accounts[0].Tokens[0].Name).To(Equal("ci"))
+				Expect(accounts[0].Tokens[0].Role).To(Equal("member"))
+				Expect(accounts[0].Tokens[0].Pipeline).To(BeEmpty())
+				Expect(accounts[0].Tokens[0].ExpiresAt).To(Equal(expiresAt))
+				Expect(accounts[0].Tokens[0].LastUsedAt).To(BeZero())
+				Expect(accounts[0].Tokens[0].Token).To(BeEmpty())
+
+				Expect(accounts[0].Tokens[1].Name).To(Equal("scoped"))
+				Expect(accounts[0].Tokens[1].Pipeline).To(Equal("some-pipeline"))
+			})
+
+			It("does not create a token with the same name twice", func() {
+				_, err := team.CreateServiceAccountToken("deploy-bot", atc.ServiceAccountToken{
+					Name:      "ci",
+					Role:      "viewer",
+					ExpiresAt: expiresAt,
+				}, "yet-another-hash")
+				Expect(err).To(Equal(db.ErrServiceAccountTokenExists))
+			})
+
+			It("does not create tokens for missing accounts or pipelines", func() {
+				created, err := team.CreateServiceAccountToken("missing-bot", atc.ServiceAccountToken{
+					Name:      "ci",
+					Role:      "viewer",
+					ExpiresAt: expiresAt,
+				}, "yet-another-hash")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeFalse())
+
+				created, err = team.CreateServiceAccountToken("deploy-bot", atc.ServiceAccountToken{
+					Name:      "missing-pipeline",
+					Role:      "viewer",
+					Pipeline:  "missing-pipeline",
+					ExpiresAt: expiresAt,
+				}, "yet-another-hash")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeFalse())
+			})
+
+			It("revokes a token", func() {
+				revoked, err := team.RevokeServiceAccountToken("deploy-bot", "ci")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(revoked).To(BeTrue())
+
+				accounts, err := team.ServiceAccounts()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(accounts[0].Tokens).To(HaveLen(1))
+
+				revoked, err = team.RevokeServiceAccountToken("deploy-bot", "ci")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(revoked).To(BeFalse())
+			})
+
+			It("destroys the account with its tokens", func() {
+				destroyed, err := team.DestroyServiceAccount("deploy-bot")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(destroyed).To(BeTrue())
+
+				accounts, err := team.ServiceAccounts()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(accounts).To(BeEmpty())
+
+				var count int
+				err = dbConn.QueryRow("SELECT COUNT(*) FROM service_account_tokens").Scan(&count)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(BeZero())
+			})
+		})
+	})
+
 	Describe("Pipelines", func() {
 		var (
 			pipelines []db.Pipeline
@@ -1050,7 +1301,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			startedBuild, err = team.CreateStartedBuild(plan)
+			startedBuild, err = team.CreateStartedBuild(plan, "some-user")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -1063,6 +1314,11 @@ var _ = Describe("Team", func() {
 			Expect(startedBuild.Status()).To(Equal(db.BuildStatusStarted))
 		})
 
+		It("records who created it", func() {
+			Expect(startedBuild.CreatedBy()).To(Equal("some-user"))
+			Expect(startedBuild.Trigger()).To(Equal(&atc.BuildTrigger{Type: atc.BuildTriggerTypeOneOff}))
+		})
+
 		It("saves the public plan", func() {
 			found, err := startedBuild.Reload()
 			Expect(err).NotTo(HaveOccurred())
@@ -1127,7 +1383,7 @@ var _ = Describe("Team", func() {
 				Expect(found).To(BeTrue())
 
 				for i := 3; i < 5; i++ {
-					build, err := job.CreateBuild()
+					build, err := job.CreateBuild("some-user")
 					Expect(err).ToNot(HaveOccurred())
 					allBuilds[i] = build
 					pipelineBuilds[i-3] = build
@@ -1278,7 +1534,7 @@ var _ = Describe("Team", func() {
 			Expect(found).To(BeTrue())
 
 			for i := range builds {
-				builds[i], err = job.CreateBuild()
+				builds[i], err = job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				buildStart := time.Date(2020, 11, i+1, 0, 0, 0, 0, time.UTC)
@@ -1383,11 +1639,11 @@ var _ = Describe("Team", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err = job.CreateBuild()
+			build, err = job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, build)
 
-			secondBuild, err = job.CreateBuild()
+			secondBuild, err = job.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, secondBuild)
 
@@ -1395,7 +1651,7 @@ var _ = Describe("Team", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			thirdBuild, err = someOtherJob.CreateBuild()
+			thirdBuild, err = someOtherJob.CreateBuild("some-user")
 			Expect(err).ToNot(HaveOccurred())
 			expectedBuilds = append(expectedBuilds, thirdBuild)
 		})
@@ -2676,7 +2932,7 @@ var _ = Describe("Team", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild("some-user")
 				Expect(err).ToNot(HaveOccurred())
 
 				creatingContainer, err := defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(build.ID(), atc.PlanID("some-job"), defaultTeam.ID()), db.ContainerMetadata{Type: "task", StepName: "some-task"})
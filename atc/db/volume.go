@@ -148,6 +148,7 @@ type CreatedVolume interface {
 	TeamID() int
 	WorkerArtifactID() int
 	CreateChildForContainer(CreatingContainer, string) (CreatingVolume, error)
+	CreateChildForArtifact() (CreatingVolume, error)
 	Destroying() (DestroyingVolume, error)
 	WorkerName() string
 
@@ -569,6 +570,50 @@ func (volume *createdVolume) CreateChildForContainer(container CreatingContainer
 	}, nil
 }
 
+// CreateChildForArtifact creates a volume for a new artifact which is a
+// copy-on-write child of this one. The child keeps this volume from being
+// destroyed for as long as it exists.
+func (volume *createdVolume) CreateChildForArtifact() (CreatingVolume, error) {
+	handle, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	var volumeID int
+	err = psql.Insert("volumes").
+		Columns(
+			"worker_name",
+			"parent_id",
+			"parent_state",
+			"handle",
+			"team_id",
+		).
+		Values(
+			volume.workerName,
+			volume.id,
+			VolumeStateCreated,
+			handle.String(),
+			volume.teamID,
+		).
+		Suffix("RETURNING id").
+		RunWith(volume.conn).
+		QueryRow().
+		Scan(&volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &creatingVolume{
+		id:           volumeID,
+		workerName:   volume.workerName,
+		handle:       handle.String(),
+		teamID:       volume.teamID,
+		typ:          VolumeTypeArtifact,
+		parentHandle: volume.Handle(),
+		conn:         volume.conn,
+	}, nil
+}
+
 func (volume *createdVolume) Destroying() (DestroyingVolume, error) {
 	err := volumeStateTransition(
 		volume.id,
@@ -600,6 +600,34 @@ var _ = Describe("Volume", func() {
 		})
 	})
 
+	Describe("createdVolume.CreateChildForArtifact", func() {
+		var parentVolume db.CreatedVolume
+
+		BeforeEach(func() {
+			creatingParentVolume, err := volumeRepository.CreateVolume(defaultTeam.ID(), defaultWorker.Name(), db.VolumeTypeArtifact)
+			Expect(err).ToNot(HaveOccurred())
+
+			parentVolume, err = creatingParentVolume.Created()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("creates an artifact volume which keeps its parent from being destroyed", func() {
+			creatingChildVolume, err := parentVolume.CreateChildForArtifact()
+			Expect(err).ToNot(HaveOccurred())
+
+			createdChildVolume, err := creatingChildVolume.Created()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(createdChildVolume.ParentHandle()).To(Equal(parentVolume.Handle()))
+			Expect(createdChildVolume.TeamID()).To(Equal(defaultTeam.ID()))
+
+			artifact, err := createdChildVolume.InitializeArtifact("", 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(artifact.ID()).ToNot(BeZero())
+
+			_, err = parentVolume.Destroying()
+			Expect(err).To(HaveOccurred())
+		})
+	})
 	Context("when worker is no longer in database", func() {
 		BeforeEach(func() {
 			var err error
@@ -59,6 +59,7 @@ type Worker interface {
 	StartTime() time.Time
 	ExpiresAt() time.Time
 	Ephemeral() bool
+	EnforcesNetworkRules() bool
 
 	Reload() (bool, error)
 
@@ -98,6 +99,8 @@ type worker struct {
 	expiresAt        time.Time
 	certsPath        *string
 	ephemeral        bool
+
+	enforcesNetworkRules bool
 }
 
 func (worker *worker) Name() string             { return worker.name }
@@ -118,6 +121,7 @@ func (worker *worker) Tags() []string                          { return worker.t
 func (worker *worker) TeamID() int                             { return worker.teamID }
 func (worker *worker) TeamName() string                        { return worker.teamName }
 func (worker *worker) Ephemeral() bool                         { return worker.ephemeral }
+func (worker *worker) EnforcesNetworkRules() bool              { return worker.enforcesNetworkRules }
 
 func (worker *worker) StartTime() time.Time { return worker.startTime }
 func (worker *worker) ExpiresAt() time.Time { return worker.expiresAt }
@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -18,21 +19,30 @@ type WorkerArtifact interface {
 	BuildID() int
 	CreatedAt() time.Time
 	Volume(teamID int) (CreatedVolume, bool, error)
+
+	// Generation is the number of artifacts this one was assembled on top
+	// of, i.e. the depth of its chain of copy-on-write volumes.
+	Generation() int
+
+	Manifest() (atc.ArtifactManifest, bool, error)
+	SetManifest(manifest atc.ArtifactManifest, generation int) error
 }
 
 type artifact struct {
 	conn Conn
 
-	id        int
-	name      string
-	buildID   int
-	createdAt time.Time
+	id         int
+	name       string
+	buildID    int
+	createdAt  time.Time
+	generation int
 }
 
 func (a *artifact) ID() int              { return a.id }
 func (a *artifact) Name() string         { return a.name }
 func (a *artifact) BuildID() int         { return a.buildID }
 func (a *artifact) CreatedAt() time.Time { return a.createdAt }
+func (a *artifact) Generation() int      { return a.generation }
 
 func (a *artifact) Volume(teamID int) (CreatedVolume, bool, error) {
 	where := map[string]interface{}{
@@ -52,6 +62,56 @@ func (a *artifact) Volume(teamID int) (CreatedVolume, bool, error) {
 	return created, true, nil
 }
 
+func (a *artifact) Manifest() (atc.ArtifactManifest, bool, error) {
+	var manifestJSON sql.NullString
+	err := psql.Select("manifest").
+		From("worker_artifacts").
+		Where(sq.Eq{"id": a.id}).
+		RunWith(a.conn).
+		QueryRow().
+		Scan(&manifestJSON)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	if !manifestJSON.Valid {
+		return nil, false, nil
+	}
+
+	var manifest atc.ArtifactManifest
+	err = json.Unmarshal([]byte(manifestJSON.String), &manifest)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return manifest, true, nil
+}
+
+func (a *artifact) SetManifest(manifest atc.ArtifactManifest, generation int) error {
+	manifestJSON, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("worker_artifacts").
+		Set("manifest", string(manifestJSON)).
+		Set("generation", generation).
+		Where(sq.Eq{"id": a.id}).
+		RunWith(a.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	a.generation = generation
+
+	return nil
+}
+
 func saveWorkerArtifact(tx Tx, conn Conn, atcArtifact atc.WorkerArtifact) (WorkerArtifact, error) {
 
 	var artifactID int
@@ -96,14 +156,14 @@ func getWorkerArtifact(tx Tx, conn Conn, id int) (WorkerArtifact, bool, error) {
 
 	artifact := &artifact{conn: conn}
 
-	err := psql.Select("id", "created_at", "name", "build_id").
+	err := psql.Select("id", "created_at", "name", "build_id", "generation").
 		From("worker_artifacts").
 		Where(sq.Eq{
 			"id": id,
 		}).
 		RunWith(tx).
 		QueryRow().
-		Scan(&artifact.id, &createdAtTime, &artifact.name, &buildID)
+		Scan(&artifact.id, &createdAtTime, &artifact.name, &buildID, &artifact.generation)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, false, nil
@@ -54,7 +54,8 @@ var workersQuery = psql.Select(`
 		w.team_id,
 		w.start_time,
 		w.expires,
-		w.ephemeral
+		w.ephemeral,
+		w.enforces_network_rules
 	`).
 	From("workers w").
 	LeftJoin("teams t ON w.team_id = t.id")
@@ -162,6 +163,7 @@ func scanWorker(worker *worker, row scannable) error {
 		&startTime,
 		&expiresAt,
 		&ephemeral,
+		&worker.enforcesNetworkRules,
 	)
 	if err != nil {
 		return err
@@ -411,6 +413,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		string(workerState),
 		teamID,
 		atcWorker.Ephemeral,
+		atcWorker.EnforcesNetworkRules,
 	}
 
 	conflictValues := values
@@ -442,6 +445,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 			"state",
 			"team_id",
 			"ephemeral",
+			"enforces_network_rules",
 		).
 		Values(append([]interface{}{
 			sq.Expr(expires),
@@ -466,7 +470,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				version = ?,
 				state = ?,
 				team_id = ?,
-				ephemeral = ?
+				ephemeral = ?,
+				enforces_network_rules = ?
 			WHERE `+matchTeamUpsert,
 			conflictValues...,
 		).
@@ -510,6 +515,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		startTime:        time.Unix(atcWorker.StartTime, 0),
 		ephemeral:        atcWorker.Ephemeral,
 		conn:             conn,
+
+		enforcesNetworkRules: atcWorker.EnforcesNetworkRules,
 	}
 
 	workerBaseResourceTypeIDs := []int{}
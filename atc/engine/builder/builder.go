@@ -473,5 +473,6 @@ func (builder *stepBuilder) stepMetadata(
 		PipelineID:   build.PipelineID(),
 		PipelineName: build.PipelineName(),
 		ExternalURL:  externalURL,
+		Priority:     build.Priority(),
 	}
 }
@@ -1,6 +1,7 @@
 package builder
 
 import (
+	"fmt"
 	"io"
 	"strings"
 	"time"
@@ -233,22 +234,34 @@ func (d *putDelegate) SaveOutput(log lager.Logger, plan atc.PutPlan, source atc.
 		logger.Error("failed-to-save-output", err)
 		return
 	}
+
+	if len(plan.AddLabels) != 0 {
+		err = d.build.LabelOutput(plan.Resource, info.Version, plan.AddLabels)
+		if err != nil {
+			logger.Error("failed-to-label-output", err)
+			return
+		}
+	}
 }
 
 func NewTaskDelegate(build db.Build, planID atc.PlanID, credVarsTracker vars.CredVarsTracker, clock clock.Clock) exec.TaskDelegate {
 	return &taskDelegate{
 		BuildStepDelegate: NewBuildStepDelegate(build, planID, credVarsTracker, clock),
 
+		planID:      planID,
 		eventOrigin: event.Origin{ID: event.OriginID(planID)},
 		build:       build,
+		clock:       clock,
 	}
 }
 
 type taskDelegate struct {
 	exec.BuildStepDelegate
 
+	planID      atc.PlanID
 	build       db.Build
 	eventOrigin event.Origin
+	clock       clock.Clock
 }
 
 func (d *taskDelegate) Initializing(logger lager.Logger, taskConfig atc.TaskConfig) {
@@ -297,6 +310,43 @@ func (d *taskDelegate) Finished(logger lager.Logger, exitStatus exec.ExitStatus)
 	logger.Info("finished", lager.Data{"exit-status": exitStatus})
 }
 
+func (d *taskDelegate) SaveResourceUsage(logger lager.Logger, stepName string, usage atc.TaskResourceUsage) {
+	err := d.build.SaveEvent(event.TaskResourceUsage{
+		Time:   time.Now().Unix(),
+		Origin: d.eventOrigin,
+		Usage:  usage,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-task-resource-usage-event", err)
+		return
+	}
+
+	err = d.build.SaveTaskResourceUsage(d.planID, stepName, usage)
+	if err != nil {
+		logger.Error("failed-to-save-task-resource-usage", err)
+		return
+	}
+}
+
+func (d *taskDelegate) HoldContainerForDebugging(logger lager.Logger, duration time.Duration) {
+	until := d.clock.Now().Add(duration)
+
+	held, err := d.build.HoldContainerForDebugging(logger, d.planID, until, atc.DebugContainers)
+	if err != nil {
+		logger.Error("failed-to-hold-container-for-debugging", err)
+		return
+	}
+
+	if !held {
+		fmt.Fprintln(d.Stderr(), "[WARNING]", "not keeping the container for debugging: too many debug containers are already being kept")
+		return
+	}
+
+	logger.Info("holding-container-for-debugging", lager.Data{"until": until})
+
+	fmt.Fprintf(d.Stderr(), "keeping the container for debugging for %s; use 'fly hijack -b %d' to get into it\n", duration, d.build.ID())
+}
+
 func NewCheckDelegate(check db.Check, planID atc.PlanID, credVarsTracker vars.CredVarsTracker, clock clock.Clock) exec.CheckDelegate {
 	return &checkDelegate{
 		BuildStepDelegate: NewBuildStepDelegate(nil, planID, credVarsTracker, clock),
@@ -192,12 +192,15 @@ var _ = Describe("DelegateFactory", func() {
 			var source atc.Source
 			var resourceTypes atc.VersionedResourceTypes
 
-			JustBeforeEach(func() {
+			BeforeEach(func() {
 				plan = atc.PutPlan{
 					Name:     "some-name",
 					Type:     "some-type",
 					Resource: "some-resource",
 				}
+			})
+
+			JustBeforeEach(func() {
 				source = atc.Source{"some": "source"}
 				resourceTypes = atc.VersionedResourceTypes{}
 
@@ -215,6 +218,24 @@ var _ = Describe("DelegateFactory", func() {
 				Expect(name).To(Equal(plan.Name))
 				Expect(resource).To(Equal(plan.Resource))
 			})
+
+			It("does not label the output", func() {
+				Expect(fakeBuild.LabelOutputCallCount()).To(Equal(0))
+			})
+
+			Context("when the plan adds labels", func() {
+				BeforeEach(func() {
+					plan.AddLabels = []string{"deployed-to-prod"}
+				})
+
+				It("labels the output", func() {
+					Expect(fakeBuild.LabelOutputCallCount()).To(Equal(1))
+					resource, version, labels := fakeBuild.LabelOutputArgsForCall(0)
+					Expect(resource).To(Equal(plan.Resource))
+					Expect(version).To(Equal(info.Version))
+					Expect(labels).To(Equal([]string{"deployed-to-prod"}))
+				})
+			})
 		})
 	})
 
@@ -264,6 +285,85 @@ var _ = Describe("DelegateFactory", func() {
 				Expect(event.EventType()).To(Equal(atc.EventType("finish-task")))
 			})
 		})
+
+		Describe("SaveResourceUsage", func() {
+			var usage atc.TaskResourceUsage
+
+			BeforeEach(func() {
+				usage = atc.TaskResourceUsage{
+					CPUTime:       1000,
+					PeakMemory:    2048,
+					AverageMemory: 1024,
+				}
+			})
+
+			JustBeforeEach(func() {
+				delegate.SaveResourceUsage(logger, "some-task", usage)
+			})
+
+			It("saves an event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				event := fakeBuild.SaveEventArgsForCall(0)
+				Expect(event.EventType()).To(Equal(atc.EventType("task-resource-usage")))
+			})
+
+			It("saves the usage with the build", func() {
+				Expect(fakeBuild.SaveTaskResourceUsageCallCount()).To(Equal(1))
+				planID, stepName, savedUsage := fakeBuild.SaveTaskResourceUsageArgsForCall(0)
+				Expect(planID).To(Equal(atc.PlanID("some-plan-id")))
+				Expect(stepName).To(Equal("some-task"))
+				Expect(savedUsage).To(Equal(usage))
+			})
+		})
+
+		Describe("HoldContainerForDebugging", func() {
+			BeforeEach(func() {
+				atc.DebugContainers = atc.DebugContainerLimits{Max: 10, MaxPerTeam: 2}
+				fakeBuild.IDReturns(42)
+			})
+
+			AfterEach(func() {
+				atc.DebugContainers = atc.DebugContainerLimits{}
+			})
+
+			JustBeforeEach(func() {
+				delegate.HoldContainerForDebugging(logger, 30*time.Minute)
+			})
+
+			It("holds the container of the step with the build", func() {
+				Expect(fakeBuild.HoldContainerForDebuggingCallCount()).To(Equal(1))
+				_, planID, until, limits := fakeBuild.HoldContainerForDebuggingArgsForCall(0)
+				Expect(planID).To(Equal(atc.PlanID("some-plan-id")))
+				Expect(until).To(Equal(fakeClock.Now().Add(30 * time.Minute)))
+				Expect(limits).To(Equal(atc.DebugContainerLimits{Max: 10, MaxPerTeam: 2}))
+			})
+
+			Context("when the container is held", func() {
+				BeforeEach(func() {
+					fakeBuild.HoldContainerForDebuggingReturns(true, nil)
+				})
+
+				It("tells the user how to hijack it", func() {
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+					Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Log{
+						Time:    fakeClock.Now().Unix(),
+						Origin:  event.Origin{ID: "some-plan-id", Source: "stderr"},
+						Payload: "keeping the container for debugging for 30m0s; use 'fly hijack -b 42' to get into it\n",
+					}))
+				})
+			})
+
+			Context("when the limits have been reached", func() {
+				BeforeEach(func() {
+					fakeBuild.HoldContainerForDebuggingReturns(false, nil)
+				})
+
+				It("warns the user", func() {
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+					Expect(fakeBuild.SaveEventArgsForCall(0).(event.Log).Payload).To(ContainSubstring("not keeping the container for debugging"))
+				})
+			})
+		})
 	})
 
 	Describe("CheckDelegate", func() {
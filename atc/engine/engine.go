@@ -39,20 +39,22 @@ type StepBuilder interface {
 	BuildStepErrored(lager.Logger, db.Build, error)
 }
 
-func NewEngine(builder StepBuilder) Engine {
+func NewEngine(builder StepBuilder, maxBuildDuration time.Duration) Engine {
 	return &engine{
-		builder:       builder,
-		release:       make(chan bool),
-		trackedStates: new(sync.Map),
-		waitGroup:     new(sync.WaitGroup),
+		builder:          builder,
+		release:          make(chan bool),
+		trackedStates:    new(sync.Map),
+		waitGroup:        new(sync.WaitGroup),
+		maxBuildDuration: maxBuildDuration,
 	}
 }
 
 type engine struct {
-	builder       StepBuilder
-	release       chan bool
-	trackedStates *sync.Map
-	waitGroup     *sync.WaitGroup
+	builder          StepBuilder
+	release          chan bool
+	trackedStates    *sync.Map
+	waitGroup        *sync.WaitGroup
+	maxBuildDuration time.Duration
 }
 
 func (engine *engine) ReleaseAll(logger lager.Logger) {
@@ -79,6 +81,7 @@ func (engine *engine) NewBuild(build db.Build) Runnable {
 		engine.release,
 		engine.trackedStates,
 		engine.waitGroup,
+		engine.maxBuildDuration,
 	)
 }
 
@@ -105,6 +108,7 @@ func NewBuild(
 	release chan bool,
 	trackedStates *sync.Map,
 	waitGroup *sync.WaitGroup,
+	maxBuildDuration time.Duration,
 ) Runnable {
 	return &engineBuild{
 		ctx:    ctx,
@@ -116,6 +120,8 @@ func NewBuild(
 		release:       release,
 		trackedStates: trackedStates,
 		waitGroup:     waitGroup,
+
+		maxBuildDuration: maxBuildDuration,
 	}
 }
 
@@ -130,9 +136,23 @@ type engineBuild struct {
 	trackedStates *sync.Map
 	waitGroup     *sync.WaitGroup
 
+	maxBuildDuration time.Duration
+
 	pipelineCredMgrs []creds.Manager
 }
 
+// TimeoutGracePeriod is how long a build which exceeded its timeout is given
+// to stop before it is finished regardless.
+var TimeoutGracePeriod = time.Minute
+
+type BuildTimeoutError struct {
+	Timeout time.Duration
+}
+
+func (err BuildTimeoutError) Error() string {
+	return fmt.Sprintf("build exceeded its timeout of %s", err.Timeout)
+}
+
 func (b *engineBuild) Run(logger lager.Logger) {
 	b.waitGroup.Add(1)
 	defer b.waitGroup.Done()
@@ -212,7 +232,23 @@ func (b *engineBuild) Run(logger lager.Logger) {
 		}
 	}()
 
-	done := make(chan error)
+	var timedOut <-chan time.Time
+
+	timeout := b.timeout()
+	if timeout > 0 {
+		startTime := b.build.StartTime()
+		if startTime.IsZero() {
+			startTime = time.Now()
+		}
+
+		timer := time.NewTimer(time.Until(startTime.Add(timeout)))
+		defer timer.Stop()
+
+		timedOut = timer.C
+	}
+
+	// buffered so that the step can still finish after the build has timed out
+	done := make(chan error, 1)
 	go func() {
 		ctx := lagerctx.NewContext(b.ctx, logger)
 		done <- step.Run(ctx, state)
@@ -222,12 +258,45 @@ func (b *engineBuild) Run(logger lager.Logger) {
 	case <-b.release:
 		logger.Info("releasing")
 
+	case <-timedOut:
+		logger.Info("timed-out", lager.Data{"timeout": timeout.String()})
+
+		b.cancel()
+
+		// give the step a chance to stop its containers and run its abort
+		// hooks, but do not let it hold the build open forever
+		grace := time.NewTimer(TimeoutGracePeriod)
+		select {
+		case <-done:
+		case <-grace.C:
+			logger.Info("step-did-not-stop", lager.Data{"grace-period": TimeoutGracePeriod.String()})
+		}
+		grace.Stop()
+
+		err = BuildTimeoutError{Timeout: timeout}
+		b.builder.BuildStepErrored(logger, b.build, err)
+		b.finish(logger.Session("finish"), err, false)
+
 	case err = <-done:
 		logger.Debug("engine-build-done")
 		b.finish(logger.Session("finish"), err, step.Succeeded())
 	}
 }
 
+// timeout returns the maximum duration of the build, taking the lower of the
+// timeout of the job when the build started and the cluster-wide maximum. Zero
+// means the build may run forever.
+func (b *engineBuild) timeout() time.Duration {
+	timeout := b.maxBuildDuration
+
+	jobTimeout := b.build.Timeout()
+	if jobTimeout > 0 && (timeout == 0 || jobTimeout < timeout) {
+		return jobTimeout
+	}
+
+	return timeout
+}
+
 func (b *engineBuild) finish(logger lager.Logger, err error, succeeded bool) {
 	if err == context.Canceled {
 		b.saveStatus(logger, atc.StatusAborted)
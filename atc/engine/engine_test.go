@@ -45,7 +45,7 @@ var _ = Describe("Engine", func() {
 		)
 
 		BeforeEach(func() {
-			engine = NewEngine(fakeStepBuilder)
+			engine = NewEngine(fakeStepBuilder, 0)
 		})
 
 		JustBeforeEach(func() {
@@ -64,7 +64,7 @@ var _ = Describe("Engine", func() {
 		)
 
 		BeforeEach(func() {
-			engine = NewEngine(fakeStepBuilder)
+			engine = NewEngine(fakeStepBuilder, 0)
 		})
 
 		JustBeforeEach(func() {
@@ -78,28 +78,30 @@ var _ = Describe("Engine", func() {
 
 	Describe("Build", func() {
 		var (
-			build     Runnable
-			release   chan bool
-			cancel    chan bool
-			waitGroup *sync.WaitGroup
+			build            Runnable
+			release          chan bool
+			cancel           chan bool
+			waitGroup        *sync.WaitGroup
+			maxBuildDuration time.Duration
 		)
 
 		BeforeEach(func() {
-
-			ctx := context.Background()
 			cancel = make(chan bool)
 			release = make(chan bool)
-			trackedStates := new(sync.Map)
 			waitGroup = new(sync.WaitGroup)
+			maxBuildDuration = 0
+		})
 
+		JustBeforeEach(func() {
 			build = NewBuild(
-				ctx,
+				context.Background(),
 				func() { cancel <- true },
 				fakeBuild,
 				fakeStepBuilder,
 				release,
-				trackedStates,
+				new(sync.Map),
 				waitGroup,
+				maxBuildDuration,
 			)
 		})
 
@@ -205,6 +207,114 @@ var _ = Describe("Engine", func() {
 								})
 							})
 
+							Context("when the build exceeds its timeout", func() {
+								var (
+									stepStopped chan struct{}
+									finished    chan struct{}
+								)
+
+								BeforeEach(func() {
+									fakeBuild.JobIDReturns(1)
+									fakeBuild.JobNameReturns("some-job")
+									fakeBuild.StartTimeReturns(time.Now())
+									fakeBuild.TimeoutReturns(10 * time.Millisecond)
+
+									stepStopped = make(chan struct{})
+									fakeStep.RunStub = func(ctx context.Context, state exec.RunState) error {
+										<-stepStopped
+										return context.Canceled
+									}
+
+									finished = make(chan struct{})
+									fakeBuild.FinishStub = func(db.BuildStatus) error {
+										close(finished)
+										return nil
+									}
+
+									go func() {
+										defer GinkgoRecover()
+										Eventually(cancel).Should(Receive())
+
+										// the build must not be finished while the step is still
+										// stopping
+										Consistently(finished, 20*time.Millisecond).ShouldNot(BeClosed())
+										close(stepStopped)
+									}()
+								})
+
+								It("finishes the build as errored once the step stops", func() {
+									waitGroup.Wait()
+									Expect(fakeBuild.FinishCallCount()).To(Equal(1))
+									Expect(fakeBuild.FinishArgsForCall(0)).To(Equal(db.BuildStatusErrored))
+								})
+
+								It("saves an error event explaining the timeout", func() {
+									waitGroup.Wait()
+									Expect(fakeStepBuilder.BuildStepErroredCallCount()).To(Equal(1))
+									_, erroredBuild, err := fakeStepBuilder.BuildStepErroredArgsForCall(0)
+									Expect(erroredBuild).To(Equal(fakeBuild))
+									Expect(err).To(Equal(BuildTimeoutError{Timeout: 10 * time.Millisecond}))
+								})
+
+								Context("when the cluster-wide maximum is lower", func() {
+									BeforeEach(func() {
+										fakeBuild.TimeoutReturns(time.Hour)
+										maxBuildDuration = 20 * time.Millisecond
+									})
+
+									It("times out at the maximum", func() {
+										waitGroup.Wait()
+										_, _, err := fakeStepBuilder.BuildStepErroredArgsForCall(0)
+										Expect(err).To(Equal(BuildTimeoutError{Timeout: 20 * time.Millisecond}))
+									})
+								})
+
+								Context("when the build has no timeout of its own", func() {
+									BeforeEach(func() {
+										fakeBuild.TimeoutReturns(0)
+										maxBuildDuration = 10 * time.Millisecond
+									})
+
+									It("only applies the cluster-wide maximum", func() {
+										waitGroup.Wait()
+										_, _, err := fakeStepBuilder.BuildStepErroredArgsForCall(0)
+										Expect(err).To(Equal(BuildTimeoutError{Timeout: 10 * time.Millisecond}))
+									})
+								})
+							})
+
+							Context("when the build exceeds its timeout and the step does not stop", func() {
+								var gracePeriod time.Duration
+
+								BeforeEach(func() {
+									fakeBuild.TimeoutReturns(10 * time.Millisecond)
+									fakeBuild.StartTimeReturns(time.Now())
+
+									gracePeriod = TimeoutGracePeriod
+									TimeoutGracePeriod = 10 * time.Millisecond
+
+									fakeStep.RunStub = func(ctx context.Context, state exec.RunState) error {
+										<-time.After(time.Hour)
+										return nil
+									}
+
+									go func() {
+										defer GinkgoRecover()
+										Eventually(cancel).Should(Receive())
+									}()
+								})
+
+								AfterEach(func() {
+									TimeoutGracePeriod = gracePeriod
+								})
+
+								It("finishes the build after the grace period", func() {
+									waitGroup.Wait()
+									Expect(fakeBuild.FinishCallCount()).To(Equal(1))
+									Expect(fakeBuild.FinishArgsForCall(0)).To(Equal(db.BuildStatusErrored))
+								})
+							})
+
 							Context("when the build finishes without error", func() {
 								BeforeEach(func() {
 									fakeStep.RunReturns(nil)
@@ -22,6 +22,15 @@ type FinishTask struct {
 func (FinishTask) EventType() atc.EventType  { return EventTypeFinishTask }
 func (FinishTask) Version() atc.EventVersion { return "4.0" }
 
+type TaskResourceUsage struct {
+	Time   int64                 `json:"time"`
+	Origin Origin                `json:"origin"`
+	Usage  atc.TaskResourceUsage `json:"usage"`
+}
+
+func (TaskResourceUsage) EventType() atc.EventType  { return EventTypeTaskResourceUsage }
+func (TaskResourceUsage) Version() atc.EventVersion { return "1.0" }
+
 type InitializeTask struct {
 	Time       int64      `json:"time"`
 	Origin     Origin     `json:"origin"`
@@ -37,6 +37,7 @@ func init() {
 	RegisterEvent(InitializeTask{})
 	RegisterEvent(StartTask{})
 	RegisterEvent(FinishTask{})
+	RegisterEvent(TaskResourceUsage{})
 	RegisterEvent(InitializeGet{})
 	RegisterEvent(StartGet{})
 	RegisterEvent(FinishGet{})
@@ -18,6 +18,9 @@ const (
 	// task execution finished
 	EventTypeFinishTask atc.EventType = "finish-task"
 
+	// resources consumed by a finished task
+	EventTypeTaskResourceUsage atc.EventType = "task-resource-usage"
+
 	// initialize getting something
 	EventTypeInitializeGet atc.EventType = "initialize-get"
 
@@ -4,6 +4,7 @@ package execfakes
 import (
 	"io"
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
@@ -25,6 +26,12 @@ type FakeTaskDelegate struct {
 		arg1 lager.Logger
 		arg2 exec.ExitStatus
 	}
+	HoldContainerForDebuggingStub        func(lager.Logger, time.Duration)
+	holdContainerForDebuggingMutex       sync.RWMutex
+	holdContainerForDebuggingArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 time.Duration
+	}
 	ImageVersionDeterminedStub        func(db.UsedResourceCache) error
 	imageVersionDeterminedMutex       sync.RWMutex
 	imageVersionDeterminedArgsForCall []struct {
@@ -42,6 +49,13 @@ type FakeTaskDelegate struct {
 		arg1 lager.Logger
 		arg2 atc.TaskConfig
 	}
+	SaveResourceUsageStub        func(lager.Logger, string, atc.TaskResourceUsage)
+	saveResourceUsageMutex       sync.RWMutex
+	saveResourceUsageArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 atc.TaskResourceUsage
+	}
 	StartingStub        func(lager.Logger, atc.TaskConfig)
 	startingMutex       sync.RWMutex
 	startingArgsForCall []struct {
@@ -146,6 +160,38 @@ func (fake *FakeTaskDelegate) FinishedArgsForCall(i int) (lager.Logger, exec.Exi
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeTaskDelegate) HoldContainerForDebugging(arg1 lager.Logger, arg2 time.Duration) {
+	fake.holdContainerForDebuggingMutex.Lock()
+	fake.holdContainerForDebuggingArgsForCall = append(fake.holdContainerForDebuggingArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("HoldContainerForDebugging", []interface{}{arg1, arg2})
+	fake.holdContainerForDebuggingMutex.Unlock()
+	if fake.HoldContainerForDebuggingStub != nil {
+		fake.HoldContainerForDebuggingStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeTaskDelegate) HoldContainerForDebuggingCallCount() int {
+	fake.holdContainerForDebuggingMutex.RLock()
+	defer fake.holdContainerForDebuggingMutex.RUnlock()
+	return len(fake.holdContainerForDebuggingArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) HoldContainerForDebuggingCalls(stub func(lager.Logger, time.Duration)) {
+	fake.holdContainerForDebuggingMutex.Lock()
+	defer fake.holdContainerForDebuggingMutex.Unlock()
+	fake.HoldContainerForDebuggingStub = stub
+}
+
+func (fake *FakeTaskDelegate) HoldContainerForDebuggingArgsForCall(i int) (lager.Logger, time.Duration) {
+	fake.holdContainerForDebuggingMutex.RLock()
+	defer fake.holdContainerForDebuggingMutex.RUnlock()
+	argsForCall := fake.holdContainerForDebuggingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeTaskDelegate) ImageVersionDetermined(arg1 db.UsedResourceCache) error {
 	fake.imageVersionDeterminedMutex.Lock()
 	ret, specificReturn := fake.imageVersionDeterminedReturnsOnCall[len(fake.imageVersionDeterminedArgsForCall)]
@@ -238,6 +284,39 @@ func (fake *FakeTaskDelegate) InitializingArgsForCall(i int) (lager.Logger, atc.
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeTaskDelegate) SaveResourceUsage(arg1 lager.Logger, arg2 string, arg3 atc.TaskResourceUsage) {
+	fake.saveResourceUsageMutex.Lock()
+	fake.saveResourceUsageArgsForCall = append(fake.saveResourceUsageArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 atc.TaskResourceUsage
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SaveResourceUsage", []interface{}{arg1, arg2, arg3})
+	fake.saveResourceUsageMutex.Unlock()
+	if fake.SaveResourceUsageStub != nil {
+		fake.SaveResourceUsageStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeTaskDelegate) SaveResourceUsageCallCount() int {
+	fake.saveResourceUsageMutex.RLock()
+	defer fake.saveResourceUsageMutex.RUnlock()
+	return len(fake.saveResourceUsageArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) SaveResourceUsageCalls(stub func(lager.Logger, string, atc.TaskResourceUsage)) {
+	fake.saveResourceUsageMutex.Lock()
+	defer fake.saveResourceUsageMutex.Unlock()
+	fake.SaveResourceUsageStub = stub
+}
+
+func (fake *FakeTaskDelegate) SaveResourceUsageArgsForCall(i int) (lager.Logger, string, atc.TaskResourceUsage) {
+	fake.saveResourceUsageMutex.RLock()
+	defer fake.saveResourceUsageMutex.RUnlock()
+	argsForCall := fake.saveResourceUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
 func (fake *FakeTaskDelegate) Starting(arg1 lager.Logger, arg2 atc.TaskConfig) {
 	fake.startingMutex.Lock()
 	fake.startingArgsForCall = append(fake.startingArgsForCall, struct {
@@ -433,10 +512,14 @@ func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	defer fake.erroredMutex.RUnlock()
 	fake.finishedMutex.RLock()
 	defer fake.finishedMutex.RUnlock()
+	fake.holdContainerForDebuggingMutex.RLock()
+	defer fake.holdContainerForDebuggingMutex.RUnlock()
 	fake.imageVersionDeterminedMutex.RLock()
 	defer fake.imageVersionDeterminedMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.saveResourceUsageMutex.RLock()
+	defer fake.saveResourceUsageMutex.RUnlock()
 	fake.startingMutex.RLock()
 	defer fake.startingMutex.RUnlock()
 	fake.stderrMutex.RLock()
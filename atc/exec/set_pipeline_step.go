@@ -81,6 +81,7 @@ func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
 	step.delegate.Starting(logger)
 
 	warnings, errors := configvalidate.Validate(atcConfig)
+	errors = append(errors, configvalidate.ValidateNetworkPolicy(atcConfig, atc.NetworkPolicies.ForTeam(step.metadata.TeamName))...)
 	for _, warning := range warnings {
 		fmt.Fprintf(stderr, "WARNING: %s\n", warning.Message)
 	}
@@ -17,6 +17,10 @@ type StepMetadata struct {
 	ResourceConfigID      int
 	BaseResourceTypeID    int
 	ExternalURL           string
+
+	// Priority of the build's job, used to order task steps waiting for a
+	// worker. It is not exposed to the step's environment.
+	Priority int
 }
 
 func (metadata StepMetadata) Env() []string {
@@ -8,6 +8,7 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
@@ -50,6 +51,16 @@ func (err TaskImageSourceParametersError) Error() string {
 	return fmt.Sprintf("failed to evaluate image resource parameters: %s", err.Err)
 }
 
+// TaskNetworkPolicyError is returned when the task's network config is not
+// permitted by its team's network policy.
+type TaskNetworkPolicyError struct {
+	Err error
+}
+
+func (err TaskNetworkPolicyError) Error() string {
+	return fmt.Sprintf("task network config rejected: %s", err.Err)
+}
+
 //go:generate counterfeiter . TaskDelegate
 
 type TaskDelegate interface {
@@ -64,6 +75,12 @@ type TaskDelegate interface {
 	Starting(lager.Logger, atc.TaskConfig)
 	Finished(lager.Logger, ExitStatus)
 	Errored(lager.Logger, string)
+
+	SaveResourceUsage(lager.Logger, string, atc.TaskResourceUsage)
+
+	// HoldContainerForDebugging keeps the task's container around for the
+	// given duration after it failed.
+	HoldContainerForDebugging(lager.Logger, time.Duration)
 }
 
 // TaskStep executes a TaskConfig, whose inputs will be fetched from the
@@ -179,6 +196,11 @@ func (step *TaskStep) Run(ctx context.Context, state RunState) error {
 		config.Limits.Memory = step.defaultLimits.Memory
 	}
 
+	err = atc.NetworkPolicies.ForTeam(step.metadata.TeamName).Check(config.Network)
+	if err != nil {
+		return TaskNetworkPolicyError{Err: err}
+	}
+
 	step.delegate.Initializing(logger, config)
 
 	workerSpec, err := step.workerSpec(logger, resourceTypes, repository, config)
@@ -246,8 +268,22 @@ func (step *TaskStep) Run(ctx context.Context, state RunState) error {
 	}
 
 	step.succeeded = (result.Status == 0)
+
+	if !step.succeeded && step.plan.DebugOnFailure != "" {
+		duration, err := time.ParseDuration(step.plan.DebugOnFailure)
+		if err != nil {
+			logger.Error("failed-to-parse-debug-on-failure", err)
+		} else {
+			step.delegate.HoldContainerForDebugging(logger, duration)
+		}
+	}
+
 	step.delegate.Finished(logger, ExitStatus(result.Status))
 
+	if result.ResourceUsage != nil {
+		step.delegate.SaveResourceUsage(logger, step.plan.Name, *result.ResourceUsage)
+	}
+
 	step.registerOutputs(logger, repository, config, result.VolumeMounts, step.containerMetadata)
 
 	// Do not initialize caches for one-off builds
@@ -359,6 +395,15 @@ func (step *TaskStep) containerSpec(logger lager.Logger, repository *artifact.Re
 		Outputs: worker.OutputPaths{},
 	}
 
+	if config.Network != nil && config.Network.Restricted() {
+		allow, err := config.Network.Rules()
+		if err != nil {
+			return worker.ContainerSpec{}, err
+		}
+
+		containerSpec.Network = &worker.ContainerNetwork{Allow: allow}
+	}
+
 	containerSpec.Inputs, err = step.containerInputs(logger, repository, config, metadata)
 	if err != nil {
 		return worker.ContainerSpec{}, err
@@ -378,6 +423,9 @@ func (step *TaskStep) workerSpec(logger lager.Logger, resourceTypes atc.Versione
 		Tags:          step.plan.Tags,
 		TeamID:        step.metadata.TeamID,
 		ResourceTypes: resourceTypes,
+		Priority:      step.metadata.Priority,
+
+		RestrictedNetwork: config.Network != nil && config.Network.Restricted(),
 	}
 
 	imageSpec, err := step.imageSpec(logger, repository, config)
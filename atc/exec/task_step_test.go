@@ -7,6 +7,7 @@ import (
 	"io"
 	"io/ioutil"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
@@ -748,6 +749,21 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Context("when the build has a priority", func() {
+			BeforeEach(func() {
+				stepMetadata.Priority = 7
+			})
+
+			AfterEach(func() {
+				stepMetadata.Priority = 0
+			})
+
+			It("passes it along in the worker spec", func() {
+				_, _, _, _, _, workerSpec, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(workerSpec.Priority).To(Equal(7))
+			})
+		})
+
 		Context("when a run dir is specified", func() {
 			var dir string
 			BeforeEach(func() {
@@ -777,6 +793,68 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Context("when the task restricts its network", func() {
+			BeforeEach(func() {
+				taskPlan.Config.Network = &atc.TaskNetworkConfig{
+					Egress: "none",
+					Allow:  []string{"10.0.0.0/8:443"},
+				}
+			})
+
+			It("restricts the container's network to the allowed destinations", func() {
+				_, _, _, _, containerSpec, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(containerSpec.Network).ToNot(BeNil())
+				Expect(containerSpec.Network.Allow).To(HaveLen(1))
+				Expect(containerSpec.Network.Allow[0].String()).To(Equal("10.0.0.0/8:443"))
+			})
+
+			It("only runs on workers enforcing network rules", func() {
+				_, _, _, _, _, workerSpec, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(workerSpec.RestrictedNetwork).To(BeTrue())
+			})
+
+			Context("when the team's network policy does not permit the destination", func() {
+				BeforeEach(func() {
+					atc.NetworkPolicies = atc.TaskNetworkPolicies{
+						"*": {AllowedNetworks: []string{"192.168.0.0/16"}},
+					}
+				})
+
+				AfterEach(func() {
+					atc.NetworkPolicies = nil
+				})
+
+				It("returns an error without running the task", func() {
+					Expect(stepErr).To(BeAssignableToTypeOf(exec.TaskNetworkPolicyError{}))
+					Expect(fakeClient.RunTaskStepCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when the team's network policy requires restricted egress", func() {
+			BeforeEach(func() {
+				atc.NetworkPolicies = atc.TaskNetworkPolicies{
+					stepMetadata.TeamName: {RequireRestrictedEgress: true},
+				}
+			})
+
+			AfterEach(func() {
+				atc.NetworkPolicies = nil
+			})
+
+			It("returns an error without running the task", func() {
+				Expect(stepErr).To(BeAssignableToTypeOf(exec.TaskNetworkPolicyError{}))
+				Expect(fakeClient.RunTaskStepCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the task does not restrict its network", func() {
+			It("leaves the container's network unrestricted", func() {
+				_, _, _, _, containerSpec, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(containerSpec.Network).To(BeNil())
+			})
+		})
+
 		Context("when running the task succeeds", func() {
 			var taskStepStatus int
 			BeforeEach(func() {
@@ -820,6 +898,47 @@ var _ = Describe("TaskStep", func() {
 					Expect(stepErr).ToNot(HaveOccurred())
 				})
 
+				It("does not save any resource usage", func() {
+					Expect(fakeDelegate.SaveResourceUsageCallCount()).To(BeZero())
+				})
+
+				Context("when the task is configured to be debugged on failure", func() {
+					BeforeEach(func() {
+						taskPlan.DebugOnFailure = "30m"
+					})
+
+					It("does not hold the container for debugging", func() {
+						Expect(fakeDelegate.HoldContainerForDebuggingCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the resource usage was measured", func() {
+					var usage atc.TaskResourceUsage
+
+					BeforeEach(func() {
+						usage = atc.TaskResourceUsage{
+							CPUTime:       1000,
+							PeakMemory:    2048,
+							AverageMemory: 1024,
+							PeakDisk:      4096,
+							OutputSizes:   map[string]uint64{"some-output": 512},
+						}
+
+						fakeClient.RunTaskStepReturns(worker.TaskResult{
+							Status:        taskStepStatus,
+							VolumeMounts:  []worker.VolumeMount{},
+							ResourceUsage: &usage,
+						})
+					})
+
+					It("saves the resource usage via the delegate", func() {
+						Expect(fakeDelegate.SaveResourceUsageCallCount()).To(Equal(1))
+						_, stepName, savedUsage := fakeDelegate.SaveResourceUsageArgsForCall(0)
+						Expect(stepName).To(Equal("some-task"))
+						Expect(savedUsage).To(Equal(usage))
+					})
+				})
+
 				Describe("the registered sources", func() {
 					var (
 						artifactSource1 worker.ArtifactSource
@@ -1025,6 +1144,22 @@ var _ = Describe("TaskStep", func() {
 				It("returns successfully", func() {
 					Expect(stepErr).ToNot(HaveOccurred())
 				})
+
+				It("does not hold the container for debugging", func() {
+					Expect(fakeDelegate.HoldContainerForDebuggingCallCount()).To(BeZero())
+				})
+
+				Context("when the task is configured to be debugged on failure", func() {
+					BeforeEach(func() {
+						taskPlan.DebugOnFailure = "30m"
+					})
+
+					It("holds the container for debugging via the delegate", func() {
+						Expect(fakeDelegate.HoldContainerForDebuggingCallCount()).To(Equal(1))
+						_, duration := fakeDelegate.HoldContainerForDebuggingArgsForCall(0)
+						Expect(duration).To(Equal(30 * time.Minute))
+					})
+				})
 			})
 		})
 
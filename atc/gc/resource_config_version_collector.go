@@ -0,0 +1,36 @@
+package gc
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+type resourceConfigVersionCollector struct {
+	versionLifecycle db.ResourceConfigVersionLifecycle
+}
+
+func NewResourceConfigVersionCollector(versionLifecycle db.ResourceConfigVersionLifecycle) *resourceConfigVersionCollector {
+	return &resourceConfigVersionCollector{
+		versionLifecycle: versionLifecycle,
+	}
+}
+
+func (c *resourceConfigVersionCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("resource-config-version-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	pruned, err := c.versionLifecycle.RemoveExpiredVersions()
+	if err != nil {
+		logger.Error("failed-to-remove-expired-versions", err)
+		return err
+	}
+
+	metric.VersionsPruned.IncDelta(pruned)
+
+	return nil
+}
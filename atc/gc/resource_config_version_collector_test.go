@@ -0,0 +1,42 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResourceConfigVersionCollector", func() {
+	var collector GcCollector
+	var fakeVersionLifecycle *dbfakes.FakeResourceConfigVersionLifecycle
+
+	BeforeEach(func() {
+		fakeVersionLifecycle = new(dbfakes.FakeResourceConfigVersionLifecycle)
+
+		collector = gc.NewResourceConfigVersionCollector(fakeVersionLifecycle)
+	})
+
+	Describe("Run", func() {
+		It("tells the version lifecycle to remove expired versions", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeVersionLifecycle.RemoveExpiredVersionsCallCount()).To(Equal(1))
+		})
+
+		Context("when removing expired versions fails", func() {
+			BeforeEach(func() {
+				fakeVersionLifecycle.RemoveExpiredVersionsReturns(0, errors.New("disaster"))
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(MatchError("disaster"))
+			})
+		})
+	})
+})
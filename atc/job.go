@@ -13,6 +13,7 @@ type Job struct {
 	FinishedBuild        *Build `json:"finished_build"`
 	TransitionBuild      *Build `json:"transition_build,omitempty"`
 	HasNewInputs         bool   `json:"has_new_inputs,omitempty"`
+	NextScheduledBuild   int64  `json:"next_scheduled_build,omitempty"`
 
 	Inputs  []JobInput  `json:"inputs"`
 	Outputs []JobOutput `json:"outputs"`
@@ -21,13 +22,15 @@ type Job struct {
 }
 
 type JobInput struct {
-	Name     string         `json:"name"`
-	Resource string         `json:"resource"`
-	Passed   []string       `json:"passed,omitempty"`
-	Trigger  bool           `json:"trigger"`
-	Version  *VersionConfig `json:"version,omitempty"`
-	Params   Params         `json:"params,omitempty"`
-	Tags     Tags           `json:"tags,omitempty"`
+	Name      string         `json:"name"`
+	Resource  string         `json:"resource"`
+	Passed    []string       `json:"passed,omitempty"`
+	PassedAny []string       `json:"passed_any,omitempty"`
+	Labeled   []string       `json:"labeled,omitempty"`
+	Trigger   bool           `json:"trigger"`
+	Version   *VersionConfig `json:"version,omitempty"`
+	Params    Params         `json:"params,omitempty"`
+	Tags      Tags           `json:"tags,omitempty"`
 }
 
 type JobOutput struct {
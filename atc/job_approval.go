@@ -0,0 +1,100 @@
+package atc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ApprovalConfig requires each build of a job to be approved before it runs.
+//
+// It is configured either as `requires_approval: true`, allowing anyone who
+// may trigger the job to approve its builds, or with a list of the team roles
+// which may approve them.
+type ApprovalConfig struct {
+	Roles []string `json:"roles,omitempty"`
+}
+
+func (config *ApprovalConfig) UnmarshalJSON(data []byte) error {
+	var required bool
+	if err := json.Unmarshal(data, &required); err == nil {
+		if !required {
+			return errors.New("requires_approval must be true or a list of roles")
+		}
+
+		*config = ApprovalConfig{}
+		return nil
+	}
+
+	var raw struct {
+		Roles []string `json:"roles"`
+	}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return errors.New("requires_approval must be true or a list of roles")
+	}
+
+	config.Roles = raw.Roles
+
+	return nil
+}
+
+func (config ApprovalConfig) MarshalJSON() ([]byte, error) {
+	if len(config.Roles) == 0 {
+		return json.Marshal(true)
+	}
+
+	return json.Marshal(struct {
+		Roles []string `json:"roles"`
+	}{config.Roles})
+}
+
+func (config ApprovalConfig) Validate() error {
+	for _, role := range config.Roles {
+		valid := false
+		for _, r := range teamRoles {
+			if role == r {
+				valid = true
+				break
+			}
+		}
+
+		if !valid {
+			return fmt.Errorf("unknown role '%s'", role)
+		}
+	}
+
+	return nil
+}
+
+// AllowsRoles returns whether a user with any of the given team roles may
+// approve builds.
+func (config ApprovalConfig) AllowsRoles(roles []string) bool {
+	if len(config.Roles) == 0 {
+		return true
+	}
+
+	for _, role := range roles {
+		for _, allowed := range config.Roles {
+			if role == allowed {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// BuildApproval records the decision made on a build awaiting approval.
+type BuildApproval struct {
+	Approved bool   `json:"approved"`
+	By       string `json:"by"`
+	Comment  string `json:"comment,omitempty"`
+	Time     int64  `json:"time"`
+}
+
+// ApprovalRequest is the body of a request to approve or reject a build.
+type ApprovalRequest struct {
+	Comment string `json:"comment,omitempty"`
+}
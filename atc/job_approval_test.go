@@ -0,0 +1,65 @@
+package atc_test
+
+import (
+	"encoding/json"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ApprovalConfig", func() {
+	Describe("unmarshaling", func() {
+		It("accepts true", func() {
+			var config atc.JobConfig
+			Expect(json.Unmarshal([]byte(`{"requires_approval": true}`), &config)).To(Succeed())
+			Expect(config.RequiresApproval).To(Equal(&atc.ApprovalConfig{}))
+		})
+
+		It("accepts a list of roles", func() {
+			var config atc.JobConfig
+			Expect(json.Unmarshal([]byte(`{"requires_approval": {"roles": ["owner", "member"]}}`), &config)).To(Succeed())
+			Expect(config.RequiresApproval).To(Equal(&atc.ApprovalConfig{Roles: []string{"owner", "member"}}))
+		})
+
+		It("rejects false", func() {
+			var config atc.JobConfig
+			err := json.Unmarshal([]byte(`{"requires_approval": false}`), &config)
+			Expect(err).To(MatchError("requires_approval must be true or a list of roles"))
+		})
+
+		It("rejects anything else", func() {
+			var config atc.JobConfig
+			err := json.Unmarshal([]byte(`{"requires_approval": "yes"}`), &config)
+			Expect(err).To(MatchError("requires_approval must be true or a list of roles"))
+		})
+	})
+
+	Describe("marshaling", func() {
+		It("marshals to true without roles", func() {
+			bs, err := json.Marshal(atc.JobConfig{RequiresApproval: &atc.ApprovalConfig{}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bs).To(ContainSubstring(`"requires_approval":true`))
+		})
+
+		It("marshals the roles", func() {
+			bs, err := json.Marshal(atc.JobConfig{RequiresApproval: &atc.ApprovalConfig{Roles: []string{"owner"}}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bs).To(ContainSubstring(`"requires_approval":{"roles":["owner"]}`))
+		})
+	})
+
+	Describe("AllowsRoles", func() {
+		It("allows any role when none are configured", func() {
+			Expect(atc.ApprovalConfig{}.AllowsRoles([]string{"viewer"})).To(BeTrue())
+		})
+
+		It("only allows the configured roles", func() {
+			config := atc.ApprovalConfig{Roles: []string{"owner"}}
+			Expect(config.AllowsRoles([]string{"member", "owner"})).To(BeTrue())
+			Expect(config.AllowsRoles([]string{"member"})).To(BeFalse())
+			Expect(config.AllowsRoles(nil)).To(BeFalse())
+		})
+	})
+})
@@ -14,6 +14,36 @@ type JobConfig struct {
 
 	BuildLogRetention *BuildLogRetention `json:"build_log_retention,omitempty"`
 
+	// Timeout bounds the duration of the entire build, including hooks.
+	Timeout string `json:"timeout,omitempty"`
+
+	// DefaultStepTimeout applies to every get, put, task and set_pipeline
+	// step in the plan which does not configure its own timeout.
+	DefaultStepTimeout string `json:"default_step_timeout,omitempty"`
+
+	// DebugOnFailure keeps the containers of failed task steps around for the
+	// given duration so that they can be hijacked, unless the step configures
+	// its own.
+	DebugOnFailure string `json:"debug_on_failure,omitempty"`
+
+	Schedule *ScheduleConfig `json:"schedule,omitempty"`
+
+	RequiresApproval *ApprovalConfig `json:"requires_approval,omitempty"`
+
+	// Locks names team-wide locks which must all be held by a build of this
+	// job before it can start. Unlike serial groups they apply across
+	// pipelines.
+	Locks []string `json:"locks,omitempty"`
+
+	// Priority determines the order in which pending builds are started when
+	// capacity is limited; higher priorities go first. Jobs without one use
+	// their team's default job priority.
+	//
+	// Builds are only ordered against the other jobs of the same pipeline
+	// when scheduling, and against the other steps on the same ATC when
+	// waiting for a worker; it is not a global ordering.
+	Priority *int `json:"priority,omitempty"`
+
 	Abort   *PlanConfig `json:"on_abort,omitempty"`
 	Error   *PlanConfig `json:"on_error,omitempty"`
 	Failure *PlanConfig `json:"on_failure,omitempty"`
@@ -161,13 +191,15 @@ func (config JobConfig) Inputs() []JobInput {
 			}
 
 			inputs = append(inputs, JobInput{
-				Name:     get,
-				Resource: resource,
-				Passed:   plan.Passed,
-				Version:  plan.Version,
-				Trigger:  plan.Trigger,
-				Params:   plan.Params,
-				Tags:     plan.Tags,
+				Name:      get,
+				Resource:  resource,
+				Passed:    plan.Passed,
+				PassedAny: plan.PassedAny,
+				Labeled:   plan.Labeled,
+				Version:   plan.Version,
+				Trigger:   plan.Trigger,
+				Params:    plan.Params,
+				Tags:      plan.Tags,
 			})
 		}
 	}
@@ -0,0 +1,39 @@
+package atc
+
+// JobInputsExplanation describes how the scheduler would resolve the inputs
+// of a job's next build, or why it cannot.
+type JobInputsExplanation struct {
+	Resolved  bool                 `json:"resolved"`
+	Inputs    []InputExplanation   `json:"inputs"`
+	Conflicts []ConstraintConflict `json:"conflicts,omitempty"`
+}
+
+type InputExplanation struct {
+	Name      string   `json:"name"`
+	Resource  string   `json:"resource"`
+	Passed    []string `json:"passed,omitempty"`
+	PassedAny []string `json:"passed_any,omitempty"`
+	Labeled   []string `json:"labeled,omitempty"`
+	Pinned    bool     `json:"pinned,omitempty"`
+
+	// The version that would be used if the inputs resolved.
+	Version Version `json:"version,omitempty"`
+
+	// The newest versions of the resource, each listing the passed jobs
+	// which have not used it.
+	Candidates []CandidateExplanation `json:"candidates"`
+}
+
+type CandidateExplanation struct {
+	ID           int      `json:"id"`
+	Version      Version  `json:"version"`
+	EliminatedBy []string `json:"eliminated_by,omitempty"`
+}
+
+// ConstraintConflict is a job that the given inputs must all have passed
+// through together, but no single build of the job used a remaining
+// candidate of each.
+type ConstraintConflict struct {
+	Job    string   `json:"job"`
+	Inputs []string `json:"inputs"`
+}
@@ -0,0 +1,82 @@
+package atc
+
+import (
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/robfig/cron"
+)
+
+// ScheduleConfig configures a job to be triggered periodically, without
+// having to configure a time resource.
+type ScheduleConfig struct {
+	// Cron is a standard five-field cron expression, e.g. "0 3 * * *".
+	Cron string `json:"cron"`
+
+	// Location is the IANA time zone in which the expression is evaluated.
+	// Defaults to UTC.
+	Location string `json:"location,omitempty"`
+
+	// Jitter delays each run by up to the given duration, to spread out jobs
+	// sharing the same expression.
+	Jitter string `json:"jitter,omitempty"`
+}
+
+// Validate checks that the expression, location and jitter can be parsed.
+func (config ScheduleConfig) Validate() error {
+	_, _, _, err := config.parse()
+	return err
+}
+
+// NextFireTime returns the first time after the given time at which the
+// schedule fires, including jitter. The jitter is derived from the seed and
+// the fire time so that every ATC computes the same value.
+func (config ScheduleConfig) NextFireTime(after time.Time, seed int) (time.Time, error) {
+	schedule, location, jitter, err := config.parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	next := schedule.Next(after.In(location))
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("schedule '%s' never fires", config.Cron)
+	}
+
+	if jitter > 0 {
+		hash := fnv.New64a()
+		_, _ = fmt.Fprintf(hash, "%d/%d", seed, next.Unix())
+		next = next.Add(time.Duration(hash.Sum64() % uint64(jitter)))
+	}
+
+	return next, nil
+}
+
+func (config ScheduleConfig) parse() (cron.Schedule, *time.Location, time.Duration, error) {
+	schedule, err := cron.ParseStandard(config.Cron)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("invalid cron expression '%s': %s", config.Cron, err)
+	}
+
+	location := time.UTC
+	if config.Location != "" {
+		location, err = time.LoadLocation(config.Location)
+		if err != nil {
+			return nil, nil, 0, fmt.Errorf("invalid location '%s': %s", config.Location, err)
+		}
+	}
+
+	var jitter time.Duration
+	if config.Jitter != "" {
+		jitter, err = time.ParseDuration(config.Jitter)
+		if err != nil {
+			return nil, nil, 0, fmt.Errorf("invalid jitter '%s': %s", config.Jitter, err)
+		}
+
+		if jitter < 0 {
+			return nil, nil, 0, fmt.Errorf("invalid jitter '%s': must not be negative", config.Jitter)
+		}
+	}
+
+	return schedule, location, jitter, nil
+}
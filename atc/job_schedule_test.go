@@ -0,0 +1,86 @@
+package atc_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ScheduleConfig", func() {
+	var (
+		config atc.ScheduleConfig
+		after  time.Time
+	)
+
+	BeforeEach(func() {
+		config = atc.ScheduleConfig{Cron: "30 2 * * *"}
+		after = time.Date(2019, 11, 12, 10, 0, 0, 0, time.UTC)
+	})
+
+	Describe("NextFireTime", func() {
+		It("returns the next matching time in UTC", func() {
+			next, err := config.NextFireTime(after, 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next).To(BeTemporally("==", time.Date(2019, 11, 13, 2, 30, 0, 0, time.UTC)))
+		})
+
+		Context("when a location is configured", func() {
+			BeforeEach(func() {
+				config.Location = "America/Toronto"
+			})
+
+			It("evaluates the expression in that location", func() {
+				next, err := config.NextFireTime(after, 1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(next).To(BeTemporally("==", time.Date(2019, 11, 13, 7, 30, 0, 0, time.UTC)))
+			})
+		})
+
+		Context("when jitter is configured", func() {
+			BeforeEach(func() {
+				config.Jitter = "10m"
+			})
+
+			It("delays the fire time by up to the jitter", func() {
+				next, err := config.NextFireTime(after, 1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(next).To(BeTemporally(">=", time.Date(2019, 11, 13, 2, 30, 0, 0, time.UTC)))
+				Expect(next).To(BeTemporally("<", time.Date(2019, 11, 13, 2, 40, 0, 0, time.UTC)))
+			})
+
+			It("is deterministic for the same seed", func() {
+				first, err := config.NextFireTime(after, 1)
+				Expect(err).ToNot(HaveOccurred())
+
+				second, err := config.NextFireTime(after, 1)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(first).To(Equal(second))
+			})
+		})
+	})
+
+	Describe("Validate", func() {
+		It("accepts a valid schedule", func() {
+			Expect(config.Validate()).To(Succeed())
+		})
+
+		It("rejects an invalid cron expression", func() {
+			config.Cron = "every tuesday"
+			Expect(config.Validate()).To(MatchError(ContainSubstring("invalid cron expression 'every tuesday'")))
+		})
+
+		It("rejects an unknown location", func() {
+			config.Location = "Mars/Olympus_Mons"
+			Expect(config.Validate()).To(MatchError(ContainSubstring("invalid location 'Mars/Olympus_Mons'")))
+		})
+
+		It("rejects an unparseable jitter", func() {
+			config.Jitter = "a bit"
+			Expect(config.Validate()).To(MatchError(ContainSubstring("invalid jitter 'a bit'")))
+		})
+	})
+})
@@ -97,7 +97,8 @@ func (emitter *NewRelicEmitter) Emit(logger lager.Logger, event metric.Event) {
 		"database queries",
 		"database connections",
 		"worker unknown containers",
-		"worker unknown volumes":
+		"worker unknown volumes",
+		"versions pruned":
 		emitter.NewRelicBatch = append(emitter.NewRelicBatch, emitter.transformToNewRelicEvent(event, ""))
 
 	// These are periodic metrics that are consolidated and only emitted once
@@ -141,6 +142,9 @@ func (emitter *NewRelicEmitter) Emit(logger lager.Logger, event metric.Event) {
 	case "scheduling: job duration (ms)":
 		emitter.NewRelicBatch = append(emitter.NewRelicBatch, emitter.transformToNewRelicEvent(event,
 			"scheduling_job_duration_ms"))
+	case "scheduling: job input resolution duration (ms)":
+		emitter.NewRelicBatch = append(emitter.NewRelicBatch, emitter.transformToNewRelicEvent(event,
+			"scheduling_job_input_resolution_duration_ms"))
 	default:
 		// Ignore the rest
 	}
@@ -44,8 +44,9 @@ type PrometheusEmitter struct {
 	checkEnqueueVec *prometheus.CounterVec
 	checkQueueSize  prometheus.Gauge
 
-	schedulingFullDuration    *prometheus.CounterVec
-	schedulingLoadingDuration *prometheus.CounterVec
+	schedulingFullDuration            *prometheus.CounterVec
+	schedulingLoadingDuration         *prometheus.CounterVec
+	schedulingInputResolutionDuration *prometheus.CounterVec
 
 	workerContainers        *prometheus.GaugeVec
 	workerUnknownContainers *prometheus.GaugeVec
@@ -292,6 +293,17 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(schedulingLoadingDuration)
 
+	schedulingInputResolutionDuration := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "scheduling",
+			Name:      "input_resolution_duration_seconds_total",
+			Help:      "Total time taken to resolve the inputs of a job",
+		},
+		[]string{"pipeline", "job"},
+	)
+	prometheus.MustRegister(schedulingInputResolutionDuration)
+
 	pipelineScheduled := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: "concourse",
@@ -400,8 +412,9 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		checkEnqueueVec: checkEnqueueVec,
 		checkQueueSize:  checkQueueSize,
 
-		schedulingFullDuration:    schedulingFullDuration,
-		schedulingLoadingDuration: schedulingLoadingDuration,
+		schedulingFullDuration:            schedulingFullDuration,
+		schedulingLoadingDuration:         schedulingLoadingDuration,
+		schedulingInputResolutionDuration: schedulingInputResolutionDuration,
 
 		workerContainers:        workerContainers,
 		workersRegistered:       workersRegistered,
@@ -457,6 +470,8 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.schedulingMetrics(logger, event)
 	case "scheduling: job duration (ms)":
 		emitter.schedulingMetrics(logger, event)
+	case "scheduling: job input resolution duration (ms)":
+		emitter.schedulingMetrics(logger, event)
 	case "database queries":
 		emitter.databaseMetrics(logger, event)
 	case "database connections":
@@ -780,6 +795,9 @@ func (emitter *PrometheusEmitter) schedulingMetrics(logger lager.Logger, event m
 	case "scheduling: loading versions duration (ms)":
 		// concourse_scheduling_loading_duration_seconds_total
 		emitter.schedulingLoadingDuration.WithLabelValues(pipeline).Add(duration / 1000)
+	case "scheduling: job input resolution duration (ms)":
+		// concourse_scheduling_input_resolution_duration_seconds_total
+		emitter.schedulingInputResolutionDuration.WithLabelValues(pipeline, event.Attributes["job"]).Add(duration / 1000)
 	default:
 	}
 }
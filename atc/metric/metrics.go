@@ -23,6 +23,7 @@ var FailedVolumes = Meter(0)
 var ContainersDeleted = Meter(0)
 var VolumesDeleted = Meter(0)
 var ChecksDeleted = Meter(0)
+var VersionsPruned = Meter(0)
 
 type SchedulingFullDuration struct {
 	PipelineName string
@@ -113,6 +114,37 @@ func (event SchedulingJobDuration) Emit(logger lager.Logger) {
 	)
 }
 
+type JobInputResolutionDuration struct {
+	PipelineName string
+	JobName      string
+	Duration     time.Duration
+}
+
+func (event JobInputResolutionDuration) Emit(logger lager.Logger) {
+	state := EventStateOK
+
+	if event.Duration > time.Second {
+		state = EventStateWarning
+	}
+
+	if event.Duration > 5*time.Second {
+		state = EventStateCritical
+	}
+
+	emit(
+		logger.Session("job-input-resolution-duration"),
+		Event{
+			Name:  "scheduling: job input resolution duration (ms)",
+			Value: ms(event.Duration),
+			State: state,
+			Attributes: map[string]string{
+				"pipeline": event.PipelineName,
+				"job":      event.JobName,
+			},
+		},
+	)
+}
+
 type WorkerContainers struct {
 	WorkerName string
 	Platform   string
@@ -80,6 +80,15 @@ func tick(logger lager.Logger) {
 		},
 	)
 
+	emit(
+		logger.Session("versions-pruned"),
+		Event{
+			Name:  "versions pruned",
+			Value: VersionsPruned.Delta(),
+			State: EventStateOK,
+		},
+	)
+
 	emit(
 		logger.Session("containers-created"),
 		Event{
@@ -12,3 +12,15 @@ type Pipeline struct {
 type RenameRequest struct {
 	NewName string `json:"name"`
 }
+
+// FreezeRequest pins every resource of a pipeline to the versions used by one
+// of its builds.
+type FreezeRequest struct {
+	BuildID int    `json:"build_id"`
+	Comment string `json:"comment,omitempty"`
+}
+
+type FrozenResource struct {
+	Resource string  `json:"resource"`
+	Version  Version `json:"version"`
+}
@@ -84,5 +84,7 @@ func (rsf *radarSchedulerFactory) BuildScheduler(pipeline db.Pipeline) scheduler
 			),
 			inputMapper,
 		),
+		Clock:                  clock.NewClock(),
+		FullResolutionInterval: 5 * time.Minute,
 	}
 }
@@ -106,6 +106,9 @@ type PutPlan struct {
 	Tags     Tags          `json:"tags,omitempty"`
 	Inputs   *InputsConfig `json:"inputs,omitempty"`
 
+	// Labels to add to the version the put produces.
+	AddLabels []string `json:"add_labels,omitempty"`
+
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
@@ -135,6 +138,8 @@ type TaskPlan struct {
 	OutputMapping     map[string]string `json:"output_mapping,omitempty"`
 	ImageArtifactName string            `json:"image,omitempty"`
 
+	DebugOnFailure string `json:"debug_on_failure,omitempty"`
+
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
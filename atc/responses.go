@@ -7,6 +7,20 @@ type ClearTaskCacheResponse struct {
 type SaveConfigResponse struct {
 	Errors   []string        `json:"errors,omitempty"`
 	Warnings []ConfigWarning `json:"warnings,omitempty"`
+
+	// DryRun is only set in response to a dry run, which validates the config
+	// without saving it.
+	DryRun *ConfigDryRun `json:"dry_run,omitempty"`
+}
+
+type ConfigDryRun struct {
+	// Created is true if saving the config would create the pipeline.
+	Created bool `json:"created"`
+
+	// Diff is the rendered diff from the current config, empty if nothing
+	// changed.
+	Diff   string       `json:"diff,omitempty"`
+	Impact ConfigImpact `json:"impact"`
 }
 
 type ConfigResponse struct {
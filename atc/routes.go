@@ -3,8 +3,9 @@ package atc
 import "github.com/tedsuo/rata"
 
 const (
-	SaveConfig = "SaveConfig"
-	GetConfig  = "GetConfig"
+	SaveConfig   = "SaveConfig"
+	DryRunConfig = "DryRunConfig"
+	GetConfig    = "GetConfig"
 
 	GetBuild            = "GetBuild"
 	GetBuildPlan        = "GetBuildPlan"
@@ -13,6 +14,8 @@ const (
 	BuildEvents         = "BuildEvents"
 	BuildResources      = "BuildResources"
 	AbortBuild          = "AbortBuild"
+	ApproveBuild        = "ApproveBuild"
+	RejectBuild         = "RejectBuild"
 	GetBuildPreparation = "GetBuildPreparation"
 
 	GetCheck = "GetCheck"
@@ -30,6 +33,9 @@ const (
 	JobBadge       = "JobBadge"
 	MainJobBadge   = "MainJobBadge"
 
+	ListJobTaskResourceUsage = "ListJobTaskResourceUsage"
+	ExplainJobInputs         = "ExplainJobInputs"
+
 	ClearTaskCache = "ClearTaskCache"
 
 	ListAllResources     = "ListAllResources"
@@ -45,6 +51,8 @@ const (
 	EnableResourceVersion         = "EnableResourceVersion"
 	DisableResourceVersion        = "DisableResourceVersion"
 	PinResourceVersion            = "PinResourceVersion"
+	AddResourceVersionLabel       = "AddResourceVersionLabel"
+	RemoveResourceVersionLabel    = "RemoveResourceVersionLabel"
 	UnpinResource                 = "UnpinResource"
 	SetPinCommentOnResource       = "SetPinCommentOnResource"
 	ListBuildsWithVersionAsInput  = "ListBuildsWithVersionAsInput"
@@ -60,6 +68,8 @@ const (
 	OrderPipelines      = "OrderPipelines"
 	PausePipeline       = "PausePipeline"
 	UnpausePipeline     = "UnpausePipeline"
+	FreezePipeline      = "FreezePipeline"
+	UnfreezePipeline    = "UnfreezePipeline"
 	ExposePipeline      = "ExposePipeline"
 	HidePipeline        = "HidePipeline"
 	RenamePipeline      = "RenamePipeline"
@@ -99,8 +109,20 @@ const (
 	DestroyTeam    = "DestroyTeam"
 	ListTeamBuilds = "ListTeamBuilds"
 
+	ListLocks   = "ListLocks"
+	ReleaseLock = "ReleaseLock"
+
+	ListServiceAccounts       = "ListServiceAccounts"
+	CreateServiceAccount      = "CreateServiceAccount"
+	DestroyServiceAccount     = "DestroyServiceAccount"
+	CreateServiceAccountToken = "CreateServiceAccountToken"
+	RevokeServiceAccountToken = "RevokeServiceAccountToken"
+
+	ListTeamQueue = "ListTeamQueue"
+
 	CreateArtifact     = "CreateArtifact"
 	GetArtifact        = "GetArtifact"
+	DiffArtifact       = "DiffArtifact"
 	ListBuildArtifacts = "ListBuildArtifacts"
 
 	ListActiveUsersSince = "ListActiveUsersSince"
@@ -113,6 +135,7 @@ const (
 
 var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config", Method: "PUT", Name: SaveConfig},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/dry-run", Method: "PUT", Name: DryRunConfig},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config", Method: "GET", Name: GetConfig},
 
 	{Path: "/api/v1/teams/:team_name/builds", Method: "POST", Name: CreateBuild},
@@ -123,6 +146,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/builds/:build_id/events", Method: "GET", Name: BuildEvents},
 	{Path: "/api/v1/builds/:build_id/resources", Method: "GET", Name: BuildResources},
 	{Path: "/api/v1/builds/:build_id/abort", Method: "PUT", Name: AbortBuild},
+	{Path: "/api/v1/builds/:build_id/approve", Method: "PUT", Name: ApproveBuild},
+	{Path: "/api/v1/builds/:build_id/reject", Method: "PUT", Name: RejectBuild},
 	{Path: "/api/v1/builds/:build_id/preparation", Method: "GET", Name: GetBuildPreparation},
 	{Path: "/api/v1/builds/:build_id/artifacts", Method: "GET", Name: ListBuildArtifacts},
 
@@ -139,6 +164,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/unpause", Method: "PUT", Name: UnpauseJob},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/badge", Method: "GET", Name: JobBadge},
 	{Path: "/api/v1/pipelines/:pipeline_name/jobs/:job_name/badge", Method: "GET", Name: MainJobBadge},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/resource-usage", Method: "GET", Name: ListJobTaskResourceUsage},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/explain", Method: "GET", Name: ExplainJobInputs},
 
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/tasks/:step_name/cache", Method: "DELETE", Name: ClearTaskCache},
 
@@ -149,6 +176,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/ordering", Method: "PUT", Name: OrderPipelines},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/pause", Method: "PUT", Name: PausePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/unpause", Method: "PUT", Name: UnpausePipeline},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/freeze", Method: "PUT", Name: FreezePipeline},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/unfreeze", Method: "PUT", Name: UnfreezePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/expose", Method: "PUT", Name: ExposePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/hide", Method: "PUT", Name: HidePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/versions-db", Method: "GET", Name: GetVersionsDB},
@@ -170,6 +199,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/enable", Method: "PUT", Name: EnableResourceVersion},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/disable", Method: "PUT", Name: DisableResourceVersion},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/pin", Method: "PUT", Name: PinResourceVersion},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/labels/:label", Method: "PUT", Name: AddResourceVersionLabel},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/labels/:label", Method: "DELETE", Name: RemoveResourceVersionLabel},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/unpin", Method: "PUT", Name: UnpinResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/pin_comment", Method: "PUT", Name: SetPinCommentOnResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/input_to", Method: "GET", Name: ListBuildsWithVersionAsInput},
@@ -212,6 +243,17 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name", Method: "DELETE", Name: DestroyTeam},
 	{Path: "/api/v1/teams/:team_name/builds", Method: "GET", Name: ListTeamBuilds},
 
+	{Path: "/api/v1/teams/:team_name/locks", Method: "GET", Name: ListLocks},
+	{Path: "/api/v1/teams/:team_name/locks/:lock_name", Method: "DELETE", Name: ReleaseLock},
+	{Path: "/api/v1/teams/:team_name/queue", Method: "GET", Name: ListTeamQueue},
+
+	{Path: "/api/v1/teams/:team_name/service-accounts", Method: "GET", Name: ListServiceAccounts},
+	{Path: "/api/v1/teams/:team_name/service-accounts/:service_account_name", Method: "PUT", Name: CreateServiceAccount},
+	{Path: "/api/v1/teams/:team_name/service-accounts/:service_account_name", Method: "DELETE", Name: DestroyServiceAccount},
+	{Path: "/api/v1/teams/:team_name/service-accounts/:service_account_name/tokens", Method: "POST", Name: CreateServiceAccountToken},
+	{Path: "/api/v1/teams/:team_name/service-accounts/:service_account_name/tokens/:token_name", Method: "DELETE", Name: RevokeServiceAccountToken},
+
 	{Path: "/api/v1/teams/:team_name/artifacts", Method: "POST", Name: CreateArtifact},
 	{Path: "/api/v1/teams/:team_name/artifacts/:artifact_id", Method: "GET", Name: GetArtifact},
+	{Path: "/api/v1/teams/:team_name/artifacts/:artifact_id/diff", Method: "POST", Name: DiffArtifact},
 })
@@ -74,12 +74,26 @@ func (s *buildStarter) tryStartNextPendingBuild(
 	job db.Job,
 	resources db.Resources,
 	resourceTypes atc.VersionedResourceTypes,
-) (bool, error) {
+) (started bool, err error) {
 	logger = logger.Session("try-start-next-pending-build", lager.Data{
 		"build-id":   nextPendingBuild.ID(),
 		"build-name": nextPendingBuild.Name(),
 	})
 
+	// a build which is not starting must not stay queued for its locks, or it
+	// would hold up the builds of other jobs waiting for them
+	var keepLocks bool
+	defer func() {
+		if started || keepLocks || len(job.Config().Locks) == 0 {
+			return
+		}
+
+		releaseErr := nextPendingBuild.ReleaseLocks()
+		if releaseErr != nil {
+			logger.Error("failed-to-release-locks", releaseErr)
+		}
+	}()
+
 	if nextPendingBuild.IsAborted() {
 		logger.Debug("cancel-aborted-pending-build")
 		err := nextPendingBuild.Finish(db.BuildStatusAborted)
@@ -90,6 +104,12 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		return true, nil
 	}
 
+	requiresApproval := job.Config().RequiresApproval != nil
+	if requiresApproval && nextPendingBuild.AwaitingApproval() {
+		logger.Debug("build-awaiting-approval")
+		return false, nil
+	}
+
 	reachedMaxInFlight, err := s.maxInFlightUpdater.UpdateMaxInFlightReached(logger, job, nextPendingBuild.ID())
 	if err != nil {
 		return false, err
@@ -134,13 +154,26 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		resourceTypes = dbResourceTypes.Deserialize()
 	}
 
-	buildInputs, found, err := job.GetNextBuildInputs()
-	if err != nil {
-		logger.Error("failed-to-get-next-build-inputs", err)
-		return false, err
-	}
-	if !found {
-		return false, nil
+	var buildInputs []db.BuildInput
+	approval := nextPendingBuild.Approval()
+	if requiresApproval && approval != nil && approval.Approved {
+		// start the build with the inputs that were approved rather than
+		// whatever is newest now
+		buildInputs, err = nextPendingBuild.ApprovalInputs()
+		if err != nil {
+			logger.Error("failed-to-get-approved-build-inputs", err)
+			return false, err
+		}
+	} else {
+		var found bool
+		buildInputs, found, err = job.GetNextBuildInputs()
+		if err != nil {
+			logger.Error("failed-to-get-next-build-inputs", err)
+			return false, err
+		}
+		if !found {
+			return false, nil
+		}
 	}
 
 	pipelinePaused, err := s.pipeline.CheckPaused()
@@ -156,6 +189,31 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		return false, nil
 	}
 
+	if requiresApproval && approval == nil {
+		_, err = nextPendingBuild.RequestApproval(buildInputs)
+		if err != nil {
+			logger.Error("failed-to-request-approval", err)
+			return false, err
+		}
+
+		logger.Info("awaiting-approval")
+		return false, nil
+	}
+
+	if locks := job.Config().Locks; len(locks) > 0 {
+		acquired, err := nextPendingBuild.AcquireLocks(locks)
+		if err != nil {
+			logger.Error("failed-to-acquire-locks", err)
+			return false, err
+		}
+
+		if !acquired {
+			logger.Debug("waiting-for-locks", lager.Data{"locks": locks})
+			keepLocks = true
+			return false, nil
+		}
+	}
+
 	updated, err := nextPendingBuild.Schedule()
 	if err != nil {
 		logger.Error("failed-to-update-build-to-scheduled", err)
@@ -163,7 +221,9 @@ func (s *buildStarter) tryStartNextPendingBuild(
 	}
 
 	if !updated {
+		// whoever scheduled the build is starting it with the locks
 		logger.Debug("build-already-scheduled")
+		keepLocks = true
 		return false, nil
 	}
 
@@ -191,7 +251,7 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		return false, nil
 	}
 
-	started, err := nextPendingBuild.Start(plan)
+	started, err = nextPendingBuild.Start(plan)
 	if err != nil {
 		logger.Error("failed-to-mark-build-as-started", err)
 		return false, nil
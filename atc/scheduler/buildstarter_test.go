@@ -648,6 +648,147 @@ var _ = Describe("BuildStarter", func() {
 						itDoesntReturnAnErrorOrMarkTheBuildAsScheduled()
 						itUpdatedMaxInFlightForTheFirstBuild()
 					})
+
+					Context("when the job declares locks", func() {
+						BeforeEach(func() {
+							job.ConfigReturns(atc.JobConfig{
+								Name:  "some-job",
+								Locks: []string{"staging-env"},
+							})
+						})
+
+						Context("when the locks cannot be acquired", func() {
+							BeforeEach(func() {
+								pendingBuild1.AcquireLocksReturns(false, nil)
+							})
+
+							itDoesntReturnAnErrorOrMarkTheBuildAsScheduled()
+							itUpdatedMaxInFlightForTheFirstBuild()
+
+							It("tries to acquire the job's locks", func() {
+								Expect(pendingBuild1.AcquireLocksCallCount()).To(Equal(1))
+								Expect(pendingBuild1.AcquireLocksArgsForCall(0)).To(Equal([]string{"staging-env"}))
+							})
+
+							It("keeps the build queued for the locks", func() {
+								Expect(pendingBuild1.ReleaseLocksCallCount()).To(BeZero())
+							})
+						})
+
+						Context("when the job is paused", func() {
+							BeforeEach(func() {
+								job.PausedReturns(true)
+							})
+
+							It("removes the build from the lock queues", func() {
+								Expect(pendingBuild1.AcquireLocksCallCount()).To(BeZero())
+								Expect(pendingBuild1.ReleaseLocksCallCount()).To(Equal(1))
+							})
+						})
+
+						Context("when acquiring the locks fails", func() {
+							BeforeEach(func() {
+								pendingBuild1.AcquireLocksReturns(false, disaster)
+							})
+
+							itReturnsTheError()
+						})
+
+						Context("when the locks are acquired", func() {
+							BeforeEach(func() {
+								pendingBuild1.AcquireLocksReturns(true, nil)
+							})
+
+							It("schedules the build", func() {
+								Expect(pendingBuild1.ScheduleCallCount()).To(Equal(1))
+							})
+
+							Context("when the build fails to start", func() {
+								BeforeEach(func() {
+									pendingBuild1.ScheduleReturns(true, nil)
+									pendingBuild1.StartReturns(false, disaster)
+								})
+
+								It("releases the locks", func() {
+									Expect(pendingBuild1.ReleaseLocksCallCount()).To(Equal(1))
+								})
+							})
+
+							Context("when the build starts", func() {
+								BeforeEach(func() {
+									pendingBuild1.ScheduleReturns(true, nil)
+									pendingBuild1.StartReturns(true, nil)
+								})
+
+								It("keeps the locks", func() {
+									Expect(pendingBuild1.ReleaseLocksCallCount()).To(BeZero())
+								})
+							})
+						})
+					})
+
+					Context("when the job requires approval", func() {
+						BeforeEach(func() {
+							job.ConfigReturns(atc.JobConfig{
+								Name:             "some-job",
+								RequiresApproval: &atc.ApprovalConfig{},
+							})
+						})
+
+						Context("when the build has not been approved", func() {
+							itDoesntReturnAnErrorOrMarkTheBuildAsScheduled()
+							itUpdatedMaxInFlightForTheFirstBuild()
+
+							It("requests approval of the inputs without using them", func() {
+								Expect(pendingBuild1.RequestApprovalCallCount()).To(Equal(1))
+								Expect(pendingBuild1.RequestApprovalArgsForCall(0)).To(Equal([]db.BuildInput{{Name: "some-input"}}))
+								Expect(pendingBuild1.UseInputsCallCount()).To(BeZero())
+							})
+
+							Context("when requesting approval fails", func() {
+								BeforeEach(func() {
+									pendingBuild1.RequestApprovalReturns(false, disaster)
+								})
+
+								itReturnsTheError()
+							})
+						})
+
+						Context("when the build is awaiting approval", func() {
+							BeforeEach(func() {
+								pendingBuild1.AwaitingApprovalReturns(true)
+							})
+
+							itDoesntReturnAnErrorOrMarkTheBuildAsScheduled()
+
+							It("does not update max in flight or request approval again", func() {
+								Expect(fakeUpdater.UpdateMaxInFlightReachedCallCount()).To(BeZero())
+								Expect(pendingBuild1.RequestApprovalCallCount()).To(BeZero())
+							})
+						})
+
+						Context("when the build has been approved", func() {
+							BeforeEach(func() {
+								pendingBuild1.ApprovalReturns(&atc.BuildApproval{Approved: true, By: "some-user"})
+								pendingBuild1.ApprovalInputsReturns([]db.BuildInput{{Name: "approved-input"}}, nil)
+								fakeFactory.CreateReturns(atc.Plan{}, nil)
+								pendingBuild1.StartReturns(true, nil)
+							})
+
+							It("starts the build with the approved inputs", func() {
+								Expect(tryStartErr).NotTo(HaveOccurred())
+								Expect(pendingBuild1.ScheduleCallCount()).To(Equal(1))
+
+								_, _, _, actualInputs := fakeFactory.CreateArgsForCall(0)
+								Expect(actualInputs).To(Equal([]db.BuildInput{{Name: "approved-input"}}))
+
+								Expect(pendingBuild1.UseInputsCallCount()).To(Equal(1))
+								Expect(pendingBuild1.UseInputsArgsForCall(0)).To(Equal([]db.BuildInput{{Name: "approved-input"}}))
+
+								Expect(pendingBuild1.StartCallCount()).To(Equal(1))
+							})
+						})
+					})
 				})
 			})
 		})
@@ -178,6 +178,8 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Tags:     planConfig.Tags,
 			Inputs:   planConfig.Inputs,
 
+			AddLabels: planConfig.AddLabels,
+
 			VersionedResourceTypes: resourceTypes,
 		}
 
@@ -234,6 +236,11 @@ func (factory *buildFactory) constructUnhookedPlan(
 		})
 
 	case planConfig.Task != "":
+		debugOnFailure := planConfig.DebugOnFailure
+		if debugOnFailure == "" {
+			debugOnFailure = job.DebugOnFailure
+		}
+
 		plan = factory.planFactory.NewPlan(atc.TaskPlan{
 			Name:              planConfig.Task,
 			Privileged:        planConfig.Privileged,
@@ -245,6 +252,7 @@ func (factory *buildFactory) constructUnhookedPlan(
 			InputMapping:      planConfig.InputMapping,
 			OutputMapping:     planConfig.OutputMapping,
 			ImageArtifactName: planConfig.ImageArtifactName,
+			DebugOnFailure:    debugOnFailure,
 
 			VersionedResourceTypes: resourceTypes,
 		})
@@ -319,9 +327,14 @@ func (factory *buildFactory) constructUnhookedPlan(
 		})
 	}
 
-	if planConfig.Timeout != "" {
+	timeout := planConfig.Timeout
+	if timeout == "" && isLeafStep(planConfig) {
+		timeout = job.DefaultStepTimeout
+	}
+
+	if timeout != "" {
 		plan = factory.planFactory.NewPlan(atc.TimeoutPlan{
-			Duration: planConfig.Timeout,
+			Duration: timeout,
 			Step:     plan,
 		})
 	}
@@ -329,6 +342,15 @@ func (factory *buildFactory) constructUnhookedPlan(
 	return plan, nil
 }
 
+// isLeafStep returns true for steps which run something themselves rather
+// than composing other steps.
+func isLeafStep(planConfig atc.PlanConfig) bool {
+	return planConfig.Get != "" ||
+		planConfig.Put != "" ||
+		planConfig.Task != "" ||
+		planConfig.SetPipeline != ""
+}
+
 type constructionParams struct {
 	plan          atc.Plan
 	hooks         atc.Hooks
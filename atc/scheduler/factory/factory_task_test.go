@@ -76,6 +76,42 @@ var _ = Describe("Factory Task", func() {
 			})
 		})
 
+		Context("when debug_on_failure is specified", func() {
+			BeforeEach(func() {
+				input = atc.JobConfig{
+					DebugOnFailure: "1h",
+					Plan: atc.PlanSequence{
+						{
+							Task:           "some-task",
+							DebugOnFailure: "30m",
+						},
+						{
+							Task: "other-task",
+						},
+					},
+				}
+			})
+
+			It("prefers the step's over the job's", func() {
+				actual, err := buildFactory.Create(input, resources, resourceTypes, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				expected := expectedPlanFactory.NewPlan(atc.DoPlan{
+					expectedPlanFactory.NewPlan(atc.TaskPlan{
+						Name:                   "some-task",
+						DebugOnFailure:         "30m",
+						VersionedResourceTypes: resourceTypes,
+					}),
+					expectedPlanFactory.NewPlan(atc.TaskPlan{
+						Name:                   "other-task",
+						DebugOnFailure:         "1h",
+						VersionedResourceTypes: resourceTypes,
+					}),
+				})
+				Expect(actual).To(testhelpers.MatchPlan(expected))
+			})
+		})
+
 		Context("when input mapping is specified", func() {
 			BeforeEach(func() {
 				input = atc.JobConfig{
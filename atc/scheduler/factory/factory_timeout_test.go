@@ -57,4 +57,49 @@ var _ = Describe("Factory Timeout Step", func() {
 			Expect(actual).To(Equal(expected))
 		})
 	})
+
+	Context("When the job has a default step timeout", func() {
+		It("applies it to steps without their own timeout", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				DefaultStepTimeout: "1h",
+				Plan: atc.PlanSequence{
+					{
+						InParallel: &atc.InParallelConfig{
+							Steps: atc.PlanSequence{
+								{
+									Task: "first task",
+								},
+								{
+									Task:    "second task",
+									Timeout: "10s",
+								},
+							},
+						},
+					},
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.InParallelPlan{
+				Steps: []atc.Plan{
+					expectedPlanFactory.NewPlan(atc.TimeoutPlan{
+						Duration: "1h",
+						Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+							Name:                   "first task",
+							VersionedResourceTypes: resourceTypes,
+						}),
+					}),
+					expectedPlanFactory.NewPlan(atc.TimeoutPlan{
+						Duration: "10s",
+						Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+							Name:                   "second task",
+							VersionedResourceTypes: resourceTypes,
+						}),
+					}),
+				},
+			})
+
+			Expect(actual).To(Equal(expected))
+		})
+	})
 })
@@ -0,0 +1,166 @@
+package scheduler
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/algorithm"
+)
+
+// inputTracker remembers what each job's inputs looked like the last time they
+// were resolved, so that jobs unaffected by new versions, new builds of their
+// upstream jobs or config changes can skip resolution.
+//
+// Only resolution is skipped: the versions DB is still loaded in full on every
+// tick, since the fingerprints are computed from it.
+type inputTracker struct {
+	versions *algorithm.VersionsDB
+	summary  versionsSummary
+
+	fingerprints map[int]string
+	lastFullPass time.Time
+}
+
+type versionsSummary struct {
+	resources map[int]versionSetSummary
+	labels    map[int]uint64
+	outputs   map[int]int
+	inputs    map[int]int
+}
+
+// versionSetSummary changes whenever a resource's versions are checked or
+// re-ordered, which bumps the highest check order, or whenever one of its
+// versions is disabled or re-enabled, which changes the set of enabled
+// versions.
+type versionSetSummary struct {
+	maxCheckOrder int
+	enabled       uint64
+}
+
+// setHash hashes a member of a set such that XORing the hashes of every member
+// gives a hash of the set that does not depend on the order of its members.
+func setHash(format string, args ...interface{}) uint64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, format, args...)
+	return h.Sum64()
+}
+
+func summarizeVersions(versions *algorithm.VersionsDB) versionsSummary {
+	summary := versionsSummary{
+		resources: map[int]versionSetSummary{},
+		labels:    map[int]uint64{},
+		outputs:   map[int]int{},
+		inputs:    map[int]int{},
+	}
+
+	for _, v := range versions.ResourceVersions {
+		s := summary.resources[v.ResourceID]
+		if v.CheckOrder > s.maxCheckOrder {
+			s.maxCheckOrder = v.CheckOrder
+		}
+		s.enabled ^= setHash("%d", v.VersionID)
+		summary.resources[v.ResourceID] = s
+	}
+
+	for _, l := range versions.VersionLabels {
+		summary.labels[l.ResourceID] ^= setHash("%d:%s", l.VersionID, l.Label)
+	}
+
+	for _, o := range versions.BuildOutputs {
+		if o.BuildID > summary.outputs[o.JobID] {
+			summary.outputs[o.JobID] = o.BuildID
+		}
+	}
+
+	for _, i := range versions.BuildInputs {
+		if i.BuildID > summary.inputs[i.JobID] {
+			summary.inputs[i.JobID] = i.BuildID
+		}
+	}
+
+	return summary
+}
+
+// StartPass prepares the tracker for a scheduling pass. It returns true if
+// every job should be resolved regardless of whether it was affected.
+func (t *inputTracker) StartPass(versions *algorithm.VersionsDB, now time.Time, fullPassInterval time.Duration) bool {
+	if t.fingerprints == nil {
+		t.fingerprints = map[int]string{}
+	}
+
+	if t.versions != versions {
+		t.versions = versions
+		t.summary = summarizeVersions(versions)
+	}
+
+	if now.Sub(t.lastFullPass) >= fullPassInterval {
+		t.lastFullPass = now
+		return true
+	}
+
+	return false
+}
+
+// Affected returns the job's current fingerprint and whether it differs from
+// the one recorded when the job was last resolved.
+func (t *inputTracker) Affected(configVersion db.ConfigVersion, job db.Job, resources db.Resources) (string, bool) {
+	fingerprint := t.fingerprint(configVersion, job, resources)
+	return fingerprint, t.fingerprints[job.ID()] != fingerprint
+}
+
+// Resolved records the fingerprint the job was resolved with.
+func (t *inputTracker) Resolved(job db.Job, fingerprint string) {
+	t.fingerprints[job.ID()] = fingerprint
+}
+
+// Forget makes sure the job is resolved again on the next pass.
+func (t *inputTracker) Forget(job db.Job) {
+	delete(t.fingerprints, job.ID())
+}
+
+func (t *inputTracker) fingerprint(configVersion db.ConfigVersion, job db.Job, resources db.Resources) string {
+	parts := []string{
+		fmt.Sprintf("config:%d", configVersion),
+		fmt.Sprintf("self:%d", t.summary.inputs[job.ID()]),
+	}
+
+	passedJobs := map[string]bool{}
+	for _, input := range job.Config().Inputs() {
+		resourceID := t.versions.ResourceIDs[input.Resource]
+		resourceSummary := t.summary.resources[resourceID]
+
+		part := fmt.Sprintf("resource:%s:%d:%x", input.Resource, resourceSummary.maxCheckOrder, resourceSummary.enabled)
+
+		if len(input.Labeled) != 0 {
+			part += fmt.Sprintf(":labels:%x", t.summary.labels[resourceID])
+		}
+
+		resource, found := resources.Lookup(input.Resource)
+		if found && resource.CurrentPinnedVersion() != nil {
+			part += fmt.Sprintf(":pinned:%v", resource.CurrentPinnedVersion())
+		}
+
+		parts = append(parts, part)
+
+		for _, passed := range input.Passed {
+			passedJobs[passed] = true
+		}
+
+		for _, passed := range input.PassedAny {
+			passedJobs[passed] = true
+		}
+	}
+
+	for passed := range passedJobs {
+		jobID := t.versions.JobIDs[passed]
+		parts = append(parts, fmt.Sprintf("passed:%s:%d:%d", passed, t.summary.outputs[jobID], t.summary.inputs[jobID]))
+	}
+
+	sort.Strings(parts)
+
+	return strings.Join(parts, ",")
+}
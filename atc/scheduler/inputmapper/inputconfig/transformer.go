@@ -51,17 +51,50 @@ func (i *transformer) TransformInputConfigs(db *algorithm.VersionsDB, jobName st
 			pinnedVersionID = id
 		}
 
+		var filter algorithm.VersionFilter
+		if input.Version.Filter != nil {
+			resource, found, err := i.pipeline.Resource(input.Resource)
+			if err != nil {
+				return nil, err
+			}
+
+			if !found {
+				continue
+			}
+
+			ids, err := resource.FilteredVersionIDs(*input.Version.Filter)
+			if err != nil {
+				return nil, err
+			}
+
+			filter = algorithm.VersionFilter{}
+			for _, id := range ids {
+				filter[id] = struct{}{}
+			}
+		}
+
 		jobs := algorithm.JobSet{}
 		for _, passedJobName := range input.Passed {
 			jobs[db.JobIDs[passedJobName]] = struct{}{}
 		}
 
+		var passedAny algorithm.JobSet
+		if len(input.PassedAny) != 0 {
+			passedAny = algorithm.JobSet{}
+			for _, passedJobName := range input.PassedAny {
+				passedAny[db.JobIDs[passedJobName]] = struct{}{}
+			}
+		}
+
 		inputConfigs = append(inputConfigs, algorithm.InputConfig{
 			Name:            input.Name,
 			UseEveryVersion: input.Version.Every,
 			PinnedVersionID: pinnedVersionID,
+			VersionFilter:   filter,
 			ResourceID:      db.ResourceIDs[input.Resource],
 			Passed:          jobs,
+			PassedAny:       passedAny,
+			Labeled:         input.Labeled,
 			JobID:           db.JobIDs[jobName],
 		})
 	}
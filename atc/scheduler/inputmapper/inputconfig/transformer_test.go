@@ -85,6 +85,52 @@ var _ = Describe("Transformer", func() {
 				})
 			})
 
+			Context("when an input has passed_any constraints", func() {
+				BeforeEach(func() {
+					jobInputs = []atc.JobInput{{
+						Name:      "job-input-1",
+						Resource:  "r1",
+						Version:   &atc.VersionConfig{Latest: true},
+						PassedAny: []string{"j1", "j2"},
+					}}
+				})
+
+				It("expresses them as a separate JobSet", func() {
+					Expect(algorithmInputs).To(ConsistOf(algorithm.InputConfig{
+						Name:            "job-input-1",
+						UseEveryVersion: false,
+						PinnedVersionID: 0,
+						ResourceID:      11,
+						Passed:          algorithm.JobSet{},
+						PassedAny:       algorithm.JobSet{1: struct{}{}, 2: struct{}{}},
+						JobID:           1,
+					}))
+				})
+			})
+
+			Context("when an input requires labels", func() {
+				BeforeEach(func() {
+					jobInputs = []atc.JobInput{{
+						Name:     "job-input-1",
+						Resource: "r1",
+						Version:  &atc.VersionConfig{Latest: true},
+						Labeled:  []string{"deployed-to-prod"},
+					}}
+				})
+
+				It("passes them along", func() {
+					Expect(algorithmInputs).To(ConsistOf(algorithm.InputConfig{
+						Name:            "job-input-1",
+						UseEveryVersion: false,
+						PinnedVersionID: 0,
+						ResourceID:      11,
+						Passed:          algorithm.JobSet{},
+						Labeled:         []string{"deployed-to-prod"},
+						JobID:           1,
+					}))
+				})
+			})
+
 			Context("when an input has version: every", func() {
 				BeforeEach(func() {
 					jobInputs = []atc.JobInput{{
@@ -221,6 +267,73 @@ var _ = Describe("Transformer", func() {
 					})
 				})
 			})
+
+			Context("when an input has a version filter", func() {
+				var filter atc.VersionFilter
+
+				BeforeEach(func() {
+					filter = atc.VersionFilter{Fields: map[string]string{"tag": "v1.*"}, Every: true}
+
+					jobInputs = []atc.JobInput{
+						{
+							Name:     "job-input-1",
+							Resource: "r1",
+							Version:  &atc.VersionConfig{Every: true, Filter: &filter},
+						},
+					}
+				})
+
+				Context("when looking up the resource fails", func() {
+					BeforeEach(func() {
+						fakePipeline.ResourceReturns(nil, false, errors.New("ah"))
+					})
+
+					It("returns the error", func() {
+						Expect(tranformErr).To(Equal(errors.New("ah")))
+					})
+				})
+
+				Context("when the resource is found", func() {
+					var fakeResource *dbfakes.FakeResource
+
+					BeforeEach(func() {
+						fakeResource = new(dbfakes.FakeResource)
+						fakePipeline.ResourceReturns(fakeResource, true, nil)
+					})
+
+					Context("when filtering the versions fails", func() {
+						BeforeEach(func() {
+							fakeResource.FilteredVersionIDsReturns(nil, errors.New("bad thing"))
+						})
+
+						It("returns the error", func() {
+							Expect(tranformErr).To(Equal(errors.New("bad thing")))
+						})
+					})
+
+					Context("when filtering the versions succeeds", func() {
+						BeforeEach(func() {
+							fakeResource.FilteredVersionIDsReturns([]int{3, 1}, nil)
+						})
+
+						It("filtered with the configured filter", func() {
+							Expect(fakeResource.FilteredVersionIDsCallCount()).To(Equal(1))
+							Expect(fakeResource.FilteredVersionIDsArgsForCall(0)).To(Equal(filter))
+						})
+
+						It("restricts the input to the matching versions", func() {
+							Expect(algorithmInputs).To(ConsistOf(algorithm.InputConfig{
+								Name:            "job-input-1",
+								UseEveryVersion: true,
+								ResourceID:      11,
+								Passed:          algorithm.JobSet{},
+								JobID:           1,
+								VersionFilter:   algorithm.VersionFilter{1: {}, 3: {}},
+							}))
+						})
+					})
+				})
+			})
 		})
 
 		Context("when an input has things that don't exist", func() {
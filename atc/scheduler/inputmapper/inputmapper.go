@@ -17,6 +17,13 @@ type InputMapper interface {
 		job db.Job,
 		resources db.Resources,
 	) (algorithm.InputMapping, error)
+
+	ExplainNextInputMapping(
+		logger lager.Logger,
+		versions *algorithm.VersionsDB,
+		job db.Job,
+		resources db.Resources,
+	) (algorithm.Explanation, error)
 }
 
 func NewInputMapper(pipeline db.Pipeline, transformer inputconfig.Transformer) InputMapper {
@@ -36,24 +43,7 @@ func (i *inputMapper) SaveNextInputMapping(
 ) (algorithm.InputMapping, error) {
 	logger = logger.Session("save-next-input-mapping")
 
-	inputConfigs := job.Config().Inputs()
-
-	for i, inputConfig := range inputConfigs {
-		resource, found := resources.Lookup(inputConfig.Resource)
-
-		if !found {
-			logger.Debug("failed-to-find-resource")
-			continue
-		}
-
-		if inputConfig.Version != nil && inputConfig.Version.Pinned != nil {
-			continue
-		}
-
-		if resource.CurrentPinnedVersion() != nil {
-			inputConfigs[i].Version = &atc.VersionConfig{Pinned: resource.CurrentPinnedVersion()}
-		}
-	}
+	inputConfigs := pinnedInputConfigs(logger, job, resources)
 
 	algorithmInputConfigs, err := i.transformer.TransformInputConfigs(versions, job.Name(), inputConfigs)
 	if err != nil {
@@ -103,3 +93,62 @@ func (i *inputMapper) SaveNextInputMapping(
 
 	return resolvedMapping, nil
 }
+
+func (i *inputMapper) ExplainNextInputMapping(
+	logger lager.Logger,
+	versions *algorithm.VersionsDB,
+	job db.Job,
+	resources db.Resources,
+) (algorithm.Explanation, error) {
+	logger = logger.Session("explain-next-input-mapping")
+
+	inputConfigs := pinnedInputConfigs(logger, job, resources)
+
+	algorithmInputConfigs, err := i.transformer.TransformInputConfigs(versions, job.Name(), inputConfigs)
+	if err != nil {
+		logger.Error("failed-to-get-algorithm-input-configs", err)
+		return algorithm.Explanation{}, err
+	}
+
+	return algorithmInputConfigs.Explain(versions), nil
+}
+
+func pinnedInputConfigs(logger lager.Logger, job db.Job, resources db.Resources) []atc.JobInput {
+	inputConfigs := job.Config().Inputs()
+
+	for i, inputConfig := range inputConfigs {
+		resource, found := resources.Lookup(inputConfig.Resource)
+
+		if !found {
+			logger.Debug("failed-to-find-resource")
+			continue
+		}
+
+		if inputConfig.Version != nil && inputConfig.Version.Pinned != nil {
+			continue
+		}
+
+		if resource.CurrentPinnedVersion() != nil {
+			inputConfigs[i].Version = &atc.VersionConfig{Pinned: resource.CurrentPinnedVersion()}
+		}
+	}
+
+	return inputConfigs
+}
+
+// Explainer explains the next input mapping of the jobs of any pipeline.
+type Explainer struct{}
+
+func NewExplainer() Explainer {
+	return Explainer{}
+}
+
+func (Explainer) ExplainJobInputs(
+	logger lager.Logger,
+	pipeline db.Pipeline,
+	versions *algorithm.VersionsDB,
+	job db.Job,
+	resources db.Resources,
+) (algorithm.Explanation, error) {
+	return NewInputMapper(pipeline, inputconfig.NewTransformer(pipeline)).ExplainNextInputMapping(logger, versions, job, resources)
+}
@@ -583,4 +583,69 @@ var _ = Describe("Inputmapper", func() {
 			})
 		})
 	})
+
+	Describe("ExplainNextInputMapping", func() {
+		var (
+			versionsDB     *algorithm.VersionsDB
+			fakeJob        *dbfakes.FakeJob
+			explanation    algorithm.Explanation
+			explanationErr error
+		)
+
+		BeforeEach(func() {
+			versionsDB = &algorithm.VersionsDB{
+				JobIDs:      map[string]int{"some-job": 1},
+				ResourceIDs: map[string]int{"a": 11},
+				ResourceVersions: []algorithm.ResourceVersion{
+					{VersionID: 1, ResourceID: 11, CheckOrder: 1},
+				},
+			}
+
+			fakeJob = new(dbfakes.FakeJob)
+			fakeJob.NameReturns("some-job")
+			fakeJob.ConfigReturns(atc.JobConfig{
+				Plan: atc.PlanSequence{{Get: "a"}},
+			})
+		})
+
+		JustBeforeEach(func() {
+			explanation, explanationErr = inputMapper.ExplainNextInputMapping(
+				lagertest.NewTestLogger("test"),
+				versionsDB,
+				fakeJob,
+				db.Resources{},
+			)
+		})
+
+		Context("when transforming the input configs fails", func() {
+			BeforeEach(func() {
+				fakeTransformer.TransformInputConfigsReturns(nil, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(explanationErr).To(Equal(disaster))
+			})
+		})
+
+		Context("when transforming the input configs succeeds", func() {
+			BeforeEach(func() {
+				fakeTransformer.TransformInputConfigsReturns(algorithm.InputConfigs{
+					{Name: "a", ResourceID: 11, Passed: algorithm.JobSet{}, JobID: 1},
+				}, nil)
+			})
+
+			It("explains the resolution", func() {
+				Expect(explanationErr).NotTo(HaveOccurred())
+				Expect(explanation.Resolved).To(BeTrue())
+				Expect(explanation.Inputs).To(HaveLen(1))
+				Expect(explanation.Inputs[0].VersionID).To(Equal(1))
+			})
+
+			It("does not save any input mappings", func() {
+				Expect(fakeJob.SaveIndependentInputMappingCallCount()).To(BeZero())
+				Expect(fakeJob.SaveNextInputMappingCallCount()).To(BeZero())
+				Expect(fakeJob.DeleteNextInputMappingCallCount()).To(BeZero())
+			})
+		})
+	})
 })
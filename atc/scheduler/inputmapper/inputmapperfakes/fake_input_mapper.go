@@ -11,6 +11,22 @@ import (
 )
 
 type FakeInputMapper struct {
+	ExplainNextInputMappingStub        func(lager.Logger, *algorithm.VersionsDB, db.Job, db.Resources) (algorithm.Explanation, error)
+	explainNextInputMappingMutex       sync.RWMutex
+	explainNextInputMappingArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 *algorithm.VersionsDB
+		arg3 db.Job
+		arg4 db.Resources
+	}
+	explainNextInputMappingReturns struct {
+		result1 algorithm.Explanation
+		result2 error
+	}
+	explainNextInputMappingReturnsOnCall map[int]struct {
+		result1 algorithm.Explanation
+		result2 error
+	}
 	SaveNextInputMappingStub        func(lager.Logger, *algorithm.VersionsDB, db.Job, db.Resources) (algorithm.InputMapping, error)
 	saveNextInputMappingMutex       sync.RWMutex
 	saveNextInputMappingArgsForCall []struct {
@@ -31,6 +47,72 @@ type FakeInputMapper struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeInputMapper) ExplainNextInputMapping(arg1 lager.Logger, arg2 *algorithm.VersionsDB, arg3 db.Job, arg4 db.Resources) (algorithm.Explanation, error) {
+	fake.explainNextInputMappingMutex.Lock()
+	ret, specificReturn := fake.explainNextInputMappingReturnsOnCall[len(fake.explainNextInputMappingArgsForCall)]
+	fake.explainNextInputMappingArgsForCall = append(fake.explainNextInputMappingArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 *algorithm.VersionsDB
+		arg3 db.Job
+		arg4 db.Resources
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("ExplainNextInputMapping", []interface{}{arg1, arg2, arg3, arg4})
+	fake.explainNextInputMappingMutex.Unlock()
+	if fake.ExplainNextInputMappingStub != nil {
+		return fake.ExplainNextInputMappingStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.explainNextInputMappingReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInputMapper) ExplainNextInputMappingCallCount() int {
+	fake.explainNextInputMappingMutex.RLock()
+	defer fake.explainNextInputMappingMutex.RUnlock()
+	return len(fake.explainNextInputMappingArgsForCall)
+}
+
+func (fake *FakeInputMapper) ExplainNextInputMappingCalls(stub func(lager.Logger, *algorithm.VersionsDB, db.Job, db.Resources) (algorithm.Explanation, error)) {
+	fake.explainNextInputMappingMutex.Lock()
+	defer fake.explainNextInputMappingMutex.Unlock()
+	fake.ExplainNextInputMappingStub = stub
+}
+
+func (fake *FakeInputMapper) ExplainNextInputMappingArgsForCall(i int) (lager.Logger, *algorithm.VersionsDB, db.Job, db.Resources) {
+	fake.explainNextInputMappingMutex.RLock()
+	defer fake.explainNextInputMappingMutex.RUnlock()
+	argsForCall := fake.explainNextInputMappingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInputMapper) ExplainNextInputMappingReturns(result1 algorithm.Explanation, result2 error) {
+	fake.explainNextInputMappingMutex.Lock()
+	defer fake.explainNextInputMappingMutex.Unlock()
+	fake.ExplainNextInputMappingStub = nil
+	fake.explainNextInputMappingReturns = struct {
+		result1 algorithm.Explanation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInputMapper) ExplainNextInputMappingReturnsOnCall(i int, result1 algorithm.Explanation, result2 error) {
+	fake.explainNextInputMappingMutex.Lock()
+	defer fake.explainNextInputMappingMutex.Unlock()
+	fake.ExplainNextInputMappingStub = nil
+	if fake.explainNextInputMappingReturnsOnCall == nil {
+		fake.explainNextInputMappingReturnsOnCall = make(map[int]struct {
+			result1 algorithm.Explanation
+			result2 error
+		})
+	}
+	fake.explainNextInputMappingReturnsOnCall[i] = struct {
+		result1 algorithm.Explanation
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInputMapper) SaveNextInputMapping(arg1 lager.Logger, arg2 *algorithm.VersionsDB, arg3 db.Job, arg4 db.Resources) (algorithm.InputMapping, error) {
 	fake.saveNextInputMappingMutex.Lock()
 	ret, specificReturn := fake.saveNextInputMappingReturnsOnCall[len(fake.saveNextInputMappingArgsForCall)]
@@ -100,6 +182,8 @@ func (fake *FakeInputMapper) SaveNextInputMappingReturnsOnCall(i int, result1 al
 func (fake *FakeInputMapper) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.explainNextInputMappingMutex.RLock()
+	defer fake.explainNextInputMappingMutex.RUnlock()
 	fake.saveNextInputMappingMutex.RLock()
 	defer fake.saveNextInputMappingMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
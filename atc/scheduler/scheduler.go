@@ -1,12 +1,15 @@
 package scheduler
 
 import (
+	"sort"
 	"time"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/algorithm"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/scheduler/inputmapper"
 )
 
@@ -14,6 +17,14 @@ type Scheduler struct {
 	Pipeline     db.Pipeline
 	InputMapper  inputmapper.InputMapper
 	BuildStarter BuildStarter
+	Clock        clock.Clock
+
+	// FullResolutionInterval is how often the inputs of every job are
+	// resolved, even if nothing they depend on has changed. In between, only
+	// affected jobs are resolved. Zero resolves every job on every pass.
+	FullResolutionInterval time.Duration
+
+	tracker inputTracker
 }
 
 func (s *Scheduler) Schedule(
@@ -25,9 +36,15 @@ func (s *Scheduler) Schedule(
 ) (map[string]time.Duration, error) {
 	jobSchedulingTime := map[string]time.Duration{}
 
+	fullPass := s.tracker.StartPass(versions, s.Clock.Now(), s.FullResolutionInterval)
+
 	for _, job := range jobs {
 		jStart := time.Now()
-		err := s.ensurePendingBuildExists(logger, versions, job, resources)
+		err := s.resolveJobIfAffected(logger, versions, job, resources, fullPass)
+		if err == nil {
+			err = s.ensureScheduledBuildExists(logger, job)
+		}
+
 		jobSchedulingTime[job.Name()] = time.Since(jStart)
 
 		if err != nil {
@@ -41,7 +58,19 @@ func (s *Scheduler) Schedule(
 		return jobSchedulingTime, err
 	}
 
-	for _, job := range jobs {
+	// start the builds of higher priority jobs first so that they get to
+	// claim workers before the rest when capacity is limited
+	//
+	// this only orders the jobs of this pipeline; pipelines are scheduled
+	// independently, so a high priority job may still start after a lower
+	// priority job of another pipeline
+	jobsByPriority := make([]db.Job, len(jobs))
+	copy(jobsByPriority, jobs)
+	sort.SliceStable(jobsByPriority, func(i, j int) bool {
+		return jobsByPriority[i].Priority() > jobsByPriority[j].Priority()
+	})
+
+	for _, job := range jobsByPriority {
 		jStart := time.Now()
 		nextPendingBuildsForJob, ok := nextPendingBuilds[job.Name()]
 		if !ok {
@@ -59,6 +88,38 @@ func (s *Scheduler) Schedule(
 	return jobSchedulingTime, nil
 }
 
+func (s *Scheduler) resolveJobIfAffected(
+	logger lager.Logger,
+	versions *algorithm.VersionsDB,
+	job db.Job,
+	resources db.Resources,
+	fullPass bool,
+) error {
+	fingerprint, affected := s.tracker.Affected(s.Pipeline.ConfigVersion(), job, resources)
+	if !fullPass && !affected {
+		return nil
+	}
+
+	start := time.Now()
+
+	err := s.ensurePendingBuildExists(logger, versions, job, resources)
+
+	metric.JobInputResolutionDuration{
+		PipelineName: s.Pipeline.Name(),
+		JobName:      job.Name(),
+		Duration:     time.Since(start),
+	}.Emit(logger)
+
+	if err != nil {
+		s.tracker.Forget(job)
+		return err
+	}
+
+	s.tracker.Resolved(job, fingerprint)
+
+	return nil
+}
+
 func (s *Scheduler) ensurePendingBuildExists(
 	logger lager.Logger,
 	versions *algorithm.VersionsDB,
@@ -78,7 +139,23 @@ func (s *Scheduler) ensurePendingBuildExists(
 		if ok && inputVersion.FirstOccurrence {
 			hasNewInputs = true
 			if inputConfig.Trigger {
-				err := job.EnsurePendingBuildExists()
+				trigger := atc.BuildTrigger{
+					Type:     atc.BuildTriggerTypeResource,
+					Input:    inputConfig.Name,
+					Resource: inputConfig.Resource,
+				}
+
+				version, found, err := s.Pipeline.ResourceVersion(inputVersion.VersionID)
+				if err != nil {
+					logger.Error("failed-to-get-triggering-version", err)
+					return err
+				}
+
+				if found {
+					trigger.Version = version.Version
+				}
+
+				err = job.EnsurePendingBuildExists(trigger)
 				if err != nil {
 					logger.Error("failed-to-ensure-pending-build-exists", err)
 					return err
@@ -97,3 +174,50 @@ func (s *Scheduler) ensurePendingBuildExists(
 
 	return nil
 }
+
+func (s *Scheduler) ensureScheduledBuildExists(logger lager.Logger, job db.Job) error {
+	schedule := job.Config().Schedule
+	if schedule == nil {
+		return nil
+	}
+
+	now := s.Clock.Now()
+
+	lastScheduled := job.LastScheduled()
+	if lastScheduled.IsZero() {
+		// start counting from now rather than firing as soon as the job is
+		// configured
+		err := job.SetLastScheduled(now)
+		if err != nil {
+			logger.Error("failed-to-initialize-schedule", err)
+			return err
+		}
+
+		return nil
+	}
+
+	nextFireTime, err := schedule.NextFireTime(lastScheduled, job.ID())
+	if err != nil {
+		logger.Error("failed-to-determine-next-fire-time", err)
+		return nil
+	}
+
+	if now.Before(nextFireTime) {
+		return nil
+	}
+
+	// fire times missed while the scheduler was not running are collapsed
+	// into a single build
+	err = job.EnsureScheduledBuildExists(now)
+	if err != nil {
+		logger.Error("failed-to-ensure-scheduled-build-exists", err)
+		return err
+	}
+
+	logger.Info("triggered-scheduled-build", lager.Data{
+		"job":       job.Name(),
+		"fire-time": nextFireTime,
+	})
+
+	return nil
+}
@@ -2,7 +2,9 @@ package scheduler_test
 
 import (
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -20,6 +22,7 @@ var _ = Describe("Scheduler", func() {
 		fakePipeline     *dbfakes.FakePipeline
 		fakeInputMapper  *inputmapperfakes.FakeInputMapper
 		fakeBuildStarter *schedulerfakes.FakeBuildStarter
+		fakeClock        *fakeclock.FakeClock
 
 		scheduler *Scheduler
 
@@ -30,11 +33,13 @@ var _ = Describe("Scheduler", func() {
 		fakePipeline = new(dbfakes.FakePipeline)
 		fakeInputMapper = new(inputmapperfakes.FakeInputMapper)
 		fakeBuildStarter = new(schedulerfakes.FakeBuildStarter)
+		fakeClock = fakeclock.NewFakeClock(time.Date(2019, 11, 12, 10, 30, 0, 0, time.UTC))
 
 		scheduler = &Scheduler{
 			Pipeline:     fakePipeline,
 			InputMapper:  fakeInputMapper,
 			BuildStarter: fakeBuildStarter,
+			Clock:        fakeClock,
 		}
 
 		disaster = errors.New("bad thing")
@@ -266,6 +271,32 @@ var _ = Describe("Scheduler", func() {
 						"a": algorithm.InputVersion{VersionID: 1, ResourceID: 11, FirstOccurrence: true},
 						"b": algorithm.InputVersion{VersionID: 2, ResourceID: 12, FirstOccurrence: false},
 					}, nil)
+
+					fakePipeline.ResourceVersionReturns(atc.ResourceVersion{ID: 1, Version: atc.Version{"ref": "abc"}}, true, nil)
+				})
+
+				It("records the triggering version on the pending build", func() {
+					Expect(fakePipeline.ResourceVersionCallCount()).To(Equal(1))
+					Expect(fakePipeline.ResourceVersionArgsForCall(0)).To(Equal(1))
+
+					Expect(fakeJob.EnsurePendingBuildExistsCallCount()).To(Equal(1))
+					Expect(fakeJob.EnsurePendingBuildExistsArgsForCall(0)).To(Equal(atc.BuildTrigger{
+						Type:     atc.BuildTriggerTypeResource,
+						Input:    "a",
+						Resource: "a",
+						Version:  atc.Version{"ref": "abc"},
+					}))
+				})
+
+				Context("when looking up the triggering version fails", func() {
+					BeforeEach(func() {
+						fakePipeline.ResourceVersionReturns(atc.ResourceVersion{}, false, disaster)
+					})
+
+					It("returns the error", func() {
+						Expect(scheduleErr).To(Equal(disaster))
+						Expect(fakeJob.EnsurePendingBuildExistsCallCount()).To(BeZero())
+					})
 				})
 
 				Context("when creating a pending build fails", func() {
@@ -324,5 +355,277 @@ var _ = Describe("Scheduler", func() {
 				})
 			})
 		})
+
+		Context("when the job has a schedule", func() {
+			BeforeEach(func() {
+				fakeJob = new(dbfakes.FakeJob)
+				fakeJob.NameReturns("some-job")
+				fakeJob.ConfigReturns(atc.JobConfig{
+					Schedule: &atc.ScheduleConfig{Cron: "0 * * * *"},
+				})
+
+				fakeJobs = []db.Job{fakeJob}
+
+				fakeInputMapper.SaveNextInputMappingReturns(algorithm.InputMapping{}, nil)
+				fakeBuildStarter.TryStartPendingBuildsForJobReturns(nil)
+			})
+
+			Context("when the job has never been scheduled", func() {
+				It("starts the schedule from now without triggering", func() {
+					Expect(scheduleErr).NotTo(HaveOccurred())
+					Expect(fakeJob.SetLastScheduledCallCount()).To(Equal(1))
+					Expect(fakeJob.SetLastScheduledArgsForCall(0)).To(Equal(fakeClock.Now()))
+					Expect(fakeJob.EnsureScheduledBuildExistsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the next fire time has not yet been reached", func() {
+				BeforeEach(func() {
+					fakeJob.LastScheduledReturns(fakeClock.Now().Add(-time.Minute))
+				})
+
+				It("does not trigger a build", func() {
+					Expect(scheduleErr).NotTo(HaveOccurred())
+					Expect(fakeJob.EnsureScheduledBuildExistsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the next fire time has passed", func() {
+				BeforeEach(func() {
+					fakeJob.LastScheduledReturns(fakeClock.Now().Add(-time.Hour - time.Minute))
+				})
+
+				It("ensures a scheduled build exists", func() {
+					Expect(scheduleErr).NotTo(HaveOccurred())
+					Expect(fakeJob.EnsureScheduledBuildExistsCallCount()).To(Equal(1))
+					Expect(fakeJob.EnsureScheduledBuildExistsArgsForCall(0)).To(Equal(fakeClock.Now()))
+				})
+
+				Context("when ensuring the build exists fails", func() {
+					BeforeEach(func() {
+						fakeJob.EnsureScheduledBuildExistsReturns(disaster)
+					})
+
+					It("returns the error", func() {
+						Expect(scheduleErr).To(Equal(disaster))
+					})
+				})
+			})
+		})
+
+		Context("when jobs have different priorities", func() {
+			BeforeEach(func() {
+				fakeJob = new(dbfakes.FakeJob)
+				fakeJob.NameReturns("some-job-1")
+				fakeJob.PriorityReturns(1)
+
+				fakeJob2 = new(dbfakes.FakeJob)
+				fakeJob2.NameReturns("some-job-2")
+				fakeJob2.PriorityReturns(10)
+
+				fakeJobs = []db.Job{fakeJob, fakeJob2}
+
+				fakeInputMapper.SaveNextInputMappingReturns(algorithm.InputMapping{}, nil)
+				fakeBuildStarter.TryStartPendingBuildsForJobReturns(nil)
+			})
+
+			It("starts the pending builds of the higher priority job first", func() {
+				Expect(scheduleErr).NotTo(HaveOccurred())
+				Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(2))
+
+				_, firstJob, _, _, firstBuilds := fakeBuildStarter.TryStartPendingBuildsForJobArgsForCall(0)
+				Expect(firstJob.Name()).To(Equal("some-job-2"))
+				Expect(firstBuilds).To(Equal(nextPendingBuildsJob2))
+
+				_, secondJob, _, _, secondBuilds := fakeBuildStarter.TryStartPendingBuildsForJobArgsForCall(1)
+				Expect(secondJob.Name()).To(Equal("some-job-1"))
+				Expect(secondBuilds).To(Equal(nextPendingBuildsJob1))
+			})
+		})
+	})
+
+	Describe("incremental input resolution", func() {
+		var (
+			versionsDB    *algorithm.VersionsDB
+			upstreamJob   *dbfakes.FakeJob
+			downstreamJob *dbfakes.FakeJob
+			otherJob      *dbfakes.FakeJob
+			resources     db.Resources
+		)
+
+		schedule := func() {
+			_, err := scheduler.Schedule(
+				lagertest.NewTestLogger("test"),
+				versionsDB,
+				[]db.Job{upstreamJob, downstreamJob, otherJob},
+				resources,
+				atc.VersionedResourceTypes{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		resolvedJobs := func(since int) []string {
+			names := []string{}
+			for i := since; i < fakeInputMapper.SaveNextInputMappingCallCount(); i++ {
+				_, _, job, _ := fakeInputMapper.SaveNextInputMappingArgsForCall(i)
+				names = append(names, job.Name())
+			}
+			return names
+		}
+
+		BeforeEach(func() {
+			scheduler.FullResolutionInterval = time.Hour
+
+			fakeInputMapper.SaveNextInputMappingReturns(algorithm.InputMapping{}, nil)
+			fakePipeline.GetAllPendingBuildsReturns(map[string][]db.Build{}, nil)
+
+			versionsDB = &algorithm.VersionsDB{
+				ResourceVersions: []algorithm.ResourceVersion{
+					{VersionID: 1, ResourceID: 11, CheckOrder: 1},
+					{VersionID: 2, ResourceID: 12, CheckOrder: 1},
+				},
+				JobIDs:      map[string]int{"upstream": 1, "downstream": 2, "other": 3},
+				ResourceIDs: map[string]int{"repo": 11, "other-repo": 12},
+			}
+
+			upstreamJob = new(dbfakes.FakeJob)
+			upstreamJob.IDReturns(1)
+			upstreamJob.NameReturns("upstream")
+			upstreamJob.ConfigReturns(atc.JobConfig{
+				Name: "upstream",
+				Plan: atc.PlanSequence{{Get: "repo"}},
+			})
+
+			downstreamJob = new(dbfakes.FakeJob)
+			downstreamJob.IDReturns(2)
+			downstreamJob.NameReturns("downstream")
+			downstreamJob.ConfigReturns(atc.JobConfig{
+				Name: "downstream",
+				Plan: atc.PlanSequence{{Get: "repo", Passed: []string{"upstream"}}},
+			})
+
+			otherJob = new(dbfakes.FakeJob)
+			otherJob.IDReturns(3)
+			otherJob.NameReturns("other")
+			otherJob.ConfigReturns(atc.JobConfig{
+				Name: "other",
+				Plan: atc.PlanSequence{{Get: "other-repo"}},
+			})
+
+			repo := new(dbfakes.FakeResource)
+			repo.NameReturns("repo")
+			otherRepo := new(dbfakes.FakeResource)
+			otherRepo.NameReturns("other-repo")
+			resources = db.Resources{repo, otherRepo}
+
+			schedule()
+		})
+
+		It("resolves every job on the first pass", func() {
+			Expect(resolvedJobs(0)).To(ConsistOf("upstream", "downstream", "other"))
+		})
+
+		It("does not resolve unaffected jobs again", func() {
+			schedule()
+			Expect(resolvedJobs(3)).To(BeEmpty())
+		})
+
+		Context("when a resource has a new version", func() {
+			BeforeEach(func() {
+				versionsDB = &algorithm.VersionsDB{
+					ResourceVersions: append(versionsDB.ResourceVersions, algorithm.ResourceVersion{VersionID: 3, ResourceID: 11, CheckOrder: 2}),
+					JobIDs:           versionsDB.JobIDs,
+					ResourceIDs:      versionsDB.ResourceIDs,
+				}
+			})
+
+			It("resolves only the jobs using the resource", func() {
+				schedule()
+				Expect(resolvedJobs(3)).To(ConsistOf("upstream", "downstream"))
+			})
+		})
+
+		Context("when a version of a resource is disabled", func() {
+			BeforeEach(func() {
+				versionsDB = &algorithm.VersionsDB{
+					ResourceVersions: versionsDB.ResourceVersions[1:],
+					JobIDs:           versionsDB.JobIDs,
+					ResourceIDs:      versionsDB.ResourceIDs,
+				}
+			})
+
+			It("resolves only the jobs using the resource", func() {
+				schedule()
+				Expect(resolvedJobs(3)).To(ConsistOf("upstream", "downstream"))
+			})
+		})
+
+		Context("when an upstream job has a new build", func() {
+			BeforeEach(func() {
+				versionsDB = &algorithm.VersionsDB{
+					ResourceVersions: versionsDB.ResourceVersions,
+					BuildOutputs: []algorithm.BuildOutput{
+						{ResourceVersion: algorithm.ResourceVersion{VersionID: 1, ResourceID: 11, CheckOrder: 1}, BuildID: 7, JobID: 1},
+					},
+					BuildInputs: []algorithm.BuildInput{
+						{ResourceVersion: algorithm.ResourceVersion{VersionID: 1, ResourceID: 11, CheckOrder: 1}, BuildID: 7, JobID: 1, InputName: "repo"},
+					},
+					JobIDs:      versionsDB.JobIDs,
+					ResourceIDs: versionsDB.ResourceIDs,
+				}
+			})
+
+			It("resolves the job and the jobs depending on it", func() {
+				schedule()
+				Expect(resolvedJobs(3)).To(ConsistOf("upstream", "downstream"))
+			})
+		})
+
+		Context("when the pipeline config changes", func() {
+			BeforeEach(func() {
+				fakePipeline.ConfigVersionReturns(2)
+			})
+
+			It("resolves every job", func() {
+				schedule()
+				Expect(resolvedJobs(3)).To(ConsistOf("upstream", "downstream", "other"))
+			})
+		})
+
+		Context("when the full resolution interval has elapsed", func() {
+			BeforeEach(func() {
+				fakeClock.Increment(time.Hour)
+			})
+
+			It("resolves every job", func() {
+				schedule()
+				Expect(resolvedJobs(3)).To(ConsistOf("upstream", "downstream", "other"))
+			})
+		})
+
+		Context("when resolving a job fails", func() {
+			BeforeEach(func() {
+				fakeInputMapper.SaveNextInputMappingReturnsOnCall(3, nil, disaster)
+				versionsDB = &algorithm.VersionsDB{
+					ResourceVersions: append(versionsDB.ResourceVersions, algorithm.ResourceVersion{VersionID: 3, ResourceID: 12, CheckOrder: 2}),
+					JobIDs:           versionsDB.JobIDs,
+					ResourceIDs:      versionsDB.ResourceIDs,
+				}
+			})
+
+			It("resolves the job again on the next pass", func() {
+				_, err := scheduler.Schedule(
+					lagertest.NewTestLogger("test"),
+					versionsDB,
+					[]db.Job{upstreamJob, downstreamJob, otherJob},
+					resources,
+					atc.VersionedResourceTypes{},
+				)
+				Expect(err).To(Equal(disaster))
+
+				schedule()
+				Expect(resolvedJobs(4)).To(ConsistOf("other"))
+			})
+		})
 	})
 })
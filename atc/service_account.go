@@ -0,0 +1,60 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ServiceAccountTokenPrefix is prepended to every service account token so
+// that clients can tell them apart from the JWTs issued on login.
+const ServiceAccountTokenPrefix = "cst_"
+
+// ServiceAccount is a team-scoped identity for automation, authenticating
+// with long-lived API tokens rather than logging in as a user.
+type ServiceAccount struct {
+	Name   string                `json:"name"`
+	Tokens []ServiceAccountToken `json:"tokens"`
+}
+
+// ServiceAccountToken grants the holder a role in the service account's
+// team, optionally limited to a single pipeline. The token itself is only
+// returned when it is created.
+type ServiceAccountToken struct {
+	Name       string `json:"name"`
+	Role       string `json:"role"`
+	Pipeline   string `json:"pipeline,omitempty"`
+	ExpiresAt  int64  `json:"expires_at"`
+	LastUsedAt int64  `json:"last_used_at,omitempty"`
+	CreatedAt  int64  `json:"created_at,omitempty"`
+
+	Token string `json:"token,omitempty"`
+}
+
+func (token ServiceAccountToken) Validate() error {
+	if token.Name == "" {
+		return errors.New("token name must be specified")
+	}
+
+	valid := false
+	for _, role := range teamRoles {
+		if token.Role == role {
+			valid = true
+			break
+		}
+	}
+
+	if !valid {
+		return fmt.Errorf("unknown role '%s'", token.Role)
+	}
+
+	if token.ExpiresAt <= 0 {
+		return errors.New("token expiry must be specified")
+	}
+
+	if token.ExpiresAt <= time.Now().Unix() {
+		return errors.New("token expiry must be in the future")
+	}
+
+	return nil
+}
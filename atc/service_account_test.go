@@ -0,0 +1,48 @@
+package atc_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ServiceAccountToken", func() {
+	Describe("Validate", func() {
+		var token atc.ServiceAccountToken
+
+		BeforeEach(func() {
+			token = atc.ServiceAccountToken{
+				Name:      "deploy-bot",
+				Role:      "pipeline-operator",
+				ExpiresAt: time.Now().Add(time.Hour).Unix(),
+			}
+		})
+
+		It("accepts a named token with a known role and expiry", func() {
+			Expect(token.Validate()).To(Succeed())
+		})
+
+		It("requires a name", func() {
+			token.Name = ""
+			Expect(token.Validate()).To(MatchError("token name must be specified"))
+		})
+
+		It("rejects unknown roles", func() {
+			token.Role = "admin"
+			Expect(token.Validate()).To(MatchError("unknown role 'admin'"))
+		})
+
+		It("requires an expiry", func() {
+			token.ExpiresAt = 0
+			Expect(token.Validate()).To(MatchError("token expiry must be specified"))
+		})
+
+		It("rejects an expiry in the past", func() {
+			token.ExpiresAt = time.Now().Add(-time.Minute).Unix()
+			Expect(token.Validate()).To(MatchError("token expiry must be in the future"))
+		})
+	})
+})
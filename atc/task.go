@@ -22,6 +22,9 @@ type TaskConfig struct {
 	// Limits to set on the Task Container
 	Limits ContainerLimits `json:"container_limits,omitempty"`
 
+	// Outbound network access granted to the Task Container.
+	Network *TaskNetworkConfig `json:"network,omitempty"`
+
 	// Parameters to pass to the task via environment variables.
 	Params TaskEnv `json:"params,omitempty"`
 
@@ -80,6 +83,10 @@ func (config TaskConfig) Validate() error {
 	messages = append(messages, config.validateInputContainsNames()...)
 	messages = append(messages, config.validateOutputContainsNames()...)
 
+	if config.Network != nil {
+		messages = append(messages, config.Network.validate()...)
+	}
+
 	if len(messages) > 0 {
 		return fmt.Errorf("invalid task configuration:\n%s", strings.Join(messages, "\n"))
 	}
@@ -0,0 +1,305 @@
+package atc
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const (
+	EgressAll  = "all"
+	EgressNone = "none"
+)
+
+// TaskNetworkConfig restricts the outbound network access of a task's
+// container.
+type TaskNetworkConfig struct {
+	// Either "all" (the default) or "none". When "none", only the
+	// destinations listed in Allow are reachable.
+	Egress string `json:"egress,omitempty"`
+
+	// Destinations of the form "cidr[:port[-port]]" which remain reachable
+	// when egress is "none".
+	Allow []string `json:"allow,omitempty"`
+}
+
+// Restricted returns true if outbound traffic should be denied by default.
+func (config TaskNetworkConfig) Restricted() bool {
+	return config.Egress == EgressNone
+}
+
+// Rules parses the allowed destinations.
+func (config TaskNetworkConfig) Rules() ([]NetworkRule, error) {
+	rules := make([]NetworkRule, 0, len(config.Allow))
+	for _, allow := range config.Allow {
+		rule, err := ParseNetworkRule(allow)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func (config TaskNetworkConfig) validate() []string {
+	var messages []string
+
+	switch config.Egress {
+	case "", EgressAll:
+		if len(config.Allow) > 0 {
+			messages = append(messages, "  network 'allow' can only be used with 'egress: none'")
+		}
+	case EgressNone:
+	default:
+		messages = append(messages, fmt.Sprintf("  unknown network egress '%s' (must be 'all' or 'none')", config.Egress))
+	}
+
+	for _, allow := range config.Allow {
+		if _, err := ParseNetworkRule(allow); err != nil {
+			messages = append(messages, fmt.Sprintf("  invalid network allow rule: %s", err))
+		}
+	}
+
+	return messages
+}
+
+// NetworkRule is a range of destination addresses and ports.
+type NetworkRule struct {
+	Network *net.IPNet
+
+	// The inclusive port range; both are zero when all ports are allowed.
+	StartPort uint16
+	EndPort   uint16
+}
+
+// ParseNetworkRule parses a rule of the form "cidr[:port[-port]]". A bare IP
+// address is treated as a single host, and IPv6 addresses must be enclosed in
+// brackets when a port is given (e.g. "[2001:db8::/32]:443").
+func ParseNetworkRule(rule string) (NetworkRule, error) {
+	host, ports := rule, ""
+
+	if strings.HasPrefix(rule, "[") {
+		end := strings.Index(rule, "]")
+		if end == -1 {
+			return NetworkRule{}, fmt.Errorf("'%s' is missing a closing bracket", rule)
+		}
+
+		host = rule[1:end]
+
+		rest := rule[end+1:]
+		if rest != "" {
+			if !strings.HasPrefix(rest, ":") {
+				return NetworkRule{}, fmt.Errorf("'%s' has unexpected characters after the address", rule)
+			}
+
+			ports = rest[1:]
+		}
+	} else if strings.Count(rule, ":") == 1 {
+		idx := strings.Index(rule, ":")
+		host, ports = rule[:idx], rule[idx+1:]
+	}
+
+	network, err := parseNetwork(host)
+	if err != nil {
+		return NetworkRule{}, fmt.Errorf("'%s' has an invalid address: %s", rule, err)
+	}
+
+	parsed := NetworkRule{Network: network}
+
+	if ports != "" {
+		parsed.StartPort, parsed.EndPort, err = parsePortRange(ports)
+		if err != nil {
+			return NetworkRule{}, fmt.Errorf("'%s' has an invalid port: %s", rule, err)
+		}
+	}
+
+	return parsed, nil
+}
+
+// AllPorts returns true if the rule does not restrict the destination port.
+func (rule NetworkRule) AllPorts() bool {
+	return rule.StartPort == 0 && rule.EndPort == 0
+}
+
+// Contains returns true if every destination matched by other is also matched
+// by the rule.
+func (rule NetworkRule) Contains(other NetworkRule) bool {
+	ruleOnes, ruleBits := rule.Network.Mask.Size()
+	otherOnes, otherBits := other.Network.Mask.Size()
+
+	if ruleBits != otherBits || otherOnes < ruleOnes || !rule.Network.Contains(other.Network.IP) {
+		return false
+	}
+
+	if rule.AllPorts() {
+		return true
+	}
+
+	if other.AllPorts() {
+		return false
+	}
+
+	return rule.StartPort <= other.StartPort && other.EndPort <= rule.EndPort
+}
+
+func (rule NetworkRule) String() string {
+	if rule.AllPorts() {
+		return rule.Network.String()
+	}
+
+	network := rule.Network.String()
+	if rule.Network.IP.To4() == nil {
+		network = "[" + network + "]"
+	}
+
+	if rule.StartPort == rule.EndPort {
+		return fmt.Sprintf("%s:%d", network, rule.StartPort)
+	}
+
+	return fmt.Sprintf("%s:%d-%d", network, rule.StartPort, rule.EndPort)
+}
+
+func parseNetwork(host string) (*net.IPNet, error) {
+	if strings.Contains(host, "/") {
+		_, network, err := net.ParseCIDR(host)
+		return network, err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil, fmt.Errorf("'%s' is not an IP address or CIDR", host)
+	}
+
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+	}
+
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+}
+
+func parsePortRange(ports string) (uint16, uint16, error) {
+	parts := strings.SplitN(ports, "-", 2)
+
+	start, err := parsePort(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if len(parts) == 1 {
+		return start, start, nil
+	}
+
+	end, err := parsePort(parts[1])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if end < start {
+		return 0, 0, fmt.Errorf("range %d-%d is backwards", start, end)
+	}
+
+	return start, end, nil
+}
+
+func parsePort(port string) (uint16, error) {
+	p, err := strconv.ParseUint(port, 10, 16)
+	if err != nil || p == 0 {
+		return 0, fmt.Errorf("'%s' is not a port between 1 and 65535", port)
+	}
+
+	return uint16(p), nil
+}
+
+// TaskNetworkPolicy limits the network access that tasks run by a team may
+// request.
+type TaskNetworkPolicy struct {
+	// Every task must set 'egress: none'.
+	RequireRestrictedEgress bool `json:"require_restricted_egress,omitempty"`
+
+	// Destinations which tasks may allow; when empty, any destination may be
+	// allowed.
+	AllowedNetworks []string `json:"allowed_networks,omitempty"`
+}
+
+// Validate checks that the policy's allowed networks are well-formed.
+func (policy TaskNetworkPolicy) Validate() error {
+	for _, allowed := range policy.AllowedNetworks {
+		if _, err := ParseNetworkRule(allowed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Check returns an error describing how the given network config violates the
+// policy. A nil config is treated as unrestricted egress.
+func (policy TaskNetworkPolicy) Check(config *TaskNetworkConfig) error {
+	if config == nil || !config.Restricted() {
+		if policy.RequireRestrictedEgress {
+			return fmt.Errorf("network policy requires tasks to set 'egress: %s'", EgressNone)
+		}
+
+		return nil
+	}
+
+	if len(policy.AllowedNetworks) == 0 {
+		return nil
+	}
+
+	allowedRules := make([]NetworkRule, 0, len(policy.AllowedNetworks))
+	for _, allowed := range policy.AllowedNetworks {
+		rule, err := ParseNetworkRule(allowed)
+		if err != nil {
+			return err
+		}
+
+		allowedRules = append(allowedRules, rule)
+	}
+
+	requested, err := config.Rules()
+	if err != nil {
+		return err
+	}
+
+	var denied []string
+	for _, rule := range requested {
+		permitted := false
+		for _, allowed := range allowedRules {
+			if allowed.Contains(rule) {
+				permitted = true
+				break
+			}
+		}
+
+		if !permitted {
+			denied = append(denied, rule.String())
+		}
+	}
+
+	if len(denied) > 0 {
+		return fmt.Errorf("network policy does not permit access to %s", strings.Join(denied, ", "))
+	}
+
+	return nil
+}
+
+// TaskNetworkPolicies maps team names to the policy their tasks must satisfy.
+// The policy under the "*" key applies to teams without an entry of their own.
+type TaskNetworkPolicies map[string]TaskNetworkPolicy
+
+const DefaultNetworkPolicyKey = "*"
+
+func (policies TaskNetworkPolicies) ForTeam(teamName string) TaskNetworkPolicy {
+	if policy, found := policies[teamName]; found {
+		return policy
+	}
+
+	return policies[DefaultNetworkPolicyKey]
+}
+
+// NetworkPolicies is configured by the ATC at startup.
+var NetworkPolicies TaskNetworkPolicies
@@ -0,0 +1,28 @@
+package atc
+
+// TaskResourceUsage summarizes the resources consumed by a task's container
+// over the course of its execution.
+type TaskResourceUsage struct {
+	// Total CPU time consumed, in nanoseconds.
+	CPUTime uint64 `json:"cpu_time"`
+
+	// Memory usage in bytes, as counted towards the container's limit.
+	PeakMemory    uint64 `json:"peak_memory"`
+	AverageMemory uint64 `json:"average_memory"`
+
+	// Disk usage of the container in bytes.
+	PeakDisk uint64 `json:"peak_disk"`
+
+	// Size in bytes of each output, keyed by output name.
+	OutputSizes map[string]uint64 `json:"output_sizes,omitempty"`
+}
+
+// BuildTaskResourceUsage is the resource usage of a single task step run by a
+// build.
+type BuildTaskResourceUsage struct {
+	BuildID   int               `json:"build_id"`
+	BuildName string            `json:"build_name"`
+	StepName  string            `json:"step_name"`
+	Time      int64             `json:"time"`
+	Usage     TaskResourceUsage `json:"usage"`
+}
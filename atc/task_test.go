@@ -213,6 +213,51 @@ run: {path: a/file}
 			})
 		})
 
+		Context("when network access is specified", func() {
+			It("parses egress and the allowed destinations", func() {
+				data := []byte(`
+platform: beos
+network:
+  egress: none
+  allow: [10.0.0.0/8:443, 192.168.1.1, "[2001:db8::/32]:8000-9000"]
+
+run: {path: a/file}
+`)
+				task, err := NewTaskConfig(data)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(task.Network.Restricted()).To(BeTrue())
+
+				rules, err := task.Network.Rules()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rules).To(HaveLen(3))
+				Expect(rules[0].String()).To(Equal("10.0.0.0/8:443"))
+				Expect(rules[1].String()).To(Equal("192.168.1.1/32"))
+				Expect(rules[2].String()).To(Equal("[2001:db8::/32]:8000-9000"))
+			})
+
+			It("returns an error when egress is unknown", func() {
+				invalidConfig.Network = &TaskNetworkConfig{Egress: "some"}
+				Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("unknown network egress 'some'")))
+			})
+
+			It("returns an error when allowing destinations without restricting egress", func() {
+				invalidConfig.Network = &TaskNetworkConfig{Allow: []string{"10.0.0.0/8"}}
+				Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("network 'allow' can only be used with 'egress: none'")))
+			})
+
+			It("returns an error when an allowed destination is malformed", func() {
+				invalidConfig.Network = &TaskNetworkConfig{
+					Egress: EgressNone,
+					Allow:  []string{"10.0.0.0/8:0", "example.com", "10.0.0.1:90-80"},
+				}
+
+				err := invalidConfig.Validate()
+				Expect(err).To(MatchError(ContainSubstring("'10.0.0.0/8:0' has an invalid port")))
+				Expect(err).To(MatchError(ContainSubstring("'example.com' has an invalid address")))
+				Expect(err).To(MatchError(ContainSubstring("range 90-80 is backwards")))
+			})
+		})
+
 		Context("when container limits are specified", func() {
 			Context("when memory and cpu limits are correctly specified", func() {
 				It("successfully parses the limits with memory units", func() {
@@ -399,3 +444,48 @@ run: {path: a/file}
 	})
 
 })
+
+var _ = Describe("TaskNetworkPolicy", func() {
+	var policy TaskNetworkPolicy
+
+	BeforeEach(func() {
+		policy = TaskNetworkPolicy{
+			AllowedNetworks: []string{"10.0.0.0/8", "192.168.0.0/16:8000-9000"},
+		}
+	})
+
+	It("permits destinations within the allowed networks", func() {
+		Expect(policy.Check(&TaskNetworkConfig{
+			Egress: EgressNone,
+			Allow:  []string{"10.1.2.3:443", "192.168.1.0/24:8080"},
+		})).To(Succeed())
+	})
+
+	It("rejects destinations outside the allowed networks", func() {
+		Expect(policy.Check(&TaskNetworkConfig{
+			Egress: EgressNone,
+			Allow:  []string{"10.1.2.3", "0.0.0.0/0", "192.168.1.1:22", "192.168.1.1"},
+		})).To(MatchError("network policy does not permit access to 0.0.0.0/0, 192.168.1.1/32:22, 192.168.1.1/32"))
+	})
+
+	It("permits unrestricted egress unless required", func() {
+		Expect(policy.Check(nil)).To(Succeed())
+
+		policy.RequireRestrictedEgress = true
+		Expect(policy.Check(nil)).To(MatchError(ContainSubstring("requires tasks to set 'egress: none'")))
+		Expect(policy.Check(&TaskNetworkConfig{Egress: EgressAll})).ToNot(Succeed())
+		Expect(policy.Check(&TaskNetworkConfig{Egress: EgressNone})).To(Succeed())
+	})
+
+	Describe("TaskNetworkPolicies", func() {
+		It("falls back to the default policy for teams without their own", func() {
+			policies := TaskNetworkPolicies{
+				"*":         {RequireRestrictedEgress: true},
+				"some-team": {},
+			}
+
+			Expect(policies.ForTeam("some-team").RequireRestrictedEgress).To(BeFalse())
+			Expect(policies.ForTeam("other-team").RequireRestrictedEgress).To(BeTrue())
+		})
+	})
+})
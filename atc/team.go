@@ -4,6 +4,13 @@ type Team struct {
 	ID   int      `json:"id,omitempty"`
 	Name string   `json:"name,omitempty"`
 	Auth TeamAuth `json:"auth,omitempty"`
+
+	// DefaultJobPriority is the priority of jobs which do not configure one.
+	// It is left unchanged when a team is updated without one.
+	DefaultJobPriority *int `json:"default_job_priority,omitempty"`
 }
 
 type TeamAuth map[string]map[string][]string
+
+// teamRoles are the roles a team's auth config may grant.
+var teamRoles = []string{"owner", "member", "pipeline-operator", "viewer"}
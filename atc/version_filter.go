@@ -0,0 +1,138 @@
+package atc
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// A VersionFilter restricts the versions of a resource that may be used, by
+// matching on the fields of each version and its metadata.
+type VersionFilter struct {
+	// Glob patterns keyed by field name. Each pattern is matched against the
+	// version field of that name, or the metadata field if the version has no
+	// such field. All patterns must match.
+	Fields map[string]string `json:"fields,omitempty"`
+
+	// A semantic version range, e.g. ">=2.0 <3", which the version must fall
+	// within.
+	Semver string `json:"semver,omitempty"`
+
+	// The field holding the semantic version. Defaults to the version's only
+	// field.
+	SemverField string `json:"semver_field,omitempty"`
+
+	// Use every matching version rather than only the latest one.
+	Every bool `json:"every,omitempty"`
+}
+
+func (filter VersionFilter) Validate() error {
+	if len(filter.Fields) == 0 && filter.Semver == "" {
+		return fmt.Errorf("must specify fields or semver")
+	}
+
+	if filter.SemverField != "" && filter.Semver == "" {
+		return fmt.Errorf("semver_field given without semver")
+	}
+
+	if filter.Semver != "" {
+		_, err := semver.NewConstraint(filter.Semver)
+		if err != nil {
+			return fmt.Errorf("invalid semver range '%s': %s", filter.Semver, err)
+		}
+	}
+
+	return nil
+}
+
+// Matcher compiles the filter's patterns and range so that it can be matched
+// against many versions.
+func (filter VersionFilter) Matcher() (VersionMatcher, error) {
+	matcher := VersionMatcher{
+		fields:      map[string]*regexp.Regexp{},
+		semverField: filter.SemverField,
+	}
+
+	for field, pattern := range filter.Fields {
+		matcher.fields[field] = globRegexp(pattern)
+	}
+
+	if filter.Semver != "" {
+		constraint, err := semver.NewConstraint(filter.Semver)
+		if err != nil {
+			return VersionMatcher{}, fmt.Errorf("invalid semver range '%s': %s", filter.Semver, err)
+		}
+
+		matcher.constraint = constraint
+	}
+
+	return matcher, nil
+}
+
+// A VersionMatcher is a compiled VersionFilter.
+type VersionMatcher struct {
+	fields      map[string]*regexp.Regexp
+	constraint  *semver.Constraints
+	semverField string
+}
+
+// Matches returns whether the version, with its metadata, satisfies the
+// filter.
+func (matcher VersionMatcher) Matches(version Version, metadata []MetadataField) bool {
+	for field, pattern := range matcher.fields {
+		value, found := version[field]
+		if !found {
+			for _, m := range metadata {
+				if m.Name == field {
+					value, found = m.Value, true
+					break
+				}
+			}
+		}
+
+		if !found || !pattern.MatchString(value) {
+			return false
+		}
+	}
+
+	if matcher.constraint != nil {
+		field := matcher.semverField
+		if field == "" {
+			if len(version) != 1 {
+				return false
+			}
+
+			for name := range version {
+				field = name
+			}
+		}
+
+		v, err := semver.NewVersion(version[field])
+		if err != nil {
+			return false
+		}
+
+		if !matcher.constraint.Check(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func globRegexp(pattern string) *regexp.Regexp {
+	expr := "^"
+	for _, c := range pattern {
+		switch c {
+		case '*':
+			expr += ".*"
+		case '?':
+			expr += "."
+		default:
+			expr += regexp.QuoteMeta(string(c))
+		}
+	}
+
+	return regexp.MustCompile(expr + "$")
+}
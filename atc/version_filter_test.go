@@ -0,0 +1,120 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VersionFilter", func() {
+	Describe("Validate", func() {
+		It("requires fields or semver", func() {
+			Expect(atc.VersionFilter{}.Validate()).To(MatchError("must specify fields or semver"))
+		})
+
+		It("rejects an invalid semver range", func() {
+			err := atc.VersionFilter{Semver: "not a range"}.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid semver range 'not a range'"))
+		})
+
+		It("rejects a semver field without a range", func() {
+			err := atc.VersionFilter{Fields: map[string]string{"a": "b"}, SemverField: "tag"}.Validate()
+			Expect(err).To(MatchError("semver_field given without semver"))
+		})
+
+		It("accepts a valid filter", func() {
+			Expect(atc.VersionFilter{Semver: ">=2.0 <3"}.Validate()).To(Succeed())
+			Expect(atc.VersionFilter{Semver: ">= 2.0, < 3 || ~4.1"}.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("Matcher", func() {
+		var filter atc.VersionFilter
+
+		matches := func(version atc.Version, metadata []atc.MetadataField) bool {
+			matcher, err := filter.Matcher()
+			Expect(err).NotTo(HaveOccurred())
+			return matcher.Matches(version, metadata)
+		}
+
+		Context("with field patterns", func() {
+			BeforeEach(func() {
+				filter = atc.VersionFilter{
+					Fields: map[string]string{"tag": "v1.*", "branch": "release/*"},
+				}
+			})
+
+			It("matches version and metadata fields", func() {
+				Expect(matches(
+					atc.Version{"tag": "v1.2"},
+					[]atc.MetadataField{{Name: "branch", Value: "release/1.x"}},
+				)).To(BeTrue())
+			})
+
+			It("does not match when a pattern fails", func() {
+				Expect(matches(
+					atc.Version{"tag": "v2.0"},
+					[]atc.MetadataField{{Name: "branch", Value: "release/1.x"}},
+				)).To(BeFalse())
+			})
+
+			It("does not match when a field is missing", func() {
+				Expect(matches(atc.Version{"tag": "v1.2"}, nil)).To(BeFalse())
+			})
+
+			It("treats other characters literally", func() {
+				Expect(matches(
+					atc.Version{"tag": "v1x2"},
+					[]atc.MetadataField{{Name: "branch", Value: "release/1.x"}},
+				)).To(BeFalse())
+
+				filter = atc.VersionFilter{Fields: map[string]string{"tag": "v1.?"}}
+				Expect(matches(atc.Version{"tag": "v1x2"}, nil)).To(BeFalse())
+			})
+		})
+
+		Context("with a semver range", func() {
+			BeforeEach(func() {
+				filter = atc.VersionFilter{Semver: ">=2.0 <3"}
+			})
+
+			It("matches versions within the range", func() {
+				Expect(matches(atc.Version{"number": "2.4.1"}, nil)).To(BeTrue())
+				Expect(matches(atc.Version{"number": "v2.0.0"}, nil)).To(BeTrue())
+			})
+
+			It("does not match versions outside the range", func() {
+				Expect(matches(atc.Version{"number": "3.0.0"}, nil)).To(BeFalse())
+				Expect(matches(atc.Version{"number": "1.9.9"}, nil)).To(BeFalse())
+			})
+
+			It("matches any alternative range", func() {
+				filter.Semver = "<1 || ~4.1"
+				Expect(matches(atc.Version{"number": "0.3.0"}, nil)).To(BeTrue())
+				Expect(matches(atc.Version{"number": "4.1.7"}, nil)).To(BeTrue())
+				Expect(matches(atc.Version{"number": "4.2.0"}, nil)).To(BeFalse())
+			})
+
+			It("does not match versions which are not semantic versions", func() {
+				Expect(matches(atc.Version{"number": "latest"}, nil)).To(BeFalse())
+			})
+
+			It("fails to compile an invalid range", func() {
+				filter.Semver = "not a range"
+
+				_, err := filter.Matcher()
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("does not match versions with several fields unless the field is given", func() {
+				version := atc.Version{"tag": "2.1.0", "ref": "abc"}
+				Expect(matches(version, nil)).To(BeFalse())
+
+				filter.SemverField = "tag"
+				Expect(matches(version, nil)).To(BeTrue())
+			})
+		})
+	})
+})
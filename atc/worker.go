@@ -30,6 +30,11 @@ type Worker struct {
 	StartTime int64    `json:"start_time"`
 	Ephemeral bool     `json:"ephemeral"`
 	State     string   `json:"state"`
+
+	// EnforcesNetworkRules is set by workers whose containers have no outbound
+	// network access beyond what they are given, which is required for running
+	// tasks with network restrictions.
+	EnforcesNetworkRules bool `json:"enforces_network_rules,omitempty"`
 }
 
 var ErrInvalidWorkerVersion = errors.New("invalid worker version, only numeric characters are allowed")
@@ -26,6 +26,7 @@ type Client interface {
 	FindContainer(logger lager.Logger, teamID int, handle string) (Container, bool, error)
 	FindVolume(logger lager.Logger, teamID int, handle string) (Volume, bool, error)
 	CreateVolume(logger lager.Logger, vSpec VolumeSpec, wSpec WorkerSpec, volumeType db.VolumeType) (Volume, error)
+	CreateChildVolumeForArtifact(logger lager.Logger, teamID int, parent Volume) (Volume, bool, error)
 	RunTaskStep(
 		context.Context,
 		lager.Logger,
@@ -45,18 +46,21 @@ func NewClient(pool Pool, provider WorkerProvider) *client {
 	return &client{
 		pool:     pool,
 		provider: provider,
+		waiters:  newTaskWaiters(),
 	}
 }
 
 type client struct {
 	pool     Pool
 	provider WorkerProvider
+	waiters  *taskWaiters
 }
 
 type TaskResult struct {
-	Status       int
-	VolumeMounts []VolumeMount
-	Err          error
+	Status        int
+	VolumeMounts  []VolumeMount
+	ResourceUsage *atc.TaskResourceUsage
+	Err           error
 }
 
 type TaskProcessSpec struct {
@@ -116,6 +120,30 @@ func (client *client) CreateVolume(logger lager.Logger, volumeSpec VolumeSpec, w
 	return worker.CreateVolume(logger, volumeSpec, workerSpec.TeamID, volumeType)
 }
 
+// CreateChildVolumeForArtifact creates a copy-on-write volume of the parent
+// on the parent's worker, or returns false if the worker is gone.
+func (client *client) CreateChildVolumeForArtifact(logger lager.Logger, teamID int, parent Volume) (Volume, bool, error) {
+	worker, found, err := client.provider.FindWorkerForVolume(
+		logger.Session("find-worker"),
+		teamID,
+		parent.Handle(),
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	volume, err := worker.CreateChildVolumeForArtifact(logger, parent)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return volume, true, nil
+}
+
 func (client *client) RunTaskStep(
 	ctx context.Context,
 	logger lager.Logger,
@@ -169,7 +197,7 @@ func (client *client) RunTaskStep(
 
 		status, err := strconv.Atoi(code)
 		if err != nil {
-			return TaskResult{-1, []VolumeMount{}, err}
+			return TaskResult{Status: -1, VolumeMounts: []VolumeMount{}, Err: err}
 		}
 
 		return TaskResult{Status: status, VolumeMounts: container.VolumeMounts(), Err: nil}
@@ -217,6 +245,13 @@ func (client *client) RunTaskStep(
 
 	logger.Info("attached")
 
+	sampler := newResourceUsageSampler(container)
+	sampler.Sample(logger)
+
+	doneSampling := make(chan struct{})
+	go sampler.Run(logger.Session("sample-resource-usage"), doneSampling)
+	defer close(doneSampling)
+
 	exitStatusChan := make(chan processStatus)
 
 	go func() {
@@ -240,11 +275,19 @@ func (client *client) RunTaskStep(
 			return TaskResult{Status: status.processStatus, VolumeMounts: []VolumeMount{}, Err: status.processErr}
 		}
 
+		sampler.Sample(logger)
+		sampler.MeasureOutputs(ctx, logger, containerSpec.Outputs)
+
 		err = container.SetProperty(taskExitStatusPropertyName, fmt.Sprintf("%d", status.processStatus))
 		if err != nil {
 			return TaskResult{Status: status.processStatus, VolumeMounts: []VolumeMount{}, Err: err}
 		}
-		return TaskResult{Status: status.processStatus, VolumeMounts: container.VolumeMounts(), Err: nil}
+		return TaskResult{
+			Status:        status.processStatus,
+			VolumeMounts:  container.VolumeMounts(),
+			ResourceUsage: sampler.Usage(),
+			Err:           nil,
+		}
 	}
 }
 func (client *client) chooseTaskWorker(
@@ -263,8 +306,14 @@ func (client *client) chooseTaskWorker(
 		elapsed           time.Duration
 		err               error
 		existingContainer bool
+		waiter            *taskWaiter
 	)
 
+	if strategy.ModifiesActiveTasks() {
+		waiter = client.waiters.Add(workerSpec)
+		defer client.waiters.Remove(waiter)
+	}
+
 	for {
 		if strategy.ModifiesActiveTasks() {
 			var acquired bool
@@ -313,7 +362,8 @@ func (client *client) chooseTaskWorker(
 			default:
 			}
 
-			if chosenWorker == nil {
+			// leave the worker to a higher priority task waiting for one
+			if chosenWorker == nil || client.waiters.Outranked(waiter) {
 				err = activeTasksLock.Release()
 				if err != nil {
 					return nil, err
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"time"
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/garden/gardenfakes"
@@ -160,6 +161,62 @@ var _ = Describe("Client", func() {
 		})
 	})
 
+	Describe("CreateChildVolumeForArtifact", func() {
+		var (
+			fakeParent *workerfakes.FakeVolume
+
+			childVolume worker.Volume
+			found       bool
+			createErr   error
+		)
+
+		BeforeEach(func() {
+			fakeParent = new(workerfakes.FakeVolume)
+			fakeParent.HandleReturns("parent-handle")
+		})
+
+		JustBeforeEach(func() {
+			childVolume, found, createErr = client.CreateChildVolumeForArtifact(logger, 4567, fakeParent)
+		})
+
+		Context("when the parent's worker is not found", func() {
+			BeforeEach(func() {
+				fakeProvider.FindWorkerForVolumeReturns(nil, false, nil)
+			})
+
+			It("returns not found", func() {
+				Expect(createErr).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the parent's worker is found", func() {
+			var fakeWorker *workerfakes.FakeWorker
+			var fakeVolume *workerfakes.FakeVolume
+
+			BeforeEach(func() {
+				fakeWorker = new(workerfakes.FakeWorker)
+				fakeProvider.FindWorkerForVolumeReturns(fakeWorker, true, nil)
+
+				fakeVolume = new(workerfakes.FakeVolume)
+				fakeWorker.CreateChildVolumeForArtifactReturns(fakeVolume, nil)
+			})
+
+			It("creates the child volume on the parent's worker", func() {
+				Expect(createErr).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(childVolume).To(Equal(fakeVolume))
+
+				_, teamID, handle := fakeProvider.FindWorkerForVolumeArgsForCall(0)
+				Expect(teamID).To(Equal(4567))
+				Expect(handle).To(Equal("parent-handle"))
+
+				_, parent := fakeWorker.CreateChildVolumeForArtifactArgsForCall(0)
+				Expect(parent).To(Equal(fakeParent))
+			})
+		})
+	})
+
 	Describe("CreateVolume", func() {
 		var (
 			fakeWorker *workerfakes.FakeWorker
@@ -215,9 +272,10 @@ var _ = Describe("Client", func() {
 
 	Describe("RunTaskStep", func() {
 		var (
-			status       int
-			volumeMounts []worker.VolumeMount
-			err          error
+			status        int
+			volumeMounts  []worker.VolumeMount
+			resourceUsage *atc.TaskResourceUsage
+			err           error
 
 			fakeWorker           *workerfakes.FakeWorker
 			fakeContainerOwner   db.ContainerOwner
@@ -249,6 +307,7 @@ var _ = Describe("Client", func() {
 			)
 			status = taskResult.Status
 			volumeMounts = taskResult.VolumeMounts
+			resourceUsage = taskResult.ResourceUsage
 			err = taskResult.Err
 		})
 
@@ -650,6 +709,132 @@ var _ = Describe("Client", func() {
 						))
 					})
 
+					Context("when the container reports metrics", func() {
+						BeforeEach(func() {
+							fakeContainer.MetricsReturnsOnCall(0, garden.Metrics{
+								MemoryStat: garden.ContainerMemoryStat{TotalUsageTowardLimit: 100},
+								CPUStat:    garden.ContainerCPUStat{Usage: 10},
+								DiskStat:   garden.ContainerDiskStat{TotalBytesUsed: 2048},
+							}, nil)
+							fakeContainer.MetricsReturnsOnCall(1, garden.Metrics{
+								MemoryStat: garden.ContainerMemoryStat{TotalUsageTowardLimit: 300},
+								CPUStat:    garden.ContainerCPUStat{Usage: 50},
+								DiskStat:   garden.ContainerDiskStat{TotalBytesUsed: 1024},
+							}, nil)
+						})
+
+						It("returns the resource usage", func() {
+							Expect(resourceUsage).To(Equal(&atc.TaskResourceUsage{
+								CPUTime:       50,
+								PeakMemory:    300,
+								AverageMemory: 200,
+								PeakDisk:      2048,
+							}))
+						})
+					})
+
+					Context("when sampling metrics fails", func() {
+						BeforeEach(func() {
+							fakeContainer.MetricsReturns(garden.Metrics{}, errors.New("nope"))
+						})
+
+						It("succeeds without resource usage", func() {
+							Expect(err).ToNot(HaveOccurred())
+							Expect(resourceUsage).To(BeNil())
+						})
+					})
+
+					Context("when the task has outputs", func() {
+						var duProcess *gardenfakes.FakeProcess
+
+						BeforeEach(func() {
+							worker.MeasureTaskOutputSizes = true
+
+							fakeContainerSpec.Outputs = worker.OutputPaths{
+								"some-output":  "/tmp/build/some-output/",
+								"other-output": "/tmp/build/other-output/",
+							}
+
+							duProcess = new(gardenfakes.FakeProcess)
+							fakeContainer.RunStub = func(_ context.Context, spec garden.ProcessSpec, io garden.ProcessIO) (garden.Process, error) {
+								io.Stdout.Write([]byte("4\t/tmp/build/some-output\n8\t/tmp/build/other-output\n"))
+								return duProcess, nil
+							}
+						})
+
+						AfterEach(func() {
+							worker.MeasureTaskOutputSizes = false
+						})
+
+						It("measures the size of each output", func() {
+							Expect(fakeContainer.RunCallCount()).To(Equal(1))
+
+							_, spec, _ := fakeContainer.RunArgsForCall(0)
+							Expect(spec.ID).To(HavePrefix("output-sizes-"))
+							Expect(spec.Path).To(Equal("du"))
+							Expect(spec.Args).To(ConsistOf("-s", "-k", "/tmp/build/some-output", "/tmp/build/other-output"))
+
+							Expect(resourceUsage.OutputSizes).To(Equal(map[string]uint64{
+								"some-output":  4096,
+								"other-output": 8192,
+							}))
+						})
+
+						Context("when measuring the outputs fails", func() {
+							BeforeEach(func() {
+								fakeContainer.RunReturns(nil, errors.New("no du"))
+								fakeContainer.RunStub = nil
+							})
+
+							It("still succeeds", func() {
+								Expect(err).ToNot(HaveOccurred())
+								Expect(resourceUsage.OutputSizes).To(BeEmpty())
+							})
+						})
+
+						Context("when measuring the outputs takes too long", func() {
+							var originalTimeout time.Duration
+
+							BeforeEach(func() {
+								originalTimeout = worker.TaskOutputSizesTimeout
+								worker.TaskOutputSizesTimeout = 10 * time.Millisecond
+
+								killed := make(chan struct{})
+								duProcess.SignalStub = func(garden.Signal) error {
+									close(killed)
+									return nil
+								}
+
+								duProcess.WaitStub = func() (int, error) {
+									<-killed
+									return 137, nil
+								}
+							})
+
+							AfterEach(func() {
+								worker.TaskOutputSizesTimeout = originalTimeout
+							})
+
+							It("kills du and succeeds without output sizes", func() {
+								Expect(err).ToNot(HaveOccurred())
+								Expect(duProcess.SignalCallCount()).To(Equal(1))
+								Expect(duProcess.SignalArgsForCall(0)).To(Equal(garden.SignalKill))
+								Expect(resourceUsage.OutputSizes).To(BeEmpty())
+							})
+						})
+
+						Context("when measuring output sizes is disabled", func() {
+							BeforeEach(func() {
+								worker.MeasureTaskOutputSizes = false
+							})
+
+							It("does not run du", func() {
+								Expect(err).ToNot(HaveOccurred())
+								Expect(fakeContainer.RunCallCount()).To(BeZero())
+							})
+						})
+					})
+
 					Context("when 'limit-active-tasks' strategy is chosen", func() {
 						BeforeEach(func() {
 							fakeStrategy.ModifiesActiveTasksReturns(true)
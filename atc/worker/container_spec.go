@@ -2,6 +2,7 @@ package worker
 
 import (
 	"fmt"
+	"net"
 	"strings"
 
 	"code.cloudfoundry.org/garden"
@@ -15,6 +16,15 @@ type WorkerSpec struct {
 	Tags          []string
 	TeamID        int
 	ResourceTypes atc.VersionedResourceTypes
+
+	// Priority orders task steps competing for workers when active tasks are
+	// limited; higher priorities get a worker first.
+	Priority int
+
+	// RestrictedNetwork limits the choice to workers which enforce the
+	// container's network rules. Garden workers which do not deny networks by
+	// default would otherwise permit all traffic.
+	RestrictedNetwork bool
 }
 
 type ContainerSpec struct {
@@ -39,6 +49,9 @@ type ContainerSpec struct {
 	// Resource limits to be set on the container when creating in garden.
 	Limits ContainerLimits
 
+	// Outbound network restrictions; nil leaves egress unrestricted.
+	Network *ContainerNetwork
+
 	// Local volumes to bind mount directly to the container when creating in garden.
 	BindMounts []BindMountSource
 
@@ -99,6 +112,58 @@ func (cl ContainerLimits) ToGardenLimits() garden.Limits {
 	return gardenLimits
 }
 
+type ContainerNetwork struct {
+	// Destinations which remain reachable; all other outbound traffic is
+	// denied.
+	Allow []atc.NetworkRule
+}
+
+var allowAllNetOutRule = garden.NetOutRule{
+	Protocol: garden.ProtocolAll,
+	Networks: []garden.IPRange{
+		garden.IPRangeFromIPNet(&net.IPNet{IP: net.IPv4zero.To4(), Mask: net.CIDRMask(0, 32)}),
+		garden.IPRangeFromIPNet(&net.IPNet{IP: net.IPv6zero, Mask: net.CIDRMask(0, 128)}),
+	},
+}
+
+// ToGardenNetOutRules converts the network restrictions into the rules given
+// to garden.
+//
+// Unrestricted containers are only given a rule permitting everything on
+// workers which enforce network rules, as those deny all outbound traffic by
+// default. Elsewhere an allow rule would override the networks the operator
+// has denied with --deny-network, so no rules are given at all.
+func (network *ContainerNetwork) ToGardenNetOutRules(enforced bool) []garden.NetOutRule {
+	if network == nil {
+		if enforced {
+			return []garden.NetOutRule{allowAllNetOutRule}
+		}
+
+		return nil
+	}
+
+	rules := []garden.NetOutRule{}
+	for _, allow := range network.Allow {
+		rule := garden.NetOutRule{
+			Protocol: garden.ProtocolAll,
+			Networks: []garden.IPRange{garden.IPRangeFromIPNet(allow.Network)},
+		}
+
+		if !allow.AllPorts() {
+			rule.Protocol = garden.ProtocolTCP
+			rule.Ports = []garden.PortRange{{Start: allow.StartPort, End: allow.EndPort}}
+
+			rules = append(rules, rule)
+
+			rule.Protocol = garden.ProtocolUDP
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
 func (spec WorkerSpec) Description() string {
 	var attrs []string
 
@@ -114,5 +179,9 @@ func (spec WorkerSpec) Description() string {
 		attrs = append(attrs, fmt.Sprintf("tag '%s'", tag))
 	}
 
+	if spec.RestrictedNetwork {
+		attrs = append(attrs, "network rules enforced")
+	}
+
 	return strings.Join(attrs, ", ")
 }
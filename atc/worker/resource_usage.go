@@ -0,0 +1,207 @@
+package worker
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/nu7hatch/gouuid"
+)
+
+// TaskResourceUsageSampleInterval is how often a running task's container
+// metrics are sampled.
+var TaskResourceUsageSampleInterval = 10 * time.Second
+
+// MeasureTaskOutputSizes enables measuring the size of each task output once
+// the task exits. It is configured by the ATC at startup.
+var MeasureTaskOutputSizes bool
+
+// TaskOutputSizesTimeout bounds how long measuring a task's outputs may take.
+var TaskOutputSizesTimeout = 30 * time.Second
+
+const outputSizesProcessID = "output-sizes"
+
+type resourceUsageSampler struct {
+	container Container
+
+	lock        sync.Mutex
+	samples     uint64
+	memoryTotal uint64
+	usage       atc.TaskResourceUsage
+}
+
+func newResourceUsageSampler(container Container) *resourceUsageSampler {
+	return &resourceUsageSampler{container: container}
+}
+
+// Run samples the container's metrics until the given channel is closed.
+func (sampler *resourceUsageSampler) Run(logger lager.Logger, done <-chan struct{}) {
+	ticker := time.NewTicker(TaskResourceUsageSampleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			sampler.Sample(logger)
+		case <-done:
+			return
+		}
+	}
+}
+
+func (sampler *resourceUsageSampler) Sample(logger lager.Logger) {
+	metrics, err := sampler.container.Metrics()
+	if err != nil {
+		logger.Debug("failed-to-sample-metrics", lager.Data{"error": err.Error()})
+		return
+	}
+
+	sampler.lock.Lock()
+	defer sampler.lock.Unlock()
+
+	memory := metrics.MemoryStat.TotalUsageTowardLimit
+
+	sampler.samples++
+	sampler.memoryTotal += memory
+
+	if memory > sampler.usage.PeakMemory {
+		sampler.usage.PeakMemory = memory
+	}
+
+	if metrics.DiskStat.TotalBytesUsed > sampler.usage.PeakDisk {
+		sampler.usage.PeakDisk = metrics.DiskStat.TotalBytesUsed
+	}
+
+	// CPU usage is cumulative for the lifetime of the container
+	if metrics.CPUStat.Usage > sampler.usage.CPUTime {
+		sampler.usage.CPUTime = metrics.CPUStat.Usage
+	}
+}
+
+// Usage returns the summary of all samples taken so far, or nil if no sample
+// succeeded.
+func (sampler *resourceUsageSampler) Usage() *atc.TaskResourceUsage {
+	sampler.lock.Lock()
+	defer sampler.lock.Unlock()
+
+	if sampler.samples == 0 {
+		return nil
+	}
+
+	usage := sampler.usage
+	usage.AverageMemory = sampler.memoryTotal / sampler.samples
+
+	return &usage
+}
+
+// MeasureOutputs records the size of each output directory by running du
+// within the container, if enabled. It is best-effort: failures are logged
+// and otherwise ignored, as not every image is guaranteed to ship with du, and
+// du is killed if it takes longer than TaskOutputSizesTimeout.
+func (sampler *resourceUsageSampler) MeasureOutputs(ctx context.Context, logger lager.Logger, outputs OutputPaths) {
+	if !MeasureTaskOutputSizes || len(outputs) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, TaskOutputSizesTimeout)
+	defer cancel()
+
+	// each measurement gets its own process so that it can't collide with
+	// one left behind by an earlier attempt in the same container
+	processID, err := uuid.NewV4()
+	if err != nil {
+		logger.Debug("failed-to-measure-outputs", lager.Data{"error": err.Error()})
+		return
+	}
+
+	names := make(map[string]string, len(outputs))
+	args := []string{"-s", "-k"}
+	for name, path := range outputs {
+		path = strings.TrimSuffix(path, "/")
+		names[path] = name
+		args = append(args, path)
+	}
+
+	stdout := new(bytes.Buffer)
+
+	process, err := sampler.container.Run(
+		ctx,
+		garden.ProcessSpec{
+			ID:   outputSizesProcessID + "-" + processID.String(),
+			Path: "du",
+			Args: args,
+		},
+		garden.ProcessIO{Stdout: stdout},
+	)
+	if err != nil {
+		logger.Debug("failed-to-measure-outputs", lager.Data{"error": err.Error()})
+		return
+	}
+
+	exited := make(chan error, 1)
+	go func() {
+		_, err := process.Wait()
+		exited <- err
+	}()
+
+	select {
+	case err = <-exited:
+		if err != nil {
+			logger.Debug("failed-to-measure-outputs", lager.Data{"error": err.Error()})
+			return
+		}
+	case <-ctx.Done():
+		logger.Debug("timed-out-measuring-outputs", lager.Data{"error": ctx.Err().Error()})
+
+		err = process.Signal(garden.SignalKill)
+		if err != nil {
+			logger.Debug("failed-to-kill-output-measurement", lager.Data{"error": err.Error()})
+		}
+
+		return
+	}
+
+	sizes := parseDiskUsage(stdout.String(), names)
+	if len(sizes) == 0 {
+		return
+	}
+
+	sampler.lock.Lock()
+	sampler.usage.OutputSizes = sizes
+	sampler.lock.Unlock()
+}
+
+// parseDiskUsage parses the output of 'du -s -k', mapping each path to its
+// output name.
+func parseDiskUsage(output string, names map[string]string) map[string]uint64 {
+	sizes := map[string]uint64{}
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		fields := strings.SplitN(scanner.Text(), "\t", 2)
+		if len(fields) != 2 {
+			continue
+		}
+
+		name, found := names[strings.TrimSuffix(fields[1], "/")]
+		if !found {
+			continue
+		}
+
+		kilobytes, err := strconv.ParseUint(strings.TrimSpace(fields[0]), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		sizes[name] = kilobytes * 1024
+	}
+
+	return sizes
+}
@@ -0,0 +1,89 @@
+package worker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// taskWaiters tracks the task steps on this ATC which are looking for a
+// worker under a placement strategy that limits active tasks, so that
+// capacity freed up on a worker goes to the highest priority step first.
+//
+// Steps only yield to steps with the same placement requirements; a step
+// waiting for a worker that no other step can use must not hold them up.
+//
+// Waiters are tracked in memory, so steps only yield to higher priority steps
+// running on the same ATC; other ATCs may still place their steps first.
+type taskWaiters struct {
+	lock    sync.Mutex
+	waiters map[*taskWaiter]struct{}
+}
+
+type taskWaiter struct {
+	key      string
+	priority int
+}
+
+func newTaskWaiters() *taskWaiters {
+	return &taskWaiters{
+		waiters: map[*taskWaiter]struct{}{},
+	}
+}
+
+func (tw *taskWaiters) Add(spec WorkerSpec) *taskWaiter {
+	waiter := &taskWaiter{
+		key:      placementKey(spec),
+		priority: spec.Priority,
+	}
+
+	tw.lock.Lock()
+	tw.waiters[waiter] = struct{}{}
+	tw.lock.Unlock()
+
+	return waiter
+}
+
+func (tw *taskWaiters) Remove(waiter *taskWaiter) {
+	tw.lock.Lock()
+	delete(tw.waiters, waiter)
+	tw.lock.Unlock()
+}
+
+// placementKey identifies the workers a step can be placed on. It must cover
+// every field of the spec which Satisfies checks, so that steps which can use
+// workers others can't are never held up by them.
+func placementKey(spec WorkerSpec) string {
+	tags := append([]string{}, spec.Tags...)
+	sort.Strings(tags)
+
+	resourceType := ""
+	if spec.ResourceType != "" {
+		resourceType = determineUnderlyingTypeName(spec.ResourceType, spec.ResourceTypes)
+	}
+
+	return fmt.Sprintf(
+		"%d/%s/%s/%s/%t",
+		spec.TeamID,
+		spec.Platform,
+		strings.Join(tags, ","),
+		resourceType,
+		spec.RestrictedNetwork,
+	)
+}
+
+// Outranked returns true if a step with the same placement requirements and a
+// higher priority is also waiting for a worker.
+func (tw *taskWaiters) Outranked(waiter *taskWaiter) bool {
+	tw.lock.Lock()
+	defer tw.lock.Unlock()
+
+	for other := range tw.waiters {
+		if other.key == waiter.key && other.priority > waiter.priority {
+			return true
+		}
+	}
+
+	return false
+}
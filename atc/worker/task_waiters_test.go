@@ -0,0 +1,68 @@
+package worker
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("taskWaiters", func() {
+	var (
+		waiters  *taskWaiters
+		lowSpec  WorkerSpec
+		highSpec WorkerSpec
+	)
+
+	BeforeEach(func() {
+		waiters = newTaskWaiters()
+
+		lowSpec = WorkerSpec{
+			TeamID:   1,
+			Platform: "linux",
+			Tags:     []string{"b", "a"},
+			Priority: 1,
+		}
+
+		highSpec = WorkerSpec{
+			TeamID:   1,
+			Platform: "linux",
+			Tags:     []string{"a", "b"},
+			Priority: 2,
+		}
+	})
+
+	Describe("Outranked", func() {
+		It("is true when a step with the same placement has a higher priority", func() {
+			low := waiters.Add(lowSpec)
+			high := waiters.Add(highSpec)
+
+			Expect(waiters.Outranked(low)).To(BeTrue())
+			Expect(waiters.Outranked(high)).To(BeFalse())
+		})
+
+		It("is false once the higher priority step is removed", func() {
+			low := waiters.Add(lowSpec)
+			waiters.Remove(waiters.Add(highSpec))
+
+			Expect(waiters.Outranked(low)).To(BeFalse())
+		})
+
+		It("is false when the higher priority step needs a network enforcing worker", func() {
+			highSpec.RestrictedNetwork = true
+
+			low := waiters.Add(lowSpec)
+			waiters.Add(highSpec)
+
+			Expect(waiters.Outranked(low)).To(BeFalse())
+		})
+
+		It("is false when the higher priority step needs a different resource type", func() {
+			lowSpec.ResourceType = "git"
+			highSpec.ResourceType = "s3"
+
+			low := waiters.Add(lowSpec)
+			waiters.Add(highSpec)
+
+			Expect(waiters.Outranked(low)).To(BeFalse())
+		})
+	})
+})
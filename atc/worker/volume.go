@@ -30,6 +30,7 @@ type Volume interface {
 	InitializeArtifact(name string, buildID int) (db.WorkerArtifact, error)
 
 	CreateChildForContainer(db.CreatingContainer, string) (db.CreatingVolume, error)
+	CreateChildForArtifact() (db.CreatingVolume, error)
 
 	WorkerName() string
 	Destroy() error
@@ -154,3 +155,7 @@ func (v *volume) InitializeTaskCache(
 func (v *volume) CreateChildForContainer(creatingContainer db.CreatingContainer, mountPath string) (db.CreatingVolume, error) {
 	return v.dbVolume.CreateChildForContainer(creatingContainer, mountPath)
 }
+
+func (v *volume) CreateChildForArtifact() (db.CreatingVolume, error) {
+	return v.dbVolume.CreateChildForArtifact()
+}
@@ -46,6 +46,10 @@ type VolumeClient interface {
 		string,
 		db.VolumeType,
 	) (Volume, error)
+	CreateChildVolumeForArtifact(
+		lager.Logger,
+		Volume,
+	) (Volume, error)
 	FindVolumeForResourceCache(
 		lager.Logger,
 		db.UsedResourceCache,
@@ -192,6 +196,24 @@ func (c *volumeClient) CreateVolume(
 	)
 }
 
+func (c *volumeClient) CreateChildVolumeForArtifact(
+	logger lager.Logger,
+	parent Volume,
+) (Volume, error) {
+	return c.findOrCreateVolume(
+		logger.Session("create-child-volume-for-artifact"),
+		VolumeSpec{
+			Strategy: parent.COWStrategy(),
+		},
+		func() (db.CreatingVolume, db.CreatedVolume, error) {
+			return nil, nil, nil
+		},
+		func() (db.CreatingVolume, error) {
+			return parent.CreateChildForArtifact()
+		},
+	)
+}
+
 func (c *volumeClient) FindOrCreateVolumeForBaseResourceType(
 	logger lager.Logger,
 	volumeSpec VolumeSpec,
@@ -57,6 +57,7 @@ type Worker interface {
 	CertsVolume(lager.Logger) (volume Volume, found bool, err error)
 	LookupVolume(lager.Logger, string) (Volume, bool, error)
 	CreateVolume(logger lager.Logger, spec VolumeSpec, teamID int, volumeType db.VolumeType) (Volume, error)
+	CreateChildVolumeForArtifact(logger lager.Logger, parent Volume) (Volume, error)
 
 	GardenClient() gclient.Client
 	ActiveTasks() (int, error)
@@ -168,6 +169,10 @@ func (worker *gardenWorker) CreateVolume(logger lager.Logger, spec VolumeSpec, t
 	return worker.volumeClient.CreateVolume(logger.Session("find-or-create"), spec, teamID, worker.dbWorker.Name(), volumeType)
 }
 
+func (worker *gardenWorker) CreateChildVolumeForArtifact(logger lager.Logger, parent Volume) (Volume, error) {
+	return worker.volumeClient.CreateChildVolumeForArtifact(logger, parent)
+}
+
 func (worker *gardenWorker) LookupVolume(logger lager.Logger, handle string) (Volume, bool, error) {
 	return worker.volumeClient.LookupVolume(logger, handle)
 }
@@ -687,6 +692,10 @@ func (worker *gardenWorker) Satisfies(logger lager.Logger, spec WorkerSpec) bool
 		return false
 	}
 
+	if spec.RestrictedNetwork && !worker.dbWorker.EnforcesNetworkRules() {
+		return false
+	}
+
 	return true
 }
 
@@ -714,6 +723,10 @@ func (worker *gardenWorker) Description() string {
 		messages = append(messages, fmt.Sprintf("tag '%s'", tag))
 	}
 
+	if worker.dbWorker.EnforcesNetworkRules() {
+		messages = append(messages, "network rules enforced")
+	}
+
 	return strings.Join(messages, ", ")
 }
 
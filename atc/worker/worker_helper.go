@@ -54,6 +54,7 @@ func (w workerHelper) createGardenContainer(
 			Privileged: fetchedImage.Privileged,
 			BindMounts: bindMounts,
 			Limits:     containerSpec.Limits.ToGardenLimits(),
+			NetOut:     containerSpec.Network.ToGardenNetOutRules(w.dbWorker.EnforcesNetworkRules()),
 			Env:        env,
 			Properties: gardenProperties,
 		})
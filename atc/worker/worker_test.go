@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/lager"
@@ -696,6 +697,27 @@ var _ = Describe("Worker", func() {
 			})
 		})
 
+		Context("when the network is restricted", func() {
+			BeforeEach(func() {
+				spec.Platform = "some-platform"
+				spec.RestrictedNetwork = true
+			})
+
+			It("returns false if the worker does not enforce network rules", func() {
+				Expect(satisfies).To(BeFalse())
+			})
+
+			Context("when the worker enforces network rules", func() {
+				BeforeEach(func() {
+					fakeDBWorker.EnforcesNetworkRulesReturns(true)
+				})
+
+				It("returns true", func() {
+					Expect(satisfies).To(BeTrue())
+				})
+			})
+		})
+
 		Context("when the platform is incompatible", func() {
 			BeforeEach(func() {
 				spec.Platform = "some-bogus-platform"
@@ -996,6 +1018,59 @@ var _ = Describe("Worker", func() {
 					}))
 				})
 
+				Context("when the worker only denies some networks", func() {
+					BeforeEach(func() {
+						fakeDBWorker.EnforcesNetworkRulesReturns(false)
+					})
+
+					It("does not permit any traffic which the worker denies", func() {
+						actualSpec := fakeGardenClient.CreateArgsForCall(0)
+						Expect(actualSpec.NetOut).To(BeEmpty())
+					})
+				})
+
+				Context("when the worker enforces network rules", func() {
+					BeforeEach(func() {
+						fakeDBWorker.EnforcesNetworkRulesReturns(true)
+					})
+
+					It("permits all traffic from unrestricted containers", func() {
+						actualSpec := fakeGardenClient.CreateArgsForCall(0)
+						Expect(actualSpec.NetOut).To(Equal([]garden.NetOutRule{allowAllNetOutRule}))
+					})
+				})
+
+				Context("when the container's network is restricted", func() {
+					BeforeEach(func() {
+						containerSpec.Network = &ContainerNetwork{
+							Allow: []atc.NetworkRule{
+								{Network: mustParseCIDR("10.0.0.0/8")},
+								{Network: mustParseCIDR("192.168.1.1/32"), StartPort: 8000, EndPort: 8080},
+							},
+						}
+					})
+
+					It("only permits traffic to the allowed destinations", func() {
+						actualSpec := fakeGardenClient.CreateArgsForCall(0)
+						Expect(actualSpec.NetOut).To(Equal([]garden.NetOutRule{
+							{
+								Protocol: garden.ProtocolAll,
+								Networks: []garden.IPRange{{Start: net.ParseIP("10.0.0.0").To4(), End: net.ParseIP("10.255.255.255").To4()}},
+							},
+							{
+								Protocol: garden.ProtocolTCP,
+								Networks: []garden.IPRange{{Start: net.ParseIP("192.168.1.1").To4(), End: net.ParseIP("192.168.1.1").To4()}},
+								Ports:    []garden.PortRange{{Start: 8000, End: 8080}},
+							},
+							{
+								Protocol: garden.ProtocolUDP,
+								Networks: []garden.IPRange{{Start: net.ParseIP("192.168.1.1").To4(), End: net.ParseIP("192.168.1.1").To4()}},
+								Ports:    []garden.PortRange{{Start: 8000, End: 8080}},
+							},
+						}))
+					})
+				})
+
 				Context("when the input and output destination paths overlap", func() {
 					var (
 						fakeRemoteInputUnderInput    *workerfakes.FakeInputSource
@@ -1535,3 +1610,17 @@ var _ = Describe("Worker", func() {
 		})
 	})
 })
+
+var allowAllNetOutRule = garden.NetOutRule{
+	Protocol: garden.ProtocolAll,
+	Networks: []garden.IPRange{
+		{Start: net.IPv4zero.To4(), End: net.IPv4bcast.To4()},
+		{Start: net.IPv6zero, End: net.ParseIP("ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff")},
+	},
+}
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	Expect(err).ToNot(HaveOccurred())
+	return network
+}
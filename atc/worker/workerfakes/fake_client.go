@@ -13,6 +13,23 @@ import (
 )
 
 type FakeClient struct {
+	CreateChildVolumeForArtifactStub        func(lager.Logger, int, worker.Volume) (worker.Volume, bool, error)
+	createChildVolumeForArtifactMutex       sync.RWMutex
+	createChildVolumeForArtifactArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 int
+		arg3 worker.Volume
+	}
+	createChildVolumeForArtifactReturns struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}
+	createChildVolumeForArtifactReturnsOnCall map[int]struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}
 	CreateVolumeStub        func(lager.Logger, worker.VolumeSpec, worker.WorkerSpec, db.VolumeType) (worker.Volume, error)
 	createVolumeMutex       sync.RWMutex
 	createVolumeArgsForCall []struct {
@@ -88,6 +105,74 @@ type FakeClient struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeClient) CreateChildVolumeForArtifact(arg1 lager.Logger, arg2 int, arg3 worker.Volume) (worker.Volume, bool, error) {
+	fake.createChildVolumeForArtifactMutex.Lock()
+	ret, specificReturn := fake.createChildVolumeForArtifactReturnsOnCall[len(fake.createChildVolumeForArtifactArgsForCall)]
+	fake.createChildVolumeForArtifactArgsForCall = append(fake.createChildVolumeForArtifactArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 int
+		arg3 worker.Volume
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateChildVolumeForArtifact", []interface{}{arg1, arg2, arg3})
+	fake.createChildVolumeForArtifactMutex.Unlock()
+	if fake.CreateChildVolumeForArtifactStub != nil {
+		return fake.CreateChildVolumeForArtifactStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createChildVolumeForArtifactReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) CreateChildVolumeForArtifactCallCount() int {
+	fake.createChildVolumeForArtifactMutex.RLock()
+	defer fake.createChildVolumeForArtifactMutex.RUnlock()
+	return len(fake.createChildVolumeForArtifactArgsForCall)
+}
+
+func (fake *FakeClient) CreateChildVolumeForArtifactCalls(stub func(lager.Logger, int, worker.Volume) (worker.Volume, bool, error)) {
+	fake.createChildVolumeForArtifactMutex.Lock()
+	defer fake.createChildVolumeForArtifactMutex.Unlock()
+	fake.CreateChildVolumeForArtifactStub = stub
+}
+
+func (fake *FakeClient) CreateChildVolumeForArtifactArgsForCall(i int) (lager.Logger, int, worker.Volume) {
+	fake.createChildVolumeForArtifactMutex.RLock()
+	defer fake.createChildVolumeForArtifactMutex.RUnlock()
+	argsForCall := fake.createChildVolumeForArtifactArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) CreateChildVolumeForArtifactReturns(result1 worker.Volume, result2 bool, result3 error) {
+	fake.createChildVolumeForArtifactMutex.Lock()
+	defer fake.createChildVolumeForArtifactMutex.Unlock()
+	fake.CreateChildVolumeForArtifactStub = nil
+	fake.createChildVolumeForArtifactReturns = struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) CreateChildVolumeForArtifactReturnsOnCall(i int, result1 worker.Volume, result2 bool, result3 error) {
+	fake.createChildVolumeForArtifactMutex.Lock()
+	defer fake.createChildVolumeForArtifactMutex.Unlock()
+	fake.CreateChildVolumeForArtifactStub = nil
+	if fake.createChildVolumeForArtifactReturnsOnCall == nil {
+		fake.createChildVolumeForArtifactReturnsOnCall = make(map[int]struct {
+			result1 worker.Volume
+			result2 bool
+			result3 error
+		})
+	}
+	fake.createChildVolumeForArtifactReturnsOnCall[i] = struct {
+		result1 worker.Volume
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) CreateVolume(arg1 lager.Logger, arg2 worker.VolumeSpec, arg3 worker.WorkerSpec, arg4 db.VolumeType) (worker.Volume, error) {
 	fake.createVolumeMutex.Lock()
 	ret, specificReturn := fake.createVolumeReturnsOnCall[len(fake.createVolumeArgsForCall)]
@@ -363,6 +448,8 @@ func (fake *FakeClient) RunTaskStepReturnsOnCall(i int, result1 worker.TaskResul
 func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.createChildVolumeForArtifactMutex.RLock()
+	defer fake.createChildVolumeForArtifactMutex.RUnlock()
 	fake.createVolumeMutex.RLock()
 	defer fake.createVolumeMutex.RUnlock()
 	fake.findContainerMutex.RLock()
@@ -23,6 +23,18 @@ type FakeVolume struct {
 	cOWStrategyReturnsOnCall map[int]struct {
 		result1 baggageclaim.COWStrategy
 	}
+	CreateChildForArtifactStub        func() (db.CreatingVolume, error)
+	createChildForArtifactMutex       sync.RWMutex
+	createChildForArtifactArgsForCall []struct {
+	}
+	createChildForArtifactReturns struct {
+		result1 db.CreatingVolume
+		result2 error
+	}
+	createChildForArtifactReturnsOnCall map[int]struct {
+		result1 db.CreatingVolume
+		result2 error
+	}
 	CreateChildForContainerStub        func(db.CreatingContainer, string) (db.CreatingVolume, error)
 	createChildForContainerMutex       sync.RWMutex
 	createChildForContainerArgsForCall []struct {
@@ -235,6 +247,61 @@ func (fake *FakeVolume) COWStrategyReturnsOnCall(i int, result1 baggageclaim.COW
 	}{result1}
 }
 
+func (fake *FakeVolume) CreateChildForArtifact() (db.CreatingVolume, error) {
+	fake.createChildForArtifactMutex.Lock()
+	ret, specificReturn := fake.createChildForArtifactReturnsOnCall[len(fake.createChildForArtifactArgsForCall)]
+	fake.createChildForArtifactArgsForCall = append(fake.createChildForArtifactArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CreateChildForArtifact", []interface{}{})
+	fake.createChildForArtifactMutex.Unlock()
+	if fake.CreateChildForArtifactStub != nil {
+		return fake.CreateChildForArtifactStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createChildForArtifactReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeVolume) CreateChildForArtifactCallCount() int {
+	fake.createChildForArtifactMutex.RLock()
+	defer fake.createChildForArtifactMutex.RUnlock()
+	return len(fake.createChildForArtifactArgsForCall)
+}
+
+func (fake *FakeVolume) CreateChildForArtifactCalls(stub func() (db.CreatingVolume, error)) {
+	fake.createChildForArtifactMutex.Lock()
+	defer fake.createChildForArtifactMutex.Unlock()
+	fake.CreateChildForArtifactStub = stub
+}
+
+func (fake *FakeVolume) CreateChildForArtifactReturns(result1 db.CreatingVolume, result2 error) {
+	fake.createChildForArtifactMutex.Lock()
+	defer fake.createChildForArtifactMutex.Unlock()
+	fake.CreateChildForArtifactStub = nil
+	fake.createChildForArtifactReturns = struct {
+		result1 db.CreatingVolume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVolume) CreateChildForArtifactReturnsOnCall(i int, result1 db.CreatingVolume, result2 error) {
+	fake.createChildForArtifactMutex.Lock()
+	defer fake.createChildForArtifactMutex.Unlock()
+	fake.CreateChildForArtifactStub = nil
+	if fake.createChildForArtifactReturnsOnCall == nil {
+		fake.createChildForArtifactReturnsOnCall = make(map[int]struct {
+			result1 db.CreatingVolume
+			result2 error
+		})
+	}
+	fake.createChildForArtifactReturnsOnCall[i] = struct {
+		result1 db.CreatingVolume
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeVolume) CreateChildForContainer(arg1 db.CreatingContainer, arg2 string) (db.CreatingVolume, error) {
 	fake.createChildForContainerMutex.Lock()
 	ret, specificReturn := fake.createChildForContainerReturnsOnCall[len(fake.createChildForContainerArgsForCall)]
@@ -1002,6 +1069,8 @@ func (fake *FakeVolume) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.cOWStrategyMutex.RLock()
 	defer fake.cOWStrategyMutex.RUnlock()
+	fake.createChildForArtifactMutex.RLock()
+	defer fake.createChildForArtifactMutex.RUnlock()
 	fake.createChildForContainerMutex.RLock()
 	defer fake.createChildForContainerMutex.RUnlock()
 	fake.destroyMutex.RLock()
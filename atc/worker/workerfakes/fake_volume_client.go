@@ -10,6 +10,20 @@ import (
 )
 
 type FakeVolumeClient struct {
+	CreateChildVolumeForArtifactStub        func(lager.Logger, worker.Volume) (worker.Volume, error)
+	createChildVolumeForArtifactMutex       sync.RWMutex
+	createChildVolumeForArtifactArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 worker.Volume
+	}
+	createChildVolumeForArtifactReturns struct {
+		result1 worker.Volume
+		result2 error
+	}
+	createChildVolumeForArtifactReturnsOnCall map[int]struct {
+		result1 worker.Volume
+		result2 error
+	}
 	CreateVolumeStub        func(lager.Logger, worker.VolumeSpec, int, string, db.VolumeType) (worker.Volume, error)
 	createVolumeMutex       sync.RWMutex
 	createVolumeArgsForCall []struct {
@@ -166,6 +180,70 @@ type FakeVolumeClient struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeVolumeClient) CreateChildVolumeForArtifact(arg1 lager.Logger, arg2 worker.Volume) (worker.Volume, error) {
+	fake.createChildVolumeForArtifactMutex.Lock()
+	ret, specificReturn := fake.createChildVolumeForArtifactReturnsOnCall[len(fake.createChildVolumeForArtifactArgsForCall)]
+	fake.createChildVolumeForArtifactArgsForCall = append(fake.createChildVolumeForArtifactArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 worker.Volume
+	}{arg1, arg2})
+	fake.recordInvocation("CreateChildVolumeForArtifact", []interface{}{arg1, arg2})
+	fake.createChildVolumeForArtifactMutex.Unlock()
+	if fake.CreateChildVolumeForArtifactStub != nil {
+		return fake.CreateChildVolumeForArtifactStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createChildVolumeForArtifactReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeVolumeClient) CreateChildVolumeForArtifactCallCount() int {
+	fake.createChildVolumeForArtifactMutex.RLock()
+	defer fake.createChildVolumeForArtifactMutex.RUnlock()
+	return len(fake.createChildVolumeForArtifactArgsForCall)
+}
+
+func (fake *FakeVolumeClient) CreateChildVolumeForArtifactCalls(stub func(lager.Logger, worker.Volume) (worker.Volume, error)) {
+	fake.createChildVolumeForArtifactMutex.Lock()
+	defer fake.createChildVolumeForArtifactMutex.Unlock()
+	fake.CreateChildVolumeForArtifactStub = stub
+}
+
+func (fake *FakeVolumeClient) CreateChildVolumeForArtifactArgsForCall(i int) (lager.Logger, worker.Volume) {
+	fake.createChildVolumeForArtifactMutex.RLock()
+	defer fake.createChildVolumeForArtifactMutex.RUnlock()
+	argsForCall := fake.createChildVolumeForArtifactArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeVolumeClient) CreateChildVolumeForArtifactReturns(result1 worker.Volume, result2 error) {
+	fake.createChildVolumeForArtifactMutex.Lock()
+	defer fake.createChildVolumeForArtifactMutex.Unlock()
+	fake.CreateChildVolumeForArtifactStub = nil
+	fake.createChildVolumeForArtifactReturns = struct {
+		result1 worker.Volume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVolumeClient) CreateChildVolumeForArtifactReturnsOnCall(i int, result1 worker.Volume, result2 error) {
+	fake.createChildVolumeForArtifactMutex.Lock()
+	defer fake.createChildVolumeForArtifactMutex.Unlock()
+	fake.CreateChildVolumeForArtifactStub = nil
+	if fake.createChildVolumeForArtifactReturnsOnCall == nil {
+		fake.createChildVolumeForArtifactReturnsOnCall = make(map[int]struct {
+			result1 worker.Volume
+			result2 error
+		})
+	}
+	fake.createChildVolumeForArtifactReturnsOnCall[i] = struct {
+		result1 worker.Volume
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeVolumeClient) CreateVolume(arg1 lager.Logger, arg2 worker.VolumeSpec, arg3 int, arg4 string, arg5 db.VolumeType) (worker.Volume, error) {
 	fake.createVolumeMutex.Lock()
 	ret, specificReturn := fake.createVolumeReturnsOnCall[len(fake.createVolumeArgsForCall)]
@@ -775,6 +853,8 @@ func (fake *FakeVolumeClient) LookupVolumeReturnsOnCall(i int, result1 worker.Vo
 func (fake *FakeVolumeClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.createChildVolumeForArtifactMutex.RLock()
+	defer fake.createChildVolumeForArtifactMutex.RUnlock()
 	fake.createVolumeMutex.RLock()
 	defer fake.createVolumeMutex.RUnlock()
 	fake.createVolumeForTaskCacheMutex.RLock()
@@ -52,6 +52,20 @@ type FakeWorker struct {
 		result2 bool
 		result3 error
 	}
+	CreateChildVolumeForArtifactStub        func(lager.Logger, worker.Volume) (worker.Volume, error)
+	createChildVolumeForArtifactMutex       sync.RWMutex
+	createChildVolumeForArtifactArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 worker.Volume
+	}
+	createChildVolumeForArtifactReturns struct {
+		result1 worker.Volume
+		result2 error
+	}
+	createChildVolumeForArtifactReturnsOnCall map[int]struct {
+		result1 worker.Volume
+		result2 error
+	}
 	CreateVolumeStub        func(lager.Logger, worker.VolumeSpec, int, db.VolumeType) (worker.Volume, error)
 	createVolumeMutex       sync.RWMutex
 	createVolumeArgsForCall []struct {
@@ -456,6 +470,70 @@ func (fake *FakeWorker) CertsVolumeReturnsOnCall(i int, result1 worker.Volume, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeWorker) CreateChildVolumeForArtifact(arg1 lager.Logger, arg2 worker.Volume) (worker.Volume, error) {
+	fake.createChildVolumeForArtifactMutex.Lock()
+	ret, specificReturn := fake.createChildVolumeForArtifactReturnsOnCall[len(fake.createChildVolumeForArtifactArgsForCall)]
+	fake.createChildVolumeForArtifactArgsForCall = append(fake.createChildVolumeForArtifactArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 worker.Volume
+	}{arg1, arg2})
+	fake.recordInvocation("CreateChildVolumeForArtifact", []interface{}{arg1, arg2})
+	fake.createChildVolumeForArtifactMutex.Unlock()
+	if fake.CreateChildVolumeForArtifactStub != nil {
+		return fake.CreateChildVolumeForArtifactStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createChildVolumeForArtifactReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) CreateChildVolumeForArtifactCallCount() int {
+	fake.createChildVolumeForArtifactMutex.RLock()
+	defer fake.createChildVolumeForArtifactMutex.RUnlock()
+	return len(fake.createChildVolumeForArtifactArgsForCall)
+}
+
+func (fake *FakeWorker) CreateChildVolumeForArtifactCalls(stub func(lager.Logger, worker.Volume) (worker.Volume, error)) {
+	fake.createChildVolumeForArtifactMutex.Lock()
+	defer fake.createChildVolumeForArtifactMutex.Unlock()
+	fake.CreateChildVolumeForArtifactStub = stub
+}
+
+func (fake *FakeWorker) CreateChildVolumeForArtifactArgsForCall(i int) (lager.Logger, worker.Volume) {
+	fake.createChildVolumeForArtifactMutex.RLock()
+	defer fake.createChildVolumeForArtifactMutex.RUnlock()
+	argsForCall := fake.createChildVolumeForArtifactArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWorker) CreateChildVolumeForArtifactReturns(result1 worker.Volume, result2 error) {
+	fake.createChildVolumeForArtifactMutex.Lock()
+	defer fake.createChildVolumeForArtifactMutex.Unlock()
+	fake.CreateChildVolumeForArtifactStub = nil
+	fake.createChildVolumeForArtifactReturns = struct {
+		result1 worker.Volume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) CreateChildVolumeForArtifactReturnsOnCall(i int, result1 worker.Volume, result2 error) {
+	fake.createChildVolumeForArtifactMutex.Lock()
+	defer fake.createChildVolumeForArtifactMutex.Unlock()
+	fake.CreateChildVolumeForArtifactStub = nil
+	if fake.createChildVolumeForArtifactReturnsOnCall == nil {
+		fake.createChildVolumeForArtifactReturnsOnCall = make(map[int]struct {
+			result1 worker.Volume
+			result2 error
+		})
+	}
+	fake.createChildVolumeForArtifactReturnsOnCall[i] = struct {
+		result1 worker.Volume
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorker) CreateVolume(arg1 lager.Logger, arg2 worker.VolumeSpec, arg3 int, arg4 db.VolumeType) (worker.Volume, error) {
 	fake.createVolumeMutex.Lock()
 	ret, specificReturn := fake.createVolumeReturnsOnCall[len(fake.createVolumeArgsForCall)]
@@ -1514,6 +1592,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.buildContainersMutex.RUnlock()
 	fake.certsVolumeMutex.RLock()
 	defer fake.certsVolumeMutex.RUnlock()
+	fake.createChildVolumeForArtifactMutex.RLock()
+	defer fake.createChildVolumeForArtifactMutex.RUnlock()
 	fake.createVolumeMutex.RLock()
 	defer fake.createVolumeMutex.RUnlock()
 	fake.decreaseActiveTasksMutex.RLock()
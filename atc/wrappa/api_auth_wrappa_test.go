@@ -154,7 +154,9 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetBuildPlan:        checksIfPrivateJob(inputHandlers[atc.GetBuildPlan]),
 
 				// resource belongs to authorized team
-				atc.AbortBuild: checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
+				atc.AbortBuild:   checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
+				atc.ApproveBuild: checkWritePermissionForBuild(inputHandlers[atc.ApproveBuild]),
+				atc.RejectBuild:  checkWritePermissionForBuild(inputHandlers[atc.RejectBuild]),
 
 				// resource belongs to authorized team
 				atc.PruneWorker:              checkTeamAccessForWorker(inputHandlers[atc.PruneWorker]),
@@ -173,6 +175,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ListJobs:                      openForPublicPipelineOrAuthorized(inputHandlers[atc.ListJobs]),
 				atc.GetJob:                        openForPublicPipelineOrAuthorized(inputHandlers[atc.GetJob]),
 				atc.ListJobBuilds:                 openForPublicPipelineOrAuthorized(inputHandlers[atc.ListJobBuilds]),
+				atc.ListJobTaskResourceUsage:      openForPublicPipelineOrAuthorized(inputHandlers[atc.ListJobTaskResourceUsage]),
 				atc.ListPipelineBuilds:            openForPublicPipelineOrAuthorized(inputHandlers[atc.ListPipelineBuilds]),
 				atc.GetResource:                   openForPublicPipelineOrAuthorized(inputHandlers[atc.GetResource]),
 				atc.ListBuildsWithVersionAsInput:  openForPublicPipelineOrAuthorized(inputHandlers[atc.ListBuildsWithVersionAsInput]),
@@ -189,6 +192,8 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.HijackContainer: authenticated(inputHandlers[atc.HijackContainer]),
 				atc.ListContainers:  authenticated(inputHandlers[atc.ListContainers]),
 				atc.ListVolumes:     authenticated(inputHandlers[atc.ListVolumes]),
+				atc.ListLocks:       authenticated(inputHandlers[atc.ListLocks]),
+				atc.ListTeamQueue:   authenticated(inputHandlers[atc.ListTeamQueue]),
 				atc.ListTeamBuilds:  authenticated(inputHandlers[atc.ListTeamBuilds]),
 				atc.ListWorkers:     authenticated(inputHandlers[atc.ListWorkers]),
 				atc.RegisterWorker:  authenticated(inputHandlers[atc.RegisterWorker]),
@@ -217,34 +222,47 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.SetLogLevel:          authenticatedAndAdmin(inputHandlers[atc.SetLogLevel]),
 				atc.GetInfoCreds:         authenticatedAndAdmin(inputHandlers[atc.GetInfoCreds]),
 				atc.ListActiveUsersSince: authenticatedAndAdmin(inputHandlers[atc.ListActiveUsersSince]),
+				atc.ReleaseLock:          authenticatedAndAdmin(inputHandlers[atc.ReleaseLock]),
 
 				// authorized (requested team matches resource team)
-				atc.CheckResource:           authorized(inputHandlers[atc.CheckResource]),
-				atc.CheckResourceType:       authorized(inputHandlers[atc.CheckResourceType]),
-				atc.CreateJobBuild:          authorized(inputHandlers[atc.CreateJobBuild]),
-				atc.DeletePipeline:          authorized(inputHandlers[atc.DeletePipeline]),
-				atc.DisableResourceVersion:  authorized(inputHandlers[atc.DisableResourceVersion]),
-				atc.EnableResourceVersion:   authorized(inputHandlers[atc.EnableResourceVersion]),
-				atc.PinResourceVersion:      authorized(inputHandlers[atc.PinResourceVersion]),
-				atc.UnpinResource:           authorized(inputHandlers[atc.UnpinResource]),
-				atc.SetPinCommentOnResource: authorized(inputHandlers[atc.SetPinCommentOnResource]),
-				atc.GetConfig:               authorized(inputHandlers[atc.GetConfig]),
-				atc.GetCC:                   authorized(inputHandlers[atc.GetCC]),
-				atc.GetVersionsDB:           authorized(inputHandlers[atc.GetVersionsDB]),
-				atc.ListJobInputs:           authorized(inputHandlers[atc.ListJobInputs]),
-				atc.OrderPipelines:          authorized(inputHandlers[atc.OrderPipelines]),
-				atc.PauseJob:                authorized(inputHandlers[atc.PauseJob]),
-				atc.PausePipeline:           authorized(inputHandlers[atc.PausePipeline]),
-				atc.RenamePipeline:          authorized(inputHandlers[atc.RenamePipeline]),
-				atc.SaveConfig:              authorized(inputHandlers[atc.SaveConfig]),
-				atc.UnpauseJob:              authorized(inputHandlers[atc.UnpauseJob]),
-				atc.UnpausePipeline:         authorized(inputHandlers[atc.UnpausePipeline]),
-				atc.ExposePipeline:          authorized(inputHandlers[atc.ExposePipeline]),
-				atc.HidePipeline:            authorized(inputHandlers[atc.HidePipeline]),
-				atc.CreatePipelineBuild:     authorized(inputHandlers[atc.CreatePipelineBuild]),
-				atc.ClearTaskCache:          authorized(inputHandlers[atc.ClearTaskCache]),
-				atc.CreateArtifact:          authorized(inputHandlers[atc.CreateArtifact]),
-				atc.GetArtifact:             authorized(inputHandlers[atc.GetArtifact]),
+				atc.CheckResource:              authorized(inputHandlers[atc.CheckResource]),
+				atc.CheckResourceType:          authorized(inputHandlers[atc.CheckResourceType]),
+				atc.CreateJobBuild:             authorized(inputHandlers[atc.CreateJobBuild]),
+				atc.DeletePipeline:             authorized(inputHandlers[atc.DeletePipeline]),
+				atc.DisableResourceVersion:     authorized(inputHandlers[atc.DisableResourceVersion]),
+				atc.EnableResourceVersion:      authorized(inputHandlers[atc.EnableResourceVersion]),
+				atc.PinResourceVersion:         authorized(inputHandlers[atc.PinResourceVersion]),
+				atc.AddResourceVersionLabel:    authorized(inputHandlers[atc.AddResourceVersionLabel]),
+				atc.RemoveResourceVersionLabel: authorized(inputHandlers[atc.RemoveResourceVersionLabel]),
+				atc.UnpinResource:              authorized(inputHandlers[atc.UnpinResource]),
+				atc.SetPinCommentOnResource:    authorized(inputHandlers[atc.SetPinCommentOnResource]),
+				atc.GetConfig:                  authorized(inputHandlers[atc.GetConfig]),
+				atc.GetCC:                      authorized(inputHandlers[atc.GetCC]),
+				atc.GetVersionsDB:              authorized(inputHandlers[atc.GetVersionsDB]),
+				atc.ListJobInputs:              authorized(inputHandlers[atc.ListJobInputs]),
+				atc.ExplainJobInputs:           authorized(inputHandlers[atc.ExplainJobInputs]),
+				atc.OrderPipelines:             authorized(inputHandlers[atc.OrderPipelines]),
+				atc.PauseJob:                   authorized(inputHandlers[atc.PauseJob]),
+				atc.PausePipeline:              authorized(inputHandlers[atc.PausePipeline]),
+				atc.RenamePipeline:             authorized(inputHandlers[atc.RenamePipeline]),
+				atc.SaveConfig:                 authorized(inputHandlers[atc.SaveConfig]),
+				atc.DryRunConfig:               authorized(inputHandlers[atc.DryRunConfig]),
+				atc.UnpauseJob:                 authorized(inputHandlers[atc.UnpauseJob]),
+				atc.UnpausePipeline:            authorized(inputHandlers[atc.UnpausePipeline]),
+				atc.FreezePipeline:             authorized(inputHandlers[atc.FreezePipeline]),
+				atc.UnfreezePipeline:           authorized(inputHandlers[atc.UnfreezePipeline]),
+				atc.ExposePipeline:             authorized(inputHandlers[atc.ExposePipeline]),
+				atc.HidePipeline:               authorized(inputHandlers[atc.HidePipeline]),
+				atc.CreatePipelineBuild:        authorized(inputHandlers[atc.CreatePipelineBuild]),
+				atc.ClearTaskCache:             authorized(inputHandlers[atc.ClearTaskCache]),
+				atc.CreateArtifact:             authorized(inputHandlers[atc.CreateArtifact]),
+				atc.GetArtifact:                authorized(inputHandlers[atc.GetArtifact]),
+				atc.DiffArtifact:               authorized(inputHandlers[atc.DiffArtifact]),
+				atc.ListServiceAccounts:        authorized(inputHandlers[atc.ListServiceAccounts]),
+				atc.CreateServiceAccount:       authorized(inputHandlers[atc.CreateServiceAccount]),
+				atc.DestroyServiceAccount:      authorized(inputHandlers[atc.DestroyServiceAccount]),
+				atc.CreateServiceAccountToken:  authorized(inputHandlers[atc.CreateServiceAccountToken]),
+				atc.RevokeServiceAccountToken:  authorized(inputHandlers[atc.RevokeServiceAccountToken]),
 			}
 		})
 
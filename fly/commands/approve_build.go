@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type ApproveBuildCommand struct {
+	Job     flaghelpers.JobFlag `short:"j" long:"job" value-name:"PIPELINE/JOB"   description:"Name of a job whose build to approve"`
+	Build   string              `short:"b" long:"build" required:"true" description:"If job is specified: build number to approve. If job not specified: build id"`
+	Reject  bool                `long:"reject" description:"Reject the build instead of approving it, cancelling it"`
+	Comment string              `short:"m" long:"comment" description:"Comment to record with the decision"`
+}
+
+func (command *ApproveBuildCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var build atc.Build
+	var exists bool
+	if command.Job.PipelineName == "" && command.Job.JobName == "" {
+		build, exists, err = target.Client().Build(command.Build)
+	} else {
+		build, exists, err = target.Team().JobBuild(command.Job.PipelineName, command.Job.JobName, command.Build)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("build does not exist")
+	}
+
+	var decided bool
+	if command.Reject {
+		decided, err = target.Client().RejectBuild(strconv.Itoa(build.ID), command.Comment)
+	} else {
+		decided, err = target.Client().ApproveBuild(strconv.Itoa(build.ID), command.Comment)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !decided {
+		return fmt.Errorf("build is not awaiting approval")
+	}
+
+	if command.Reject {
+		fmt.Println("build rejected")
+	} else {
+		fmt.Println("build approved")
+	}
+
+	return nil
+}
@@ -173,13 +173,8 @@ func (command *BuildsCommand) validatePipelineBuilds(builds []atc.Build, current
 }
 
 func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
-	var err error
-	if command.Json {
-		err = displayhelpers.JsonPrint(builds)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := outputFormat(command.Json); !format.IsTable() {
+		return displayhelpers.PrintFormatted(os.Stdout, format, builds)
 	}
 
 	table := ui.Table{
@@ -192,6 +187,8 @@ func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
 			{Contents: "end", Color: color.New(color.Bold)},
 			{Contents: "duration", Color: color.New(color.Bold)},
 			{Contents: "team", Color: color.New(color.Bold)},
+			{Contents: "created by", Color: color.New(color.Bold)},
+			{Contents: "trigger", Color: color.New(color.Bold)},
 		},
 	}
 
@@ -228,6 +225,20 @@ func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
 			statusCell.Color = ui.PausedColor
 		}
 
+		if b.DebugUntil != 0 {
+			statusCell.Contents += " (debuggable)"
+		}
+
+		createdByCell := ui.TableCell{Contents: b.CreatedBy}
+		if b.CreatedBy == "" {
+			createdByCell.Contents = "n/a"
+		}
+
+		triggerCell := ui.TableCell{Contents: "n/a"}
+		if b.Trigger != nil {
+			triggerCell.Contents = presentBuildTrigger(*b.Trigger)
+		}
+
 		table.Data = append(table.Data, []ui.TableCell{
 			{Contents: strconv.Itoa(b.ID)},
 			pipelineJobCell,
@@ -237,10 +248,24 @@ func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
 			endTimeCell,
 			durationCell,
 			{Contents: b.TeamName},
+			createdByCell,
+			triggerCell,
 		})
 	}
 
-	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+	return displayhelpers.RenderTable(os.Stdout, table, tableOptions())
+}
+
+func presentBuildTrigger(trigger atc.BuildTrigger) string {
+	if trigger.Type != atc.BuildTriggerTypeResource {
+		return string(trigger.Type)
+	}
+
+	if len(trigger.Version) == 0 {
+		return trigger.Input
+	}
+
+	return trigger.Input + " " + ui.PresentVersion(trigger.Version)
 }
 
 func (command *BuildsCommand) validateBuildArguments(timeSince time.Time, page concourse.Page, timeUntil time.Time) (concourse.Page, error) {
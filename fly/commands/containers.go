@@ -31,12 +31,8 @@ func (command *ContainersCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(containers)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := outputFormat(command.Json); !format.IsTable() {
+		return displayhelpers.PrintFormatted(os.Stdout, format, containers)
 	}
 
 	table := ui.Table{
@@ -71,7 +67,7 @@ func (command *ContainersCommand) Execute([]string) error {
 
 	sort.Sort(table.Data)
 
-	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+	return displayhelpers.RenderTable(os.Stdout, table, tableOptions())
 }
 
 func buildIDOrNone(id int) ui.TableCell {
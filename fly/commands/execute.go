@@ -1,10 +1,12 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strconv"
 	"syscall"
 	"time"
@@ -13,6 +15,7 @@ import (
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/executehelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/localexec"
 	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
 	"github.com/concourse/concourse/fly/config"
 	"github.com/concourse/concourse/fly/eventstream"
@@ -36,9 +39,14 @@ type ExecuteCommand struct {
 	Var            []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       value-name:"[NAME=STRING]"  description:"Specify a string value to set for a variable in the pipeline"`
 	YAMLVar        []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the pipeline"`
 	VarsFrom       []atc.PathFlag                     `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
+	Local          bool                               `          long:"local"                                 description:"Run the task on this machine instead of on the target"`
 }
 
 func (command *ExecuteCommand) Execute(args []string) error {
+	if command.Local {
+		return command.executeLocally(args)
+	}
+
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
 		return err
@@ -181,6 +189,55 @@ func (command *ExecuteCommand) Execute(args []string) error {
 	return nil
 }
 
+func (command *ExecuteCommand) executeLocally(args []string) error {
+	if command.InputsFrom.PipelineName != "" || command.Image != "" {
+		return errors.New("--inputs-from and --image cannot be used with --local")
+	}
+
+	taskConfig, err := command.CreateTaskConfig(args)
+	if err != nil {
+		return err
+	}
+
+	inputs, err := executehelpers.DetermineLocalInputs(taskConfig.Inputs, command.Inputs)
+	if err != nil {
+		return err
+	}
+
+	outputs, err := executehelpers.DetermineOutputs(
+		atc.NewPlanFactory(time.Now().Unix()),
+		taskConfig.Outputs,
+		command.Outputs,
+	)
+	if err != nil {
+		return err
+	}
+
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return err
+	}
+
+	task := localexec.Task{
+		Config:         taskConfig,
+		Inputs:         inputs,
+		Outputs:        outputs,
+		IncludeIgnored: command.IncludeIgnored,
+		CacheDir:       filepath.Join(cacheDir, "fly", "images"),
+		Stdout:         os.Stdout,
+		Stderr:         ui.Stderr,
+	}
+
+	exitCode, err := task.Run()
+	if err != nil {
+		return err
+	}
+
+	os.Exit(exitCode)
+
+	return nil
+}
+
 func (command *ExecuteCommand) CreateTaskConfig(args []string) (atc.TaskConfig, error) {
 
 	taskTemplate := templatehelpers.NewYamlTemplateWithParams(
@@ -0,0 +1,139 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type ExplainJobCommand struct {
+	Job  flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Job to explain the input resolution of"`
+	Json bool                `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *ExplainJobCommand) Execute([]string) error {
+	err := rejectTableOptions("explain-job")
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	explanation, found, err := target.Team().ExplainJobInputs(command.Job.PipelineName, command.Job.JobName)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("job '%s' not found", command.Job.JobName)
+	}
+
+	if format := outputFormat(command.Json); !format.IsTable() {
+		return displayhelpers.PrintFormatted(os.Stdout, format, explanation)
+	}
+
+	return renderExplanation(os.Stdout, command.Job.JobName, explanation)
+}
+
+func renderExplanation(dst io.Writer, jobName string, explanation atc.JobInputsExplanation) error {
+	status := ui.SucceededColor.Sprint("resolved")
+	if !explanation.Resolved {
+		status = ui.FailedColor.Sprint("unresolved")
+	}
+
+	fmt.Fprintf(dst, "%s: %s\n", jobName, status)
+
+	for i, input := range explanation.Inputs {
+		branch, indent := "├── ", "│   "
+		if i == len(explanation.Inputs)-1 {
+			branch, indent = "└── ", "    "
+		}
+
+		details := []string{"resource: " + input.Resource}
+		if len(input.Passed) > 0 {
+			details = append(details, "passed: "+strings.Join(input.Passed, ", "))
+		}
+
+		if len(input.PassedAny) > 0 {
+			details = append(details, "passed any: "+strings.Join(input.PassedAny, ", "))
+		}
+
+		if len(input.Labeled) > 0 {
+			details = append(details, "labeled: "+strings.Join(input.Labeled, ", "))
+		}
+
+		if input.Pinned {
+			details = append(details, "pinned")
+		}
+
+		fmt.Fprintf(dst, "%s%s (%s)\n", branch, color.New(color.Bold).Sprint(input.Name), strings.Join(details, "; "))
+
+		if len(input.Candidates) == 0 {
+			fmt.Fprintf(dst, "%s└── %s\n", indent, ui.OffColor.Sprint("no versions"))
+			continue
+		}
+
+		for j, candidate := range input.Candidates {
+			candidateBranch := "├── "
+			if j == len(input.Candidates)-1 {
+				candidateBranch = "└── "
+			}
+
+			version := ui.PresentVersion(candidate.Version)
+
+			var note string
+			switch {
+			case len(candidate.EliminatedBy) > 0:
+				version = ui.OffColor.Sprint(version)
+				note = ui.FailedColor.Sprint("not passed by " + strings.Join(candidate.EliminatedBy, ", "))
+			case explanation.Resolved && candidate.Version != nil && versionsEqual(candidate.Version, input.Version):
+				note = ui.SucceededColor.Sprint("chosen")
+			default:
+				note = ui.OffColor.Sprint("candidate")
+			}
+
+			fmt.Fprintf(dst, "%s%s%s  %s\n", indent, candidateBranch, version, note)
+		}
+	}
+
+	if len(explanation.Conflicts) > 0 {
+		fmt.Fprintln(dst, "")
+		fmt.Fprintln(dst, ui.FailedColor.Sprint("conflicts:"))
+
+		for _, conflict := range explanation.Conflicts {
+			fmt.Fprintf(dst, "  no build of '%s' used a candidate version of each of: %s\n", conflict.Job, strings.Join(conflict.Inputs, ", "))
+		}
+	}
+
+	return nil
+}
+
+func versionsEqual(a, b atc.Version) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, v := range a {
+		if b[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/teamarchive"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+)
+
+type ExportTeamCommand struct {
+	Team   flaghelpers.TeamFlag `short:"n" long:"team-name" description:"The team to export. Defaults to the target's team"`
+	Output string               `short:"o" long:"output" required:"true" value-name:"PATH" description:"File to write the archive to, or '-' for stdout"`
+}
+
+func (command *ExportTeamCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team := target.Team()
+	if command.Team != "" {
+		team = target.Client().Team(command.Team.Name())
+	}
+
+	archive, err := teamarchive.Export(team)
+	if err != nil {
+		return err
+	}
+
+	var dst io.Writer = os.Stdout
+	if command.Output != "-" {
+		file, err := os.Create(command.Output)
+		if err != nil {
+			return err
+		}
+
+		defer file.Close()
+
+		dst = file
+	}
+
+	err = teamarchive.Write(dst, archive)
+	if err != nil {
+		return err
+	}
+
+	if command.Output != "-" {
+		fmt.Fprintf(ui.Stderr, "exported team %s with %d pipelines to %s\n", ui.Embolden("%s", team.Name()), len(archive.Pipelines), command.Output)
+	}
+
+	return nil
+}
@@ -1,6 +1,9 @@
 package commands
 
-import "github.com/concourse/concourse/fly/rc"
+import (
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+)
 
 type FlyCommand struct {
 	Help HelpCommand `command:"help" description:"Print this help message"`
@@ -16,6 +19,10 @@ type FlyCommand struct {
 
 	PrintTableHeaders bool `long:"print-table-headers" description:"Print table headers even for redirected output"`
 
+	Format  ui.OutputFormat `long:"format"  description:"Output format of list and get commands: table, json, yaml, go-template=TEMPLATE, or jsonpath=EXPRESSION"`
+	Columns string          `long:"columns" description:"Comma-separated list of table columns to print, in order"`
+	SortBy  string          `long:"sort-by" description:"Table column to sort rows by; prefix with '-' to sort in descending order, e.g. --sort-by=-id"`
+
 	Login  LoginCommand  `command:"login" alias:"l" description:"Authenticate with the target"`
 	Logout LogoutCommand `command:"logout" alias:"o" description:"Release authentication with the target"`
 	Status StatusCommand `command:"status" description:"Login status"`
@@ -29,6 +36,8 @@ type FlyCommand struct {
 	SetTeam     SetTeamCommand     `command:"set-team"  alias:"st" description:"Create or modify a team to have the given credentials"`
 	RenameTeam  RenameTeamCommand  `command:"rename-team"   alias:"rt" description:"Rename a team"`
 	DestroyTeam DestroyTeamCommand `command:"destroy-team"  alias:"dt" description:"Destroy a team and delete all of its data"`
+	ExportTeam  ExportTeamCommand  `command:"export-team"   alias:"xt" description:"Write an archive of a team's auth, pipelines and their state"`
+	ImportTeam  ImportTeamCommand  `command:"import-team"   alias:"it" description:"Apply an archive written by export-team to a team"`
 
 	Checklist ChecklistCommand `command:"checklist" alias:"cl" description:"Print a Checkfile of the given pipeline"`
 
@@ -60,22 +69,44 @@ type FlyCommand struct {
 	CheckResource    CheckResourceCommand    `command:"check-resource"          alias:"cr"   description:"Check a resource"`
 	PinResource      PinResourceCommand      `command:"pin-resource"    alias:"pr"  description:"Pin a version to a resource"`
 	UnpinResource    UnpinResourceCommand    `command:"unpin-resource"          alias:"ur"  description:"Unpin a resource"`
+	PinPipeline      PinPipelineCommand      `command:"pin-pipeline"            alias:"ppl" description:"Pin every resource of a pipeline to the versions used by a build"`
+	UnpinPipeline    UnpinPipelineCommand    `command:"unpin-pipeline"          alias:"upl" description:"Restore the pins a pipeline had before pin-pipeline"`
+	LabelVersion     LabelVersionCommand     `command:"label-version"           alias:"lv"  description:"Add a label to a version of a resource"`
+	UnlabelVersion   UnlabelVersionCommand   `command:"unlabel-version"         alias:"ulv" description:"Remove a label from a version of a resource"`
 
 	CheckResourceType CheckResourceTypeCommand `command:"check-resource-type" alias:"crt"  description:"Check a resource-type"`
 
 	ClearTaskCache ClearTaskCacheCommand `command:"clear-task-cache" alias:"ctc" description:"Clears cache from a task container"`
 
-	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
-	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
+	TaskResourceUsage TaskResourceUsageCommand `command:"task-resource-usage" alias:"tru" description:"List the resources used by a job's tasks"`
+
+	ExplainJob ExplainJobCommand `command:"explain-job" alias:"ej" description:"Explain how the inputs of a job's next build are resolved"`
+
+	Builds       BuildsCommand       `command:"builds"        alias:"bs" description:"List builds data"`
+	AbortBuild   AbortBuildCommand   `command:"abort-build"   alias:"ab" description:"Abort a build"`
+	ApproveBuild ApproveBuildCommand `command:"approve-build" alias:"apb" description:"Approve or reject a build awaiting approval"`
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
 
 	Volumes VolumesCommand `command:"volumes" alias:"vs" description:"List the active volumes"`
 
+	Locks       LocksCommand       `command:"locks"        alias:"lks" description:"List the team's named locks with their holders and waiters"`
+	ReleaseLock ReleaseLockCommand `command:"release-lock" alias:"rl"  description:"Forcibly release a named lock (admin only)"`
+
+	Queue QueueCommand `command:"queue" alias:"q" description:"List the team's pending builds in the order they will be started"`
+
+	ServiceAccounts       ServiceAccountsCommand       `command:"service-accounts"        alias:"sas" description:"List the team's service accounts and their tokens"`
+	CreateServiceAccount  CreateServiceAccountCommand  `command:"create-service-account"  alias:"csa" description:"Create a service account for automation"`
+	DestroyServiceAccount DestroyServiceAccountCommand `command:"destroy-service-account" alias:"dsa" description:"Destroy a service account and revoke its tokens"`
+	CreateToken           CreateTokenCommand           `command:"create-token"            alias:"ct"  description:"Create a long-lived API token for a service account"`
+	RevokeToken           RevokeTokenCommand           `command:"revoke-token"            alias:"rvt" description:"Revoke a service account's API token"`
+
 	Workers     WorkersCommand     `command:"workers" alias:"ws" description:"List the registered workers"`
 	LandWorker  LandWorkerCommand  `command:"land-worker" alias:"lw" description:"Land a worker"`
 	PruneWorker PruneWorkerCommand `command:"prune-worker" alias:"pw" description:"Prune a stalled, landing, landed, or retiring worker"`
 
+	Top TopCommand `command:"top" description:"Show a live dashboard of pipelines, builds and workers"`
+
 	Curl CurlCommand `command:"curl" alias:"c" description:"curl the api"`
 
 	Completion CompletionCommand `command:"completion" description:"generate shell completion code"`
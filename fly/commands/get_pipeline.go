@@ -31,6 +31,11 @@ func (command *GetPipelineCommand) Execute(args []string) error {
 		return err
 	}
 
+	err = rejectTableOptions("get-pipeline")
+	if err != nil {
+		return err
+	}
+
 	asJSON := command.JSON
 	pipelineName := string(command.Pipeline)
 
@@ -53,6 +58,11 @@ func (command *GetPipelineCommand) Execute(args []string) error {
 		return errors.New("pipeline not found")
 	}
 
+	// the config is printed as YAML unless another format is asked for
+	if !asJSON && !Fly.Format.IsTable() {
+		return displayhelpers.PrintFormatted(os.Stdout, Fly.Format, config)
+	}
+
 	return dump(config, asJSON)
 }
 
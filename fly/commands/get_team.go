@@ -39,11 +39,8 @@ func (command *GetTeamCommand) Execute(args []string) error {
 		return errors.New("team not found")
 	}
 
-	if command.JSON {
-		if err := displayhelpers.JsonPrint(team); err != nil {
-			return err
-		}
-		return nil
+	if format := outputFormat(command.JSON); !format.IsTable() {
+		return displayhelpers.PrintFormatted(os.Stdout, format, team)
 	}
 
 	headers := ui.TableRow{
@@ -82,5 +79,5 @@ func (command *GetTeamCommand) Execute(args []string) error {
 		table.Data = append(table.Data, row)
 	}
 	sort.Sort(table.Data)
-	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+	return displayhelpers.RenderTable(os.Stdout, table, tableOptions())
 }
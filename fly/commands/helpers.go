@@ -4,8 +4,11 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/ui"
 	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
@@ -80,3 +83,136 @@ func SliceItoa(slice []int) string {
 	}
 	return strSlice
 }
+
+// VersionFilter builds a version filter from the fields, given as
+// "name:pattern", and semver range passed on the command line. It returns
+// nil if neither were given.
+func VersionFilter(fields []string, semver string, semverField string) (*atc.VersionFilter, error) {
+	if len(fields) == 0 && semver == "" {
+		if semverField != "" {
+			return nil, errors.New("--semver-field requires --semver")
+		}
+
+		return nil, nil
+	}
+
+	filter := &atc.VersionFilter{
+		Semver:      semver,
+		SemverField: semverField,
+	}
+
+	if len(fields) > 0 {
+		filter.Fields = map[string]string{}
+		for _, field := range fields {
+			kv := strings.SplitN(field, ":", 2)
+			if len(kv) != 2 {
+				return nil, fmt.Errorf("invalid filter '%s': expected NAME:PATTERN", field)
+			}
+
+			filter.Fields[kv[0]] = kv[1]
+		}
+	}
+
+	err := filter.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+// FilteredResourceVersions returns up to limit of the newest versions of a
+// resource which match the filter. Older servers which do not filter versions
+// themselves are paged through instead.
+func FilteredResourceVersions(team concourse.Team, pipelineName string, resourceName string, filter atc.VersionFilter, limit int) ([]atc.ResourceVersion, bool, error) {
+	matcher, err := filter.Matcher()
+	if err != nil {
+		return nil, false, err
+	}
+
+	versions, found, err := team.FilteredResourceVersions(pipelineName, resourceName, filter, limit)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	filtered := true
+	for _, version := range versions {
+		if !matcher.Matches(version.Version, version.Metadata) {
+			filtered = false
+			break
+		}
+	}
+
+	if filtered {
+		return versions, true, nil
+	}
+
+	matching := []atc.ResourceVersion{}
+
+	page := &concourse.Page{Limit: 100}
+	for page != nil {
+		versions, pagination, found, err := team.ResourceVersions(pipelineName, resourceName, *page, atc.Version{})
+		if err != nil {
+			return nil, false, err
+		}
+
+		if !found {
+			return nil, false, nil
+		}
+
+		for _, version := range versions {
+			if !matcher.Matches(version.Version, version.Metadata) {
+				continue
+			}
+
+			matching = append(matching, version)
+
+			if len(matching) == limit {
+				return matching, true, nil
+			}
+		}
+
+		page = pagination.Next
+	}
+
+	return matching, true, nil
+}
+
+// outputFormat returns the format requested with the global --format flag.
+// A command's own --json flag takes precedence.
+func outputFormat(json bool) ui.OutputFormat {
+	if json {
+		return ui.OutputFormat{Kind: ui.FormatJSON}
+	}
+
+	return Fly.Format
+}
+
+// rejectTableOptions fails commands which do not print a table when the global
+// --columns or --sort-by flags are given, rather than silently ignoring them.
+func rejectTableOptions(command string) error {
+	if Fly.Columns != "" || Fly.SortBy != "" {
+		return fmt.Errorf("%s does not print a table, so --columns and --sort-by are not supported", command)
+	}
+
+	return nil
+}
+
+func tableOptions() displayhelpers.TableOptions {
+	options := displayhelpers.TableOptions{
+		SortBy:       Fly.SortBy,
+		PrintHeaders: Fly.PrintTableHeaders,
+	}
+
+	for _, column := range strings.Split(Fly.Columns, ",") {
+		if strings.TrimSpace(column) != "" {
+			options.Columns = append(options.Columns, column)
+		}
+	}
+
+	return options
+}
@@ -90,6 +90,10 @@ func (command *HijackCommand) Execute([]string) error {
 			}
 		}
 
+		if command.Build != "" {
+			hijackableContainers = preferDebugContainers(hijackableContainers)
+		}
+
 		if len(hijackableContainers) == 0 {
 			displayhelpers.Failf("no containers matched your search parameters!\n\nthey may have expired if your build hasn't recently finished.")
 		} else if len(hijackableContainers) > 1 {
@@ -115,7 +119,7 @@ func (command *HijackCommand) Execute([]string) error {
 
 				infos = append(infos, fmt.Sprintf("type: %s", container.Type))
 
-				if container.Type == "check" {
+				if container.Type == "check" || container.DebugUntil != 0 {
 					infos = append(infos, fmt.Sprintf("expires in: %s", container.ExpiresIn))
 				}
 
@@ -308,6 +312,23 @@ func (command *HijackCommand) getContainerIDs(target rc.Target, fingerprint *con
 	return containers, nil
 }
 
+// preferDebugContainers narrows the containers down to the ones kept around
+// for debugging a failed task, if there are any.
+func preferDebugContainers(containers []atc.Container) []atc.Container {
+	var debugContainers []atc.Container
+	for _, container := range containers {
+		if container.DebugUntil != 0 {
+			debugContainers = append(debugContainers, container)
+		}
+	}
+
+	if len(debugContainers) == 0 {
+		return containers
+	}
+
+	return debugContainers
+}
+
 func remoteCommand(argv []string) (string, []string) {
 	var path string
 	var args []string
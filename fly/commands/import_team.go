@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/vito/go-interact/interact"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/teamarchive"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+)
+
+type ImportTeamCommand struct {
+	Input string               `short:"i" long:"input" required:"true" value-name:"PATH" description:"Archive written by export-team, or '-' for stdin"`
+	Team  flaghelpers.TeamFlag `short:"n" long:"team-name" description:"The team to import into. Defaults to the team in the archive"`
+
+	DryRun           bool `long:"dry-run" description:"Show the changes without making them"`
+	SkipInteractive  bool `long:"non-interactive" description:"Make the changes without confirmation"`
+	CheckCredentials bool `long:"check-creds" description:"Validate credential variables against credential manager"`
+}
+
+func (command *ImportTeamCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var src io.Reader = os.Stdin
+	if command.Input != "-" {
+		file, err := os.Open(command.Input)
+		if err != nil {
+			return err
+		}
+
+		defer file.Close()
+
+		src = file
+	}
+
+	archive, err := teamarchive.Read(src)
+	if err != nil {
+		return err
+	}
+
+	teamName := archive.Team.Name
+	if command.Team != "" {
+		teamName = command.Team.Name()
+	}
+
+	stdout, _ := ui.ForTTY(os.Stdout)
+
+	importer := &teamarchive.Importer{
+		Team:             target.Client().Team(teamName),
+		Output:           stdout,
+		Diff:             stdout,
+		DryRun:           command.DryRun || !command.SkipInteractive,
+		CheckCredentials: command.CheckCredentials,
+	}
+
+	fmt.Println("importing team:", ui.Embolden("%s", teamName))
+	fmt.Println()
+
+	changed, err := importer.Import(archive)
+	if err != nil {
+		return err
+	}
+
+	if !changed {
+		fmt.Println("no changes to apply")
+		return nil
+	}
+
+	if command.DryRun || command.SkipInteractive {
+		return nil
+	}
+
+	confirm := false
+	err = interact.NewInteraction("\napply changes?").Resolve(&confirm)
+	if err != nil {
+		return err
+	}
+
+	if !confirm {
+		displayhelpers.Failf("bailing out")
+	}
+
+	// the changes have been shown already
+	importer.DryRun = false
+	importer.Diff = ioutil.Discard
+
+	_, err = importer.Import(archive)
+	return err
+}
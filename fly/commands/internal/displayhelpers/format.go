@@ -0,0 +1,111 @@
+package displayhelpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/concourse/concourse/fly/ui"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/yaml"
+)
+
+// TableOptions configures how a list command's table is rendered.
+type TableOptions struct {
+	Columns      []string
+	SortBy       string
+	PrintHeaders bool
+}
+
+// PrintFormatted prints result to dst in the given format. Templates and
+// JSONPath expressions are evaluated against the result's JSON representation,
+// so they refer to fields by their JSON names.
+func PrintFormatted(dst io.Writer, format ui.OutputFormat, result interface{}) error {
+	switch format.Kind {
+	case ui.FormatJSON:
+		payload, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(dst, string(payload))
+		return err
+
+	case ui.FormatYAML:
+		payload, err := yaml.Marshal(result)
+		if err != nil {
+			return err
+		}
+
+		_, err = dst.Write(payload)
+		return err
+
+	case ui.FormatGoTemplate:
+		tmpl, err := template.New("format").Parse(format.Template)
+		if err != nil {
+			return fmt.Errorf("invalid go-template: %s", err)
+		}
+
+		data, err := jsonData(result)
+		if err != nil {
+			return err
+		}
+
+		return tmpl.Execute(dst, data)
+
+	case ui.FormatJSONPath:
+		expr := format.Template
+		if !strings.Contains(expr, "{") {
+			expr = "{" + expr + "}"
+		}
+
+		path := jsonpath.New("format")
+
+		err := path.Parse(expr)
+		if err != nil {
+			return fmt.Errorf("invalid jsonpath: %s", err)
+		}
+
+		data, err := jsonData(result)
+		if err != nil {
+			return err
+		}
+
+		return path.Execute(dst, data)
+	}
+
+	return fmt.Errorf("format '%s' is not supported by this command", format.Kind)
+}
+
+// RenderTable renders the table to dst, keeping only the selected columns and
+// sorting the rows as configured.
+func RenderTable(dst io.Writer, table ui.Table, options TableOptions) error {
+	table, err := table.Select(options.Columns)
+	if err != nil {
+		return err
+	}
+
+	table, err = table.SortBy(options.SortBy)
+	if err != nil {
+		return err
+	}
+
+	return table.Render(dst, options.PrintHeaders)
+}
+
+func jsonData(result interface{}) (interface{}, error) {
+	payload, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	var data interface{}
+	err = json.Unmarshal(payload, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
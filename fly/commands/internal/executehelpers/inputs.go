@@ -92,6 +92,59 @@ func DetermineInputs(
 	return inputs, inputMappings, imageResourceFromJob, nil
 }
 
+// DetermineLocalInputs resolves the task's inputs to local directories for
+// running the task locally, without uploading anything.
+func DetermineLocalInputs(
+	taskInputs []atc.TaskInputConfig,
+	localInputMappings []flaghelpers.InputPairFlag,
+) ([]Input, error) {
+	err := CheckForUnknownInputMappings(localInputMappings, taskInputs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = CheckForInputType(localInputMappings)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(localInputMappings) == 0 {
+		wd, err := os.Getwd()
+		if err != nil {
+			return nil, err
+		}
+
+		localInputMappings = append(localInputMappings, flaghelpers.InputPairFlag{
+			Name: filepath.Base(wd),
+			Path: ".",
+		})
+	}
+
+	inputsFromLocal := map[string]Input{}
+	for _, mapping := range localInputMappings {
+		inputsFromLocal[mapping.Name] = Input{
+			Name: mapping.Name,
+			Path: mapping.Path,
+		}
+	}
+
+	inputs := []Input{}
+	for _, taskInput := range taskInputs {
+		input, found := inputsFromLocal[taskInput.Name]
+		if !found {
+			if taskInput.Optional {
+				continue
+			}
+
+			return nil, fmt.Errorf("missing required input `%s`", taskInput.Name)
+		}
+
+		inputs = append(inputs, input)
+	}
+
+	return inputs, nil
+}
+
 func ConvertInputMappings(variables []flaghelpers.VariablePairFlag) map[string]string {
 	inputMappings := map[string]string{}
 	for _, flag := range variables {
@@ -0,0 +1,180 @@
+package executehelpers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// BuildManifest describes the given files under dir, recursing into any
+// directories, so that the ATC can tell which of them an earlier artifact
+// already has.
+func BuildManifest(dir string, files []string) (atc.ArtifactManifest, error) {
+	manifest := atc.ArtifactManifest{}
+
+	for _, file := range files {
+		err := filepath.Walk(filepath.Join(dir, file), func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+
+			if rel == "." {
+				return nil
+			}
+
+			entry := atc.ArtifactManifestEntry{Mode: info.Mode()}
+
+			switch {
+			case info.Mode()&os.ModeSymlink != 0:
+				entry.Link, err = os.Readlink(path)
+				if err != nil {
+					return err
+				}
+
+			case info.Mode().IsRegular():
+				entry.Digest, err = fileDigest(path)
+				if err != nil {
+					return err
+				}
+			}
+
+			manifest[filepath.ToSlash(rel)] = entry
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return manifest, nil
+}
+
+func fileDigest(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+
+	defer file.Close()
+
+	hash := sha256.New()
+
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return "", err
+	}
+
+	return "sha256:" + hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// withoutNestedPaths drops the paths which are inside another one of the
+// paths, as archiving a directory already includes its contents.
+func withoutNestedPaths(paths []string) []string {
+	dirs := map[string]bool{}
+	for _, path := range paths {
+		dirs[path] = true
+	}
+
+	var result []string
+	for _, path := range paths {
+		nested := false
+		for parent := filepath.Dir(path); parent != "." && parent != "/"; parent = filepath.Dir(parent) {
+			if dirs[parent] {
+				nested = true
+				break
+			}
+		}
+
+		if !nested {
+			result = append(result, path)
+		}
+	}
+
+	return result
+}
+
+var artifactCacheLock sync.Mutex
+
+// lastArtifact returns the ID of the artifact last uploaded for the same
+// directory and platform, to be used as the base of the next upload.
+func lastArtifact(team string, dir string, platform string) int {
+	artifactCacheLock.Lock()
+	defer artifactCacheLock.Unlock()
+
+	return loadArtifactCache()[artifactCacheKey(team, dir, platform)]
+}
+
+func saveLastArtifact(team string, dir string, platform string, artifactID int) error {
+	artifactCacheLock.Lock()
+	defer artifactCacheLock.Unlock()
+
+	path, err := artifactCachePath()
+	if err != nil {
+		return err
+	}
+
+	cache := loadArtifactCache()
+	cache[artifactCacheKey(team, dir, platform)] = artifactID
+
+	payload, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, payload, 0600)
+}
+
+func loadArtifactCache() map[string]int {
+	cache := map[string]int{}
+
+	path, err := artifactCachePath()
+	if err != nil {
+		return cache
+	}
+
+	payload, err := ioutil.ReadFile(path)
+	if err != nil {
+		return cache
+	}
+
+	_ = json.Unmarshal(payload, &cache)
+
+	return cache
+}
+
+func artifactCachePath() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(cacheDir, "fly", "artifacts.json"), nil
+}
+
+func artifactCacheKey(team string, dir string, platform string) string {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		abs = dir
+	}
+
+	return strings.Join([]string{team, platform, abs}, ":")
+}
@@ -1,6 +1,7 @@
 package executehelpers
 
 import (
+	"archive/tar"
 	"bufio"
 	"bytes"
 	"io"
@@ -13,16 +14,99 @@ import (
 	"github.com/vbauerster/mpb/v4"
 )
 
+// Upload uploads the directory at path as an artifact. Only the files that
+// changed since the last upload of the same directory are sent; the ATC
+// assembles the rest from the previous artifact. The whole directory is sent
+// to servers without incremental uploads, and whenever the server no longer
+// has the previous artifact or has assembled too many artifacts on top of it.
 func Upload(bar *mpb.Bar, team concourse.Team, path string, includeIgnored bool, platform string) (atc.WorkerArtifact, error) {
 	files := getFiles(path, includeIgnored)
 
+	manifest, err := BuildManifest(path, files)
+	if err != nil {
+		return atc.WorkerArtifact{}, err
+	}
+
+	// diffing is done even without a previous upload to find out whether the
+	// server supports incremental uploads at all
+	baseID := lastArtifact(team.Name(), path, platform)
+	missing, found, err := team.DiffArtifact(baseID, manifest)
+	if err == concourse.ErrIncrementalArtifactsUnsupported {
+		return team.CreateArtifact(bar.ProxyReader(archive(path, files)), platform)
+	}
+
+	if err != nil {
+		return atc.WorkerArtifact{}, err
+	}
+
+	if found && baseID != 0 {
+		artifact, found, err := team.CreateIncrementalArtifact(
+			bar.ProxyReader(archive(path, withoutNestedPaths(missing))),
+			platform,
+			baseID,
+			manifest,
+		)
+		if err != nil {
+			return atc.WorkerArtifact{}, err
+		}
+
+		// the base artifact may have expired since diffing against it, in
+		// which case everything is uploaded below
+		if found {
+			return artifact, saveLastArtifact(team.Name(), path, platform, artifact.ID)
+		}
+	}
+
+	artifact, _, err := team.CreateIncrementalArtifact(bar.ProxyReader(archive(path, files)), platform, 0, manifest)
+	if err == concourse.ErrIncrementalArtifactsUnsupported {
+		return team.CreateArtifact(bar.ProxyReader(archive(path, files)), platform)
+	}
+
+	if err != nil {
+		return atc.WorkerArtifact{}, err
+	}
+
+	return artifact, saveLastArtifact(team.Name(), path, platform, artifact.ID)
+}
+
+func archive(path string, files []string) io.Reader {
+	archiveStream, archiveWriter := io.Pipe()
+
+	go func() {
+		zstdWriter := zstd.NewWriter(archiveWriter)
+
+		var err error
+		if len(files) == 0 {
+			err = tar.NewWriter(zstdWriter).Close()
+		} else {
+			err = tarfs.Compress(zstdWriter, path, files...)
+		}
+
+		if err == nil {
+			err = zstdWriter.Close()
+		}
+
+		archiveWriter.CloseWithError(err)
+	}()
+
+	return archiveStream
+}
+
+// Copy copies the directory at path into dest, skipping .gitignored files
+// the same way Upload does unless includeIgnored is set.
+func Copy(path string, dest string, includeIgnored bool) error {
+	files := getFiles(path, includeIgnored)
+
 	archiveStream, archiveWriter := io.Pipe()
 
 	go func() {
-		archiveWriter.CloseWithError(tarfs.Compress(zstd.NewWriter(archiveWriter), path, files...))
+		archiveWriter.CloseWithError(tarfs.Compress(archiveWriter, path, files...))
 	}()
 
-	return team.CreateArtifact(bar.ProxyReader(archiveStream), platform)
+	err := tarfs.Extract(archiveStream, dest)
+	archiveStream.Close()
+
+	return err
 }
 
 func getFiles(dir string, includeIgnored bool) []string {
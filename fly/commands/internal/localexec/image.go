@@ -0,0 +1,442 @@
+package localexec
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+const (
+	mediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	mediaTypeOCIManifest        = "application/vnd.oci.image.manifest.v1+json"
+	mediaTypeOCIIndex           = "application/vnd.oci.image.index.v1+json"
+
+	defaultRegistry = "registry-1.docker.io"
+)
+
+// ErrNoImage is returned when a task config does not specify an image, in
+// which case the task runs directly on the host.
+var ErrNoImage = errors.New("task config does not specify an image")
+
+// ImageRef identifies an image in a registry.
+type ImageRef struct {
+	Registry   string
+	Repository string
+	Tag        string
+
+	Username string
+	Password string
+}
+
+func (ref ImageRef) String() string {
+	return ref.Registry + "/" + ref.Repository + ":" + ref.Tag
+}
+
+// Image is an image whose layers have been fetched into the local cache.
+type Image struct {
+	// Paths to the layer blobs, from the bottom layer up.
+	Layers []string
+
+	// Environment variables configured by the image.
+	Env []string
+}
+
+// ImageRefForTask determines the image to fetch for the task, either from its
+// image_resource (registry-image or docker-image) or from a docker:/// rootfs
+// URI.
+func ImageRefForTask(config atc.TaskConfig) (ImageRef, error) {
+	if config.ImageResource != nil {
+		switch config.ImageResource.Type {
+		case "registry-image", "docker-image":
+		default:
+			return ImageRef{}, fmt.Errorf("image resource type '%s' is not supported when running locally", config.ImageResource.Type)
+		}
+
+		source := config.ImageResource.Source
+
+		repository, _ := source["repository"].(string)
+		if repository == "" {
+			return ImageRef{}, errors.New("image resource is missing a repository")
+		}
+
+		tag, _ := source["tag"].(string)
+		if config.ImageResource.Version != nil && (*config.ImageResource.Version)["digest"] != "" {
+			tag = (*config.ImageResource.Version)["digest"]
+		}
+
+		ref := parseRepository(repository, tag)
+		ref.Username, _ = source["username"].(string)
+		ref.Password, _ = source["password"].(string)
+
+		return ref, nil
+	}
+
+	if config.RootfsURI != "" {
+		uri, err := url.Parse(config.RootfsURI)
+		if err != nil {
+			return ImageRef{}, err
+		}
+
+		if uri.Scheme != "docker" {
+			return ImageRef{}, fmt.Errorf("rootfs_uri scheme '%s' is not supported when running locally", uri.Scheme)
+		}
+
+		repository := strings.TrimPrefix(uri.Path, "/")
+		if uri.Host != "" {
+			repository = uri.Host + "/" + repository
+		}
+
+		return parseRepository(repository, uri.Fragment), nil
+	}
+
+	return ImageRef{}, ErrNoImage
+}
+
+func parseRepository(repository string, tag string) ImageRef {
+	if tag == "" {
+		tag = "latest"
+	}
+
+	registry := defaultRegistry
+
+	segs := strings.SplitN(repository, "/", 2)
+	if len(segs) == 2 && (strings.ContainsAny(segs[0], ".:") || segs[0] == "localhost") {
+		registry = segs[0]
+		repository = segs[1]
+	} else if len(segs) == 1 {
+		repository = "library/" + repository
+	}
+
+	return ImageRef{
+		Registry:   registry,
+		Repository: repository,
+		Tag:        tag,
+	}
+}
+
+// ImageFetcher pulls images from a registry into a local cache directory.
+// Layers are stored by digest, so they are only downloaded once and shared
+// between images.
+type ImageFetcher struct {
+	CacheDir string
+	Client   *http.Client
+	Output   io.Writer
+
+	// Scheme to use when talking to the registry; defaults to https.
+	Scheme string
+
+	token string
+}
+
+type manifest struct {
+	MediaType string       `json:"mediaType"`
+	Config    descriptor   `json:"config"`
+	Layers    []descriptor `json:"layers"`
+	Manifests []descriptor `json:"manifests"`
+}
+
+type descriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Platform  *struct {
+		OS           string `json:"os"`
+		Architecture string `json:"architecture"`
+	} `json:"platform,omitempty"`
+}
+
+type imageConfig struct {
+	Config struct {
+		Env []string `json:"Env"`
+	} `json:"config"`
+}
+
+func (fetcher *ImageFetcher) Fetch(ref ImageRef) (Image, error) {
+	fmt.Fprintf(fetcher.Output, "fetching %s\n", ref)
+
+	m, err := fetcher.fetchManifest(ref, ref.Tag)
+	if err != nil {
+		return Image{}, err
+	}
+
+	if m.MediaType == mediaTypeDockerManifestList || m.MediaType == mediaTypeOCIIndex || len(m.Manifests) > 0 {
+		digest := ""
+		for _, desc := range m.Manifests {
+			if desc.Platform != nil && desc.Platform.OS == "linux" && desc.Platform.Architecture == runtime.GOARCH {
+				digest = desc.Digest
+				break
+			}
+		}
+
+		if digest == "" {
+			return Image{}, fmt.Errorf("no image found for linux/%s in %s", runtime.GOARCH, ref)
+		}
+
+		m, err = fetcher.fetchManifest(ref, digest)
+		if err != nil {
+			return Image{}, err
+		}
+	}
+
+	configPath, err := fetcher.fetchBlob(ref, m.Config.Digest)
+	if err != nil {
+		return Image{}, err
+	}
+
+	configFile, err := os.Open(configPath)
+	if err != nil {
+		return Image{}, err
+	}
+
+	defer configFile.Close()
+
+	var config imageConfig
+	err = json.NewDecoder(configFile).Decode(&config)
+	if err != nil {
+		return Image{}, fmt.Errorf("malformed image config: %s", err)
+	}
+
+	image := Image{Env: config.Config.Env}
+
+	for _, layer := range m.Layers {
+		path, err := fetcher.fetchBlob(ref, layer.Digest)
+		if err != nil {
+			return Image{}, err
+		}
+
+		image.Layers = append(image.Layers, path)
+	}
+
+	return image, nil
+}
+
+func (fetcher *ImageFetcher) fetchManifest(ref ImageRef, reference string) (manifest, error) {
+	req, err := http.NewRequest("GET", fetcher.registryURL(ref, "manifests", reference), nil)
+	if err != nil {
+		return manifest{}, err
+	}
+
+	req.Header.Set("Accept", strings.Join([]string{
+		mediaTypeDockerManifest,
+		mediaTypeDockerManifestList,
+		mediaTypeOCIManifest,
+		mediaTypeOCIIndex,
+	}, ", "))
+
+	resp, err := fetcher.do(ref, req)
+	if err != nil {
+		return manifest{}, err
+	}
+
+	defer resp.Body.Close()
+
+	var m manifest
+	err = json.NewDecoder(resp.Body).Decode(&m)
+	if err != nil {
+		return manifest{}, fmt.Errorf("malformed manifest: %s", err)
+	}
+
+	if m.MediaType == "" {
+		m.MediaType = resp.Header.Get("Content-Type")
+	}
+
+	return m, nil
+}
+
+func (fetcher *ImageFetcher) fetchBlob(ref ImageRef, digest string) (string, error) {
+	segs := strings.SplitN(digest, ":", 2)
+	if len(segs) != 2 || segs[0] != "sha256" {
+		return "", fmt.Errorf("unsupported digest '%s'", digest)
+	}
+
+	blobPath := filepath.Join(fetcher.CacheDir, "blobs", "sha256", segs[1])
+
+	_, err := os.Stat(blobPath)
+	if err == nil {
+		return blobPath, nil
+	}
+
+	err = os.MkdirAll(filepath.Dir(blobPath), 0755)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest("GET", fetcher.registryURL(ref, "blobs", digest), nil)
+	if err != nil {
+		return "", err
+	}
+
+	fmt.Fprintf(fetcher.Output, "downloading %s\n", digest)
+
+	resp, err := fetcher.do(ref, req)
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close()
+
+	tmp, err := ioutil.TempFile(filepath.Dir(blobPath), "download")
+	if err != nil {
+		return "", err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	hash := sha256.New()
+
+	_, err = io.Copy(io.MultiWriter(tmp, hash), resp.Body)
+	if err != nil {
+		tmp.Close()
+		return "", err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return "", err
+	}
+
+	if hex.EncodeToString(hash.Sum(nil)) != segs[1] {
+		return "", fmt.Errorf("digest mismatch for %s", digest)
+	}
+
+	err = os.Rename(tmp.Name(), blobPath)
+	if err != nil {
+		return "", err
+	}
+
+	return blobPath, nil
+}
+
+func (fetcher *ImageFetcher) registryURL(ref ImageRef, kind string, reference string) string {
+	scheme := fetcher.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+
+	return fmt.Sprintf("%s://%s/v2/%s/%s/%s", scheme, ref.Registry, ref.Repository, kind, reference)
+}
+
+func (fetcher *ImageFetcher) do(ref ImageRef, req *http.Request) (*http.Response, error) {
+	client := fetcher.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	if fetcher.token != "" {
+		req.Header.Set("Authorization", "Bearer "+fetcher.token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && fetcher.token == "" {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+
+		fetcher.token, err = fetcher.authenticate(ref, challenge)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Authorization", "Bearer "+fetcher.token)
+
+		resp, err = client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("registry returned %s for %s", resp.Status, req.URL)
+	}
+
+	return resp, nil
+}
+
+func (fetcher *ImageFetcher) authenticate(ref ImageRef, challenge string) (string, error) {
+	if !strings.HasPrefix(challenge, "Bearer ") {
+		return "", fmt.Errorf("unsupported registry authentication challenge '%s'", challenge)
+	}
+
+	params := map[string]string{}
+	for _, param := range strings.Split(strings.TrimPrefix(challenge, "Bearer "), ",") {
+		kv := strings.SplitN(strings.TrimSpace(param), "=", 2)
+		if len(kv) == 2 {
+			params[kv[0]] = strings.Trim(kv[1], `"`)
+		}
+	}
+
+	tokenURL, err := url.Parse(params["realm"])
+	if err != nil {
+		return "", err
+	}
+
+	query := tokenURL.Query()
+	if params["service"] != "" {
+		query.Set("service", params["service"])
+	}
+
+	scope := params["scope"]
+	if scope == "" {
+		scope = "repository:" + ref.Repository + ":pull"
+	}
+
+	query.Set("scope", scope)
+	tokenURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequest("GET", tokenURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	if ref.Username != "" {
+		req.SetBasicAuth(ref.Username, ref.Password)
+	}
+
+	client := fetcher.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("registry authentication failed: %s", resp.Status)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&token)
+	if err != nil {
+		return "", err
+	}
+
+	if token.Token != "" {
+		return token.Token, nil
+	}
+
+	return token.AccessToken, nil
+}
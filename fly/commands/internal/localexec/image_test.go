@@ -0,0 +1,265 @@
+package localexec_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/fly/commands/internal/localexec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ImageRefForTask", func() {
+	It("uses the repository and tag of a registry-image resource", func() {
+		ref, err := ImageRefForTask(atc.TaskConfig{
+			ImageResource: &atc.ImageResource{
+				Type:   "registry-image",
+				Source: atc.Source{"repository": "example.com:5000/some/image", "tag": "1.2"},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ref).To(Equal(ImageRef{
+			Registry:   "example.com:5000",
+			Repository: "some/image",
+			Tag:        "1.2",
+		}))
+	})
+
+	It("defaults to the official images on docker hub", func() {
+		ref, err := ImageRefForTask(atc.TaskConfig{
+			ImageResource: &atc.ImageResource{
+				Type:   "docker-image",
+				Source: atc.Source{"repository": "busybox"},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ref.String()).To(Equal("registry-1.docker.io/library/busybox:latest"))
+	})
+
+	It("supports docker:/// rootfs URIs", func() {
+		ref, err := ImageRefForTask(atc.TaskConfig{RootfsURI: "docker:///some/image#some-tag"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ref.String()).To(Equal("registry-1.docker.io/some/image:some-tag"))
+	})
+
+	It("rejects other resource types", func() {
+		_, err := ImageRefForTask(atc.TaskConfig{
+			ImageResource: &atc.ImageResource{Type: "s3"},
+		})
+		Expect(err).To(MatchError("image resource type 's3' is not supported when running locally"))
+	})
+
+	It("returns ErrNoImage when there is no image", func() {
+		_, err := ImageRefForTask(atc.TaskConfig{})
+		Expect(err).To(Equal(ErrNoImage))
+	})
+})
+
+var _ = Describe("ImageFetcher", func() {
+	var (
+		registry *ghttp.Server
+		cacheDir string
+		fetcher  *ImageFetcher
+		ref      ImageRef
+
+		baseLayer  []byte
+		upperLayer []byte
+		config     []byte
+		manifest   []byte
+	)
+
+	digestOf := func(blob []byte) string {
+		sum := sha256.Sum256(blob)
+		return "sha256:" + hex.EncodeToString(sum[:])
+	}
+
+	BeforeEach(func() {
+		var err error
+		cacheDir, err = ioutil.TempDir("", "fly-image-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		registry = ghttp.NewServer()
+
+		registryURL, err := url.Parse(registry.URL())
+		Expect(err).ToNot(HaveOccurred())
+
+		fetcher = &ImageFetcher{
+			CacheDir: cacheDir,
+			Output:   GinkgoWriter,
+			Scheme:   "http",
+		}
+
+		ref = ImageRef{
+			Registry:   registryURL.Host,
+			Repository: "some/image",
+			Tag:        "latest",
+		}
+
+		baseLayer = layer(map[string]string{
+			"etc/removed":      "gone",
+			"etc/kept":         "kept",
+			"opaque/old-file":  "old",
+			"bin/some-command": "#!/bin/sh",
+		}, true)
+
+		upperLayer = layer(map[string]string{
+			"etc/.wh.removed":     "",
+			"opaque/.wh..wh..opq": "",
+			"opaque/new-file":     "new",
+		}, false)
+
+		config, err = json.Marshal(map[string]interface{}{
+			"config": map[string]interface{}{
+				"Env": []string{"PATH=/bin", "FOO=bar"},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		manifest, err = json.Marshal(map[string]interface{}{
+			"mediaType": "application/vnd.docker.distribution.manifest.v2+json",
+			"config":    map[string]string{"digest": digestOf(config)},
+			"layers": []map[string]string{
+				{"digest": digestOf(baseLayer)},
+				{"digest": digestOf(upperLayer)},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		registry.RouteToHandler("GET", "/token", ghttp.CombineHandlers(
+			ghttp.VerifyFormKV("scope", "repository:some/image:pull"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]string{"token": "some-token"}),
+		))
+
+		challenge := http.Header{
+			"WWW-Authenticate": {`Bearer realm="` + registry.URL() + `/token",service="registry"`},
+		}
+
+		registry.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/v2/some/image/manifests/latest"),
+				ghttp.RespondWith(http.StatusUnauthorized, "", challenge),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/v2/some/image/manifests/latest"),
+				ghttp.VerifyHeaderKV("Authorization", "Bearer some-token"),
+				ghttp.RespondWith(http.StatusOK, manifest),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/v2/some/image/blobs/"+digestOf(config)),
+				ghttp.RespondWith(http.StatusOK, config),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/v2/some/image/blobs/"+digestOf(baseLayer)),
+				ghttp.RespondWith(http.StatusOK, baseLayer),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/v2/some/image/blobs/"+digestOf(upperLayer)),
+				ghttp.RespondWith(http.StatusOK, upperLayer),
+			),
+		)
+	})
+
+	AfterEach(func() {
+		registry.Close()
+		os.RemoveAll(cacheDir)
+	})
+
+	It("fetches the image's layers and config", func() {
+		image, err := fetcher.Fetch(ref)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(image.Env).To(Equal([]string{"PATH=/bin", "FOO=bar"}))
+		Expect(image.Layers).To(HaveLen(2))
+
+		rootfs := filepath.Join(cacheDir, "rootfs")
+		Expect(ExtractRootfs(image, rootfs)).To(Succeed())
+
+		Expect(filepath.Join(rootfs, "etc", "kept")).To(BeAnExistingFile())
+		Expect(filepath.Join(rootfs, "etc", "removed")).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(rootfs, "etc", ".wh.removed")).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(rootfs, "opaque", "old-file")).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(rootfs, "opaque", "new-file")).To(BeAnExistingFile())
+		Expect(filepath.Join(rootfs, "bin", "some-command")).To(BeAnExistingFile())
+	})
+
+	It("does not download blobs that are already cached", func() {
+		_, err := fetcher.Fetch(ref)
+		Expect(err).ToNot(HaveOccurred())
+
+		registry.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/v2/some/image/manifests/latest"),
+				ghttp.RespondWith(http.StatusOK, manifest),
+			),
+		)
+
+		cachedFetcher := &ImageFetcher{
+			CacheDir: cacheDir,
+			Output:   GinkgoWriter,
+			Scheme:   "http",
+		}
+
+		image, err := cachedFetcher.Fetch(ref)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(image.Layers).To(HaveLen(2))
+
+		Expect(registry.ReceivedRequests()).To(HaveLen(7))
+	})
+
+	Context("when a blob does not match its digest", func() {
+		BeforeEach(func() {
+			registry.SetHandler(3, ghttp.RespondWith(http.StatusOK, []byte("bogus")))
+		})
+
+		It("returns an error", func() {
+			_, err := fetcher.Fetch(ref)
+			Expect(err).To(MatchError("digest mismatch for " + digestOf(baseLayer)))
+		})
+	})
+})
+
+func layer(files map[string]string, compress bool) []byte {
+	buf := new(bytes.Buffer)
+
+	var tw *tar.Writer
+	var gz *gzip.Writer
+	if compress {
+		gz = gzip.NewWriter(buf)
+		tw = tar.NewWriter(gz)
+	} else {
+		tw = tar.NewWriter(buf)
+	}
+
+	for name, contents := range files {
+		err := tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0755,
+			Size:     int64(len(contents)),
+			Typeflag: tar.TypeReg,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = tw.Write([]byte(contents))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	Expect(tw.Close()).To(Succeed())
+
+	if gz != nil {
+		Expect(gz.Close()).To(Succeed())
+	}
+
+	return buf.Bytes()
+}
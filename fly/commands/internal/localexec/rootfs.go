@@ -0,0 +1,312 @@
+package localexec
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	whiteoutPrefix = ".wh."
+	opaqueWhiteout = ".wh..wh..opq"
+
+	maxSymlinks = 255
+)
+
+// ExtractRootfs unpacks the image's layers on top of each other into dest,
+// honoring whiteout entries. Device nodes and fifos are skipped; the runtime
+// provides /dev.
+//
+// Layers may contain symlinks pointing anywhere, e.g. var/run -> /run, so
+// every path is resolved within dest as if it were the filesystem root, and
+// entries which would end up outside of it are refused.
+func ExtractRootfs(image Image, dest string) error {
+	for _, layer := range image.Layers {
+		err := extractLayer(layer, dest)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func extractLayer(layerPath string, dest string) error {
+	file, err := os.Open(layerPath)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	buffered := bufio.NewReader(file)
+
+	var stream io.Reader = buffered
+
+	magic, err := buffered.Peek(2)
+	if err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return err
+		}
+
+		defer gz.Close()
+
+		stream = gz
+	}
+
+	root := os.Geteuid() == 0
+
+	tarReader := tar.NewReader(stream)
+
+	for {
+		hdr, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		name := filepath.Clean(hdr.Name)
+		if name == "." || strings.HasPrefix(name, "..") {
+			continue
+		}
+
+		dir, base := filepath.Split(name)
+
+		parent, err := resolveInRoot(dest, dir)
+		if err != nil {
+			return err
+		}
+
+		if base == opaqueWhiteout {
+			err := clearDir(parent)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if strings.HasPrefix(base, whiteoutPrefix) {
+			err := os.RemoveAll(filepath.Join(parent, strings.TrimPrefix(base, whiteoutPrefix)))
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		err = extractEntry(hdr, dest, filepath.Join(parent, base), tarReader, root)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// extractEntry writes the entry to path, which has already been resolved
+// within dest. The entry itself is never followed if it is a symlink; it is
+// replaced.
+func extractEntry(hdr *tar.Header, dest string, path string, src io.Reader, root bool) error {
+	switch hdr.Typeflag {
+	case tar.TypeBlock, tar.TypeChar, tar.TypeFifo:
+		return nil
+	}
+
+	if hdr.Typeflag != tar.TypeDir {
+		err := os.RemoveAll(path)
+		if err != nil {
+			return err
+		}
+	} else if info, err := os.Lstat(path); err == nil && !info.IsDir() {
+		err := os.Remove(path)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	mode := hdr.FileInfo().Mode()
+
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		err := os.Mkdir(path, 0755)
+		if err != nil && !os.IsExist(err) {
+			return err
+		}
+
+	case tar.TypeReg, tar.TypeRegA:
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.Copy(file, src)
+		if err != nil {
+			file.Close()
+			return err
+		}
+
+		err = file.Close()
+		if err != nil {
+			return err
+		}
+
+	case tar.TypeSymlink:
+		// the target is left as is; it is only ever resolved within dest
+		err := os.Symlink(hdr.Linkname, path)
+		if err != nil {
+			return err
+		}
+
+		if root {
+			return os.Lchown(path, hdr.Uid, hdr.Gid)
+		}
+
+		return nil
+
+	case tar.TypeLink:
+		linkDir, linkBase := filepath.Split(filepath.Clean(hdr.Linkname))
+
+		target, err := resolveInRoot(dest, linkDir)
+		if err != nil {
+			return err
+		}
+
+		return os.Link(filepath.Join(target, linkBase), path)
+
+	default:
+		return nil
+	}
+
+	if root {
+		err := os.Lchown(path, hdr.Uid, hdr.Gid)
+		if err != nil {
+			return err
+		}
+	} else if mode.IsDir() {
+		// later entries must still be able to write to read-only directories
+		mode |= 0700
+	}
+
+	err = os.Chmod(path, mode)
+	if err != nil {
+		return err
+	}
+
+	return os.Chtimes(path, hdr.AccessTime, hdr.ModTime)
+}
+
+// resolveInRoot resolves path the way it would be with root as the
+// filesystem root, following symlinks and treating absolute link targets as
+// relative to root. Paths escaping root through "..", whether in the path or
+// a link target, are refused. Components which do not exist yet are taken as
+// they are.
+func resolveInRoot(root string, path string) (string, error) {
+	resolved := ""
+	remaining := filepath.ToSlash(path)
+
+	for links := 0; remaining != ""; {
+		var part string
+		if i := strings.IndexByte(remaining, '/'); i == -1 {
+			part, remaining = remaining, ""
+		} else {
+			part, remaining = remaining[:i], remaining[i+1:]
+		}
+
+		switch part {
+		case "", ".":
+			continue
+		case "..":
+			if resolved == "" {
+				return "", fmt.Errorf("path '%s' escapes the rootfs", path)
+			}
+
+			resolved = filepath.Dir(resolved)
+			if resolved == "." {
+				resolved = ""
+			}
+
+			continue
+		}
+
+		next := filepath.Join(resolved, part)
+
+		info, err := os.Lstat(filepath.Join(root, next))
+		if err != nil && !os.IsNotExist(err) {
+			return "", err
+		}
+
+		if err != nil || info.Mode()&os.ModeSymlink == 0 {
+			resolved = next
+			continue
+		}
+
+		links++
+		if links > maxSymlinks {
+			return "", fmt.Errorf("too many levels of symbolic links resolving '%s'", path)
+		}
+
+		target, err := os.Readlink(filepath.Join(root, next))
+		if err != nil {
+			return "", err
+		}
+
+		target = filepath.ToSlash(target)
+		if strings.HasPrefix(target, "/") {
+			resolved = ""
+		}
+
+		remaining = target + "/" + remaining
+	}
+
+	return filepath.Join(root, resolved), nil
+}
+
+func clearDir(dir string) error {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	for _, entry := range entries {
+		err := os.RemoveAll(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeRootfs removes an extracted rootfs, making sure read-only directories
+// from the image do not get in the way.
+func removeRootfs(dir string) error {
+	_ = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && info.IsDir() {
+			_ = os.Chmod(path, info.Mode().Perm()|0700)
+		}
+
+		return nil
+	})
+
+	return os.RemoveAll(dir)
+}
@@ -0,0 +1,152 @@
+package localexec_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/concourse/concourse/fly/commands/internal/localexec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ExtractRootfs", func() {
+	var (
+		tmpDir  string
+		rootfs  string
+		outside string
+
+		layers [][]*tar.Header
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "rootfs")
+		Expect(err).ToNot(HaveOccurred())
+
+		rootfs = filepath.Join(tmpDir, "rootfs")
+		outside = filepath.Join(tmpDir, "outside")
+
+		Expect(os.Mkdir(outside, 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(outside, "sentinel"), []byte("host"), 0644)).To(Succeed())
+
+		layers = nil
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	extract := func() error {
+		image := Image{}
+		for i, headers := range layers {
+			path := filepath.Join(tmpDir, "layer-"+string(rune('a'+i)))
+			Expect(ioutil.WriteFile(path, headerLayer(headers), 0644)).To(Succeed())
+			image.Layers = append(image.Layers, path)
+		}
+
+		return ExtractRootfs(image, rootfs)
+	}
+
+	Context("when an earlier layer symlinks a directory to an absolute path", func() {
+		BeforeEach(func() {
+			layers = [][]*tar.Header{
+				{
+					{Name: "var/", Typeflag: tar.TypeDir, Mode: 0755},
+					{Name: "var/run", Typeflag: tar.TypeSymlink, Linkname: outside},
+				},
+				{
+					{Name: "var/run/evil", Typeflag: tar.TypeReg, Mode: 0644},
+					{Name: "var/run/.wh.sentinel", Typeflag: tar.TypeReg, Mode: 0644},
+				},
+			}
+		})
+
+		It("resolves the symlink within the rootfs", func() {
+			Expect(extract()).To(Succeed())
+
+			Expect(filepath.Join(outside, "evil")).ToNot(BeAnExistingFile())
+			Expect(filepath.Join(outside, "sentinel")).To(BeAnExistingFile())
+			Expect(filepath.Join(rootfs, outside, "evil")).To(BeAnExistingFile())
+
+			target, err := os.Readlink(filepath.Join(rootfs, "var", "run"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(target).To(Equal(outside))
+		})
+	})
+
+	Context("when an earlier layer symlinks a directory out of the rootfs through ..", func() {
+		BeforeEach(func() {
+			layers = [][]*tar.Header{
+				{
+					{Name: "escape", Typeflag: tar.TypeSymlink, Linkname: "../outside"},
+				},
+			}
+		})
+
+		It("refuses to write through it", func() {
+			layers = append(layers, []*tar.Header{
+				{Name: "escape/evil", Typeflag: tar.TypeReg, Mode: 0644},
+			})
+
+			Expect(extract()).To(MatchError("path 'escape/' escapes the rootfs"))
+			Expect(filepath.Join(outside, "evil")).ToNot(BeAnExistingFile())
+		})
+
+		It("refuses to remove through it", func() {
+			layers = append(layers, []*tar.Header{
+				{Name: "escape/.wh.sentinel", Typeflag: tar.TypeReg, Mode: 0644},
+			})
+
+			Expect(extract()).To(HaveOccurred())
+			Expect(filepath.Join(outside, "sentinel")).To(BeAnExistingFile())
+		})
+
+		It("refuses to clear the directory through it", func() {
+			layers = append(layers, []*tar.Header{
+				{Name: "escape/.wh..wh..opq", Typeflag: tar.TypeReg, Mode: 0644},
+			})
+
+			Expect(extract()).To(HaveOccurred())
+			Expect(filepath.Join(outside, "sentinel")).To(BeAnExistingFile())
+		})
+
+		It("refuses to hard link through it", func() {
+			layers = append(layers, []*tar.Header{
+				{Name: "stolen", Typeflag: tar.TypeLink, Linkname: "escape/sentinel"},
+			})
+
+			Expect(extract()).To(HaveOccurred())
+			Expect(filepath.Join(rootfs, "stolen")).ToNot(BeAnExistingFile())
+		})
+
+		It("replaces the symlink itself", func() {
+			layers = append(layers, []*tar.Header{
+				{Name: "escape", Typeflag: tar.TypeReg, Mode: 0644},
+			})
+
+			Expect(extract()).To(Succeed())
+
+			info, err := os.Lstat(filepath.Join(rootfs, "escape"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().IsRegular()).To(BeTrue())
+			Expect(filepath.Join(outside, "sentinel")).To(BeAnExistingFile())
+		})
+	})
+})
+
+func headerLayer(headers []*tar.Header) []byte {
+	buf := new(bytes.Buffer)
+	tw := tar.NewWriter(buf)
+
+	for _, hdr := range headers {
+		Expect(tw.WriteHeader(hdr)).To(Succeed())
+	}
+
+	Expect(tw.Close()).To(Succeed())
+
+	return buf.Bytes()
+}
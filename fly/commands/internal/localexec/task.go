@@ -0,0 +1,276 @@
+package localexec
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/executehelpers"
+)
+
+const defaultPath = "PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+
+// Task runs a task config on the local machine instead of on a worker.
+//
+// If the task specifies an image, it is fetched into CacheDir and the task runs
+// chrooted into it, in a user namespace when not running as root. Otherwise the
+// task runs directly on the host, much like it would on a Houdini worker.
+type Task struct {
+	Config atc.TaskConfig
+
+	Inputs  []executehelpers.Input
+	Outputs []executehelpers.Output
+
+	IncludeIgnored bool
+
+	CacheDir string
+
+	Stdout io.Writer
+	Stderr io.Writer
+}
+
+// Run executes the task and returns its exit status. Outputs are copied to
+// their destinations regardless of whether the task succeeded.
+func (task Task) Run() (int, error) {
+	workDir, err := ioutil.TempDir("", "fly-execute-local")
+	if err != nil {
+		return 0, err
+	}
+
+	defer os.RemoveAll(workDir)
+
+	ref, err := ImageRefForTask(task.Config)
+	if err != nil && err != ErrNoImage {
+		return 0, err
+	}
+
+	var rootfs string
+	var buildDir string
+	var env []string
+
+	if err == ErrNoImage {
+		buildDir = filepath.Join(workDir, "build")
+		env = os.Environ()
+	} else {
+		fetcher := &ImageFetcher{
+			CacheDir: task.CacheDir,
+			Output:   task.Stderr,
+		}
+
+		image, err := fetcher.Fetch(ref)
+		if err != nil {
+			return 0, err
+		}
+
+		rootfs = filepath.Join(workDir, "rootfs")
+
+		defer removeRootfs(rootfs)
+
+		err = ExtractRootfs(image, rootfs)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, file := range []string{"/etc/resolv.conf", "/etc/hosts"} {
+			err := copyFile(file, filepath.Join(rootfs, file))
+			if err != nil && !os.IsNotExist(err) {
+				return 0, err
+			}
+		}
+
+		buildDir = path.Join("/tmp/build", randomHandle())
+		env = image.Env
+
+		if !hasVar(env, "PATH") {
+			env = append(env, defaultPath)
+		}
+	}
+
+	hostBuildDir := filepath.Join(rootfs, buildDir)
+
+	err = task.prepareBuildDir(hostBuildDir)
+	if err != nil {
+		return 0, err
+	}
+
+	env = append(env, task.Config.Params.Env()...)
+
+	dir := buildDir
+	if task.Config.Run.Dir != "" {
+		if path.IsAbs(task.Config.Run.Dir) {
+			dir = task.Config.Run.Dir
+		} else {
+			dir = path.Join(buildDir, task.Config.Run.Dir)
+		}
+	}
+
+	var cmd *exec.Cmd
+	if rootfs == "" {
+		cmd = exec.Command(task.Config.Run.Path, task.Config.Run.Args...)
+		cmd.Dir = dir
+		cmd.Env = env
+	} else {
+		cmd, err = rootfsCommand(rootfs, dir, env, task.Config.Run)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = task.Stdout
+	cmd.Stderr = task.Stderr
+
+	exitStatus, err := runForwardingSignals(cmd)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, output := range task.Outputs {
+		outputPath := output.Name
+		for _, config := range task.Config.Outputs {
+			if config.Name == output.Name && config.Path != "" {
+				outputPath = config.Path
+			}
+		}
+
+		err := executehelpers.Copy(filepath.Join(hostBuildDir, outputPath), output.Path, true)
+		if err != nil {
+			return 0, fmt.Errorf("failed to copy output '%s': %s", output.Name, err)
+		}
+	}
+
+	return exitStatus, nil
+}
+
+func (task Task) prepareBuildDir(buildDir string) error {
+	err := os.MkdirAll(buildDir, 0755)
+	if err != nil {
+		return err
+	}
+
+	for _, input := range task.Inputs {
+		inputPath := input.Name
+		for _, config := range task.Config.Inputs {
+			if config.Name == input.Name && config.Path != "" {
+				inputPath = config.Path
+			}
+		}
+
+		dest := filepath.Join(buildDir, inputPath)
+
+		err := os.MkdirAll(dest, 0755)
+		if err != nil {
+			return err
+		}
+
+		err = executehelpers.Copy(input.Path, dest, task.IncludeIgnored)
+		if err != nil {
+			return fmt.Errorf("failed to copy input '%s': %s", input.Name, err)
+		}
+	}
+
+	for _, output := range task.Config.Outputs {
+		outputPath := output.Name
+		if output.Path != "" {
+			outputPath = output.Path
+		}
+
+		err := os.MkdirAll(filepath.Join(buildDir, outputPath), 0755)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, cache := range task.Config.Caches {
+		err := os.MkdirAll(filepath.Join(buildDir, cache.Path), 0755)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func runForwardingSignals(cmd *exec.Cmd) (int, error) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	err := cmd.Start()
+	if err != nil {
+		return 0, err
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		for {
+			select {
+			case sig := <-signals:
+				_ = cmd.Process.Signal(sig)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err = cmd.Wait()
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok {
+			if status.Signaled() {
+				return 128 + int(status.Signal()), nil
+			}
+
+			return status.ExitStatus(), nil
+		}
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return 0, nil
+}
+
+func copyFile(src string, dest string) error {
+	contents, err := ioutil.ReadFile(src)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(dest), 0755)
+	if err != nil {
+		return err
+	}
+
+	_ = os.Remove(dest)
+
+	return ioutil.WriteFile(dest, contents, 0644)
+}
+
+func hasVar(env []string, name string) bool {
+	for _, kv := range env {
+		if strings.HasPrefix(kv, name+"=") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func randomHandle() string {
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,100 @@
+// +build linux
+
+package localexec
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// initEnv marks a re-exec of fly as the init process for a local task; see
+// runInit.
+const initEnv = "_FLY_EXECUTE_LOCAL_INIT"
+
+func init() {
+	if os.Getenv(initEnv) == "" {
+		return
+	}
+
+	err := runInit(os.Args[1], os.Args[2], os.Args[3], os.Args[4:])
+	fmt.Fprintf(os.Stderr, "failed to run task: %s\n", err)
+	os.Exit(255)
+}
+
+// rootfsCommand re-executes fly in a new mount namespace (and a new user
+// namespace when not running as root) so that it can mount /dev and /proc
+// into the rootfs, chroot into it and exec the task's process.
+func rootfsCommand(rootfs string, dir string, env []string, run atc.TaskRunConfig) (*exec.Cmd, error) {
+	self, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(self, append([]string{rootfs, dir, run.Path}, run.Args...)...)
+	cmd.Env = append(env, initEnv+"=1")
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Cloneflags: syscall.CLONE_NEWNS,
+	}
+
+	if os.Geteuid() != 0 {
+		cmd.SysProcAttr.Cloneflags |= syscall.CLONE_NEWUSER
+		cmd.SysProcAttr.UidMappings = []syscall.SysProcIDMap{
+			{ContainerID: 0, HostID: os.Geteuid(), Size: 1},
+		}
+		cmd.SysProcAttr.GidMappings = []syscall.SysProcIDMap{
+			{ContainerID: 0, HostID: os.Getegid(), Size: 1},
+		}
+		cmd.SysProcAttr.GidMappingsEnableSetgroups = false
+	}
+
+	return cmd, nil
+}
+
+func runInit(rootfs string, dir string, path string, args []string) error {
+	err := os.Unsetenv(initEnv)
+	if err != nil {
+		return err
+	}
+
+	err = syscall.Mount("", "/", "", syscall.MS_REC|syscall.MS_PRIVATE, "")
+	if err != nil {
+		return fmt.Errorf("make mounts private: %s", err)
+	}
+
+	for _, mount := range []string{"/dev", "/proc", "/sys"} {
+		dest := filepath.Join(rootfs, mount)
+
+		err := os.MkdirAll(dest, 0755)
+		if err != nil {
+			return err
+		}
+
+		err = syscall.Mount(mount, dest, "", syscall.MS_BIND|syscall.MS_REC, "")
+		if err != nil {
+			return fmt.Errorf("mount %s: %s", mount, err)
+		}
+	}
+
+	err = syscall.Chroot(rootfs)
+	if err != nil {
+		return fmt.Errorf("chroot: %s", err)
+	}
+
+	err = os.Chdir(dir)
+	if err != nil {
+		return err
+	}
+
+	// resolved after the chroot, using the PATH from the image and params
+	bin, err := exec.LookPath(path)
+	if err != nil {
+		return err
+	}
+
+	return syscall.Exec(bin, append([]string{path}, args...), os.Environ())
+}
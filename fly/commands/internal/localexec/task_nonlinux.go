@@ -0,0 +1,14 @@
+// +build !linux
+
+package localexec
+
+import (
+	"errors"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+)
+
+func rootfsCommand(rootfs string, dir string, env []string, run atc.TaskRunConfig) (*exec.Cmd, error) {
+	return nil, errors.New("running a task with an image locally is only supported on linux")
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"regexp"
+
 	"sigs.k8s.io/yaml"
 
 	"github.com/vito/go-interact/interact"
@@ -16,6 +18,8 @@ import (
 	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
+var colorSequence = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
 type ATCConfig struct {
 	PipelineName     string
 	Team             concourse.Team
@@ -23,6 +27,7 @@ type ATCConfig struct {
 	Target           string
 	SkipInteraction  bool
 	CheckCredentials bool
+	DisableAnsiColor bool
 }
 
 func (atcConfig ATCConfig) ApplyConfigInteraction() bool {
@@ -86,6 +91,69 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 	return nil
 }
 
+// DryRun shows what setting the pipeline would change, as determined by the
+// server, without changing anything.
+func (atcConfig ATCConfig) DryRun(yamlTemplateWithParams templatehelpers.YamlTemplateWithParams) error {
+	evaluatedTemplate, err := yamlTemplateWithParams.Evaluate(false, false)
+	if err != nil {
+		return err
+	}
+
+	dryRun, warnings, err := atcConfig.Team.DryRunPipelineConfig(
+		atcConfig.PipelineName,
+		evaluatedTemplate,
+		atcConfig.CheckCredentials,
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(warnings) > 0 {
+		displayhelpers.ShowWarnings(warnings)
+	}
+
+	if dryRun.Diff == "" {
+		fmt.Println("no changes to apply")
+		return nil
+	}
+
+	diff := dryRun.Diff
+	if atcConfig.DisableAnsiColor {
+		// the diff is rendered by the server
+		diff = colorSequence.ReplaceAllString(diff, "")
+	}
+
+	stdout, _ := ui.ForTTY(os.Stdout)
+	fmt.Fprint(stdout, diff)
+
+	if dryRun.Created {
+		fmt.Println()
+		fmt.Println("the pipeline would be created, paused")
+	}
+
+	showImpact("jobs which may start new builds:", dryRun.Impact.TriggeredJobs)
+	showImpact("resources which would be checked from scratch:", dryRun.Impact.RecheckedResources)
+	showImpact("resources which would lose version history:", dryRun.Impact.ResetResources)
+
+	fmt.Println()
+	fmt.Println("dry run: nothing has been changed")
+
+	return nil
+}
+
+func showImpact(header string, reasons []atc.ConfigImpactReason) {
+	if len(reasons) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println(ui.Embolden(header))
+
+	for _, reason := range reasons {
+		fmt.Printf("  %s: %s\n", reason.Name, reason.Reason)
+	}
+}
+
 func (atcConfig ATCConfig) UnpausePipelineCommand() string {
 	return fmt.Sprintf("%s -t %s unpause-pipeline -p %s", os.Args[0], atcConfig.TargetName, atcConfig.PipelineName)
 }
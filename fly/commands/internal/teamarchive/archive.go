@@ -0,0 +1,172 @@
+package teamarchive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/atc"
+)
+
+const (
+	teamFile       = "team.yml"
+	pipelinesDir   = "pipelines/"
+	pipelineSuffix = ".yml"
+)
+
+// Archive is everything needed to recreate a team on another Concourse
+// installation: its auth config and, for each of its pipelines, the config and
+// the state set through the API rather than the config.
+type Archive struct {
+	Team      atc.Team   `json:"team"`
+	Pipelines []Pipeline `json:"pipelines"`
+}
+
+type Pipeline struct {
+	Name   string `json:"name"`
+	Paused bool   `json:"paused"`
+	Public bool   `json:"public"`
+
+	// Pins are the versions pinned through the API. Versions pinned in the
+	// config are restored along with it.
+	Pins             []Pin             `json:"pins,omitempty"`
+	DisabledVersions []DisabledVersion `json:"disabled_versions,omitempty"`
+
+	// Config is stored in its own file in the archive, so that it can be
+	// read and edited like any other pipeline config.
+	Config atc.Config `json:"-"`
+}
+
+type Pin struct {
+	Resource string      `json:"resource"`
+	Version  atc.Version `json:"version"`
+	Comment  string      `json:"comment,omitempty"`
+}
+
+type DisabledVersion struct {
+	Resource string      `json:"resource"`
+	Version  atc.Version `json:"version"`
+}
+
+// Write writes the archive as a gzipped tarball containing team.yml and a
+// pipelines/NAME.yml config for each pipeline.
+func Write(dst io.Writer, archive Archive) error {
+	gzWriter := gzip.NewWriter(dst)
+	tarWriter := tar.NewWriter(gzWriter)
+
+	payload, err := yaml.Marshal(archive)
+	if err != nil {
+		return err
+	}
+
+	err = writeFile(tarWriter, teamFile, payload)
+	if err != nil {
+		return err
+	}
+
+	for _, pipeline := range archive.Pipelines {
+		payload, err := yaml.Marshal(pipeline.Config)
+		if err != nil {
+			return err
+		}
+
+		err = writeFile(tarWriter, pipelinesDir+pipeline.Name+pipelineSuffix, payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tarWriter.Close()
+	if err != nil {
+		return err
+	}
+
+	return gzWriter.Close()
+}
+
+// Read reads an archive written by Write.
+func Read(src io.Reader) (Archive, error) {
+	gzReader, err := gzip.NewReader(src)
+	if err != nil {
+		return Archive{}, fmt.Errorf("invalid team archive: %s", err)
+	}
+
+	defer gzReader.Close()
+
+	var archive Archive
+	var foundTeam bool
+	configs := map[string]atc.Config{}
+
+	tarReader := tar.NewReader(gzReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return Archive{}, fmt.Errorf("invalid team archive: %s", err)
+		}
+
+		payload, err := ioutil.ReadAll(tarReader)
+		if err != nil {
+			return Archive{}, err
+		}
+
+		name := path.Clean(header.Name)
+
+		switch {
+		case name == teamFile:
+			err = yaml.UnmarshalStrict(payload, &archive)
+			if err != nil {
+				return Archive{}, fmt.Errorf("invalid %s: %s", teamFile, err)
+			}
+
+			foundTeam = true
+
+		case strings.HasPrefix(name, pipelinesDir) && strings.HasSuffix(name, pipelineSuffix):
+			var config atc.Config
+			err = yaml.Unmarshal(payload, &config)
+			if err != nil {
+				return Archive{}, fmt.Errorf("invalid %s: %s", name, err)
+			}
+
+			configs[strings.TrimSuffix(strings.TrimPrefix(name, pipelinesDir), pipelineSuffix)] = config
+		}
+	}
+
+	if !foundTeam {
+		return Archive{}, fmt.Errorf("invalid team archive: missing %s", teamFile)
+	}
+
+	for i, pipeline := range archive.Pipelines {
+		config, found := configs[pipeline.Name]
+		if !found {
+			return Archive{}, fmt.Errorf("invalid team archive: missing config for pipeline '%s'", pipeline.Name)
+		}
+
+		archive.Pipelines[i].Config = config
+	}
+
+	return archive, nil
+}
+
+func writeFile(tarWriter *tar.Writer, name string, payload []byte) error {
+	err := tarWriter.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0644,
+		Size: int64(len(payload)),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = tarWriter.Write(payload)
+	return err
+}
@@ -0,0 +1,110 @@
+package teamarchive_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/teamarchive"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Archive", func() {
+	var archive teamarchive.Archive
+
+	BeforeEach(func() {
+		defaultJobPriority := 5
+
+		archive = teamarchive.Archive{
+			Team: atc.Team{
+				Name: "some-team",
+				Auth: atc.TeamAuth{
+					"owner": {"users": []string{"local:some-user"}},
+				},
+				DefaultJobPriority: &defaultJobPriority,
+			},
+			Pipelines: []teamarchive.Pipeline{
+				{
+					Name:   "some-pipeline",
+					Paused: true,
+					Config: atc.Config{
+						Resources: atc.ResourceConfigs{
+							{Name: "some-resource", Type: "git"},
+						},
+					},
+					Pins: []teamarchive.Pin{
+						{Resource: "some-resource", Version: atc.Version{"ref": "abc"}, Comment: "some comment"},
+					},
+					DisabledVersions: []teamarchive.DisabledVersion{
+						{Resource: "some-resource", Version: atc.Version{"ref": "def"}},
+					},
+				},
+				{
+					Name:   "other-pipeline",
+					Public: true,
+					Config: atc.Config{
+						Jobs: atc.JobConfigs{{Name: "some-job"}},
+					},
+				},
+			},
+		}
+	})
+
+	It("reads back what it writes", func() {
+		buf := new(bytes.Buffer)
+		Expect(teamarchive.Write(buf, archive)).To(Succeed())
+
+		read, err := teamarchive.Read(buf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(read).To(Equal(archive))
+	})
+
+	It("stores each pipeline's config in its own file", func() {
+		buf := new(bytes.Buffer)
+		Expect(teamarchive.Write(buf, archive)).To(Succeed())
+
+		gzReader, err := gzip.NewReader(buf)
+		Expect(err).ToNot(HaveOccurred())
+
+		var names []string
+		tarReader := tar.NewReader(gzReader)
+		for {
+			header, err := tarReader.Next()
+			if err != nil {
+				break
+			}
+
+			names = append(names, header.Name)
+		}
+
+		Expect(names).To(Equal([]string{
+			"team.yml",
+			"pipelines/some-pipeline.yml",
+			"pipelines/other-pipeline.yml",
+		}))
+	})
+
+	It("errors when a pipeline's config is missing", func() {
+		buf := new(bytes.Buffer)
+		gzWriter := gzip.NewWriter(buf)
+		tarWriter := tar.NewWriter(gzWriter)
+
+		payload := []byte("team: {name: some-team}\npipelines: [{name: some-pipeline}]\n")
+		Expect(tarWriter.WriteHeader(&tar.Header{Name: "team.yml", Mode: 0644, Size: int64(len(payload))})).To(Succeed())
+		_, err := tarWriter.Write(payload)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tarWriter.Close()).To(Succeed())
+		Expect(gzWriter.Close()).To(Succeed())
+
+		_, err = teamarchive.Read(buf)
+		Expect(err).To(MatchError("invalid team archive: missing config for pipeline 'some-pipeline'"))
+	})
+
+	It("errors when the input is not an archive", func() {
+		_, err := teamarchive.Read(bytes.NewBufferString("nope"))
+		Expect(err).To(HaveOccurred())
+	})
+})
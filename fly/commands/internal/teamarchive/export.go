@@ -0,0 +1,116 @@
+package teamarchive
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+// Export collects the archive of the given team through the API.
+func Export(team concourse.Team) (Archive, error) {
+	teamConfig, found, err := team.Team(team.Name())
+	if err != nil {
+		return Archive{}, err
+	}
+
+	if !found {
+		return Archive{}, fmt.Errorf("team '%s' not found", team.Name())
+	}
+
+	archive := Archive{
+		Team: atc.Team{
+			Name:               teamConfig.Name,
+			Auth:               teamConfig.Auth,
+			DefaultJobPriority: teamConfig.DefaultJobPriority,
+		},
+		Pipelines: []Pipeline{},
+	}
+
+	pipelines, err := team.ListPipelines()
+	if err != nil {
+		return Archive{}, err
+	}
+
+	for _, pipeline := range pipelines {
+		exported, err := exportPipeline(team, pipeline)
+		if err != nil {
+			return Archive{}, err
+		}
+
+		archive.Pipelines = append(archive.Pipelines, exported)
+	}
+
+	return archive, nil
+}
+
+func exportPipeline(team concourse.Team, pipeline atc.Pipeline) (Pipeline, error) {
+	config, _, found, err := team.PipelineConfig(pipeline.Name)
+	if err != nil {
+		return Pipeline{}, err
+	}
+
+	if !found {
+		return Pipeline{}, fmt.Errorf("pipeline '%s' not found", pipeline.Name)
+	}
+
+	exported := Pipeline{
+		Name:   pipeline.Name,
+		Paused: pipeline.Paused,
+		Public: pipeline.Public,
+		Config: config,
+	}
+
+	resources, err := team.ListResources(pipeline.Name)
+	if err != nil {
+		return Pipeline{}, err
+	}
+
+	for _, resource := range resources {
+		if resource.PinnedVersion != nil && !resource.PinnedInConfig {
+			exported.Pins = append(exported.Pins, Pin{
+				Resource: resource.Name,
+				Version:  resource.PinnedVersion,
+				Comment:  resource.PinComment,
+			})
+		}
+
+		disabled, err := disabledVersions(team, pipeline.Name, resource.Name)
+		if err != nil {
+			return Pipeline{}, err
+		}
+
+		exported.DisabledVersions = append(exported.DisabledVersions, disabled...)
+	}
+
+	return exported, nil
+}
+
+func disabledVersions(team concourse.Team, pipelineName string, resourceName string) ([]DisabledVersion, error) {
+	var disabled []DisabledVersion
+
+	page := &concourse.Page{Limit: 100}
+	for page != nil {
+		versions, pagination, found, err := team.ResourceVersions(pipelineName, resourceName, *page, atc.Version{})
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			return nil, nil
+		}
+
+		for _, version := range versions {
+			if !version.Enabled {
+				disabled = append(disabled, DisabledVersion{
+					Resource: resourceName,
+					Version:  version.Version,
+				})
+			}
+		}
+
+		page = pagination.Next
+	}
+
+	return disabled, nil
+}
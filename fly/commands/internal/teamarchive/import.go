@@ -0,0 +1,472 @@
+package teamarchive
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+// Importer applies an archive to a team, creating the team if it does not
+// exist. Only what differs from the archive is changed, so importing the same
+// archive twice is harmless. Pipelines which are not in the archive, and
+// versions disabled on the team but not in the archive, are left alone.
+// Pipelines are kept paused until all of their archived pins and disabled
+// versions have been applied.
+type Importer struct {
+	Team concourse.Team
+
+	// Output receives a line for each change, and Diff the changes to the
+	// team's auth and pipeline configs.
+	Output io.Writer
+	Diff   io.Writer
+
+	// DryRun prints the changes without making them.
+	DryRun bool
+
+	CheckCredentials bool
+
+	changed bool
+}
+
+// Import applies the archive, returning whether anything differed from it.
+func (importer *Importer) Import(archive Archive) (bool, error) {
+	importer.changed = false
+
+	teamExists, err := importer.importTeam(archive.Team)
+	if err != nil {
+		return false, err
+	}
+
+	existing := map[string]atc.Pipeline{}
+	var existingOrder []string
+
+	if teamExists {
+		pipelines, err := importer.Team.ListPipelines()
+		if err != nil {
+			return false, err
+		}
+
+		for _, pipeline := range pipelines {
+			existing[pipeline.Name] = pipeline
+			existingOrder = append(existingOrder, pipeline.Name)
+		}
+	}
+
+	for _, pipeline := range archive.Pipelines {
+		current, found := existing[pipeline.Name]
+		if !found {
+			// pipelines are created paused and hidden
+			current = atc.Pipeline{Name: pipeline.Name, Paused: true}
+		}
+
+		err := importer.importPipeline(pipeline, current, found)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	order := []string{}
+	for _, pipeline := range archive.Pipelines {
+		order = append(order, pipeline.Name)
+	}
+
+	for _, name := range existingOrder {
+		if !containsString(order, name) {
+			order = append(order, name)
+		}
+	}
+
+	if !reflect.DeepEqual(order, existingOrder) {
+		err := importer.apply("order pipelines", func() error {
+			return importer.Team.OrderingPipelines(order)
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return importer.changed, nil
+}
+
+func (importer *Importer) importTeam(team atc.Team) (bool, error) {
+	name := importer.Team.Name()
+
+	existing, found, err := importer.Team.Team(name)
+	if err != nil {
+		return false, err
+	}
+
+	if found && reflect.DeepEqual(existing.Auth, team.Auth) && samePriority(existing.DefaultJobPriority, team.DefaultJobPriority) {
+		return true, nil
+	}
+
+	err = importer.diffTeam(existing, team)
+	if err != nil {
+		return false, err
+	}
+
+	description := fmt.Sprintf("update team '%s'", name)
+	if !found {
+		description = fmt.Sprintf("create team '%s'", name)
+	}
+
+	err = importer.apply(description, func() error {
+		_, _, _, err := importer.Team.CreateOrUpdate(atc.Team{
+			Auth:               team.Auth,
+			DefaultJobPriority: team.DefaultJobPriority,
+		})
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return found || !importer.DryRun, nil
+}
+
+func (importer *Importer) diffTeam(existing atc.Team, team atc.Team) error {
+	for _, side := range []struct {
+		prefix string
+		team   atc.Team
+	}{
+		{"-", existing},
+		{"+", team},
+	} {
+		payload, err := yaml.Marshal(atc.Team{
+			Auth:               side.team.Auth,
+			DefaultJobPriority: side.team.DefaultJobPriority,
+		})
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(importer.Diff, "team %s\n%s\n", side.prefix, payload)
+	}
+
+	return nil
+}
+
+func (importer *Importer) importPipeline(pipeline Pipeline, current atc.Pipeline, exists bool) error {
+	team := importer.Team
+
+	existingConfig, version, _, err := team.PipelineConfig(pipeline.Name)
+	if err != nil {
+		return err
+	}
+
+	diff := new(bytes.Buffer)
+	if existingConfig.Diff(diff, pipeline.Config) {
+		fmt.Fprintf(importer.Diff, "pipeline %s:\n%s\n", pipeline.Name, diff)
+
+		payload, err := yaml.Marshal(pipeline.Config)
+		if err != nil {
+			return err
+		}
+
+		err = importer.apply(fmt.Sprintf("set pipeline '%s'", pipeline.Name), func() error {
+			_, _, warnings, err := team.CreateOrUpdatePipelineConfig(pipeline.Name, version, payload, importer.CheckCredentials)
+			if err != nil {
+				return err
+			}
+
+			for _, warning := range warnings {
+				importer.warn("pipeline '%s': %s", pipeline.Name, warning.Message)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if pipeline.Public != current.Public {
+		if pipeline.Public {
+			err = importer.apply(fmt.Sprintf("expose pipeline '%s'", pipeline.Name), func() error {
+				_, err := team.ExposePipeline(pipeline.Name)
+				return err
+			})
+		} else {
+			err = importer.apply(fmt.Sprintf("hide pipeline '%s'", pipeline.Name), func() error {
+				_, err := team.HidePipeline(pipeline.Name)
+				return err
+			})
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	var resources []atc.Resource
+	if exists {
+		resources, err = team.ListResources(pipeline.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	pinChanges, pinsResolved, err := importer.pinChanges(pipeline, resources)
+	if err != nil {
+		return err
+	}
+
+	disableChanges, disabledResolved, err := importer.disabledVersionChanges(pipeline)
+	if err != nil {
+		return err
+	}
+
+	changes := append(pinChanges, disableChanges...)
+	resolved := pinsResolved && disabledResolved
+
+	// the pipeline must not schedule builds with versions that are yet to be
+	// pinned or disabled, so it is only unpaused once all of them are
+	paused := current.Paused
+	if !paused && (!resolved || needsPause(changes)) {
+		err = importer.setPaused(pipeline.Name, true)
+		if err != nil {
+			return err
+		}
+
+		paused = true
+	}
+
+	for _, change := range changes {
+		err = importer.apply(change.description, change.action)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !pipeline.Paused && !resolved {
+		importer.warn("leaving pipeline '%s' paused until all of its pinned and disabled versions are found", pipeline.Name)
+	}
+
+	if wantPaused := pipeline.Paused || !resolved; wantPaused != paused {
+		return importer.setPaused(pipeline.Name, wantPaused)
+	}
+
+	return nil
+}
+
+func (importer *Importer) setPaused(pipelineName string, paused bool) error {
+	if paused {
+		return importer.apply(fmt.Sprintf("pause pipeline '%s'", pipelineName), func() error {
+			_, err := importer.Team.PausePipeline(pipelineName)
+			return err
+		})
+	}
+
+	return importer.apply(fmt.Sprintf("unpause pipeline '%s'", pipelineName), func() error {
+		_, err := importer.Team.UnpausePipeline(pipelineName)
+		return err
+	})
+}
+
+// change is a change to a pipeline's resources which is applied once the
+// pipeline is paused, if it affects which versions builds use.
+type change struct {
+	description string
+	action      func() error
+	needsPause  bool
+}
+
+func needsPause(changes []change) bool {
+	for _, change := range changes {
+		if change.needsPause {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pinChanges returns the changes needed for the resources to be pinned as in
+// the archive, and whether every pinned version could be found.
+func (importer *Importer) pinChanges(pipeline Pipeline, resources []atc.Resource) ([]change, bool, error) {
+	team := importer.Team
+
+	current := map[string]atc.Resource{}
+	for _, resource := range resources {
+		current[resource.Name] = resource
+	}
+
+	var changes []change
+	resolved := true
+	pinned := map[string]bool{}
+
+	for _, pin := range pipeline.Pins {
+		pinned[pin.Resource] = true
+
+		resource := current[pin.Resource]
+		if reflect.DeepEqual(resource.PinnedVersion, pin.Version) {
+			if resource.PinComment != pin.Comment {
+				changes = append(changes, importer.pinCommentChange(pipeline.Name, pin))
+			}
+
+			continue
+		}
+
+		version, found, err := importer.findVersion(pipeline.Name, pin.Resource, pin.Version)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if !found {
+			resolved = false
+			continue
+		}
+
+		pin := pin
+		changes = append(changes, change{
+			description: fmt.Sprintf("pin '%s/%s' to version %s", pipeline.Name, pin.Resource, versionString(pin.Version)),
+			action: func() error {
+				_, err := team.PinResourceVersion(pipeline.Name, pin.Resource, version.ID)
+				return err
+			},
+			needsPause: true,
+		})
+
+		if pin.Comment != "" {
+			changes = append(changes, importer.pinCommentChange(pipeline.Name, pin))
+		}
+	}
+
+	for _, resource := range resources {
+		if resource.PinnedVersion == nil || resource.PinnedInConfig || pinned[resource.Name] {
+			continue
+		}
+
+		resourceName := resource.Name
+		changes = append(changes, change{
+			description: fmt.Sprintf("unpin '%s/%s'", pipeline.Name, resourceName),
+			action: func() error {
+				_, err := team.UnpinResource(pipeline.Name, resourceName)
+				return err
+			},
+			needsPause: true,
+		})
+	}
+
+	return changes, resolved, nil
+}
+
+func (importer *Importer) pinCommentChange(pipelineName string, pin Pin) change {
+	return change{
+		description: fmt.Sprintf("set pin comment of '%s/%s' to '%s'", pipelineName, pin.Resource, pin.Comment),
+		action: func() error {
+			_, err := importer.Team.SetPinComment(pipelineName, pin.Resource, pin.Comment)
+			return err
+		},
+	}
+}
+
+// disabledVersionChanges returns the changes needed for the versions disabled
+// in the archive to be disabled, and whether every one of them could be found.
+func (importer *Importer) disabledVersionChanges(pipeline Pipeline) ([]change, bool, error) {
+	var changes []change
+	resolved := true
+
+	for _, disabled := range pipeline.DisabledVersions {
+		version, found, err := importer.findVersion(pipeline.Name, disabled.Resource, disabled.Version)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if !found {
+			resolved = false
+			continue
+		}
+
+		if !version.Enabled {
+			continue
+		}
+
+		resourceName := disabled.Resource
+		changes = append(changes, change{
+			description: fmt.Sprintf("disable version %s of '%s/%s'", versionString(disabled.Version), pipeline.Name, resourceName),
+			action: func() error {
+				_, err := importer.Team.DisableResourceVersion(pipeline.Name, resourceName, version.ID)
+				return err
+			},
+			needsPause: true,
+		})
+	}
+
+	return changes, resolved, nil
+}
+
+// findVersion looks up a version on the importing installation. A
+// version only exists once the resource has checked it, so versions which
+// cannot be found yet are skipped with a warning; importing again after the
+// resource has been checked picks them up.
+func (importer *Importer) findVersion(pipelineName string, resourceName string, version atc.Version) (atc.ResourceVersion, bool, error) {
+	versions, _, found, err := importer.Team.ResourceVersions(pipelineName, resourceName, concourse.Page{Limit: 1}, version)
+	if err != nil {
+		return atc.ResourceVersion{}, false, err
+	}
+
+	if found && len(versions) > 0 {
+		return versions[0], true, nil
+	}
+
+	importer.changed = true
+
+	if importer.DryRun {
+		// the pipeline or its resources may only be created by the import
+		fmt.Fprintf(importer.Output, "would look up version %s of '%s/%s' once it has been checked\n", versionString(version), pipelineName, resourceName)
+	} else {
+		importer.warn("version %s of '%s/%s' not found; check the resource and import again", versionString(version), pipelineName, resourceName)
+	}
+
+	return atc.ResourceVersion{}, false, nil
+}
+
+func (importer *Importer) apply(description string, action func() error) error {
+	importer.changed = true
+
+	if importer.DryRun {
+		fmt.Fprintf(importer.Output, "would %s\n", description)
+		return nil
+	}
+
+	fmt.Fprintln(importer.Output, description)
+
+	return action()
+}
+
+func (importer *Importer) warn(message string, params ...interface{}) {
+	fmt.Fprintf(importer.Output, "warning: "+message+"\n", params...)
+}
+
+// samePriority tells whether applying the archived default job priority would
+// leave the existing one unchanged. Archives without one leave it as-is.
+func samePriority(existing *int, archived *int) bool {
+	if archived == nil {
+		return true
+	}
+
+	return existing != nil && *existing == *archived
+}
+
+func versionString(version atc.Version) string {
+	payload, _ := json.Marshal(version)
+	return string(payload)
+}
+
+func containsString(list []string, str string) bool {
+	for _, s := range list {
+		if s == str {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,286 @@
+package teamarchive_test
+
+import (
+	"bytes"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/teamarchive"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/concourse/concourse/go-concourse/concourse/concoursefakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Importer", func() {
+	var (
+		fakeTeam *concoursefakes.FakeTeam
+		output   *gbytes.Buffer
+
+		importer *teamarchive.Importer
+		archive  teamarchive.Archive
+		config   atc.Config
+		auth     atc.TeamAuth
+
+		changed   bool
+		importErr error
+	)
+
+	BeforeEach(func() {
+		fakeTeam = new(concoursefakes.FakeTeam)
+		fakeTeam.NameReturns("some-team")
+
+		output = gbytes.NewBuffer()
+
+		importer = &teamarchive.Importer{
+			Team:   fakeTeam,
+			Output: output,
+			Diff:   new(bytes.Buffer),
+		}
+
+		config = atc.Config{
+			Resources: atc.ResourceConfigs{
+				{Name: "some-resource", Type: "git"},
+				{Name: "other-resource", Type: "git"},
+			},
+		}
+
+		auth = atc.TeamAuth{
+			"owner": {"users": []string{"local:some-user"}},
+		}
+
+		archive = teamarchive.Archive{
+			Team: atc.Team{Name: "some-team", Auth: auth},
+			Pipelines: []teamarchive.Pipeline{
+				{
+					Name:   "some-pipeline",
+					Public: true,
+					Config: config,
+					Pins: []teamarchive.Pin{
+						{Resource: "some-resource", Version: atc.Version{"ref": "abc"}, Comment: "some comment"},
+					},
+					DisabledVersions: []teamarchive.DisabledVersion{
+						{Resource: "some-resource", Version: atc.Version{"ref": "def"}},
+					},
+				},
+			},
+		}
+
+		fakeTeam.ResourceVersionsStub = func(pipeline string, resource string, page concourse.Page, filter atc.Version) ([]atc.ResourceVersion, concourse.Pagination, bool, error) {
+			switch filter["ref"] {
+			case "abc":
+				return []atc.ResourceVersion{{ID: 1, Version: filter, Enabled: true}}, concourse.Pagination{}, true, nil
+			case "def":
+				return []atc.ResourceVersion{{ID: 2, Version: filter, Enabled: true}}, concourse.Pagination{}, true, nil
+			}
+
+			return nil, concourse.Pagination{}, false, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		changed, importErr = importer.Import(archive)
+	})
+
+	Context("when the team does not exist", func() {
+		BeforeEach(func() {
+			fakeTeam.TeamReturns(atc.Team{}, false, nil)
+			fakeTeam.ResourceVersionsReturns(nil, concourse.Pagination{}, false, nil)
+		})
+
+		Context("in a dry run", func() {
+			BeforeEach(func() {
+				importer.DryRun = true
+			})
+
+			It("shows the changes without making them", func() {
+				Expect(importErr).ToNot(HaveOccurred())
+				Expect(changed).To(BeTrue())
+
+				Expect(output).To(gbytes.Say("would create team 'some-team'"))
+				Expect(output).To(gbytes.Say("would set pipeline 'some-pipeline'"))
+				Expect(output).To(gbytes.Say("would expose pipeline 'some-pipeline'"))
+				Expect(output).To(gbytes.Say("would order pipelines"))
+
+				Expect(fakeTeam.CreateOrUpdateCallCount()).To(Equal(0))
+				Expect(fakeTeam.CreateOrUpdatePipelineConfigCallCount()).To(Equal(0))
+				Expect(fakeTeam.ExposePipelineCallCount()).To(Equal(0))
+				Expect(fakeTeam.ListPipelinesCallCount()).To(Equal(0))
+			})
+		})
+
+		It("creates the team and its pipelines", func() {
+			Expect(importErr).ToNot(HaveOccurred())
+
+			Expect(fakeTeam.CreateOrUpdateCallCount()).To(Equal(1))
+			Expect(fakeTeam.CreateOrUpdateArgsForCall(0)).To(Equal(atc.Team{Auth: auth}))
+
+			Expect(fakeTeam.CreateOrUpdatePipelineConfigCallCount()).To(Equal(1))
+			name, _, _, _ := fakeTeam.CreateOrUpdatePipelineConfigArgsForCall(0)
+			Expect(name).To(Equal("some-pipeline"))
+
+			Expect(fakeTeam.ExposePipelineCallCount()).To(Equal(1))
+		})
+
+		It("leaves the pipelines paused until their versions have been checked", func() {
+			Expect(importErr).ToNot(HaveOccurred())
+
+			Expect(output).To(gbytes.Say("warning: leaving pipeline 'some-pipeline' paused"))
+
+			Expect(fakeTeam.PausePipelineCallCount()).To(Equal(0))
+			Expect(fakeTeam.UnpausePipelineCallCount()).To(Equal(0))
+		})
+
+		Context("when the archived versions can be found", func() {
+			BeforeEach(func() {
+				fakeTeam.ResourceVersionsStub = func(pipeline string, resource string, page concourse.Page, filter atc.Version) ([]atc.ResourceVersion, concourse.Pagination, bool, error) {
+					return []atc.ResourceVersion{{ID: 1, Version: filter, Enabled: true}}, concourse.Pagination{}, true, nil
+				}
+			})
+
+			It("unpauses the pipelines after pinning and disabling the versions", func() {
+				Expect(importErr).ToNot(HaveOccurred())
+
+				Expect(fakeTeam.PinResourceVersionCallCount()).To(Equal(1))
+				Expect(fakeTeam.DisableResourceVersionCallCount()).To(Equal(1))
+				Expect(fakeTeam.UnpausePipelineCallCount()).To(Equal(1))
+
+				Expect(output).To(gbytes.Say("pin 'some-pipeline/some-resource'"))
+				Expect(output).To(gbytes.Say("disable version"))
+				Expect(output).To(gbytes.Say("unpause pipeline 'some-pipeline'"))
+			})
+		})
+	})
+
+	Context("when the team matches the archive", func() {
+		BeforeEach(func() {
+			defaultJobPriority := 3
+
+			fakeTeam.TeamReturns(atc.Team{Name: "some-team", Auth: auth, DefaultJobPriority: &defaultJobPriority}, true, nil)
+			fakeTeam.ListPipelinesReturns([]atc.Pipeline{
+				{Name: "some-pipeline", Public: true},
+			}, nil)
+			fakeTeam.PipelineConfigReturns(config, "1", true, nil)
+			fakeTeam.ListResourcesReturns([]atc.Resource{
+				{Name: "some-resource", PinnedVersion: atc.Version{"ref": "abc"}, PinComment: "some comment"},
+				{Name: "other-resource", PinnedVersion: atc.Version{"ref": "xyz"}, PinnedInConfig: true},
+			}, nil)
+			fakeTeam.ResourceVersionsReturns([]atc.ResourceVersion{{ID: 2, Enabled: false}}, concourse.Pagination{}, true, nil)
+		})
+
+		It("changes nothing", func() {
+			Expect(importErr).ToNot(HaveOccurred())
+			Expect(changed).To(BeFalse())
+
+			Expect(fakeTeam.CreateOrUpdateCallCount()).To(Equal(0))
+			Expect(fakeTeam.CreateOrUpdatePipelineConfigCallCount()).To(Equal(0))
+			Expect(fakeTeam.UnpausePipelineCallCount()).To(Equal(0))
+			Expect(fakeTeam.PinResourceVersionCallCount()).To(Equal(0))
+			Expect(fakeTeam.UnpinResourceCallCount()).To(Equal(0))
+			Expect(fakeTeam.DisableResourceVersionCallCount()).To(Equal(0))
+			Expect(fakeTeam.OrderingPipelinesCallCount()).To(Equal(0))
+		})
+
+		Context("when the archive has a different default job priority", func() {
+			BeforeEach(func() {
+				defaultJobPriority := 5
+				archive.Team.DefaultJobPriority = &defaultJobPriority
+			})
+
+			It("updates the team", func() {
+				Expect(importErr).ToNot(HaveOccurred())
+				Expect(changed).To(BeTrue())
+
+				Expect(fakeTeam.CreateOrUpdateCallCount()).To(Equal(1))
+				Expect(*fakeTeam.CreateOrUpdateArgsForCall(0).DefaultJobPriority).To(Equal(5))
+			})
+		})
+	})
+
+	Context("when the pipeline state differs from the archive", func() {
+		BeforeEach(func() {
+			fakeTeam.TeamReturns(atc.Team{Name: "some-team", Auth: auth}, true, nil)
+			fakeTeam.ListPipelinesReturns([]atc.Pipeline{
+				{Name: "other-pipeline"},
+				{Name: "some-pipeline", Paused: true},
+			}, nil)
+			fakeTeam.PipelineConfigReturns(config, "1", true, nil)
+			fakeTeam.ListResourcesReturns([]atc.Resource{
+				{Name: "some-resource"},
+				{Name: "other-resource", PinnedVersion: atc.Version{"ref": "xyz"}},
+			}, nil)
+		})
+
+		It("applies the archived state", func() {
+			Expect(importErr).ToNot(HaveOccurred())
+			Expect(changed).To(BeTrue())
+
+			Expect(fakeTeam.CreateOrUpdatePipelineConfigCallCount()).To(Equal(0))
+
+			Expect(fakeTeam.UnpausePipelineCallCount()).To(Equal(1))
+			Expect(fakeTeam.ExposePipelineCallCount()).To(Equal(1))
+
+			Expect(fakeTeam.PinResourceVersionCallCount()).To(Equal(1))
+			pipeline, resource, id := fakeTeam.PinResourceVersionArgsForCall(0)
+			Expect([]interface{}{pipeline, resource, id}).To(Equal([]interface{}{"some-pipeline", "some-resource", 1}))
+
+			Expect(fakeTeam.SetPinCommentCallCount()).To(Equal(1))
+			_, _, comment := fakeTeam.SetPinCommentArgsForCall(0)
+			Expect(comment).To(Equal("some comment"))
+
+			Expect(fakeTeam.UnpinResourceCallCount()).To(Equal(1))
+			_, resource = fakeTeam.UnpinResourceArgsForCall(0)
+			Expect(resource).To(Equal("other-resource"))
+
+			Expect(fakeTeam.DisableResourceVersionCallCount()).To(Equal(1))
+			_, resource, id = fakeTeam.DisableResourceVersionArgsForCall(0)
+			Expect(resource).To(Equal("some-resource"))
+			Expect(id).To(Equal(2))
+
+			Expect(fakeTeam.OrderingPipelinesCallCount()).To(Equal(1))
+			Expect(fakeTeam.OrderingPipelinesArgsForCall(0)).To(Equal([]string{"some-pipeline", "other-pipeline"}))
+		})
+	})
+
+	Context("when a pinned version has not been checked yet", func() {
+		BeforeEach(func() {
+			fakeTeam.TeamReturns(atc.Team{Name: "some-team", Auth: auth}, true, nil)
+			fakeTeam.ListPipelinesReturns([]atc.Pipeline{{Name: "some-pipeline", Public: true}}, nil)
+			fakeTeam.PipelineConfigReturns(config, "1", true, nil)
+			fakeTeam.ResourceVersionsReturns(nil, concourse.Pagination{}, true, nil)
+		})
+
+		It("warns and pauses the pipeline until it is found", func() {
+			Expect(importErr).ToNot(HaveOccurred())
+			Expect(output).To(gbytes.Say(`warning: version {"ref":"abc"} of 'some-pipeline/some-resource' not found`))
+			Expect(output).To(gbytes.Say(`pause pipeline 'some-pipeline'`))
+			Expect(output).To(gbytes.Say(`warning: leaving pipeline 'some-pipeline' paused`))
+
+			Expect(fakeTeam.PinResourceVersionCallCount()).To(Equal(0))
+			Expect(fakeTeam.PausePipelineCallCount()).To(Equal(1))
+			Expect(fakeTeam.UnpausePipelineCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when an unpaused pipeline has versions to pin", func() {
+		BeforeEach(func() {
+			fakeTeam.TeamReturns(atc.Team{Name: "some-team", Auth: auth}, true, nil)
+			fakeTeam.ListPipelinesReturns([]atc.Pipeline{{Name: "some-pipeline", Public: true}}, nil)
+			fakeTeam.PipelineConfigReturns(config, "1", true, nil)
+			fakeTeam.ListResourcesReturns([]atc.Resource{{Name: "some-resource"}}, nil)
+		})
+
+		It("pauses it while pinning them", func() {
+			Expect(importErr).ToNot(HaveOccurred())
+
+			Expect(output).To(gbytes.Say(`pause pipeline 'some-pipeline'`))
+			Expect(output).To(gbytes.Say(`pin 'some-pipeline/some-resource'`))
+			Expect(output).To(gbytes.Say(`disable version`))
+			Expect(output).To(gbytes.Say(`unpause pipeline 'some-pipeline'`))
+
+			Expect(fakeTeam.PausePipelineCallCount()).To(Equal(1))
+			Expect(fakeTeam.UnpausePipelineCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package teamarchive_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestTeamArchive(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Team Archive Suite")
+}
@@ -0,0 +1,148 @@
+package top
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/eventstream"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+// maxLogLines is the number of lines of a build's log kept in memory.
+const maxLogLines = 10000
+
+var escapeSequence = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]`)
+
+// buildLog streams a build's events and keeps the rendered log as lines that
+// fit the dashboard's layout. Escape sequences are stripped, since they would
+// throw off the width of each line.
+type buildLog struct {
+	build  atc.Build
+	events concourse.Events
+
+	lock    sync.Mutex
+	lines   []string
+	partial string
+	done    bool
+
+	// scroll is how many lines from the bottom the view is scrolled up by;
+	// zero means the view follows the end of the log.
+	scroll int
+
+	changed chan<- struct{}
+}
+
+func streamBuildLog(build atc.Build, events concourse.Events, changed chan<- struct{}) *buildLog {
+	log := &buildLog{
+		build:   build,
+		events:  events,
+		changed: changed,
+	}
+
+	go func() {
+		eventstream.Render(log, events, eventstream.RenderOptions{})
+
+		log.lock.Lock()
+		log.done = true
+		log.lock.Unlock()
+
+		log.notify()
+	}()
+
+	return log
+}
+
+func (log *buildLog) Write(p []byte) (int, error) {
+	log.lock.Lock()
+
+	text := log.partial + strings.Replace(escapeSequence.ReplaceAllString(string(p), ""), "\t", "    ", -1)
+
+	segs := strings.Split(text, "\n")
+	for _, line := range segs[:len(segs)-1] {
+		log.lines = append(log.lines, lastCarriageReturn(line))
+	}
+
+	log.partial = segs[len(segs)-1]
+
+	if len(log.lines) > maxLogLines {
+		log.lines = log.lines[len(log.lines)-maxLogLines:]
+	}
+
+	log.lock.Unlock()
+
+	log.notify()
+
+	return len(p), nil
+}
+
+// Lines returns the lines visible in a view of the given height, honoring the
+// current scroll position.
+func (log *buildLog) Lines(height int) ([]string, bool) {
+	log.lock.Lock()
+	defer log.lock.Unlock()
+
+	lines := log.lines
+	if log.partial != "" {
+		lines = append(lines[:len(lines):len(lines)], lastCarriageReturn(log.partial))
+	}
+
+	maxScroll := len(lines) - height
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+
+	if log.scroll > maxScroll {
+		log.scroll = maxScroll
+	}
+
+	end := len(lines) - log.scroll
+
+	start := end - height
+	if start < 0 {
+		start = 0
+	}
+
+	return lines[start:end], log.done
+}
+
+func (log *buildLog) Scroll(delta int) {
+	log.lock.Lock()
+	defer log.lock.Unlock()
+
+	log.scroll += delta
+	if log.scroll < 0 {
+		log.scroll = 0
+	}
+}
+
+func (log *buildLog) Follow() {
+	log.lock.Lock()
+	defer log.lock.Unlock()
+
+	log.scroll = 0
+}
+
+func (log *buildLog) Close() error {
+	return log.events.Close()
+}
+
+func (log *buildLog) notify() {
+	select {
+	case log.changed <- struct{}{}:
+	default:
+	}
+}
+
+// lastCarriageReturn returns what a terminal would show for a line that
+// rewrites itself with carriage returns, as progress bars do.
+func lastCarriageReturn(line string) string {
+	line = strings.TrimRight(line, "\r")
+
+	if i := strings.LastIndex(line, "\r"); i != -1 {
+		return line[i+1:]
+	}
+
+	return line
+}
@@ -0,0 +1,613 @@
+package top
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+// Action tells the event loop what to do after a key has been handled.
+type Action int
+
+const (
+	ActionNone Action = iota
+	ActionRefresh
+	ActionQuit
+)
+
+type pane int
+
+const (
+	pipelinesPane pane = iota
+	runningPane
+	pendingPane
+	workersPane
+
+	paneCount
+)
+
+var paneTitles = [paneCount]string{
+	"pipelines",
+	"running builds",
+	"pending builds",
+	"workers",
+}
+
+const (
+	minWidth  = 20
+	minHeight = 10
+)
+
+const (
+	reverseVideo = "\x1b[7m"
+	bold         = "\x1b[1m"
+	resetStyle   = "\x1b[0m"
+)
+
+// Dashboard holds the state of the interactive view: the latest snapshot, the
+// focused pane and selection, and the build log being viewed, if any.
+type Dashboard struct {
+	client concourse.Client
+	team   concourse.Team
+
+	snapshot Snapshot
+
+	focus   pane
+	cursors [paneCount]int
+
+	message string
+
+	confirmPrompt string
+	confirmAction func() string
+
+	log *buildLog
+
+	changed chan struct{}
+}
+
+func NewDashboard(client concourse.Client, team concourse.Team) *Dashboard {
+	return &Dashboard{
+		client:  client,
+		team:    team,
+		changed: make(chan struct{}, 1),
+	}
+}
+
+// Changed receives a value whenever the view changes on its own, i.e. when a
+// streamed build log receives output.
+func (dashboard *Dashboard) Changed() <-chan struct{} {
+	return dashboard.changed
+}
+
+// ViewingLog returns true if a build's log is being viewed, in which case the
+// dashboard does not need to be refreshed periodically.
+func (dashboard *Dashboard) ViewingLog() bool {
+	return dashboard.log != nil
+}
+
+func (dashboard *Dashboard) Update(snapshot Snapshot) {
+	dashboard.snapshot = snapshot
+
+	for p := pane(0); p < paneCount; p++ {
+		dashboard.cursors[p] = clamp(dashboard.cursors[p], 0, dashboard.rowCount(p)-1)
+	}
+}
+
+func (dashboard *Dashboard) Close() {
+	if dashboard.log != nil {
+		dashboard.log.Close()
+		dashboard.log = nil
+	}
+}
+
+func (dashboard *Dashboard) HandleKey(key string) Action {
+	if key == KeyCtrlC {
+		return ActionQuit
+	}
+
+	if dashboard.confirmAction != nil {
+		action := dashboard.confirmAction
+
+		dashboard.confirmPrompt = ""
+		dashboard.confirmAction = nil
+
+		if key == "y" {
+			dashboard.message = action()
+			return ActionRefresh
+		}
+
+		dashboard.message = "cancelled"
+		return ActionNone
+	}
+
+	dashboard.message = ""
+
+	if dashboard.log != nil {
+		return dashboard.handleLogKey(key)
+	}
+
+	switch key {
+	case "q":
+		return ActionQuit
+
+	case "r":
+		return ActionRefresh
+
+	case KeyTab:
+		dashboard.focus = (dashboard.focus + 1) % paneCount
+
+	case "1", "2", "3", "4":
+		dashboard.focus = pane(key[0] - '1')
+
+	case KeyUp, "k":
+		dashboard.moveCursor(-1)
+
+	case KeyDown, "j":
+		dashboard.moveCursor(1)
+
+	case KeyPageUp:
+		dashboard.moveCursor(-10)
+
+	case KeyPageDown:
+		dashboard.moveCursor(10)
+
+	case KeyEnter, "l":
+		if build, ok := dashboard.selectedBuild(); ok {
+			dashboard.viewLog(build)
+		}
+
+	case "a":
+		if build, ok := dashboard.selectedBuild(); ok {
+			dashboard.confirmAbort(build)
+		}
+
+	case "t":
+		if build, ok := dashboard.selectedBuild(); ok && build.JobName != "" {
+			dashboard.message = dashboard.trigger(build.PipelineName, build.JobName)
+			return ActionRefresh
+		}
+
+	case "p", "u":
+		dashboard.message = dashboard.pause(key == "p")
+		return ActionRefresh
+
+	case "n":
+		if build, ok := dashboard.selectedBuild(); ok && build.PipelineName != "" {
+			dashboard.confirmPin(build)
+		}
+
+	case "N":
+		dashboard.message = dashboard.unpin()
+		return ActionRefresh
+	}
+
+	return ActionNone
+}
+
+func (dashboard *Dashboard) handleLogKey(key string) Action {
+	switch key {
+	case "q", KeyEscape, KeyBackspace, "h":
+		dashboard.Close()
+		return ActionRefresh
+
+	case KeyUp, "k":
+		dashboard.log.Scroll(1)
+
+	case KeyDown, "j":
+		dashboard.log.Scroll(-1)
+
+	case KeyPageUp:
+		dashboard.log.Scroll(20)
+
+	case KeyPageDown:
+		dashboard.log.Scroll(-20)
+
+	case "f", "G":
+		dashboard.log.Follow()
+
+	case "a":
+		dashboard.confirmAbort(dashboard.log.build)
+	}
+
+	return ActionNone
+}
+
+func (dashboard *Dashboard) moveCursor(delta int) {
+	dashboard.cursors[dashboard.focus] = clamp(
+		dashboard.cursors[dashboard.focus]+delta,
+		0,
+		dashboard.rowCount(dashboard.focus)-1,
+	)
+}
+
+func (dashboard *Dashboard) selectedBuild() (atc.Build, bool) {
+	var builds []atc.Build
+	switch dashboard.focus {
+	case runningPane:
+		builds = dashboard.snapshot.Running
+	case pendingPane:
+		builds = dashboard.snapshot.Pending
+	default:
+		return atc.Build{}, false
+	}
+
+	cursor := dashboard.cursors[dashboard.focus]
+	if cursor >= len(builds) {
+		return atc.Build{}, false
+	}
+
+	return builds[cursor], true
+}
+
+func (dashboard *Dashboard) selectedPipeline() (atc.Pipeline, bool) {
+	if dashboard.focus != pipelinesPane {
+		return atc.Pipeline{}, false
+	}
+
+	cursor := dashboard.cursors[pipelinesPane]
+	if cursor >= len(dashboard.snapshot.Pipelines) {
+		return atc.Pipeline{}, false
+	}
+
+	return dashboard.snapshot.Pipelines[cursor], true
+}
+
+func (dashboard *Dashboard) viewLog(build atc.Build) {
+	events, err := dashboard.client.BuildEvents(strconv.Itoa(build.ID))
+	if err != nil {
+		dashboard.message = fmt.Sprintf("failed to stream build %d: %s", build.ID, err)
+		return
+	}
+
+	dashboard.log = streamBuildLog(build, events, dashboard.changed)
+}
+
+func (dashboard *Dashboard) confirmAbort(build atc.Build) {
+	dashboard.confirmPrompt = fmt.Sprintf("abort build %s? (y/n)", buildName(build))
+	dashboard.confirmAction = func() string {
+		err := dashboard.client.AbortBuild(strconv.Itoa(build.ID))
+		if err != nil {
+			return fmt.Sprintf("failed to abort build %s: %s", buildName(build), err)
+		}
+
+		return fmt.Sprintf("aborted build %s", buildName(build))
+	}
+}
+
+func (dashboard *Dashboard) confirmPin(build atc.Build) {
+	dashboard.confirmPrompt = fmt.Sprintf("pin every resource of pipeline %s to the versions used by build %s? (y/n)", build.PipelineName, buildName(build))
+	dashboard.confirmAction = func() string {
+		pinned, found, err := dashboard.team.FreezePipeline(build.PipelineName, build.ID, "pinned with fly top")
+		if err != nil {
+			return fmt.Sprintf("failed to pin pipeline %s: %s", build.PipelineName, err)
+		}
+
+		if !found {
+			return fmt.Sprintf("pipeline %s not found", build.PipelineName)
+		}
+
+		return fmt.Sprintf("pinned %d resources of pipeline %s", len(pinned), build.PipelineName)
+	}
+}
+
+func (dashboard *Dashboard) trigger(pipelineName string, jobName string) string {
+	build, err := dashboard.team.CreateJobBuild(pipelineName, jobName)
+	if err != nil {
+		return fmt.Sprintf("failed to trigger %s/%s: %s", pipelineName, jobName, err)
+	}
+
+	return fmt.Sprintf("started %s/%s #%s", pipelineName, jobName, build.Name)
+}
+
+// pause pauses or unpauses the selected pipeline, or the job of the selected
+// build.
+func (dashboard *Dashboard) pause(pause bool) string {
+	verb := "unpause"
+	if pause {
+		verb = "pause"
+	}
+
+	var name string
+	var found bool
+	var err error
+
+	if pipeline, ok := dashboard.selectedPipeline(); ok {
+		name = pipeline.Name
+
+		if pause {
+			found, err = dashboard.team.PausePipeline(pipeline.Name)
+		} else {
+			found, err = dashboard.team.UnpausePipeline(pipeline.Name)
+		}
+	} else if build, ok := dashboard.selectedBuild(); ok && build.JobName != "" {
+		name = build.PipelineName + "/" + build.JobName
+
+		if pause {
+			found, err = dashboard.team.PauseJob(build.PipelineName, build.JobName)
+		} else {
+			found, err = dashboard.team.UnpauseJob(build.PipelineName, build.JobName)
+		}
+	} else {
+		return "select a pipeline or a job's build to " + verb
+	}
+
+	if err != nil {
+		return fmt.Sprintf("failed to %s %s: %s", verb, name, err)
+	}
+
+	if !found {
+		return fmt.Sprintf("%s not found", name)
+	}
+
+	return verb + "d " + name
+}
+
+// unpin restores the pins of the selected pipeline, or the pipeline of the
+// selected build, to what they were before it was pinned.
+func (dashboard *Dashboard) unpin() string {
+	var name string
+	if pipeline, ok := dashboard.selectedPipeline(); ok {
+		name = pipeline.Name
+	} else if build, ok := dashboard.selectedBuild(); ok && build.PipelineName != "" {
+		name = build.PipelineName
+	} else {
+		return "select a pipeline or a pipeline's build to unpin"
+	}
+
+	found, err := dashboard.team.UnfreezePipeline(name)
+	if err != nil {
+		return fmt.Sprintf("failed to unpin pipeline %s: %s", name, err)
+	}
+
+	if !found {
+		return fmt.Sprintf("pipeline %s not found", name)
+	}
+
+	return "unpinned pipeline " + name
+}
+
+// Lines renders the dashboard for a terminal of the given size.
+func (dashboard *Dashboard) Lines(width int, height int) []string {
+	if width < minWidth || height < minHeight {
+		return []string{fit("terminal too small", width)}
+	}
+
+	lines := []string{dashboard.headerLine(width)}
+
+	bodyHeight := height - 2
+	if dashboard.log != nil {
+		lines = append(lines, dashboard.logLines(width, bodyHeight)...)
+	} else {
+		lines = append(lines, dashboard.paneLines(width, bodyHeight)...)
+	}
+
+	for len(lines) < height-1 {
+		lines = append(lines, "")
+	}
+
+	lines = append(lines, dashboard.footerLine(width))
+
+	return lines[:height]
+}
+
+func (dashboard *Dashboard) headerLine(width int) string {
+	header := fmt.Sprintf("fly top - %s - team %s", dashboard.client.URL(), dashboard.team.Name())
+
+	status := "loading..."
+	if !dashboard.snapshot.FetchedAt.IsZero() {
+		status = "updated " + dashboard.snapshot.FetchedAt.Format("15:04:05")
+	}
+
+	if dashboard.snapshot.Err != nil {
+		status = "error: " + dashboard.snapshot.Err.Error()
+	}
+
+	return bold + fit(header+"  "+status, width) + resetStyle
+}
+
+func (dashboard *Dashboard) footerLine(width int) string {
+	if dashboard.confirmPrompt != "" {
+		return bold + fit(dashboard.confirmPrompt, width) + resetStyle
+	}
+
+	if dashboard.message != "" {
+		return fit(dashboard.message, width)
+	}
+
+	if dashboard.log != nil {
+		return reverseVideo + fit("q back  ↑/↓ scroll  f follow  a abort  ctrl-c quit", width) + resetStyle
+	}
+
+	return reverseVideo + fit("tab/1-4 pane  ↑/↓ select  enter log  a abort  t trigger  p/u pause/unpause  n/N pin/unpin  r refresh  q quit", width) + resetStyle
+}
+
+func (dashboard *Dashboard) logLines(width int, height int) []string {
+	build := dashboard.log.build
+
+	logLines, done := dashboard.log.Lines(height - 1)
+
+	title := "build " + buildName(build)
+	if done {
+		title += " (finished)"
+	}
+
+	lines := []string{bold + fit(title, width) + resetStyle}
+	for _, line := range logLines {
+		lines = append(lines, fit(line, width))
+	}
+
+	return lines
+}
+
+func (dashboard *Dashboard) paneLines(width int, height int) []string {
+	var lines []string
+
+	remaining := height
+	for p := pane(0); p < paneCount; p++ {
+		paneHeight := remaining / int(paneCount-p)
+		remaining -= paneHeight
+
+		lines = append(lines, dashboard.renderPane(p, width, paneHeight)...)
+	}
+
+	return lines
+}
+
+func (dashboard *Dashboard) renderPane(p pane, width int, height int) []string {
+	if height <= 0 {
+		return nil
+	}
+
+	title := fmt.Sprintf("[%d] %s (%d)", p+1, paneTitles[p], dashboard.rowCount(p))
+
+	titleStyle := bold
+	if p == dashboard.focus {
+		titleStyle = bold + reverseVideo
+	}
+
+	lines := []string{titleStyle + fit(title, width) + resetStyle}
+
+	rows := dashboard.rows(p)
+	visible := height - 1
+	if visible <= 0 {
+		return lines
+	}
+
+	cursor := dashboard.cursors[p]
+
+	offset := 0
+	if cursor >= visible {
+		offset = cursor - visible + 1
+	}
+
+	for i := offset; i < len(rows) && i < offset+visible; i++ {
+		row := fit(" "+rows[i], width)
+
+		if p == dashboard.focus && i == cursor {
+			row = reverseVideo + row + resetStyle
+		}
+
+		lines = append(lines, row)
+	}
+
+	for len(lines) < height {
+		lines = append(lines, "")
+	}
+
+	return lines
+}
+
+func (dashboard *Dashboard) rowCount(p pane) int {
+	switch p {
+	case pipelinesPane:
+		return len(dashboard.snapshot.Pipelines)
+	case runningPane:
+		return len(dashboard.snapshot.Running)
+	case pendingPane:
+		return len(dashboard.snapshot.Pending)
+	case workersPane:
+		return len(dashboard.snapshot.Workers)
+	}
+
+	return 0
+}
+
+func (dashboard *Dashboard) rows(p pane) []string {
+	var rows []string
+
+	switch p {
+	case pipelinesPane:
+		for _, pipeline := range dashboard.snapshot.Pipelines {
+			paused := "no"
+			if pipeline.Paused {
+				paused = "paused"
+			}
+
+			public := "private"
+			if pipeline.Public {
+				public = "public"
+			}
+
+			rows = append(rows, fmt.Sprintf("%-40s  %-6s  %s", pipeline.Name, paused, public))
+		}
+
+	case runningPane:
+		for _, build := range dashboard.snapshot.Running {
+			rows = append(rows, fmt.Sprintf("%-8d  %-50s  running for %s", build.ID, buildName(build), since(build.StartTime)))
+		}
+
+	case pendingPane:
+		for i, build := range dashboard.snapshot.Pending {
+			rows = append(rows, fmt.Sprintf("%-8d  %-50s  #%d in queue", build.ID, buildName(build), i+1))
+		}
+
+	case workersPane:
+		maxContainers := 1
+		for _, worker := range dashboard.snapshot.Workers {
+			if worker.ActiveContainers > maxContainers {
+				maxContainers = worker.ActiveContainers
+			}
+		}
+
+		for _, worker := range dashboard.snapshot.Workers {
+			rows = append(rows, fmt.Sprintf("%-30s  %-8s  %s %4d containers  %3d tasks",
+				worker.Name,
+				worker.State,
+				loadBar(worker.ActiveContainers, maxContainers, 20),
+				worker.ActiveContainers,
+				worker.ActiveTasks,
+			))
+		}
+	}
+
+	return rows
+}
+
+func buildName(build atc.Build) string {
+	if build.JobName == "" {
+		return fmt.Sprintf("%d (one-off)", build.ID)
+	}
+
+	return fmt.Sprintf("%s/%s #%s", build.PipelineName, build.JobName, build.Name)
+}
+
+func since(unix int64) string {
+	if unix == 0 {
+		return "n/a"
+	}
+
+	return time.Since(time.Unix(unix, 0)).Truncate(time.Second).String()
+}
+
+func loadBar(value int, max int, width int) string {
+	filled := value * width / max
+	return "[" + strings.Repeat("#", filled) + strings.Repeat(" ", width-filled) + "]"
+}
+
+// fit truncates or pads the line to exactly the given width.
+func fit(line string, width int) string {
+	length := utf8.RuneCountInString(line)
+
+	if length > width {
+		return string([]rune(line)[:width])
+	}
+
+	return line + strings.Repeat(" ", width-length)
+}
+
+func clamp(value int, min int, max int) int {
+	if value > max {
+		value = max
+	}
+
+	if value < min {
+		value = min
+	}
+
+	return value
+}
@@ -0,0 +1,239 @@
+package top_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	. "github.com/concourse/concourse/fly/commands/internal/top"
+	"github.com/concourse/concourse/go-concourse/concourse/concoursefakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Dashboard", func() {
+	var (
+		fakeClient *concoursefakes.FakeClient
+		fakeTeam   *concoursefakes.FakeTeam
+
+		dashboard *Dashboard
+	)
+
+	BeforeEach(func() {
+		fakeClient = new(concoursefakes.FakeClient)
+		fakeClient.URLReturns("https://ci.example.com")
+
+		fakeTeam = new(concoursefakes.FakeTeam)
+		fakeTeam.NameReturns("main")
+
+		dashboard = NewDashboard(fakeClient, fakeTeam)
+		dashboard.Update(Snapshot{
+			Pipelines: []atc.Pipeline{
+				{Name: "some-pipeline"},
+				{Name: "other-pipeline", Paused: true},
+			},
+			Running: []atc.Build{
+				{ID: 42, Name: "7", PipelineName: "some-pipeline", JobName: "some-job", Status: "started"},
+			},
+			Pending: []atc.Build{
+				{ID: 43, Status: "pending"},
+			},
+			Workers: []atc.Worker{
+				{Name: "worker-1", State: "running", ActiveContainers: 10, ActiveTasks: 2},
+				{Name: "worker-2", State: "running", ActiveContainers: 5},
+			},
+			FetchedAt: time.Date(2019, 12, 1, 10, 30, 0, 0, time.Local),
+		})
+	})
+
+	screen := func() string {
+		return strings.Join(dashboard.Lines(120, 30), "\n")
+	}
+
+	Describe("Lines", func() {
+		It("renders exactly one line per row of the terminal", func() {
+			Expect(dashboard.Lines(120, 30)).To(HaveLen(30))
+		})
+
+		It("shows each pane", func() {
+			Expect(screen()).To(ContainSubstring("fly top - https://ci.example.com - team main  updated 10:30:00"))
+			Expect(screen()).To(ContainSubstring("[1] pipelines (2)"))
+			Expect(screen()).To(MatchRegexp(`other-pipeline\s+paused`))
+			Expect(screen()).To(ContainSubstring("[2] running builds (1)"))
+			Expect(screen()).To(MatchRegexp(`42\s+some-pipeline/some-job #7`))
+			Expect(screen()).To(ContainSubstring("[3] pending builds (1)"))
+			Expect(screen()).To(MatchRegexp(`43\s+43 \(one-off\)\s+#1 in queue`))
+			Expect(screen()).To(ContainSubstring("[4] workers (2)"))
+			Expect(screen()).To(MatchRegexp(`worker-1\s+running\s+\[#{20}\]\s+10 containers\s+2 tasks`))
+			Expect(screen()).To(MatchRegexp(`worker-2\s+running\s+\[#{10} {10}\]\s+5 containers\s+0 tasks`))
+		})
+
+		It("shows errors from the last refresh", func() {
+			dashboard.Update(Snapshot{Err: errors.New("oh no")})
+			Expect(screen()).To(ContainSubstring("error: oh no"))
+		})
+
+		It("does not lay out a terminal that is too small", func() {
+			Expect(dashboard.Lines(10, 5)).To(Equal([]string{"terminal too small"[:10]}))
+		})
+	})
+
+	Describe("HandleKey", func() {
+		It("quits on q", func() {
+			Expect(dashboard.HandleKey("q")).To(Equal(ActionQuit))
+		})
+
+		It("refreshes on r", func() {
+			Expect(dashboard.HandleKey("r")).To(Equal(ActionRefresh))
+		})
+
+		Context("when a pipeline is selected", func() {
+			BeforeEach(func() {
+				dashboard.HandleKey(KeyDown)
+			})
+
+			It("pauses it", func() {
+				fakeTeam.PausePipelineReturns(true, nil)
+
+				Expect(dashboard.HandleKey("p")).To(Equal(ActionRefresh))
+				Expect(fakeTeam.PausePipelineArgsForCall(0)).To(Equal("other-pipeline"))
+				Expect(screen()).To(ContainSubstring("paused other-pipeline"))
+			})
+
+			It("unpauses it", func() {
+				fakeTeam.UnpausePipelineReturns(true, nil)
+
+				dashboard.HandleKey("u")
+				Expect(fakeTeam.UnpausePipelineArgsForCall(0)).To(Equal("other-pipeline"))
+				Expect(screen()).To(ContainSubstring("unpaused other-pipeline"))
+			})
+
+			It("unpins it", func() {
+				fakeTeam.UnfreezePipelineReturns(true, nil)
+
+				dashboard.HandleKey("N")
+				Expect(fakeTeam.UnfreezePipelineArgsForCall(0)).To(Equal("other-pipeline"))
+			})
+		})
+
+		Context("when a running build is selected", func() {
+			BeforeEach(func() {
+				dashboard.HandleKey("2")
+			})
+
+			It("aborts it after confirmation", func() {
+				Expect(dashboard.HandleKey("a")).To(Equal(ActionNone))
+				Expect(screen()).To(ContainSubstring("abort build some-pipeline/some-job #7? (y/n)"))
+				Expect(fakeClient.AbortBuildCallCount()).To(Equal(0))
+
+				Expect(dashboard.HandleKey("y")).To(Equal(ActionRefresh))
+				Expect(fakeClient.AbortBuildCallCount()).To(Equal(1))
+				Expect(fakeClient.AbortBuildArgsForCall(0)).To(Equal("42"))
+				Expect(screen()).To(ContainSubstring("aborted build some-pipeline/some-job #7"))
+			})
+
+			It("does not abort it when not confirmed", func() {
+				dashboard.HandleKey("a")
+				dashboard.HandleKey("n")
+
+				Expect(fakeClient.AbortBuildCallCount()).To(Equal(0))
+				Expect(screen()).To(ContainSubstring("cancelled"))
+			})
+
+			It("triggers its job", func() {
+				fakeTeam.CreateJobBuildReturns(atc.Build{Name: "8"}, nil)
+
+				dashboard.HandleKey("t")
+
+				pipelineName, jobName := fakeTeam.CreateJobBuildArgsForCall(0)
+				Expect(pipelineName).To(Equal("some-pipeline"))
+				Expect(jobName).To(Equal("some-job"))
+				Expect(screen()).To(ContainSubstring("started some-pipeline/some-job #8"))
+			})
+
+			It("pauses its job", func() {
+				fakeTeam.PauseJobReturns(true, nil)
+
+				dashboard.HandleKey("p")
+
+				pipelineName, jobName := fakeTeam.PauseJobArgsForCall(0)
+				Expect(pipelineName).To(Equal("some-pipeline"))
+				Expect(jobName).To(Equal("some-job"))
+			})
+
+			It("pins its pipeline to its versions after confirmation", func() {
+				fakeTeam.FreezePipelineReturns([]atc.FrozenResource{{}, {}}, true, nil)
+
+				dashboard.HandleKey("n")
+				dashboard.HandleKey("y")
+
+				pipelineName, buildID, _ := fakeTeam.FreezePipelineArgsForCall(0)
+				Expect(pipelineName).To(Equal("some-pipeline"))
+				Expect(buildID).To(Equal(42))
+				Expect(screen()).To(ContainSubstring("pinned 2 resources of pipeline some-pipeline"))
+			})
+
+			Context("when entering its log", func() {
+				var events *fakeEvents
+
+				BeforeEach(func() {
+					events = &fakeEvents{events: make(chan atc.Event, 10)}
+					fakeClient.BuildEventsReturns(events, nil)
+
+					dashboard.HandleKey(KeyEnter)
+				})
+
+				AfterEach(func() {
+					dashboard.Close()
+				})
+
+				It("streams the build's log", func() {
+					Expect(fakeClient.BuildEventsArgsForCall(0)).To(Equal("42"))
+					Expect(dashboard.ViewingLog()).To(BeTrue())
+
+					events.events <- event.Log{Payload: "hello\x1b[31m world\x1b[0m\ndownloading 10%\rdownloading 100%\n"}
+
+					Eventually(dashboard.Changed()).Should(Receive())
+					Eventually(screen).Should(ContainSubstring("hello world"))
+					Eventually(screen).Should(ContainSubstring("downloading 100%"))
+					Expect(screen()).ToNot(ContainSubstring("downloading 10%"))
+				})
+
+				It("shows when the build finishes", func() {
+					close(events.events)
+
+					Eventually(screen).Should(ContainSubstring("build some-pipeline/some-job #7 (finished)"))
+				})
+
+				It("goes back to the dashboard and stops streaming", func() {
+					Expect(dashboard.HandleKey("q")).To(Equal(ActionRefresh))
+					Expect(dashboard.ViewingLog()).To(BeFalse())
+					Expect(events.closed).To(BeTrue())
+				})
+			})
+		})
+	})
+})
+
+type fakeEvents struct {
+	events chan atc.Event
+	closed bool
+}
+
+func (events *fakeEvents) NextEvent() (atc.Event, error) {
+	ev, ok := <-events.events
+	if !ok {
+		return nil, io.EOF
+	}
+
+	return ev, nil
+}
+
+func (events *fakeEvents) Close() error {
+	events.closed = true
+	return nil
+}
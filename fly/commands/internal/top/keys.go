@@ -0,0 +1,106 @@
+package top
+
+import (
+	"io"
+	"unicode/utf8"
+)
+
+const (
+	KeyUp        = "up"
+	KeyDown      = "down"
+	KeyPageUp    = "pgup"
+	KeyPageDown  = "pgdn"
+	KeyTab       = "tab"
+	KeyEnter     = "enter"
+	KeyEscape    = "esc"
+	KeyBackspace = "backspace"
+	KeyCtrlC     = "ctrl-c"
+)
+
+var escapeSequences = map[string]string{
+	"\x1b[A":  KeyUp,
+	"\x1b[B":  KeyDown,
+	"\x1bOA":  KeyUp,
+	"\x1bOB":  KeyDown,
+	"\x1b[5~": KeyPageUp,
+	"\x1b[6~": KeyPageDown,
+}
+
+// ParseKeys splits raw terminal input into key names. Printable characters are
+// returned as-is; unrecognized escape sequences are dropped.
+func ParseKeys(input []byte) []string {
+	var keys []string
+
+	for len(input) > 0 {
+		if input[0] == 0x1b {
+			if len(input) == 1 {
+				keys = append(keys, KeyEscape)
+				break
+			}
+
+			length := escapeSequenceLength(input)
+
+			if key, found := escapeSequences[string(input[:length])]; found {
+				keys = append(keys, key)
+			}
+
+			input = input[length:]
+			continue
+		}
+
+		switch input[0] {
+		case '\t':
+			keys = append(keys, KeyTab)
+		case '\r', '\n':
+			keys = append(keys, KeyEnter)
+		case 0x7f, 0x08:
+			keys = append(keys, KeyBackspace)
+		case 0x03:
+			keys = append(keys, KeyCtrlC)
+		default:
+			r, size := utf8.DecodeRune(input)
+			if r >= ' ' {
+				keys = append(keys, string(r))
+			}
+
+			input = input[size:]
+			continue
+		}
+
+		input = input[1:]
+	}
+
+	return keys
+}
+
+func escapeSequenceLength(input []byte) int {
+	if input[1] != '[' && input[1] != 'O' {
+		return 2
+	}
+
+	for i := 2; i < len(input); i++ {
+		if input[i] >= 0x40 && input[i] <= 0x7e {
+			return i + 1
+		}
+	}
+
+	return len(input)
+}
+
+func readKeys(input io.Reader, keys chan<- string) {
+	defer close(keys)
+
+	buf := make([]byte, 64)
+
+	for {
+		n, err := input.Read(buf)
+
+		for _, key := range ParseKeys(buf[:n]) {
+			keys <- key
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
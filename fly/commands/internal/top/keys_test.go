@@ -0,0 +1,23 @@
+package top_test
+
+import (
+	. "github.com/concourse/concourse/fly/commands/internal/top"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseKeys", func() {
+	DescribeTable("parsing raw terminal input",
+		func(input string, expected []string) {
+			Expect(ParseKeys([]byte(input))).To(Equal(expected))
+		},
+		Entry("printable characters", "aq", []string{"a", "q"}),
+		Entry("arrow keys", "\x1b[A\x1b[B\x1bOA", []string{KeyUp, KeyDown, KeyUp}),
+		Entry("page keys", "\x1b[5~\x1b[6~", []string{KeyPageUp, KeyPageDown}),
+		Entry("control characters", "\t\r\x7f\x03", []string{KeyTab, KeyEnter, KeyBackspace, KeyCtrlC}),
+		Entry("a lone escape", "\x1b", []string{KeyEscape}),
+		Entry("unknown escape sequences", "\x1b[1;5Cx", []string{"x"}),
+	)
+})
@@ -0,0 +1,107 @@
+package top
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+// recentBuilds is how many of the team's most recent builds are searched for
+// running builds on each refresh.
+const recentBuilds = 100
+
+// Snapshot is the state of a team at a point in time.
+type Snapshot struct {
+	Pipelines []atc.Pipeline
+	Running   []atc.Build
+	Pending   []atc.Build
+	Workers   []atc.Worker
+
+	FetchedAt time.Time
+	Err       error
+}
+
+// Fetch collects a snapshot of the team, making its requests concurrently.
+// The first error encountered is recorded in the snapshot; whatever could be
+// fetched is still returned.
+func Fetch(client concourse.Client, team concourse.Team) Snapshot {
+	var snapshot Snapshot
+
+	var wg sync.WaitGroup
+	var errLock sync.Mutex
+
+	recordErr := func(err error) {
+		errLock.Lock()
+		if snapshot.Err == nil {
+			snapshot.Err = err
+		}
+		errLock.Unlock()
+	}
+
+	wg.Add(4)
+
+	go func() {
+		defer wg.Done()
+
+		pipelines, err := team.ListPipelines()
+		if err != nil {
+			recordErr(err)
+			return
+		}
+
+		snapshot.Pipelines = pipelines
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		builds, _, err := team.Builds(concourse.Page{Limit: recentBuilds})
+		if err != nil {
+			recordErr(err)
+			return
+		}
+
+		for _, build := range builds {
+			if atc.BuildStatus(build.Status) == atc.StatusStarted {
+				snapshot.Running = append(snapshot.Running, build)
+			}
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		builds, err := team.ListQueue()
+		if err != nil {
+			recordErr(err)
+			return
+		}
+
+		snapshot.Pending = builds
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		workers, err := client.ListWorkers()
+		if err != nil {
+			recordErr(err)
+			return
+		}
+
+		sort.Slice(workers, func(i int, j int) bool {
+			return workers[i].Name < workers[j].Name
+		})
+
+		snapshot.Workers = workers
+	}()
+
+	wg.Wait()
+
+	snapshot.FetchedAt = time.Now()
+
+	return snapshot
+}
@@ -0,0 +1,154 @@
+package top
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+const (
+	enterAltScreen = "\x1b[?1049h"
+	exitAltScreen  = "\x1b[?1049l"
+	hideCursor     = "\x1b[?25l"
+	showCursor     = "\x1b[?25h"
+	clearScreen    = "\x1b[2J"
+	clearToEOL     = "\x1b[K"
+)
+
+// Top is an interactive dashboard of a team's pipelines, running and pending
+// builds, and the load on its workers. It only relies on plain ANSI escape
+// sequences, so it works over any terminal, including SSH sessions.
+type Top struct {
+	Client concourse.Client
+	Team   concourse.Team
+
+	Interval time.Duration
+
+	// Input should be the terminal in raw mode.
+	Input  io.Reader
+	Output io.Writer
+
+	// Size returns the current width and height of the terminal.
+	Size func() (int, int)
+
+	// Resized receives a value whenever the terminal is resized.
+	Resized <-chan os.Signal
+}
+
+// Run draws the dashboard and handles input until the user quits or the input
+// is closed.
+func (top Top) Run() error {
+	_, err := fmt.Fprint(top.Output, enterAltScreen+hideCursor+clearScreen)
+	if err != nil {
+		return err
+	}
+
+	defer fmt.Fprint(top.Output, showCursor+exitAltScreen)
+
+	dashboard := NewDashboard(top.Client, top.Team)
+	defer dashboard.Close()
+
+	keys := make(chan string)
+	go readKeys(top.Input, keys)
+
+	snapshots := make(chan Snapshot, 1)
+
+	// only one fetch is in flight at a time, so a slow API does not pile up
+	// requests
+	fetching := false
+	refresh := func() {
+		if fetching {
+			return
+		}
+
+		fetching = true
+
+		go func() {
+			snapshots <- Fetch(top.Client, top.Team)
+		}()
+	}
+
+	refresh()
+
+	ticker := time.NewTicker(top.Interval)
+	defer ticker.Stop()
+
+	screen := &frame{output: top.Output}
+
+	for {
+		width, height := top.Size()
+
+		err := screen.Draw(dashboard.Lines(width, height))
+		if err != nil {
+			return err
+		}
+
+		select {
+		case key, ok := <-keys:
+			if !ok {
+				return nil
+			}
+
+			switch dashboard.HandleKey(key) {
+			case ActionQuit:
+				return nil
+			case ActionRefresh:
+				refresh()
+			}
+
+		case snapshot := <-snapshots:
+			fetching = false
+			dashboard.Update(snapshot)
+
+		case <-ticker.C:
+			if !dashboard.ViewingLog() {
+				refresh()
+			}
+
+		case <-dashboard.Changed():
+
+		case <-top.Resized:
+			screen.Reset()
+		}
+	}
+}
+
+// frame draws full screens of lines, only rewriting the lines that changed
+// since the previous draw to keep the amount of output small.
+type frame struct {
+	output   io.Writer
+	previous []string
+}
+
+func (frame *frame) Draw(lines []string) error {
+	for i, line := range lines {
+		if i < len(frame.previous) && frame.previous[i] == line {
+			continue
+		}
+
+		_, err := fmt.Fprintf(frame.output, "\x1b[%d;1H%s%s", i+1, line, clearToEOL)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(lines) < len(frame.previous) {
+		_, err := fmt.Fprintf(frame.output, "\x1b[%d;1H\x1b[J", len(lines)+1)
+		if err != nil {
+			return err
+		}
+	}
+
+	frame.previous = lines
+
+	return nil
+}
+
+// Reset forces the next draw to redraw the whole screen.
+func (frame *frame) Reset() {
+	frame.previous = nil
+	fmt.Fprint(frame.output, clearScreen)
+}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"os"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
@@ -36,15 +37,11 @@ func (command *JobsCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(jobs)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := outputFormat(command.Json); !format.IsTable() {
+		return displayhelpers.PrintFormatted(os.Stdout, format, jobs)
 	}
 
-	headers = []string{"name", "paused", "status", "next"}
+	headers = []string{"name", "paused", "status", "next", "scheduled"}
 	table := ui.Table{Headers: ui.TableRow{}}
 	for _, h := range headers {
 		table.Headers = append(table.Headers, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
@@ -102,8 +99,16 @@ func (command *JobsCommand) Execute([]string) error {
 		}
 		row = append(row, nextColumn)
 
+		var scheduledColumn ui.TableCell
+		if p.NextScheduledBuild != 0 {
+			scheduledColumn.Contents = time.Unix(p.NextScheduledBuild, 0).Format(timeDateLayout)
+		} else {
+			scheduledColumn.Contents = "n/a"
+		}
+		row = append(row, scheduledColumn)
+
 		table.Data = append(table.Data, row)
 	}
 
-	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+	return displayhelpers.RenderTable(os.Stdout, table, tableOptions())
 }
@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type LabelVersionCommand struct {
+	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of the resource"`
+	Version  atc.Version              `short:"v" long:"version" required:"true" description:"Version of the resource to label. The given key value pair(s) has to be an exact match but not all fields are needed. In the case of multiple resource versions matched, it will label the latest one."`
+	Label    string                   `short:"l" long:"label" required:"true" description:"Label to add to the version"`
+}
+
+func (command *LabelVersionCommand) Execute([]string) error {
+	team, version, err := findVersionToLabel(command.Resource, command.Version)
+	if err != nil {
+		return err
+	}
+
+	labeled, err := team.AddVersionLabel(command.Resource.PipelineName, command.Resource.ResourceName, version.ID, command.Label)
+	if err != nil {
+		return err
+	}
+
+	if !labeled {
+		displayhelpers.Failf("could not label '%s/%s', make sure the resource exists\n", command.Resource.PipelineName, command.Resource.ResourceName)
+	}
+
+	fmt.Printf("labeled '%s/%s' version %s with '%s'\n", command.Resource.PipelineName, command.Resource.ResourceName, versionJSON(version.Version), command.Label)
+
+	return nil
+}
+
+type UnlabelVersionCommand struct {
+	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of the resource"`
+	Version  atc.Version              `short:"v" long:"version" required:"true" description:"Version of the resource to remove the label from"`
+	Label    string                   `short:"l" long:"label" required:"true" description:"Label to remove from the version"`
+}
+
+func (command *UnlabelVersionCommand) Execute([]string) error {
+	team, version, err := findVersionToLabel(command.Resource, command.Version)
+	if err != nil {
+		return err
+	}
+
+	unlabeled, err := team.RemoveVersionLabel(command.Resource.PipelineName, command.Resource.ResourceName, version.ID, command.Label)
+	if err != nil {
+		return err
+	}
+
+	if !unlabeled {
+		displayhelpers.Failf("version %s of '%s/%s' is not labeled '%s'\n", versionJSON(version.Version), command.Resource.PipelineName, command.Resource.ResourceName, command.Label)
+	}
+
+	fmt.Printf("removed label '%s' from '%s/%s' version %s\n", command.Label, command.Resource.PipelineName, command.Resource.ResourceName, versionJSON(version.Version))
+
+	return nil
+}
+
+func findVersionToLabel(resource flaghelpers.ResourceFlag, version atc.Version) (concourse.Team, atc.ResourceVersion, error) {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return nil, atc.ResourceVersion{}, err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return nil, atc.ResourceVersion{}, err
+	}
+
+	team := target.Team()
+
+	versions, _, found, err := team.ResourceVersions(resource.PipelineName, resource.ResourceName, concourse.Page{}, version)
+	if err != nil {
+		return nil, atc.ResourceVersion{}, err
+	}
+
+	if !found || len(versions) == 0 {
+		displayhelpers.Failf("could not find version matching %s", versionJSON(version))
+	}
+
+	return team, versions[0], nil
+}
+
+func versionJSON(version atc.Version) string {
+	versionBytes, _ := json.Marshal(version)
+	return string(versionBytes)
+}
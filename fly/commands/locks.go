@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type LocksCommand struct {
+	Json bool `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *LocksCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	locks, err := target.Team().ListLocks()
+	if err != nil {
+		return err
+	}
+
+	if format := outputFormat(command.Json); !format.IsTable() {
+		return displayhelpers.PrintFormatted(os.Stdout, format, locks)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "state", Color: color.New(color.Bold)},
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "since", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, lock := range locks {
+		if lock.Holder != nil {
+			table.Data = append(table.Data, lockRow(lock.Name, ui.TableCell{Contents: "held", Color: ui.StartedColor}, *lock.Holder))
+		}
+
+		for _, waiter := range lock.Waiters {
+			table.Data = append(table.Data, lockRow(lock.Name, ui.TableCell{Contents: "waiting", Color: ui.PendingColor}, waiter))
+		}
+	}
+
+	return displayhelpers.RenderTable(os.Stdout, table, tableOptions())
+}
+
+func lockRow(name string, state ui.TableCell, build atc.LockBuild) ui.TableRow {
+	return ui.TableRow{
+		{Contents: name},
+		state,
+		{Contents: fmt.Sprintf("%s/%s/%s", build.PipelineName, build.JobName, build.Name)},
+		{Contents: time.Unix(build.Since, 0).Format(timeDateLayout)},
+	}
+}
+
+type ReleaseLockCommand struct {
+	Lock string `short:"l" long:"lock" required:"true" value-name:"NAME" description:"Name of the lock to release"`
+}
+
+func (command *ReleaseLockCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	released, err := target.Team().ReleaseLock(command.Lock)
+	if err != nil {
+		return err
+	}
+
+	if !released {
+		return fmt.Errorf("lock '%s' is not held", command.Lock)
+	}
+
+	fmt.Printf("released lock '%s'\n", command.Lock)
+	return nil
+}
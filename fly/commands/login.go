@@ -9,7 +9,9 @@ import (
 	"io/ioutil"
 	"net"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
@@ -29,6 +31,7 @@ type LoginCommand struct {
 	TeamName    string       `short:"n" long:"team-name" description:"Team to authenticate with"`
 	CACert      atc.PathFlag `long:"ca-cert" description:"Path to Concourse PEM-encoded CA certificate file."`
 	OpenBrowser bool         `short:"b" long:"open-browser" description:"Open browser to the auth endpoint"`
+	Device      bool         `long:"device" description:"Log in by approving a code in a browser on another device, for hosts without a browser"`
 
 	BrowserOnly bool
 }
@@ -119,6 +122,8 @@ func (command *LoginCommand) Execute(args []string) error {
 	} else {
 		if command.Username != "" && command.Password != "" {
 			tokenType, tokenValue, err = command.passwordGrant(client, command.Username, command.Password)
+		} else if command.Device {
+			tokenType, tokenValue, err = command.deviceGrant(client)
 		} else {
 			tokenType, tokenValue, err = command.authCodeGrant(client.URL(), command.BrowserOnly)
 		}
@@ -175,6 +180,105 @@ func (command *LoginCommand) passwordGrant(client concourse.Client, username, pa
 	return token.TokenType, token.AccessToken, nil
 }
 
+const deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+type deviceCodeResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+type deviceTokenResponse struct {
+	TokenType   string `json:"token_type"`
+	AccessToken string `json:"access_token"`
+	Error       string `json:"error"`
+}
+
+func (command *LoginCommand) deviceGrant(client concourse.Client) (string, string, error) {
+	codeResponse, err := deviceGrantRequest(client, "/sky/device/code", url.Values{})
+	if err != nil {
+		return "", "", err
+	}
+
+	defer codeResponse.Body.Close()
+
+	if codeResponse.StatusCode != http.StatusOK {
+		return "", "", fmt.Errorf("failed to start device login: %s", codeResponse.Status)
+	}
+
+	var device deviceCodeResponse
+	err = json.NewDecoder(codeResponse.Body).Decode(&device)
+	if err != nil {
+		return "", "", err
+	}
+
+	fmt.Println("navigate to the following URL in a browser on any device:")
+	fmt.Println("")
+	fmt.Printf("  %s\n", device.VerificationURIComplete)
+	fmt.Println("")
+	fmt.Printf("and confirm the code %s\n", device.UserCode)
+
+	if command.OpenBrowser {
+		_ = open.Start(device.VerificationURIComplete)
+	}
+
+	interval := time.Duration(device.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	deadline := time.Now().Add(time.Duration(device.ExpiresIn) * time.Second)
+
+	for time.Now().Before(deadline) {
+		time.Sleep(interval)
+
+		tokenResponse, err := deviceGrantRequest(client, "/sky/token", url.Values{
+			"grant_type":  {deviceCodeGrantType},
+			"device_code": {device.DeviceCode},
+		})
+		if err != nil {
+			return "", "", err
+		}
+
+		var token deviceTokenResponse
+		err = json.NewDecoder(tokenResponse.Body).Decode(&token)
+		tokenResponse.Body.Close()
+		if err != nil {
+			return "", "", fmt.Errorf("failed to poll for device login: %s", tokenResponse.Status)
+		}
+
+		switch token.Error {
+		case "":
+			return token.TokenType, token.AccessToken, nil
+		case "authorization_pending":
+			continue
+		case "slow_down":
+			interval += 5 * time.Second
+		case "expired_token":
+			return "", "", errors.New("the code expired before it was confirmed; please try again")
+		default:
+			return "", "", fmt.Errorf("device login failed: %s", token.Error)
+		}
+	}
+
+	return "", "", errors.New("the code expired before it was confirmed; please try again")
+}
+
+func deviceGrantRequest(client concourse.Client, path string, form url.Values) (*http.Response, error) {
+	request, err := http.NewRequest("POST", client.URL()+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	request.SetBasicAuth("fly", "Zmx5")
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return client.HTTPClient().Do(request)
+}
+
 func (command *LoginCommand) authCodeGrant(targetUrl string, browserOnly bool) (string, string, error) {
 
 	var tokenStr string
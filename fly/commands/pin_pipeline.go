@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type PinPipelineCommand struct {
+	Pipeline  flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to pin"`
+	FromBuild int                      `short:"b" long:"from-build" required:"true" value-name:"BUILD_ID" description:"Pin every resource to the versions used by the inputs and outputs of this build"`
+	Comment   string                   `short:"c" long:"comment" description:"Message to be saved to every pinned resource"`
+}
+
+func (command *PinPipelineCommand) Validate() error {
+	return command.Pipeline.Validate()
+}
+
+func (command *PinPipelineCommand) Execute(args []string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	pipelineName := string(command.Pipeline)
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	frozen, found, err := target.Team().FreezePipeline(pipelineName, command.FromBuild, command.Comment)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("could not find build %d in pipeline '%s'\n", command.FromBuild, pipelineName)
+	}
+
+	for _, resource := range frozen {
+		versionBytes, err := json.Marshal(resource.Version)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("pinned '%s/%s' with version %s\n", pipelineName, resource.Resource, string(versionBytes))
+	}
+
+	return nil
+}
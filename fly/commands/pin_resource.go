@@ -2,6 +2,7 @@ package commands
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/concourse/concourse/atc"
@@ -15,6 +16,10 @@ type PinResourceCommand struct {
 	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of the resource"`
 	Version  *atc.Version             `short:"v" long:"version" description:"Version of the resource to pin. The given key value pair(s) has to be an exact match but not all fields are needed. In the case of multiple resource versions matched, it will pin the latest one."`
 	Comment  string                   `short:"c" long:"comment" description:"Message to be saved to the pinned resource. Resource has to be pinned otherwise --version should be specified to pin the resource first."`
+
+	Filter      []string `long:"filter" value-name:"NAME:PATTERN" description:"Pin the latest version whose field (or metadata field) matches the glob pattern. Can be specified multiple times."`
+	Semver      string   `long:"semver" value-name:"RANGE" description:"Pin the latest version within the semantic version range, e.g. '>=2.0 <3'"`
+	SemverField string   `long:"semver-field" value-name:"NAME" description:"Version field holding the semantic version. Defaults to the version's only field."`
 }
 
 func (command *PinResourceCommand) Execute([]string) error {
@@ -28,17 +33,37 @@ func (command *PinResourceCommand) Execute([]string) error {
 		return err
 	}
 
+	filter, err := VersionFilter(command.Filter, command.Semver, command.SemverField)
+	if err != nil {
+		return err
+	}
+
+	if filter != nil && command.Version != nil {
+		return errors.New("--version and --filter/--semver are mutually exclusive")
+	}
+
 	team := target.Team()
 
-	if command.Version != nil {
-		versions, _, found, err := team.ResourceVersions(command.Resource.PipelineName, command.Resource.ResourceName, concourse.Page{}, *command.Version)
+	if command.Version != nil || filter != nil {
+		var versions []atc.ResourceVersion
+		var found bool
+		if filter != nil {
+			versions, found, err = FilteredResourceVersions(team, command.Resource.PipelineName, command.Resource.ResourceName, *filter, 1)
+		} else {
+			versions, _, found, err = team.ResourceVersions(command.Resource.PipelineName, command.Resource.ResourceName, concourse.Page{}, *command.Version)
+		}
 
 		if err != nil {
 			return err
 		}
 
 		if !found || len(versions) <= 0 {
-			pinVersionBytes, err := json.Marshal(command.Version)
+			var pinVersionBytes []byte
+			if filter != nil {
+				pinVersionBytes, err = json.Marshal(filter)
+			} else {
+				pinVersionBytes, err = json.Marshal(command.Version)
+			}
 			if err != nil {
 				return err
 			}
@@ -40,12 +40,8 @@ func (command *PipelinesCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(pipelines)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := outputFormat(command.Json); !format.IsTable() {
+		return displayhelpers.PrintFormatted(os.Stdout, format, pipelines)
 	}
 
 	table := ui.Table{Headers: ui.TableRow{}}
@@ -81,5 +77,5 @@ func (command *PipelinesCommand) Execute([]string) error {
 		table.Data = append(table.Data, row)
 	}
 
-	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+	return displayhelpers.RenderTable(os.Stdout, table, tableOptions())
 }
@@ -0,0 +1,269 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/jessevdk/go-flags"
+)
+
+// PluginPrefix is the prefix of the executables on $PATH that fly runs as
+// subcommands: `fly foo` runs `fly-foo` when fly has no foo command of its
+// own.
+const PluginPrefix = "fly-"
+
+type Plugin struct {
+	Name string
+	Path string
+}
+
+// FindPlugin looks up the plugin for the given command name on $PATH.
+func FindPlugin(name string) (Plugin, bool) {
+	if name == "" || strings.ContainsAny(name, `/\`) {
+		return Plugin{}, false
+	}
+
+	path, err := exec.LookPath(PluginPrefix + name)
+	if err != nil {
+		return Plugin{}, false
+	}
+
+	return Plugin{Name: name, Path: path}, true
+}
+
+// FindPlugins lists the plugins on $PATH, sorted by name. When the same plugin
+// is in multiple directories, the first one on $PATH wins, as it would when
+// running it. Plugins shadowed by one of fly's own commands are left out.
+func FindPlugins(parser *flags.Parser) []Plugin {
+	seen := map[string]bool{}
+
+	var plugins []Plugin
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		if dir == "" {
+			dir = "."
+		}
+
+		entries, err := ioutil.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			path := filepath.Join(dir, entry.Name())
+			if !strings.HasPrefix(entry.Name(), PluginPrefix) || !isExecutable(path) {
+				continue
+			}
+
+			name := strings.TrimPrefix(entry.Name(), PluginPrefix)
+			if runtime.GOOS == "windows" {
+				name = strings.TrimSuffix(name, filepath.Ext(name))
+			}
+
+			if name == "" || seen[name] || parser.Find(name) != nil {
+				continue
+			}
+
+			seen[name] = true
+
+			plugins = append(plugins, Plugin{
+				Name: name,
+				Path: path,
+			})
+		}
+	}
+
+	sort.Slice(plugins, func(i int, j int) bool {
+		return plugins[i].Name < plugins[j].Name
+	})
+
+	return plugins
+}
+
+// RunPlugin runs the plugin with the given arguments and returns its exit
+// status. The details of the target selected with -t are passed to the plugin
+// through its environment.
+func RunPlugin(plugin Plugin, args []string) (int, error) {
+	env, err := pluginEnv()
+	if err != nil {
+		return 0, err
+	}
+
+	cmd := exec.Command(plugin.Path, args...)
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	err = cmd.Run()
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok {
+			return status.ExitStatus(), nil
+		}
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to run plugin %s: %s", plugin.Name, err)
+	}
+
+	return 0, nil
+}
+
+func pluginEnv() ([]string, error) {
+	var env []string
+
+	if self, err := os.Executable(); err == nil {
+		env = append(env, "FLY_BINARY="+self)
+	}
+
+	if Fly.Target == "" {
+		return env, nil
+	}
+
+	targets, err := rc.LoadTargets()
+	if err != nil {
+		return nil, err
+	}
+
+	props, found := targets.Targets[Fly.Target]
+	if !found {
+		return nil, rc.UnknownTargetError{TargetName: Fly.Target}
+	}
+
+	env = append(env,
+		"FLY_TARGET="+string(Fly.Target),
+		"FLY_TARGET_API="+props.API,
+		"FLY_TARGET_TEAM="+props.TeamName,
+		"FLY_TARGET_INSECURE="+strconv.FormatBool(props.Insecure),
+	)
+
+	if props.CACert != "" {
+		env = append(env, "FLY_TARGET_CA_CERT="+props.CACert)
+	}
+
+	if props.Token != nil {
+		env = append(env,
+			"FLY_TARGET_TOKEN_TYPE="+props.Token.Type,
+			"FLY_TARGET_TOKEN="+props.Token.Value,
+		)
+	}
+
+	return env, nil
+}
+
+// WritePluginsHelp lists the available plugins in the style of the help
+// message's list of commands.
+func WritePluginsHelp(dst io.Writer, parser *flags.Parser) {
+	plugins := FindPlugins(parser)
+	if len(plugins) == 0 {
+		return
+	}
+
+	width := 0
+	for _, plugin := range plugins {
+		if len(plugin.Name) > width {
+			width = len(plugin.Name)
+		}
+	}
+
+	fmt.Fprintln(dst)
+	fmt.Fprintln(dst, "Available plugins:")
+
+	for _, plugin := range plugins {
+		fmt.Fprintf(dst, "  %-*s  %s\n", width, plugin.Name, plugin.Path)
+	}
+}
+
+// PluginCompletions returns the plugins to offer when completing the name of
+// the command, i.e. when args has no command before the word being completed.
+func PluginCompletions(parser *flags.Parser, args []string) []flags.Completion {
+	if len(args) == 0 || !completingCommand(parser, args) {
+		return nil
+	}
+
+	prefix := args[len(args)-1]
+
+	var completions []flags.Completion
+	for _, plugin := range FindPlugins(parser) {
+		if strings.HasPrefix(plugin.Name, prefix) {
+			completions = append(completions, flags.Completion{
+				Item:        plugin.Name,
+				Description: "plugin at " + plugin.Path,
+			})
+		}
+	}
+
+	return completions
+}
+
+func completingCommand(parser *flags.Parser, args []string) bool {
+	for i := 0; i < len(args)-1; i++ {
+		arg := args[i]
+		if arg == "--" || !strings.HasPrefix(arg, "-") {
+			return false
+		}
+
+		if strings.Contains(arg, "=") {
+			continue
+		}
+
+		var option *flags.Option
+		if strings.HasPrefix(arg, "--") {
+			option = parser.FindOptionByLongName(strings.TrimPrefix(arg, "--"))
+		} else if len(arg) == 2 {
+			option = parser.FindOptionByShortName(rune(arg[1]))
+		}
+
+		if option != nil && takesArgument(option) {
+			// the word being completed is the option's value
+			if i+1 == len(args)-1 {
+				return false
+			}
+
+			i++
+		}
+	}
+
+	return !strings.HasPrefix(args[len(args)-1], "-")
+}
+
+func takesArgument(option *flags.Option) bool {
+	valueType := reflect.TypeOf(option.Value())
+	if valueType == nil {
+		return true
+	}
+
+	switch valueType.Kind() {
+	case reflect.Bool:
+		return false
+	case reflect.Func:
+		return valueType.NumIn() > 0
+	}
+
+	return true
+}
+
+// isExecutable follows symlinks, as plugins are often linked into a
+// directory on $PATH.
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+
+	if runtime.GOOS == "windows" {
+		return true
+	}
+
+	return info.Mode()&0111 != 0
+}
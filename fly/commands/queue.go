@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type QueueCommand struct {
+	Json bool `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *QueueCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	builds, err := target.Team().ListQueue()
+	if err != nil {
+		return err
+	}
+
+	if format := outputFormat(command.Json); !format.IsTable() {
+		return displayhelpers.PrintFormatted(os.Stdout, format, builds)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "position", Color: color.New(color.Bold)},
+			{Contents: "priority", Color: color.New(color.Bold)},
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "state", Color: color.New(color.Bold)},
+		},
+	}
+
+	for i, build := range builds {
+		state := ui.TableCell{Contents: "pending", Color: ui.PendingColor}
+		if build.AwaitingApproval {
+			state = ui.TableCell{Contents: "awaiting-approval", Color: ui.PendingColor}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(i + 1)},
+			{Contents: strconv.Itoa(build.Priority)},
+			{Contents: strconv.Itoa(build.ID)},
+			{Contents: fmt.Sprintf("%s/%s/%s", build.PipelineName, build.JobName, build.Name)},
+			state,
+		})
+	}
+
+	return displayhelpers.RenderTable(os.Stdout, table, tableOptions())
+}
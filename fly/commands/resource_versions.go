@@ -19,6 +19,10 @@ type ResourceVersionsCommand struct {
 	Count    int                      `short:"c" long:"count" default:"50" description:"Number of versions you want to limit the return to"`
 	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of a resource to get versions for"`
 	Json     bool                     `long:"json" description:"Print command result as JSON"`
+
+	Filter      []string `long:"filter" value-name:"NAME:PATTERN" description:"Only show versions whose field (or metadata field) matches the glob pattern. Can be specified multiple times."`
+	Semver      string   `long:"semver" value-name:"RANGE" description:"Only show versions within the semantic version range, e.g. '>=2.0 <3'"`
+	SemverField string   `long:"semver-field" value-name:"NAME" description:"Version field holding the semantic version. Defaults to the version's only field."`
 }
 
 func (command *ResourceVersionsCommand) Execute([]string) error {
@@ -32,21 +36,26 @@ func (command *ResourceVersionsCommand) Execute([]string) error {
 		return err
 	}
 
-	page := concourse.Page{Limit: command.Count}
+	filter, err := VersionFilter(command.Filter, command.Semver, command.SemverField)
+	if err != nil {
+		return err
+	}
 
 	team := target.Team()
 
-	versions, _, _, err := team.ResourceVersions(command.Resource.PipelineName, command.Resource.ResourceName, page, atc.Version{})
+	var versions []atc.ResourceVersion
+	if filter != nil {
+		versions, _, err = FilteredResourceVersions(team, command.Resource.PipelineName, command.Resource.ResourceName, *filter, command.Count)
+	} else {
+		page := concourse.Page{Limit: command.Count}
+		versions, _, _, err = team.ResourceVersions(command.Resource.PipelineName, command.Resource.ResourceName, page, atc.Version{})
+	}
 	if err != nil {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(versions)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := outputFormat(command.Json); !format.IsTable() {
+		return displayhelpers.PrintFormatted(os.Stdout, format, versions)
 	}
 
 	table := ui.Table{
@@ -54,6 +63,7 @@ func (command *ResourceVersionsCommand) Execute([]string) error {
 			{Contents: "id", Color: color.New(color.Bold)},
 			{Contents: "version", Color: color.New(color.Bold)},
 			{Contents: "enabled", Color: color.New(color.Bold)},
+			{Contents: "labels", Color: color.New(color.Bold)},
 		},
 	}
 
@@ -80,12 +90,23 @@ func (command *ResourceVersionsCommand) Execute([]string) error {
 
 		sort.Strings(fields)
 
+		labelsCell := ui.TableCell{Contents: "n/a"}
+		if len(version.Labels) > 0 {
+			labels := []string{}
+			for _, label := range version.Labels {
+				labels = append(labels, label.Label)
+			}
+
+			labelsCell.Contents = strings.Join(labels, ",")
+		}
+
 		table.Data = append(table.Data, []ui.TableCell{
 			{Contents: strconv.Itoa(version.ID)},
 			{Contents: strings.Join(fields, ",")},
 			enabledCell,
+			labelsCell,
 		})
 	}
 
-	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+	return displayhelpers.RenderTable(os.Stdout, table, tableOptions())
 }
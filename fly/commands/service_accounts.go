@@ -0,0 +1,235 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type ServiceAccountsCommand struct {
+	Json bool `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *ServiceAccountsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	accounts, err := target.Team().ServiceAccounts()
+	if err != nil {
+		return err
+	}
+
+	if format := outputFormat(command.Json); !format.IsTable() {
+		return displayhelpers.PrintFormatted(os.Stdout, format, accounts)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "token", Color: color.New(color.Bold)},
+			{Contents: "role", Color: color.New(color.Bold)},
+			{Contents: "pipeline", Color: color.New(color.Bold)},
+			{Contents: "expires", Color: color.New(color.Bold)},
+			{Contents: "last used", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, account := range accounts {
+		if len(account.Tokens) == 0 {
+			table.Data = append(table.Data, ui.TableRow{
+				{Contents: account.Name},
+				{Contents: "none", Color: color.New(color.Faint)},
+				{Contents: "n/a", Color: color.New(color.Faint)},
+				{Contents: "n/a", Color: color.New(color.Faint)},
+				{Contents: "n/a", Color: color.New(color.Faint)},
+				{Contents: "n/a", Color: color.New(color.Faint)},
+			})
+		}
+
+		for _, token := range account.Tokens {
+			table.Data = append(table.Data, serviceAccountTokenRow(account.Name, token))
+		}
+	}
+
+	return displayhelpers.RenderTable(os.Stdout, table, tableOptions())
+}
+
+func serviceAccountTokenRow(accountName string, token atc.ServiceAccountToken) ui.TableRow {
+	row := ui.TableRow{
+		{Contents: accountName},
+		{Contents: token.Name},
+		{Contents: token.Role},
+	}
+
+	if token.Pipeline == "" {
+		row = append(row, ui.TableCell{Contents: "all", Color: color.New(color.Faint)})
+	} else {
+		row = append(row, ui.TableCell{Contents: token.Pipeline})
+	}
+
+	expiresAt := time.Unix(token.ExpiresAt, 0)
+	if expiresAt.Before(time.Now()) {
+		row = append(row, ui.TableCell{Contents: "expired", Color: ui.FailedColor})
+	} else {
+		row = append(row, ui.TableCell{Contents: expiresAt.Format(timeDateLayout)})
+	}
+
+	if token.LastUsedAt == 0 {
+		row = append(row, ui.TableCell{Contents: "never", Color: color.New(color.Faint)})
+	} else {
+		row = append(row, ui.TableCell{Contents: time.Unix(token.LastUsedAt, 0).Format(timeDateLayout)})
+	}
+
+	return row
+}
+
+type CreateServiceAccountCommand struct {
+	ServiceAccount string `short:"s" long:"service-account" required:"true" value-name:"NAME" description:"Name of the service account to create"`
+}
+
+func (command *CreateServiceAccountCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	created, err := target.Team().CreateServiceAccount(command.ServiceAccount)
+	if err != nil {
+		return err
+	}
+
+	if !created {
+		fmt.Printf("service account '%s' already exists\n", command.ServiceAccount)
+		return nil
+	}
+
+	fmt.Printf("created service account '%s'\n", command.ServiceAccount)
+	return nil
+}
+
+type DestroyServiceAccountCommand struct {
+	ServiceAccount string `short:"s" long:"service-account" required:"true" value-name:"NAME" description:"Name of the service account to destroy, along with its tokens"`
+}
+
+func (command *DestroyServiceAccountCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	destroyed, err := target.Team().DestroyServiceAccount(command.ServiceAccount)
+	if err != nil {
+		return err
+	}
+
+	if !destroyed {
+		return fmt.Errorf("service account '%s' does not exist", command.ServiceAccount)
+	}
+
+	fmt.Printf("destroyed service account '%s'\n", command.ServiceAccount)
+	return nil
+}
+
+type CreateTokenCommand struct {
+	ServiceAccount string        `short:"s" long:"service-account" required:"true" value-name:"NAME" description:"Service account to create the token for"`
+	Name           string        `short:"n" long:"name" required:"true" value-name:"NAME" description:"Name of the token"`
+	Role           string        `short:"r" long:"role" required:"true" value-name:"ROLE" description:"Role granted by the token (owner, member, pipeline-operator or viewer)"`
+	Pipeline       string        `short:"p" long:"pipeline" value-name:"PIPELINE" description:"Limit the token to a single pipeline"`
+	ExpiresIn      time.Duration `long:"expires-in" default:"2160h" value-name:"DURATION" description:"How long the token is valid for"`
+	Json           bool          `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *CreateTokenCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	if command.ExpiresIn <= 0 {
+		return fmt.Errorf("token expiry must be in the future")
+	}
+
+	token, found, err := target.Team().CreateServiceAccountToken(command.ServiceAccount, atc.ServiceAccountToken{
+		Name:      command.Name,
+		Role:      command.Role,
+		Pipeline:  command.Pipeline,
+		ExpiresAt: time.Now().Add(command.ExpiresIn).Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		if command.Pipeline != "" {
+			return fmt.Errorf("service account '%s' or pipeline '%s' does not exist", command.ServiceAccount, command.Pipeline)
+		}
+
+		return fmt.Errorf("service account '%s' does not exist", command.ServiceAccount)
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(token)
+	}
+
+	fmt.Fprintf(ui.Stderr, "created token '%s' for service account '%s'\n", token.Name, command.ServiceAccount)
+	fmt.Fprintln(ui.Stderr, "store it somewhere safe; it will not be shown again")
+	fmt.Println(token.Token)
+	return nil
+}
+
+type RevokeTokenCommand struct {
+	ServiceAccount string `short:"s" long:"service-account" required:"true" value-name:"NAME" description:"Service account the token belongs to"`
+	Name           string `short:"n" long:"name" required:"true" value-name:"NAME" description:"Name of the token to revoke"`
+}
+
+func (command *RevokeTokenCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	revoked, err := target.Team().RevokeServiceAccountToken(command.ServiceAccount, command.Name)
+	if err != nil {
+		return err
+	}
+
+	if !revoked {
+		return fmt.Errorf("service account '%s' has no token named '%s'", command.ServiceAccount, command.Name)
+	}
+
+	fmt.Printf("revoked token '%s'\n", command.Name)
+	return nil
+}
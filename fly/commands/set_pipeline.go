@@ -14,6 +14,7 @@ type SetPipelineCommand struct {
 	DisableAnsiColor bool `long:"no-color"               description:"Disable color output"`
 
 	CheckCredentials bool `long:"check-creds"  description:"Validate credential variables against credential manager"`
+	DryRun           bool `long:"dry-run"      description:"Show the changes, the jobs which may start new builds and the resources which would be rechecked, without applying them"`
 
 	Pipeline flaghelpers.PipelineFlag `short:"p"  long:"pipeline"  required:"true"  description:"Pipeline to configure"`
 	Config   atc.PathFlag             `short:"c"  long:"config"    required:"true"  description:"Pipeline configuration file"`
@@ -56,8 +57,13 @@ func (command *SetPipelineCommand) Execute(args []string) error {
 		Target:           target.Client().URL(),
 		SkipInteraction:  command.SkipInteractive,
 		CheckCredentials: command.CheckCredentials,
+		DisableAnsiColor: command.DisableAnsiColor,
 	}
 
 	yamlTemplateWithParams := templatehelpers.NewYamlTemplateWithParams(configPath, templateVariablesFiles, command.Var, command.YAMLVar)
+	if command.DryRun {
+		return atcConfig.DryRun(yamlTemplateWithParams)
+	}
+
 	return atcConfig.Set(yamlTemplateWithParams)
 }
@@ -27,6 +27,7 @@ func WireTeamConnectors(command *flags.Command) {
 type SetTeamCommand struct {
 	Team            flaghelpers.TeamFlag `short:"n" long:"team-name" required:"true" description:"The team to create or modify"`
 	SkipInteractive bool                 `long:"non-interactive" description:"Force apply configuration"`
+	DefaultPriority *int                 `long:"default-job-priority" value-name:"PRIORITY" description:"Priority of the team's jobs which do not configure one"`
 	AuthFlags       skycmd.AuthTeamFlags `group:"Authentication"`
 }
 
@@ -82,6 +83,11 @@ func (command *SetTeamCommand) Execute([]string) error {
 		}
 	}
 
+	if command.DefaultPriority != nil {
+		fmt.Println()
+		fmt.Printf("default job priority: %d\n", *command.DefaultPriority)
+	}
+
 	confirm := true
 	if !command.SkipInteractive {
 		confirm = false
@@ -95,7 +101,10 @@ func (command *SetTeamCommand) Execute([]string) error {
 		displayhelpers.Failf("bailing out")
 	}
 
-	team := atc.Team{Auth: atc.TeamAuth(authRoles)}
+	team := atc.Team{
+		Auth:               atc.TeamAuth(authRoles),
+		DefaultJobPriority: command.DefaultPriority,
+	}
 
 	_, created, updated, err := target.Client().Team(teamName).CreateOrUpdate(team)
 	if err != nil {
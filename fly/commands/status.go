@@ -2,7 +2,9 @@ package commands
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
 	jwt "github.com/dgrijalva/jwt-go"
@@ -23,7 +25,7 @@ func (c *StatusCommand) Execute([]string) error {
 		return nil
 	}
 
-	if tToken != nil {
+	if tToken != nil && !strings.HasPrefix(tToken.Value, atc.ServiceAccountTokenPrefix) {
 		_, err := jwt.Parse(tToken.Value, func(token *jwt.Token) (interface{}, error) {
 			return nil, token.Claims.Valid()
 		})
@@ -32,12 +34,12 @@ func (c *StatusCommand) Execute([]string) error {
 			displayhelpers.FailWithErrorf("please login again.\n\ntoken validation failed with error ", err)
 			return nil
 		}
+	}
 
-		_, err = target.Client().UserInfo()
-		if err != nil {
-			displayhelpers.FailWithErrorf("please login again.\n\ntoken validation failed with error ", err)
-			return nil
-		}
+	_, err = target.Client().UserInfo()
+	if err != nil {
+		displayhelpers.FailWithErrorf("please login again.\n\ntoken validation failed with error ", err)
+		return nil
 	}
 
 	fmt.Println("logged in successfully")
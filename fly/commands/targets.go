@@ -5,8 +5,10 @@ import (
 	"os"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/dgrijalva/jwt-go"
@@ -53,6 +55,10 @@ func getExpirationFromString(token *rc.TargetToken) string {
 		return "n/a"
 	}
 
+	if strings.HasPrefix(token.Value, atc.ServiceAccountTokenPrefix) {
+		return "n/a"
+	}
+
 	parsedToken, err := jwt.Parse(token.Value, func(token *jwt.Token) (interface{}, error) {
 		return "", token.Claims.Valid()
 	})
@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type TaskResourceUsageCommand struct {
+	Job   flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Job to show task resource usage for"`
+	Count int                 `short:"c" long:"count" default:"50" description:"Number of task runs to show"`
+	Json  bool                `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *TaskResourceUsageCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	usages, found, err := target.Team().JobTaskResourceUsage(command.Job.PipelineName, command.Job.JobName, command.Count)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("job '%s' not found", command.Job.JobName)
+	}
+
+	if format := outputFormat(command.Json); !format.IsTable() {
+		return displayhelpers.PrintFormatted(os.Stdout, format, usages)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "step", Color: color.New(color.Bold)},
+			{Contents: "cpu time", Color: color.New(color.Bold)},
+			{Contents: "peak memory", Color: color.New(color.Bold)},
+			{Contents: "average memory", Color: color.New(color.Bold)},
+			{Contents: "peak disk", Color: color.New(color.Bold)},
+			{Contents: "outputs", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, u := range usages {
+		outputs := []string{}
+		for name, size := range u.Usage.OutputSizes {
+			outputs = append(outputs, name+":"+formatBytes(size))
+		}
+
+		sort.Strings(outputs)
+
+		outputsCell := ui.TableCell{Contents: strings.Join(outputs, ",")}
+		if len(outputs) == 0 {
+			outputsCell.Contents = "n/a"
+			outputsCell.Color = ui.OffColor
+		}
+
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: u.BuildName},
+			{Contents: u.StepName},
+			{Contents: time.Duration(u.Usage.CPUTime).Round(time.Millisecond).String()},
+			{Contents: formatBytes(u.Usage.PeakMemory)},
+			{Contents: formatBytes(u.Usage.AverageMemory)},
+			{Contents: formatBytes(u.Usage.PeakDisk)},
+			outputsCell,
+		})
+	}
+
+	return displayhelpers.RenderTable(os.Stdout, table, tableOptions())
+}
+
+func formatBytes(bytes uint64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%dB", bytes)
+	}
+
+	div, exp := uint64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/top"
+	"github.com/concourse/concourse/fly/pty"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+)
+
+type TopCommand struct {
+	Interval time.Duration `short:"n" long:"interval" default:"2s" description:"How often to refresh the dashboard"`
+}
+
+func (command *TopCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	if _, isTTY := ui.ForTTY(os.Stdout); !isTTY || !pty.IsTerminal() {
+		return errors.New("fly top must be run in an interactive terminal")
+	}
+
+	if command.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+
+	term, err := pty.OpenRawTerm()
+	if err != nil {
+		return err
+	}
+
+	defer term.Restore()
+
+	return top.Top{
+		Client:   target.Client(),
+		Team:     target.Team(),
+		Interval: command.Interval,
+		Input:    term,
+		Output:   os.Stdout,
+		Size:     terminalSize,
+		Resized:  pty.ResizeNotifier(),
+	}.Run()
+}
+
+func terminalSize() (int, int) {
+	rows, cols, err := pty.Getsize(os.Stdin)
+	if err != nil || rows == 0 || cols == 0 {
+		return 80, 24
+	}
+
+	return cols, rows
+}
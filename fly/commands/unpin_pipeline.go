@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type UnpinPipelineCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to unpin"`
+}
+
+func (command *UnpinPipelineCommand) Validate() error {
+	return command.Pipeline.Validate()
+}
+
+func (command *UnpinPipelineCommand) Execute(args []string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	pipelineName := string(command.Pipeline)
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	unfrozen, err := target.Team().UnfreezePipeline(pipelineName)
+	if err != nil {
+		return err
+	}
+
+	if unfrozen {
+		fmt.Printf("restored the pins of '%s'\n", pipelineName)
+	} else {
+		displayhelpers.Failf("pipeline '%s' not found or not pinned by pin-pipeline\n", pipelineName)
+	}
+
+	return nil
+}
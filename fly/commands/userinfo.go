@@ -31,12 +31,8 @@ func (command *UserinfoCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(userinfo)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := outputFormat(command.Json); !format.IsTable() {
+		return displayhelpers.PrintFormatted(os.Stdout, format, userinfo)
 	}
 
 	headers := ui.TableRow{
@@ -63,5 +59,5 @@ func (command *UserinfoCommand) Execute([]string) error {
 
 	table.Data = append(table.Data, row)
 
-	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+	return displayhelpers.RenderTable(os.Stdout, table, tableOptions())
 }
@@ -47,12 +47,8 @@ func (command *ActiveUsersCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(users)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := outputFormat(command.Json); !format.IsTable() {
+		return displayhelpers.PrintFormatted(os.Stdout, format, users)
 	}
 
 	headers := ui.TableRow{
@@ -71,5 +67,5 @@ func (command *ActiveUsersCommand) Execute([]string) error {
 		}
 		table.Data = append(table.Data, row)
 	}
-	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+	return displayhelpers.RenderTable(os.Stdout, table, tableOptions())
 }
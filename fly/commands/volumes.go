@@ -36,12 +36,8 @@ func (command *VolumesCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(volumes)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := outputFormat(command.Json); !format.IsTable() {
+		return displayhelpers.PrintFormatted(os.Stdout, format, volumes)
 	}
 
 	table := ui.Table{
@@ -66,7 +62,7 @@ func (command *VolumesCommand) Execute([]string) error {
 		table.Data = append(table.Data, row)
 	}
 
-	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+	return displayhelpers.RenderTable(os.Stdout, table, tableOptions())
 }
 
 func (command *VolumesCommand) volumeIdentifier(volume atc.Volume) string {
@@ -36,12 +36,8 @@ func (command *WorkersCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(workers)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := outputFormat(command.Json); !format.IsTable() {
+		return displayhelpers.PrintFormatted(os.Stdout, format, workers)
 	}
 
 	sort.Sort(byWorkerName(workers))
@@ -68,10 +64,10 @@ func (command *WorkersCommand) Execute([]string) error {
 
 	dst, isTTY := ui.ForTTY(os.Stdout)
 	if !isTTY {
-		return command.tableFor(append(append(runningWorkers, outdatedWorkers...), stalledWorkers...)).Render(os.Stdout, Fly.PrintTableHeaders)
+		return displayhelpers.RenderTable(os.Stdout, command.tableFor(append(append(runningWorkers, outdatedWorkers...), stalledWorkers...)), tableOptions())
 	}
 
-	err = command.tableFor(runningWorkers).Render(os.Stdout, Fly.PrintTableHeaders)
+	err = displayhelpers.RenderTable(os.Stdout, command.tableFor(runningWorkers), tableOptions())
 	if err != nil {
 		return err
 	}
@@ -87,7 +83,7 @@ func (command *WorkersCommand) Execute([]string) error {
 		fmt.Fprintln(dst, "the following workers need to be updated to version "+ui.Embolden(requiredWorkerVersion)+":")
 		fmt.Fprintln(dst, "")
 
-		err = command.tableFor(outdatedWorkers).Render(os.Stdout, Fly.PrintTableHeaders)
+		err = displayhelpers.RenderTable(os.Stdout, command.tableFor(outdatedWorkers), tableOptions())
 		if err != nil {
 			return err
 		}
@@ -99,7 +95,7 @@ func (command *WorkersCommand) Execute([]string) error {
 		fmt.Fprintln(dst, "the following workers have not checked in recently:")
 		fmt.Fprintln(dst, "")
 
-		err = command.tableFor(stalledWorkers).Render(os.Stdout, Fly.PrintTableHeaders)
+		err = displayhelpers.RenderTable(os.Stdout, command.tableFor(stalledWorkers), tableOptions())
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,100 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("ApproveBuild", func() {
+	var expectedBuild = atc.Build{
+		ID:               23,
+		Name:             "42",
+		Status:           "pending",
+		JobName:          "myjob",
+		APIURL:           "api/v1/builds/23",
+		AwaitingApproval: true,
+	}
+
+	Context("when approving a build by id", func() {
+		var decisionStatus int
+
+		BeforeEach(func() {
+			decisionStatus = http.StatusNoContent
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/builds/23/approve"),
+					ghttp.VerifyJSONRepresenting(atc.ApprovalRequest{Comment: "ship it"}),
+					ghttp.RespondWith(decisionStatus, ""),
+				),
+			)
+		})
+
+		It("approves the build", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "approve-build", "-b", "23", "-m", "ship it")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("build approved"))
+		})
+
+		Context("when the build is not awaiting approval", func() {
+			BeforeEach(func() {
+				decisionStatus = http.StatusConflict
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "approve-build", "-b", "23", "-m", "ship it")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("build is not awaiting approval"))
+			})
+		})
+	})
+
+	Context("when rejecting a build of a job", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/my-pipeline/jobs/my-job/builds/42"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/builds/23/reject"),
+					ghttp.VerifyJSONRepresenting(atc.ApprovalRequest{}),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("rejects the build", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "approve-build", "-j", "my-pipeline/my-job", "-b", "42", "--reject")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("build rejected"))
+		})
+	})
+})
@@ -68,6 +68,8 @@ var _ = Describe("Fly CLI", func() {
 				{Contents: "end", Color: color.New(color.Bold)},
 				{Contents: "duration", Color: color.New(color.Bold)},
 				{Contents: "team", Color: color.New(color.Bold)},
+				{Contents: "created by", Color: color.New(color.Bold)},
+				{Contents: "trigger", Color: color.New(color.Bold)},
 			}
 		})
 
@@ -101,6 +103,8 @@ var _ = Describe("Fly CLI", func() {
 						StartTime:    runningBuildStartTime.Unix(),
 						EndTime:      0,
 						TeamName:     "team1",
+						CreatedBy:    "some-user",
+						Trigger:      &atc.BuildTrigger{Type: atc.BuildTriggerTypeManual},
 					},
 					{
 						ID:           3,
@@ -111,6 +115,12 @@ var _ = Describe("Fly CLI", func() {
 						StartTime:    pendingBuildStartTime.Unix(),
 						EndTime:      pendingBuildEndTime.Unix(),
 						TeamName:     "team1",
+						Trigger: &atc.BuildTrigger{
+							Type:     atc.BuildTriggerTypeResource,
+							Input:    "some-input",
+							Resource: "some-resource",
+							Version:  atc.Version{"ref": "abc"},
+						},
 					},
 					{
 						ID:           1000001,
@@ -121,6 +131,7 @@ var _ = Describe("Fly CLI", func() {
 						StartTime:    erroredBuildStartTime.Unix(),
 						EndTime:      erroredBuildEndTime.Unix(),
 						TeamName:     "team1",
+						DebugUntil:   erroredBuildEndTime.Add(time.Hour).Unix(),
 					},
 					{
 						ID:           1002,
@@ -161,7 +172,9 @@ var _ = Describe("Fly CLI", func() {
                 "job_name": "some-job",
                 "api_url": "",
                 "pipeline_name": "some-pipeline",
-                "start_time": 1448101815
+                "start_time": 1448101815,
+                "created_by": "some-user",
+                "trigger": {"type": "manual"}
               },
               {
                 "id": 3,
@@ -172,7 +185,13 @@ var _ = Describe("Fly CLI", func() {
                 "api_url": "",
                 "pipeline_name": "some-other-pipeline",
                 "start_time": 1448932815,
-                "end_time": 1448937315
+                "end_time": 1448937315,
+                "trigger": {
+                  "type": "resource",
+                  "input": "some-input",
+                  "resource": "some-resource",
+                  "version": {"ref": "abc"}
+                }
               },
               {
                 "id": 1000001,
@@ -181,7 +200,8 @@ var _ = Describe("Fly CLI", func() {
                 "status": "errored",
                 "api_url": "",
                 "start_time": 1436011215,
-                "end_time": 1436021115
+                "end_time": 1436021115,
+                "debug_until": 1436024715
               },
               {
                 "id": 1002,
@@ -223,6 +243,8 @@ var _ = Describe("Fly CLI", func() {
 								}.String(),
 							},
 							{Contents: "team1"},
+							{Contents: "some-user"},
+							{Contents: "manual"},
 						},
 						{
 							{Contents: "3"},
@@ -233,16 +255,20 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: pendingBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "1h15m0s"},
 							{Contents: "team1"},
+							{Contents: "n/a"},
+							{Contents: "some-input ref:abc"},
 						},
 						{
 							{Contents: "1000001"},
 							{Contents: "one-off"},
 							{Contents: "n/a"},
-							{Contents: "errored"},
+							{Contents: "errored (debuggable)"},
 							{Contents: erroredBuildStartTime.Local().Format(timeDateLayout)},
 							{Contents: erroredBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "2h45m0s"},
 							{Contents: "team1"},
+							{Contents: "n/a"},
+							{Contents: "n/a"},
 						},
 						{
 							{Contents: "1002"},
@@ -253,6 +279,8 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: abortedBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "n/a"},
 							{Contents: "team1"},
+							{Contents: "n/a"},
+							{Contents: "n/a"},
 						},
 						{
 							{Contents: "39"},
@@ -263,6 +291,8 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: "n/a"},
 							{Contents: "n/a"},
 							{Contents: "team1"},
+							{Contents: "n/a"},
+							{Contents: "n/a"},
 						},
 					},
 				}))
@@ -356,6 +386,9 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: "n/a"},
 							{Contents: "n/a"},
 							{Contents: "n/a"},
+							{Contents: ""},
+							{Contents: "n/a"},
+							{Contents: "n/a"},
 						},
 					},
 				}))
@@ -370,6 +403,9 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: "n/a"},
 							{Contents: "n/a"},
 							{Contents: "n/a"},
+							{Contents: ""},
+							{Contents: "n/a"},
+							{Contents: "n/a"},
 						},
 					},
 				}))
@@ -411,6 +447,9 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
 							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "1h15m0s"},
+							{Contents: ""},
+							{Contents: "n/a"},
+							{Contents: "n/a"},
 						},
 					},
 				}))
@@ -491,6 +530,9 @@ var _ = Describe("Fly CLI", func() {
 								{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
 								{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 								{Contents: "1h15m0s"},
+								{Contents: ""},
+								{Contents: "n/a"},
+								{Contents: "n/a"},
 							},
 						},
 					}))
@@ -531,6 +573,9 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
 							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "1h15m0s"},
+							{Contents: ""},
+							{Contents: "n/a"},
+							{Contents: "n/a"},
 						},
 					},
 				}))
@@ -580,6 +625,9 @@ var _ = Describe("Fly CLI", func() {
 								{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
 								{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 								{Contents: "1h15m0s"},
+								{Contents: ""},
+								{Contents: "n/a"},
+								{Contents: "n/a"},
 							},
 						},
 					}))
@@ -624,6 +672,8 @@ var _ = Describe("Fly CLI", func() {
 								{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 								{Contents: "1h15m0s"},
 								{Contents: "team1"},
+								{Contents: "n/a"},
+								{Contents: "n/a"},
 							},
 						},
 					}))
@@ -692,6 +742,8 @@ var _ = Describe("Fly CLI", func() {
 								{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 								{Contents: "1h15m0s"},
 								{Contents: "team1"},
+								{Contents: "n/a"},
+								{Contents: "n/a"},
 							},
 
 							{
@@ -703,6 +755,8 @@ var _ = Describe("Fly CLI", func() {
 								{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 								{Contents: "1h15m0s"},
 								{Contents: "team2"},
+								{Contents: "n/a"},
+								{Contents: "n/a"},
 							},
 						},
 					}))
@@ -791,6 +845,8 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "1h15m0s"},
 							{Contents: "team1"},
+							{Contents: "n/a"},
+							{Contents: "n/a"},
 						},
 						{
 							{Contents: "4"},
@@ -801,6 +857,8 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "1h15m0s"},
 							{Contents: "team2"},
+							{Contents: "n/a"},
+							{Contents: "n/a"},
 						},
 					},
 				}))
@@ -852,6 +910,8 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "1h15m0s"},
 							{Contents: "team1"},
+							{Contents: "n/a"},
+							{Contents: "n/a"},
 						},
 					},
 				}))
@@ -892,6 +952,9 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
 							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "1h15m0s"},
+							{Contents: ""},
+							{Contents: "n/a"},
+							{Contents: "n/a"},
 						},
 					},
 				}))
@@ -952,6 +1015,9 @@ var _ = Describe("Fly CLI", func() {
 								{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
 								{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 								{Contents: "1h15m0s"},
+								{Contents: ""},
+								{Contents: "n/a"},
+								{Contents: "n/a"},
 							},
 						},
 					}))
@@ -0,0 +1,118 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("execute --local", func() {
+		var tmpdir string
+		var inputDir string
+		var outputDir string
+		var taskConfigPath string
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = ioutil.TempDir("", "fly-execute-local")
+			Expect(err).NotTo(HaveOccurred())
+
+			inputDir = filepath.Join(tmpdir, "some-input")
+			err = os.Mkdir(inputDir, 0755)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(inputDir, "some-file"), []byte("some-content"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			outputDir = filepath.Join(tmpdir, "some-output")
+
+			taskConfigPath = filepath.Join(tmpdir, "task.yml")
+
+			err = ioutil.WriteFile(
+				taskConfigPath,
+				[]byte(`---
+platform: linux
+
+inputs:
+- name: some-input
+  path: renamed-input
+
+outputs:
+- name: some-output
+
+params:
+  FOO: bar
+
+run:
+  path: sh
+  args:
+  - -c
+  - |
+    echo "FOO is $FOO"
+    cp renamed-input/some-file some-output/copied-file
+    exit 3
+`),
+				0644,
+			)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpdir)
+		})
+
+		It("runs the task without talking to the target", func() {
+			requestsBefore := len(atcServer.ReceivedRequests())
+
+			flyCmd := exec.Command(
+				flyPath, "-t", "does-not-exist", "execute",
+				"--local",
+				"-c", taskConfigPath,
+				"-i", "some-input="+inputDir,
+				"-o", "some-output="+outputDir,
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(3))
+			Expect(sess.Out).To(gbytes.Say("FOO is bar"))
+
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(requestsBefore))
+
+			contents, err := ioutil.ReadFile(filepath.Join(outputDir, "copied-file"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-content"))
+		})
+
+		It("validates the task config", func() {
+			err := ioutil.WriteFile(taskConfigPath, []byte("platform: linux\n"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			flyCmd := exec.Command(flyPath, "execute", "--local", "-c", taskConfigPath)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("missing path to executable to run"))
+		})
+
+		It("does not support inputs from a job", func() {
+			flyCmd := exec.Command(flyPath, "execute", "--local", "-c", taskConfigPath, "-j", "some-pipeline/some-job")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("--inputs-from and --image cannot be used with --local"))
+		})
+	})
+})
@@ -133,7 +133,7 @@ run:
 				func(w http.ResponseWriter, req *http.Request) {
 					Expect(req.FormValue("platform")).To(Equal("some-platform"))
 
-					tr := tar.NewReader(zstd.NewReader(req.Body))
+					tr := tar.NewReader(zstd.NewReader(artifactStream(req)))
 
 					hdr, err := tr.Next()
 					Expect(err).NotTo(HaveOccurred())
@@ -166,7 +166,7 @@ run:
 
 					Expect(req.FormValue("platform")).To(Equal("some-platform"))
 
-					tr := tar.NewReader(zstd.NewReader(req.Body))
+					tr := tar.NewReader(zstd.NewReader(artifactStream(req)))
 
 					hdr, err := tr.Next()
 					Expect(err).NotTo(HaveOccurred())
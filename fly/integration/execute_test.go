@@ -4,6 +4,7 @@ import (
 	"archive/tar"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -134,7 +135,7 @@ run:
 				func(w http.ResponseWriter, req *http.Request) {
 					close(uploading)
 
-					tr := tar.NewReader(zstd.NewReader(req.Body))
+					tr := tar.NewReader(zstd.NewReader(artifactStream(req)))
 
 					hdr, err := tr.Next()
 					Expect(err).NotTo(HaveOccurred())
@@ -287,6 +288,133 @@ run:
 
 	})
 
+	Context("when the input has been uploaded before", func() {
+		BeforeEach(func() {
+			cacheDir, err := os.UserCacheDir()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = os.MkdirAll(filepath.Join(cacheDir, "fly"), 0755)
+			Expect(err).NotTo(HaveOccurred())
+
+			payload, err := json.Marshal(map[string]int{
+				"main:some-platform:" + buildDir: 124,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(cacheDir, "fly", "artifacts.json"), payload, 0600)
+			Expect(err).NotTo(HaveOccurred())
+
+			atcServer.RouteToHandler("POST", "/api/v1/teams/main/artifacts/124/diff",
+				ghttp.CombineHandlers(
+					func(w http.ResponseWriter, req *http.Request) {
+						var manifest atc.ArtifactManifest
+						err := json.NewDecoder(req.Body).Decode(&manifest)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(manifest).To(HaveKey("task.yml"))
+					},
+					ghttp.RespondWithJSONEncoded(200, atc.ArtifactDiff{Missing: []string{"task.yml"}}),
+				),
+			)
+		})
+
+		JustBeforeEach(func() {
+			uploading := make(chan struct{})
+			uploadingBits = uploading
+
+			atcServer.RouteToHandler("POST", "/api/v1/teams/main/artifacts",
+				ghttp.CombineHandlers(
+					func(w http.ResponseWriter, req *http.Request) {
+						close(uploading)
+
+						Expect(req.FormValue("base")).To(Equal("124"))
+
+						tr := tar.NewReader(zstd.NewReader(artifactStream(req)))
+
+						hdr, err := tr.Next()
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(hdr.Name).To(MatchRegexp("(./)?task.yml$"))
+
+						_, err = tr.Next()
+						Expect(err).To(Equal(io.EOF))
+					},
+					ghttp.RespondWith(201, `{"id":125}`),
+				),
+			)
+		})
+
+		It("only uploads the files that changed", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "e", "-c", taskConfigPath, "-i", "fixture="+buildDir)
+			flyCmd.Dir = buildDir
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(streaming).Should(BeClosed())
+
+			close(events)
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			Expect(uploadingBits).To(BeClosed())
+		})
+	})
+
+	Context("when the server does not support incremental uploads", func() {
+		BeforeEach(func() {
+			atcServer.RouteToHandler("POST", "/api/v1/teams/main/artifacts/0/diff",
+				ghttp.RespondWith(http.StatusNotFound, nil),
+			)
+		})
+
+		JustBeforeEach(func() {
+			uploading := make(chan struct{})
+			uploadingBits = uploading
+
+			atcServer.RouteToHandler("POST", "/api/v1/teams/main/artifacts",
+				ghttp.CombineHandlers(
+					func(w http.ResponseWriter, req *http.Request) {
+						close(uploading)
+
+						Expect(req.Header.Get("Content-Type")).To(Equal("application/octet-stream"))
+
+						tr := tar.NewReader(zstd.NewReader(req.Body))
+
+						hdr, err := tr.Next()
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(hdr.Name).To(Equal("./"))
+
+						hdr, err = tr.Next()
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(hdr.Name).To(MatchRegexp("(./)?task.yml$"))
+					},
+					ghttp.RespondWith(201, `{"id":125}`),
+				),
+			)
+		})
+
+		It("uploads the whole input as a plain artifact", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "e", "-c", taskConfigPath)
+			flyCmd.Dir = buildDir
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(streaming).Should(BeClosed())
+
+			close(events)
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			Expect(uploadingBits).To(BeClosed())
+		})
+	})
+
 	Context("when the build config is invalid", func() {
 		BeforeEach(func() {
 			// missing platform and run path
@@ -374,7 +502,7 @@ run: {}
 							func(w http.ResponseWriter, req *http.Request) {
 								close(uploading)
 
-								tr := tar.NewReader(zstd.NewReader(req.Body))
+								tr := tar.NewReader(zstd.NewReader(artifactStream(req)))
 
 								var matchFound = false
 								for {
@@ -423,7 +551,7 @@ run: {}
 
 								Expect(req.FormValue("platform")).To(Equal("some-platform"))
 
-								tr := tar.NewReader(zstd.NewReader(req.Body))
+								tr := tar.NewReader(zstd.NewReader(artifactStream(req)))
 
 								var matchFound = false
 								for {
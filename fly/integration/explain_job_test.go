@@ -0,0 +1,163 @@
+package integration_test
+
+import (
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("explain-job", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "explain-job", "-j", "pipeline/job")
+		})
+
+		Context("when the explanation is returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/jobs/job/explain"),
+						ghttp.RespondWithJSONEncoded(200, atc.JobInputsExplanation{
+							Resolved: false,
+							Inputs: []atc.InputExplanation{
+								{
+									Name:     "repo",
+									Resource: "some-repo",
+									Passed:   []string{"unit"},
+									Candidates: []atc.CandidateExplanation{
+										{ID: 2, Version: atc.Version{"ref": "def"}, EliminatedBy: []string{"unit"}},
+										{ID: 1, Version: atc.Version{"ref": "abc"}},
+									},
+								},
+								{
+									Name:     "image",
+									Resource: "some-image",
+									Passed:   []string{"unit"},
+									Labeled:  []string{"scanned"},
+									Candidates: []atc.CandidateExplanation{
+										{ID: 3, Version: atc.Version{"digest": "sha256:1"}},
+									},
+								},
+							},
+							Conflicts: []atc.ConstraintConflict{
+								{Job: "unit", Inputs: []string{"image", "repo"}},
+							},
+						}),
+					),
+				)
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints response in json as stdout", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`{
+						"resolved": false,
+						"inputs": [
+							{
+								"name": "repo",
+								"resource": "some-repo",
+								"passed": ["unit"],
+								"candidates": [
+									{"id": 2, "version": {"ref": "def"}, "eliminated_by": ["unit"]},
+									{"id": 1, "version": {"ref": "abc"}}
+								]
+							},
+							{
+								"name": "image",
+								"resource": "some-image",
+								"passed": ["unit"],
+								"labeled": ["scanned"],
+								"candidates": [
+									{"id": 3, "version": {"digest": "sha256:1"}}
+								]
+							}
+						],
+						"conflicts": [
+							{"job": "unit", "inputs": ["image", "repo"]}
+						]
+					}`))
+				})
+			})
+
+			Context("when --format yaml is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--format", "yaml")
+				})
+
+				It("prints the response as yaml", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(gbytes.Say(`inputs:`))
+					Expect(sess.Out).To(gbytes.Say(`- candidates:`))
+					Expect(sess.Out).To(gbytes.Say(`resolved: false`))
+				})
+			})
+
+			It("prints the resolution as a tree", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say(`job: unresolved\n`))
+				Expect(sess.Out).To(gbytes.Say(`├── repo \(resource: some-repo; passed: unit\)\n`))
+				Expect(sess.Out).To(gbytes.Say(`│   ├── ref:def  not passed by unit\n`))
+				Expect(sess.Out).To(gbytes.Say(`│   └── ref:abc  candidate\n`))
+				Expect(sess.Out).To(gbytes.Say(`└── image \(resource: some-image; passed: unit; labeled: scanned\)\n`))
+				Expect(sess.Out).To(gbytes.Say(`    └── digest:sha256:1  candidate\n`))
+				Expect(sess.Out).To(gbytes.Say(`conflicts:\n`))
+				Expect(sess.Out).To(gbytes.Say(`  no build of 'unit' used a candidate version of each of: image, repo\n`))
+			})
+		})
+
+		Context("when --sort-by is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--sort-by", "name")
+			})
+
+			It("fails rather than ignoring it", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("explain-job does not print a table, so --columns and --sort-by are not supported"))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/jobs/job/explain"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Eventually(sess.Err).Should(gbytes.Say("job 'job' not found"))
+			})
+		})
+	})
+})
@@ -0,0 +1,301 @@
+package integration_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("Fly CLI", func() {
+	var (
+		tmpdir      string
+		archivePath string
+
+		team      atc.Team
+		config    atc.Config
+		resources []atc.Resource
+		versions  []atc.ResourceVersion
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpdir, err = ioutil.TempDir("", "fly-team-archive")
+		Expect(err).NotTo(HaveOccurred())
+
+		archivePath = filepath.Join(tmpdir, "team.tgz")
+
+		team = atc.Team{
+			ID:   1,
+			Name: teamName,
+			Auth: atc.TeamAuth{
+				"owner": {"users": []string{"local:some-user"}},
+			},
+		}
+
+		config = atc.Config{
+			Resources: atc.ResourceConfigs{
+				{Name: "some-resource", Type: "git"},
+			},
+			Jobs: atc.JobConfigs{
+				{Name: "some-job"},
+			},
+		}
+
+		resources = []atc.Resource{
+			{
+				Name:          "some-resource",
+				PinnedVersion: atc.Version{"ref": "abc"},
+				PinComment:    "some comment",
+			},
+		}
+
+		versions = []atc.ResourceVersion{
+			{ID: 2, Version: atc.Version{"ref": "def"}, Enabled: false},
+			{ID: 1, Version: atc.Version{"ref": "abc"}, Enabled: true},
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpdir)
+	})
+
+	teamHandlers := func() []http.HandlerFunc {
+		return []http.HandlerFunc{
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/v1/teams/main"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, team),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Pipeline{
+					{Name: "some-pipeline", Paused: true, Public: true, TeamName: teamName},
+				}),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: config}, http.Header{atc.ConfigVersionHeader: {"42"}}),
+			),
+		}
+	}
+
+	Describe("export-team", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(teamHandlers()...)
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/resources"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, resources),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/resources/some-resource/versions"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, versions),
+				),
+			)
+		})
+
+		It("writes an archive of the team", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "export-team", "-o", archivePath)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Err).To(gbytes.Say("exported team main with 1 pipelines"))
+
+			files := readTeamArchive(archivePath)
+			Expect(files).To(HaveLen(2))
+
+			var exported map[string]interface{}
+			Expect(yaml.Unmarshal(files["team.yml"], &exported)).To(Succeed())
+			Expect(exported).To(Equal(map[string]interface{}{
+				"team": map[string]interface{}{
+					"name": "main",
+					"auth": map[string]interface{}{
+						"owner": map[string]interface{}{"users": []interface{}{"local:some-user"}},
+					},
+				},
+				"pipelines": []interface{}{
+					map[string]interface{}{
+						"name":   "some-pipeline",
+						"paused": true,
+						"public": true,
+						"pins": []interface{}{
+							map[string]interface{}{
+								"resource": "some-resource",
+								"version":  map[string]interface{}{"ref": "abc"},
+								"comment":  "some comment",
+							},
+						},
+						"disabled_versions": []interface{}{
+							map[string]interface{}{
+								"resource": "some-resource",
+								"version":  map[string]interface{}{"ref": "def"},
+							},
+						},
+					},
+				},
+			}))
+
+			var exportedConfig atc.Config
+			Expect(yaml.Unmarshal(files["pipelines/some-pipeline.yml"], &exportedConfig)).To(Succeed())
+			Expect(exportedConfig).To(Equal(config))
+		})
+	})
+
+	Describe("import-team", func() {
+		BeforeEach(func() {
+			configPayload, err := yaml.Marshal(config)
+			Expect(err).NotTo(HaveOccurred())
+
+			writeTeamArchive(archivePath, map[string]string{
+				"team.yml": `
+team:
+  name: main
+  auth:
+    owner:
+      users: [local:some-user]
+pipelines:
+- name: some-pipeline
+  paused: false
+  public: true
+  disabled_versions:
+  - resource: some-resource
+    version: {ref: def}
+`,
+				"pipelines/some-pipeline.yml": string(configPayload),
+			})
+
+			atcServer.AppendHandlers(teamHandlers()...)
+		})
+
+		resourceHandlers := func() []http.HandlerFunc {
+			return []http.HandlerFunc{
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/resources"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, resources),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/resources/some-resource/versions", "filter=ref:def&limit=1"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, versions[:1]),
+				),
+			}
+		}
+
+		Context("with --dry-run", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(resourceHandlers()...)
+			})
+
+			It("shows the changes without making them", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "import-team", "-i", archivePath, "--dry-run")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(sess.Out).To(gbytes.Say("importing team: main"))
+				Expect(sess.Out).To(gbytes.Say("would unpin 'some-pipeline/some-resource'"))
+				Expect(sess.Out).To(gbytes.Say("would unpause pipeline 'some-pipeline'"))
+				Expect(sess.Out).ToNot(gbytes.Say("would disable"))
+			})
+		})
+
+		Context("with --non-interactive", func() {
+			BeforeEach(func() {
+				handlers := resourceHandlers()
+
+				// the pipeline is only unpaused once its versions are in place
+				atcServer.AppendHandlers(
+					handlers[0],
+					handlers[1],
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/pipelines/some-pipeline/resources/some-resource/unpin"),
+						ghttp.RespondWith(http.StatusOK, ""),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/pipelines/some-pipeline/unpause"),
+						ghttp.RespondWith(http.StatusOK, ""),
+					),
+				)
+			})
+
+			It("makes the changes", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "import-team", "-i", archivePath, "--non-interactive")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(sess.Out).To(gbytes.Say("unpin 'some-pipeline/some-resource'"))
+				Expect(sess.Out).To(gbytes.Say("unpause pipeline 'some-pipeline'"))
+			})
+		})
+	})
+})
+
+func readTeamArchive(path string) map[string][]byte {
+	file, err := os.Open(path)
+	Expect(err).NotTo(HaveOccurred())
+
+	defer file.Close()
+
+	gzReader, err := gzip.NewReader(file)
+	Expect(err).NotTo(HaveOccurred())
+
+	files := map[string][]byte{}
+
+	tarReader := tar.NewReader(gzReader)
+	for {
+		header, err := tarReader.Next()
+		if err != nil {
+			break
+		}
+
+		files[header.Name], err = ioutil.ReadAll(tarReader)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	return files
+}
+
+func writeTeamArchive(path string, files map[string]string) {
+	file, err := os.Create(path)
+	Expect(err).NotTo(HaveOccurred())
+
+	defer file.Close()
+
+	gzWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzWriter)
+
+	for name, contents := range files {
+		err := tarWriter.WriteHeader(&tar.Header{
+			Name: name,
+			Mode: 0644,
+			Size: int64(len(contents)),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = tarWriter.Write([]byte(contents))
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	Expect(tarWriter.Close()).To(Succeed())
+	Expect(gzWriter.Close()).To(Succeed())
+}
@@ -171,6 +171,34 @@ var _ = Describe("Fly CLI", func() {
 						Expect(printedConfig).To(Equal(config))
 					})
 
+					Context("when --format jsonpath is given", func() {
+						It("prints the selected fields", func() {
+							flyCmd := exec.Command(flyPath, "-t", targetName, "get-pipeline", "--pipeline", "some-pipeline", "--format", "jsonpath=.resources[*].name")
+
+							sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+							Expect(err).NotTo(HaveOccurred())
+
+							<-sess.Exited
+							Expect(sess.ExitCode()).To(Equal(0))
+
+							Expect(string(sess.Out.Contents())).To(Equal("some-resource some-other-resource"))
+						})
+					})
+
+					Context("when --columns is given", func() {
+						It("fails rather than ignoring it", func() {
+							flyCmd := exec.Command(flyPath, "-t", targetName, "get-pipeline", "--pipeline", "some-pipeline", "--columns", "name")
+
+							sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+							Expect(err).NotTo(HaveOccurred())
+
+							<-sess.Exited
+							Expect(sess.ExitCode()).To(Equal(1))
+
+							Expect(sess.Err).To(gbytes.Say("get-pipeline does not print a table, so --columns and --sort-by are not supported"))
+						})
+					})
+
 					Context("when -j is given", func() {
 						It("prints the config as json to stdout", func() {
 							flyCmd := exec.Command(flyPath, "-t", targetName, "get-pipeline", "--pipeline", "some-pipeline", "-j")
@@ -1,6 +1,7 @@
 package integration_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"os/exec"
 
@@ -98,6 +99,22 @@ var _ = Describe("GetPipeline", func() {
 
 					Expect(printedConfig).To(Equal(team))
 				})
+
+				It("prints the team in the given --format", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "get-team", "myTeam", "--format", "json")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+
+					var printedTeam atc.Team
+					err = json.Unmarshal(sess.Out.Contents(), &printedTeam)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(printedTeam).To(Equal(team))
+				})
 			})
 		})
 	})
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"os/exec"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/gorilla/websocket"
@@ -166,6 +167,28 @@ var _ = Describe("Hijacking", func() {
 		})
 	})
 
+	Context("when the build has a container kept for debugging", func() {
+		BeforeEach(func() {
+			didHijack := make(chan struct{})
+			hijacked = didHijack
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/containers", "build_id=2"),
+					ghttp.RespondWithJSONEncoded(200, []atc.Container{
+						{ID: "container-id-1", State: atc.ContainerStateCreated, BuildID: 2, Type: "get", StepName: "some-input", User: user},
+						{ID: "container-id-2", State: atc.ContainerStateCreated, BuildID: 2, Type: "task", StepName: "some-step", DebugUntil: time.Now().Add(time.Hour).Unix(), User: user},
+					}),
+				),
+				hijackHandler("container-id-2", didHijack, nil),
+			)
+		})
+
+		It("hijacks it without asking", func() {
+			hijack("-b", "2")
+		})
+	})
+
 	Context("when the container specifies a working directory", func() {
 		BeforeEach(func() {
 			didHijack := make(chan struct{})
@@ -3,6 +3,7 @@ package integration_test
 import (
 	"fmt"
 	"os/exec"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/ui"
@@ -54,7 +55,13 @@ var _ = Describe("Fly CLI", func() {
 					NextBuild:     nextBuild,
 				}
 			}
+
+			var scheduledJob atc.Job
+
 			BeforeEach(func() {
+				scheduledJob = createJob(4, false, "succeeded", "")
+				scheduledJob.NextScheduledBuild = time.Date(2019, 11, 13, 2, 30, 0, 0, time.UTC).Unix()
+
 				pipelineName := "pipeline"
 				flyCmd = exec.Command(flyPath, "-t", targetName, "jobs", "--pipeline", pipelineName)
 				atcServer.AppendHandlers(
@@ -64,6 +71,7 @@ var _ = Describe("Fly CLI", func() {
 							createJob(1, false, "succeeded", "started"),
 							createJob(2, true, "failed", ""),
 							createJob(3, false, "", ""),
+							scheduledJob,
 						}),
 					),
 				)
@@ -131,11 +139,125 @@ var _ = Describe("Fly CLI", func() {
                 "inputs": null,
                 "outputs": null,
                 "groups": null
+              },
+              {
+                "id": 0,
+                "name": "job-4",
+                "pipeline_name": "",
+                "team_name": "",
+                "next_build": null,
+                "finished_build": {
+                  "id": 0,
+                  "team_name": "",
+                  "name": "",
+                  "status": "succeeded",
+                  "api_url": ""
+                },
+                "next_scheduled_build": 1573612200,
+                "inputs": null,
+                "outputs": null,
+                "groups": null
               }
             ]`))
 				})
 			})
 
+			Context("when --format yaml is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--format", "yaml")
+				})
+
+				It("prints the response as yaml", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(gbytes.Say(`- finished_build:`))
+					Expect(sess.Out).To(gbytes.Say(`  name: job-1`))
+				})
+			})
+
+			Context("when --format go-template is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--format", `go-template={{range .}}{{.name}}{{if .paused}} (paused){{end}}{{"\n"}}{{end}}`)
+				})
+
+				It("renders the template against the response", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(string(sess.Out.Contents())).To(Equal("" +
+						"job-1\n" +
+						"job-2 (paused)\n" +
+						"job-3\n" +
+						"job-4\n"))
+				})
+			})
+
+			Context("when --format jsonpath is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--format", "jsonpath=[*].finished_build.status")
+				})
+
+				It("prints the selected fields", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(string(sess.Out.Contents())).To(Equal("succeeded failed succeeded"))
+				})
+			})
+
+			Context("when an unknown --format is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--format", "xml")
+				})
+
+				It("fails", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(1))
+					Expect(sess.Err).To(gbytes.Say("unknown format 'xml'"))
+				})
+			})
+
+			Context("when --columns and --sort-by are given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--columns", "status,name", "--sort-by=-name")
+				})
+
+				It("prints only those columns, sorted", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out).To(PrintTable(ui.Table{
+						Data: []ui.TableRow{
+							{{Contents: "succeeded"}, {Contents: "job-4"}},
+							{{Contents: "n/a"}, {Contents: "job-3"}},
+							{{Contents: "failed"}, {Contents: "job-2"}},
+							{{Contents: "succeeded"}, {Contents: "job-1"}},
+						},
+					}))
+				})
+			})
+
+			Context("when an unknown column is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--columns", "bogus")
+				})
+
+				It("fails and lists the available columns", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(1))
+					Expect(sess.Err).To(gbytes.Say("unknown column 'bogus'; available columns: name, paused, status, next, scheduled"))
+				})
+			})
+
 			It("shows the pipeline's jobs", func() {
 				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
 				Expect(err).NotTo(HaveOccurred())
@@ -143,9 +265,10 @@ var _ = Describe("Fly CLI", func() {
 
 				Expect(sess.Out).To(PrintTable(ui.Table{
 					Data: []ui.TableRow{
-						{{Contents: "job-1"}, {Contents: "no"}, {Contents: "succeeded"}, {Contents: "started"}},
-						{{Contents: "job-2"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "failed"}, {Contents: "n/a"}},
-						{{Contents: "job-3"}, {Contents: "no"}, {Contents: "n/a"}, {Contents: "n/a"}},
+						{{Contents: "job-1"}, {Contents: "no"}, {Contents: "succeeded"}, {Contents: "started"}, {Contents: "n/a"}},
+						{{Contents: "job-2"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "failed"}, {Contents: "n/a"}, {Contents: "n/a"}},
+						{{Contents: "job-3"}, {Contents: "no"}, {Contents: "n/a"}, {Contents: "n/a"}, {Contents: "n/a"}},
+						{{Contents: "job-4"}, {Contents: "no"}, {Contents: "succeeded"}, {Contents: "n/a"}, {Contents: time.Unix(scheduledJob.NextScheduledBuild, 0).Format("2006-01-02@15:04:05-0700")}},
 					},
 				}))
 			})
@@ -0,0 +1,111 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	var (
+		versionsPath = "/api/v1/teams/main/pipelines/pipeline/resources/resource/versions"
+		labelPath    = "/api/v1/teams/main/pipelines/pipeline/resources/resource/versions/42/labels/deployed-to-prod"
+		versions     []atc.ResourceVersion
+		labelStatus  int
+	)
+
+	BeforeEach(func() {
+		versions = []atc.ResourceVersion{
+			{ID: 42, Version: atc.Version{"some": "value"}},
+		}
+		labelStatus = http.StatusOK
+	})
+
+	Describe("label-version", func() {
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", versionsPath, "filter=some:value"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, versions),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", labelPath),
+					ghttp.RespondWith(labelStatus, nil),
+				),
+			)
+		})
+
+		It("labels the matching version", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "label-version", "-r", "pipeline/resource", "-v", "some:value", "-l", "deployed-to-prod")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say(`labeled 'pipeline/resource' version {"some":"value"} with 'deployed-to-prod'`))
+		})
+
+		Context("when no version matches", func() {
+			BeforeEach(func() {
+				versions = []atc.ResourceVersion{}
+			})
+
+			It("fails without labelling", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "label-version", "-r", "pipeline/resource", "-v", "some:value", "-l", "deployed-to-prod")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say(`could not find version matching {"some":"value"}`))
+			})
+		})
+	})
+
+	Describe("unlabel-version", func() {
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", versionsPath, "filter=some:value"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, versions),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", labelPath),
+					ghttp.RespondWith(labelStatus, nil),
+				),
+			)
+		})
+
+		It("removes the label from the matching version", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "unlabel-version", "-r", "pipeline/resource", "-v", "some:value", "-l", "deployed-to-prod")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say(`removed label 'deployed-to-prod' from 'pipeline/resource' version {"some":"value"}`))
+		})
+
+		Context("when the version does not carry the label", func() {
+			BeforeEach(func() {
+				labelStatus = http.StatusNotFound
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "unlabel-version", "-r", "pipeline/resource", "-v", "some:value", "-l", "deployed-to-prod")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say(`version {"some":"value"} of 'pipeline/resource' is not labeled 'deployed-to-prod'`))
+			})
+		})
+	})
+})
@@ -0,0 +1,126 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("locks", func() {
+		var flyCmd *exec.Cmd
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "locks")
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/locks"),
+					ghttp.RespondWithJSONEncoded(200, []atc.Lock{
+						{
+							Name: "staging-env",
+							Holder: &atc.LockBuild{
+								ID:           1,
+								Name:         "12",
+								JobName:      "deploy",
+								PipelineName: "some-pipeline",
+								Since:        100,
+							},
+							Waiters: []atc.LockBuild{
+								{
+									ID:           2,
+									Name:         "3",
+									JobName:      "deploy",
+									PipelineName: "other-pipeline",
+									Since:        200,
+								},
+							},
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists the holders and waiters", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "name", Color: color.New(color.Bold)},
+					{Contents: "state", Color: color.New(color.Bold)},
+					{Contents: "build", Color: color.New(color.Bold)},
+					{Contents: "since", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "staging-env"},
+						{Contents: "held"},
+						{Contents: "some-pipeline/deploy/12"},
+						{Contents: time.Unix(100, 0).Format("2006-01-02@15:04:05-0700")},
+					},
+					{
+						{Contents: "staging-env"},
+						{Contents: "waiting"},
+						{Contents: "other-pipeline/deploy/3"},
+						{Contents: time.Unix(200, 0).Format("2006-01-02@15:04:05-0700")},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("release-lock", func() {
+		var releaseStatus int
+
+		BeforeEach(func() {
+			releaseStatus = http.StatusNoContent
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/locks/staging-env"),
+					ghttp.RespondWith(releaseStatus, ""),
+				),
+			)
+		})
+
+		It("releases the lock", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "release-lock", "-l", "staging-env")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("released lock 'staging-env'"))
+		})
+
+		Context("when the lock is not held", func() {
+			BeforeEach(func() {
+				releaseStatus = http.StatusNotFound
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "release-lock", "-l", "staging-env")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("lock 'staging-env' is not held"))
+			})
+		})
+	})
+})
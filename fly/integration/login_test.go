@@ -11,6 +11,7 @@ import (
 	"os"
 	"os/exec"
 	"regexp"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -536,6 +537,93 @@ var _ = Describe("login Command", func() {
 			})
 		})
 
+		Context("with device authorization grant", func() {
+			var credentials string
+
+			BeforeEach(func() {
+				credentials = base64.StdEncoding.EncodeToString([]byte("fly:Zmx5"))
+
+				loginATCServer.AppendHandlers(
+					infoHandler(),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/sky/device/code"),
+						ghttp.VerifyHeaderKV("Authorization", fmt.Sprintf("Basic %s", credentials)),
+						ghttp.RespondWithJSONEncoded(200, map[string]interface{}{
+							"device_code":               "some-device-code",
+							"user_code":                 "BCDF-GHJK",
+							"verification_uri":          "https://example.com/sky/device",
+							"verification_uri_complete": "https://example.com/sky/device?user_code=BCDF-GHJK",
+							"expires_in":                60,
+							"interval":                  1,
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/sky/token"),
+						ghttp.VerifyHeaderKV("Authorization", fmt.Sprintf("Basic %s", credentials)),
+						ghttp.VerifyFormKV("grant_type", "urn:ietf:params:oauth:grant-type:device_code"),
+						ghttp.VerifyFormKV("device_code", "some-device-code"),
+						ghttp.RespondWithJSONEncoded(400, map[string]string{
+							"error": "authorization_pending",
+						}),
+					),
+				)
+			})
+
+			Context("when the code is approved", func() {
+				BeforeEach(func() {
+					loginATCServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("POST", "/sky/token"),
+							ghttp.VerifyFormKV("device_code", "some-device-code"),
+							ghttp.RespondWithJSONEncoded(200, map[string]string{
+								"token_type":   "Bearer",
+								"access_token": "some-token",
+							}),
+						),
+					)
+				})
+
+				It("prints the code and polls until it is approved", func() {
+					flyCmd = exec.Command(flyPath, "-t", "some-target", "login", "-c", loginATCServer.URL(), "--device")
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("navigate to the following URL in a browser on any device:"))
+					Eventually(sess.Out).Should(gbytes.Say(`https://example.com/sky/device\?user_code=BCDF-GHJK`))
+					Eventually(sess.Out).Should(gbytes.Say("and confirm the code BCDF-GHJK"))
+					Eventually(sess.Out, 10*time.Second).Should(gbytes.Say("target saved"))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+					Expect(loginATCServer.ReceivedRequests()).To(HaveLen(4))
+				})
+			})
+
+			Context("when the code expires", func() {
+				BeforeEach(func() {
+					loginATCServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("POST", "/sky/token"),
+							ghttp.RespondWithJSONEncoded(400, map[string]string{
+								"error": "expired_token",
+							}),
+						),
+					)
+				})
+
+				It("errors", func() {
+					flyCmd = exec.Command(flyPath, "-t", "some-target", "login", "-c", loginATCServer.URL(), "--device")
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess.Err, 10*time.Second).Should(gbytes.Say("the code expired before it was confirmed"))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(1))
+				})
+			})
+		})
+
 		Context("with password grant", func() {
 			BeforeEach(func() {
 				credentials := base64.StdEncoding.EncodeToString([]byte("fly:Zmx5"))
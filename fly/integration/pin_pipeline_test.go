@@ -0,0 +1,151 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/tedsuo/rata"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("pin-pipeline", func() {
+		var (
+			path         string
+			err          error
+			teamName     = "main"
+			pipelineName = "pipeline"
+		)
+
+		BeforeEach(func() {
+			path, err = atc.Routes.CreatePathForRoute(atc.FreezePipeline, rata.Params{
+				"pipeline_name": pipelineName,
+				"team_name":     teamName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the build is part of the pipeline", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", path),
+						ghttp.VerifyJSON(`{"build_id":42,"comment":"incident"}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.FrozenResource{
+							{Resource: "some-resource", Version: atc.Version{"ref": "abc"}},
+							{Resource: "other-resource", Version: atc.Version{"ref": "def"}},
+						}),
+					),
+				)
+			})
+
+			It("pins every resource to the build's versions", func() {
+				Expect(func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "pin-pipeline", "-p", pipelineName, "--from-build", "42", "-c", "incident")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say(`pinned 'pipeline/some-resource' with version {"ref":"abc"}`))
+					Eventually(sess.Out).Should(gbytes.Say(`pinned 'pipeline/other-resource' with version {"ref":"def"}`))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+				}).To(Change(func() int {
+					return len(atcServer.ReceivedRequests())
+				}).By(2))
+			})
+		})
+
+		Context("when the build is not part of the pipeline", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", path),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("fails to pin", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pin-pipeline", "-p", pipelineName, "--from-build", "42")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say("could not find build 42 in pipeline 'pipeline'"))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("unpin-pipeline", func() {
+		var (
+			expectedStatus int
+			path           string
+			err            error
+			teamName       = "main"
+			pipelineName   = "pipeline"
+		)
+
+		BeforeEach(func() {
+			path, err = atc.Routes.CreatePathForRoute(atc.UnfreezePipeline, rata.Params{
+				"pipeline_name": pipelineName,
+				"team_name":     teamName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", path),
+					ghttp.RespondWith(expectedStatus, nil),
+				),
+			)
+		})
+
+		Context("when the pipeline is pinned", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusOK
+			})
+
+			It("restores the previous pins", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "unpin-pipeline", "-p", pipelineName)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("restored the pins of 'pipeline'"))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+			})
+		})
+
+		Context("when the pipeline is not pinned", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "unpin-pipeline", "-p", pipelineName)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say("pipeline 'pipeline' not found or not pinned"))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+	})
+})
@@ -149,6 +149,62 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 
+			Context("when a version filter is specified", func() {
+				BeforeEach(func() {
+					getPath, err = atc.Routes.CreatePathForRoute(atc.ListResourceVersions, rata.Params{
+						"pipeline_name": pipelineName,
+						"team_name":     teamName,
+						"resource_name": resourceName,
+					})
+					Expect(err).NotTo(HaveOccurred())
+
+					pinPath, err = atc.Routes.CreatePathForRoute(atc.PinResourceVersion, rata.Params{
+						"pipeline_name":              pipelineName,
+						"team_name":                  teamName,
+						"resource_name":              resourceName,
+						"resource_config_version_id": "41",
+					})
+					Expect(err).NotTo(HaveOccurred())
+
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", getPath, "limit=1&match=tag%3Av1.%2A"),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.ResourceVersion{
+								{ID: 41, Version: atc.Version{"tag": "v1.2.0"}},
+							}),
+						),
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", pinPath),
+							ghttp.RespondWith(http.StatusOK, nil),
+						),
+					)
+				})
+
+				It("pins the latest matching version", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "pin-resource", "-r", pipelineResource, "--filter", "tag:v1.*")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say(fmt.Sprintf("pinned '%s' with version {\"tag\":\"v1.2.0\"}\n", pipelineResource)))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+				})
+
+				It("refuses to combine the filter with a version", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "pin-resource", "-r", pipelineResource, "--filter", "tag:v1.*", "-v", pinVersion)
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say("--version and --filter/--semver are mutually exclusive"))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(1))
+				})
+			})
+
 			Context("when pin comment is provided", func() {
 				BeforeEach(func() {
 					commentPath, err = atc.Routes.CreatePathForRoute(atc.SetPinCommentOnResource, rata.Params{
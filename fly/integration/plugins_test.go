@@ -0,0 +1,144 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("plugins", func() {
+		var pluginDir string
+
+		flyWithPlugins := func(args ...string) *exec.Cmd {
+			cmd := exec.Command(flyPath, args...)
+			cmd.Env = append(os.Environ(), "PATH="+pluginDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+			return cmd
+		}
+
+		BeforeEach(func() {
+			if runtime.GOOS == "windows" {
+				Skip("the plugin fixtures are shell scripts")
+			}
+
+			var err error
+			pluginDir, err = ioutil.TempDir("", "fly-plugins")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(pluginDir, "fly-onboard"), []byte(`#!/bin/sh
+echo "args: $@"
+echo "target: $FLY_TARGET"
+echo "api: $FLY_TARGET_API"
+echo "team: $FLY_TARGET_TEAM"
+echo "token: $FLY_TARGET_TOKEN_TYPE $FLY_TARGET_TOKEN"
+exit 3
+`), 0755)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(pluginDir, "fly-not-executable"), []byte("#!/bin/sh\n"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(pluginDir, "fly-pipelines"), []byte("#!/bin/sh\necho shadowed\n"), 0755)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(pluginDir)
+		})
+
+		It("runs the plugin with the target's details, exiting with its status", func() {
+			sess, err := gexec.Start(flyWithPlugins("-t", targetName, "onboard", "some-team", "--some-flag"), GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(3))
+			Expect(sess.Out).To(gbytes.Say("args: some-team --some-flag"))
+			Expect(sess.Out).To(gbytes.Say("target: " + targetName))
+			Expect(sess.Out).To(gbytes.Say("api: " + atcServer.URL()))
+			Expect(sess.Out).To(gbytes.Say("team: main"))
+			Expect(sess.Out).To(gbytes.Say("token: Bearer some-token"))
+		})
+
+		It("runs the plugin without a target", func() {
+			sess, err := gexec.Start(flyWithPlugins("onboard"), GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(3))
+			Expect(sess.Out).To(gbytes.Say("target: \n"))
+		})
+
+		It("fails when the target is unknown", func() {
+			sess, err := gexec.Start(flyWithPlugins("-t", "bogus", "onboard"), GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("unknown target: bogus"))
+		})
+
+		It("prefers fly's own commands", func() {
+			sess, err := gexec.Start(flyWithPlugins("pipelines", "--help"), GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).ToNot(gbytes.Say("shadowed"))
+		})
+
+		It("still fails on unknown commands", func() {
+			sess, err := gexec.Start(flyWithPlugins("bogus"), GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("Unknown command `bogus'"))
+		})
+
+		It("lists the plugins in the help message", func() {
+			sess, err := gexec.Start(flyWithPlugins("help"), GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("Available plugins:"))
+			Expect(sess.Out).To(gbytes.Say(`onboard\s+` + filepath.Join(pluginDir, "fly-onboard")))
+			Expect(sess.Out.Contents()).ToNot(ContainSubstring("not-executable"))
+			Expect(sess.Out.Contents()).ToNot(ContainSubstring("fly-pipelines"))
+		})
+
+		It("completes plugin names", func() {
+			cmd := flyWithPlugins("-t", targetName, "o")
+			cmd.Env = append(cmd.Env, "GO_FLAGS_COMPLETION=1")
+
+			sess, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(string(sess.Out.Contents())).To(Equal("order-pipelines\nonboard\n"))
+		})
+
+		It("does not complete plugin names for an option's value", func() {
+			cmd := flyWithPlugins("-t", "o")
+			cmd.Env = append(cmd.Env, "GO_FLAGS_COMPLETION=1")
+
+			sess, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out.Contents()).ToNot(ContainSubstring("onboard"))
+		})
+
+		It("does not complete plugin names after a command", func() {
+			cmd := flyWithPlugins("pipelines", "o")
+			cmd.Env = append(cmd.Env, "GO_FLAGS_COMPLETION=1")
+
+			sess, err := gexec.Start(cmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out.Contents()).ToNot(ContainSubstring("onboard"))
+		})
+	})
+})
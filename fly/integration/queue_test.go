@@ -0,0 +1,80 @@
+package integration_test
+
+import (
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("queue", func() {
+		var flyCmd *exec.Cmd
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "queue")
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/queue"),
+					ghttp.RespondWithJSONEncoded(200, []atc.Build{
+						{
+							ID:           4,
+							Name:         "2",
+							Status:       "pending",
+							JobName:      "hotfix",
+							PipelineName: "some-pipeline",
+							Priority:     10,
+						},
+						{
+							ID:               3,
+							Name:             "1",
+							Status:           "pending",
+							JobName:          "fuzz",
+							PipelineName:     "other-pipeline",
+							AwaitingApproval: true,
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists the pending builds in queue order", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "position", Color: color.New(color.Bold)},
+					{Contents: "priority", Color: color.New(color.Bold)},
+					{Contents: "id", Color: color.New(color.Bold)},
+					{Contents: "build", Color: color.New(color.Bold)},
+					{Contents: "state", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "1"},
+						{Contents: "10"},
+						{Contents: "4"},
+						{Contents: "some-pipeline/hotfix/2"},
+						{Contents: "pending"},
+					},
+					{
+						{Contents: "2"},
+						{Contents: "0"},
+						{Contents: "3"},
+						{Contents: "other-pipeline/fuzz/1"},
+						{Contents: "awaiting-approval"},
+					},
+				},
+			}))
+		})
+	})
+})
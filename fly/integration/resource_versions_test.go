@@ -1,6 +1,7 @@
 package integration_test
 
 import (
+	"net/http"
 	"os/exec"
 
 	"github.com/concourse/concourse/atc"
@@ -30,7 +31,7 @@ var _ = Describe("Fly CLI", func() {
 						ghttp.CombineHandlers(
 							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/resources/foo/versions"),
 							ghttp.RespondWithJSONEncoded(200, []atc.ResourceVersion{
-								{ID: 3, Version: atc.Version{"version": "3", "another": "field"}, Enabled: true},
+								{ID: 3, Version: atc.Version{"version": "3", "another": "field"}, Enabled: true, Labels: []atc.VersionLabel{{Label: "deployed-to-prod", SetBy: "some-user", SetAt: 1234}, {Label: "tested"}}},
 								{ID: 2, Version: atc.Version{"version": "2", "another": "field"}, Enabled: false},
 								{ID: 1, Version: atc.Version{"version": "1", "another": "field"}, Enabled: true},
 							}),
@@ -52,7 +53,11 @@ var _ = Describe("Fly CLI", func() {
                 {
                   "id": 3,
 									"version": {"version":"3","another":"field"},
-									"enabled": true
+									"enabled": true,
+									"labels": [
+										{"label": "deployed-to-prod", "set_by": "some-user", "set_at": 1234},
+										{"label": "tested", "set_at": 0}
+									]
                 },
                 {
                   "id": 2,
@@ -78,17 +83,112 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: "id", Color: color.New(color.Bold)},
 							{Contents: "version", Color: color.New(color.Bold)},
 							{Contents: "enabled", Color: color.New(color.Bold)},
+							{Contents: "labels", Color: color.New(color.Bold)},
 						},
 						Data: []ui.TableRow{
-							{{Contents: "3"}, {Contents: "another:field,version:3"}, {Contents: "yes"}},
-							{{Contents: "2"}, {Contents: "another:field,version:2"}, {Contents: "no"}},
-							{{Contents: "1"}, {Contents: "another:field,version:1"}, {Contents: "yes"}},
+							{{Contents: "3"}, {Contents: "another:field,version:3"}, {Contents: "yes"}, {Contents: "deployed-to-prod,tested"}},
+							{{Contents: "2"}, {Contents: "another:field,version:2"}, {Contents: "no"}, {Contents: "n/a"}},
+							{{Contents: "1"}, {Contents: "another:field,version:1"}, {Contents: "yes"}, {Contents: "n/a"}},
 						},
 					}))
 				})
 			})
 		})
 
+		Context("when a version filter is given", func() {
+			var filterQuery string
+
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--filter", "branch:release/*", "--semver", ">=2.0 <3", "--semver-field", "tag", "-c", "2")
+
+				filterQuery = "limit=2&match=branch%3Arelease%2F%2A&semver=%3E%3D2.0+%3C3&semver_field=tag"
+			})
+
+			expectMatchingVersions := func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "version", Color: color.New(color.Bold)},
+						{Contents: "enabled", Color: color.New(color.Bold)},
+						{Contents: "labels", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "4"}, {Contents: "tag:2.1.0"}, {Contents: "yes"}, {Contents: "n/a"}},
+						{{Contents: "2"}, {Contents: "tag:2.0.0"}, {Contents: "no"}, {Contents: "n/a"}},
+					},
+				}))
+			}
+
+			Context("when the server filters the versions", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/resources/foo/versions", filterQuery),
+							ghttp.RespondWithJSONEncoded(200, []atc.ResourceVersion{
+								{ID: 4, Version: atc.Version{"tag": "2.1.0"}, Metadata: []atc.MetadataField{{Name: "branch", Value: "release/2"}}, Enabled: true},
+								{ID: 2, Version: atc.Version{"tag": "2.0.0"}, Metadata: []atc.MetadataField{{Name: "branch", Value: "release/2"}}, Enabled: false},
+							}),
+						),
+					)
+				})
+
+				It("lists the matching resource versions", expectMatchingVersions)
+			})
+
+			Context("when the server does not filter versions", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/resources/foo/versions", filterQuery),
+							ghttp.RespondWithJSONEncoded(200, []atc.ResourceVersion{
+								{ID: 5, Version: atc.Version{"tag": "3.0.0"}, Metadata: []atc.MetadataField{{Name: "branch", Value: "release/3"}}, Enabled: true},
+								{ID: 4, Version: atc.Version{"tag": "2.1.0"}, Metadata: []atc.MetadataField{{Name: "branch", Value: "release/2"}}, Enabled: true},
+							}),
+						),
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/resources/foo/versions", "limit=100"),
+							ghttp.RespondWithJSONEncoded(200, []atc.ResourceVersion{
+								{ID: 5, Version: atc.Version{"tag": "3.0.0"}, Metadata: []atc.MetadataField{{Name: "branch", Value: "release/3"}}, Enabled: true},
+								{ID: 4, Version: atc.Version{"tag": "2.1.0"}, Metadata: []atc.MetadataField{{Name: "branch", Value: "release/2"}}, Enabled: true},
+								{ID: 3, Version: atc.Version{"tag": "2.0.1"}, Metadata: []atc.MetadataField{{Name: "branch", Value: "main"}}, Enabled: true},
+							}, http.Header{
+								"Link": []string{
+									`<http://some-url.com/api/v1/teams/main/pipelines/pipeline/resources/foo/versions?since=3&limit=100>; rel="next"`,
+								},
+							}),
+						),
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/resources/foo/versions", "since=3&limit=100"),
+							ghttp.RespondWithJSONEncoded(200, []atc.ResourceVersion{
+								{ID: 2, Version: atc.Version{"tag": "2.0.0"}, Metadata: []atc.MetadataField{{Name: "branch", Value: "release/2"}}, Enabled: false},
+								{ID: 1, Version: atc.Version{"tag": "1.0.0"}, Metadata: []atc.MetadataField{{Name: "branch", Value: "release/1"}}, Enabled: true},
+							}),
+						),
+					)
+				})
+
+				It("lists the matching resource versions across pages", expectMatchingVersions)
+			})
+		})
+
+		Context("when an invalid semver range is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--semver", "not-a-range")
+			})
+
+			It("writes an error message to stderr", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Eventually(sess.Err).Should(gbytes.Say("invalid semver range 'not-a-range'"))
+			})
+		})
+
 		Context("and the api returns an internal server error", func() {
 			BeforeEach(func() {
 				atcServer.AppendHandlers(
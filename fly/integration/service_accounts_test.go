@@ -0,0 +1,315 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("service-accounts", func() {
+		var (
+			flyCmd    *exec.Cmd
+			expiresAt int64
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "service-accounts")
+			expiresAt = time.Now().Add(time.Hour).Unix()
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/service-accounts"),
+					ghttp.RespondWithJSONEncoded(200, []atc.ServiceAccount{
+						{
+							Name: "deploy-bot",
+							Tokens: []atc.ServiceAccountToken{
+								{
+									Name:       "ci",
+									Role:       "member",
+									Pipeline:   "some-pipeline",
+									ExpiresAt:  expiresAt,
+									LastUsedAt: 100,
+								},
+								{
+									Name:      "old",
+									Role:      "viewer",
+									ExpiresAt: 100,
+								},
+							},
+						},
+						{
+							Name:   "idle-bot",
+							Tokens: []atc.ServiceAccountToken{},
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists the service accounts and their tokens", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "name", Color: color.New(color.Bold)},
+					{Contents: "token", Color: color.New(color.Bold)},
+					{Contents: "role", Color: color.New(color.Bold)},
+					{Contents: "pipeline", Color: color.New(color.Bold)},
+					{Contents: "expires", Color: color.New(color.Bold)},
+					{Contents: "last used", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "deploy-bot"},
+						{Contents: "ci"},
+						{Contents: "member"},
+						{Contents: "some-pipeline"},
+						{Contents: time.Unix(expiresAt, 0).Format("2006-01-02@15:04:05-0700")},
+						{Contents: time.Unix(100, 0).Format("2006-01-02@15:04:05-0700")},
+					},
+					{
+						{Contents: "deploy-bot"},
+						{Contents: "old"},
+						{Contents: "viewer"},
+						{Contents: "all", Color: color.New(color.Faint)},
+						{Contents: "expired", Color: color.New(color.FgRed)},
+						{Contents: "never", Color: color.New(color.Faint)},
+					},
+					{
+						{Contents: "idle-bot"},
+						{Contents: "none", Color: color.New(color.Faint)},
+						{Contents: "n/a", Color: color.New(color.Faint)},
+						{Contents: "n/a", Color: color.New(color.Faint)},
+						{Contents: "n/a", Color: color.New(color.Faint)},
+						{Contents: "n/a", Color: color.New(color.Faint)},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("create-service-account", func() {
+		var createStatus int
+
+		BeforeEach(func() {
+			createStatus = http.StatusCreated
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/main/service-accounts/deploy-bot"),
+					ghttp.RespondWith(createStatus, ""),
+				),
+			)
+		})
+
+		It("creates the service account", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "create-service-account", "-s", "deploy-bot")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("created service account 'deploy-bot'"))
+		})
+
+		Context("when the service account already exists", func() {
+			BeforeEach(func() {
+				createStatus = http.StatusOK
+			})
+
+			It("says so", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "create-service-account", "-s", "deploy-bot")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("service account 'deploy-bot' already exists"))
+			})
+		})
+	})
+
+	Describe("destroy-service-account", func() {
+		var destroyStatus int
+
+		BeforeEach(func() {
+			destroyStatus = http.StatusNoContent
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/service-accounts/deploy-bot"),
+					ghttp.RespondWith(destroyStatus, ""),
+				),
+			)
+		})
+
+		It("destroys the service account", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "destroy-service-account", "-s", "deploy-bot")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("destroyed service account 'deploy-bot'"))
+		})
+
+		Context("when the service account does not exist", func() {
+			BeforeEach(func() {
+				destroyStatus = http.StatusNotFound
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "destroy-service-account", "-s", "deploy-bot")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("service account 'deploy-bot' does not exist"))
+			})
+		})
+	})
+
+	Describe("create-token", func() {
+		var (
+			requested atc.ServiceAccountToken
+			handler   http.HandlerFunc
+		)
+
+		BeforeEach(func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				body, err := ioutil.ReadAll(r.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(body, &requested)
+				Expect(err).NotTo(HaveOccurred())
+
+				created := requested
+				created.Token = "cst_some-token"
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				json.NewEncoder(w).Encode(created)
+			}
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/main/service-accounts/deploy-bot/tokens"),
+					handler,
+				),
+			)
+		})
+
+		It("creates the token and prints it", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "create-token", "-s", "deploy-bot", "-n", "ci", "-r", "member", "-p", "some-pipeline", "--expires-in", "24h")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Err).To(gbytes.Say("created token 'ci' for service account 'deploy-bot'"))
+			Expect(sess.Out).To(gbytes.Say("cst_some-token"))
+
+			Expect(requested.Name).To(Equal("ci"))
+			Expect(requested.Role).To(Equal("member"))
+			Expect(requested.Pipeline).To(Equal("some-pipeline"))
+			Expect(time.Unix(requested.ExpiresAt, 0)).To(BeTemporally("~", time.Now().Add(24*time.Hour), time.Minute))
+		})
+
+		Context("when the service account does not exist", func() {
+			BeforeEach(func() {
+				handler = ghttp.RespondWith(http.StatusNotFound, "")
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "create-token", "-s", "deploy-bot", "-n", "ci", "-r", "member")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("service account 'deploy-bot' does not exist"))
+			})
+		})
+
+		Context("when the token is rejected", func() {
+			BeforeEach(func() {
+				handler = ghttp.RespondWith(http.StatusBadRequest, "unknown role 'bogus'")
+			})
+
+			It("prints the reason", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "create-token", "-s", "deploy-bot", "-n", "ci", "-r", "bogus")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("unknown role 'bogus'"))
+			})
+		})
+	})
+
+	Describe("revoke-token", func() {
+		var revokeStatus int
+
+		BeforeEach(func() {
+			revokeStatus = http.StatusNoContent
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/service-accounts/deploy-bot/tokens/ci"),
+					ghttp.RespondWith(revokeStatus, ""),
+				),
+			)
+		})
+
+		It("revokes the token", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "revoke-token", "-s", "deploy-bot", "-n", "ci")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("revoked token 'ci'"))
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				revokeStatus = http.StatusNotFound
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "revoke-token", "-s", "deploy-bot", "-n", "ci")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("service account 'deploy-bot' has no token named 'ci'"))
+			})
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package integration_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -1103,6 +1104,72 @@ this is super secure
 				})
 			})
 
+			Context("when --dry-run is passed", func() {
+				var dryRun atc.ConfigDryRun
+
+				BeforeEach(func() {
+					dryRun = atc.ConfigDryRun{
+						Diff: "resources:\n  resource some-resource has changed:\n",
+						Impact: atc.ConfigImpact{
+							TriggeredJobs: []atc.ConfigImpactReason{
+								{Name: "some-job", Reason: "input some-resource has been added"},
+							},
+							ResetResources: []atc.ConfigImpactReason{
+								{Name: "some-resource", Reason: "source has changed"},
+							},
+						},
+					}
+
+					path, err := atc.Routes.CreatePathForRoute(atc.DryRunConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
+					Expect(err).NotTo(HaveOccurred())
+
+					atcServer.RouteToHandler("PUT", path, ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", path),
+						func(w http.ResponseWriter, r *http.Request) {
+							Expect(getConfig(r)).To(MatchYAML(payload))
+
+							w.Header().Set("Content-Type", "application/json")
+							json.NewEncoder(w).Encode(atc.SaveConfigResponse{DryRun: &dryRun})
+						},
+					))
+				})
+
+				It("shows what the server says would change without applying it", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "awesome-pipeline", "-c", configFile.Name(), "--dry-run")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+
+					Expect(sess).To(gbytes.Say("resource some-resource has changed"))
+					Expect(sess).To(gbytes.Say("jobs which may start new builds:"))
+					Expect(sess).To(gbytes.Say("some-job: input some-resource has been added"))
+					Expect(sess).To(gbytes.Say("resources which would lose version history:"))
+					Expect(sess).To(gbytes.Say("some-resource: source has changed"))
+					Expect(sess).To(gbytes.Say("dry run: nothing has been changed"))
+					Expect(sess).ToNot(gbytes.Say(`apply configuration\?`))
+				})
+
+				Context("when nothing would change", func() {
+					BeforeEach(func() {
+						dryRun = atc.ConfigDryRun{}
+					})
+
+					It("says so", func() {
+						flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-p", "awesome-pipeline", "-c", configFile.Name(), "--dry-run")
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(0))
+						Expect(sess).To(gbytes.Say("no changes to apply"))
+					})
+				})
+			})
+
 			Context("when the server rejects the request", func() {
 				BeforeEach(func() {
 					path, err := atc.Routes.CreatePathForRoute(atc.SaveConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
@@ -404,6 +404,42 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Describe("sending a default job priority", func() {
+			BeforeEach(func() {
+				cmdParams = []string{"--local-user", "some-admin", "--default-job-priority", "5", "--non-interactive"}
+
+				defaultJobPriority := 5
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": ["local:some-admin"],
+									"groups": []
+								}
+							},
+							"default_job_priority": 5
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+							Name:               "venture",
+							ID:                 8,
+							DefaultJobPriority: &defaultJobPriority,
+						}),
+					),
+				)
+			})
+
+			It("includes it in the request", func() {
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("default job priority: 5"))
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
 		Describe("handling server response", func() {
 			BeforeEach(func() {
 				cmdParams = []string{"-c", "fixtures/team_config_mixed.yml"}
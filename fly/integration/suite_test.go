@@ -1,8 +1,11 @@
 package integration_test
 
 import (
+	"bufio"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -77,6 +80,12 @@ var _ = BeforeEach(func() {
 		infoHandler(),
 	)
 
+	// inputs that were not uploaded before are diffed against artifact 0,
+	// which never exists
+	atcServer.RouteToHandler("POST", "/api/v1/teams/main/artifacts/0/diff",
+		ghttp.RespondWith(http.StatusGone, nil),
+	)
+
 	var err error
 
 	homeDir, err = ioutil.TempDir("", "fly-test")
@@ -111,6 +120,30 @@ func osFlag(short string, long string) string {
 	}
 }
 
+// artifactStream verifies that req is an incremental artifact upload and
+// returns the archive that follows its manifest.
+func artifactStream(req *http.Request) io.Reader {
+	Expect(req.Header.Get("Content-Type")).To(Equal(atc.IncrementalArtifactContentType))
+
+	decoder := json.NewDecoder(req.Body)
+
+	var manifest atc.ArtifactManifest
+	err := decoder.Decode(&manifest)
+	Expect(err).NotTo(HaveOccurred())
+
+	stream := bufio.NewReader(io.MultiReader(decoder.Buffered(), req.Body))
+	for {
+		b, err := stream.Peek(1)
+		if err != nil || (b[0] != '\n' && b[0] != ' ') {
+			break
+		}
+
+		_, _ = stream.ReadByte()
+	}
+
+	return stream
+}
+
 func userHomeDir() string {
 	return os.Getenv("HOME")
 }
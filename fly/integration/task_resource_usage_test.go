@@ -0,0 +1,162 @@
+package integration_test
+
+import (
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("task-resource-usage", func() {
+		var (
+			flyCmd *exec.Cmd
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "task-resource-usage", "-j", "pipeline/job")
+		})
+
+		Context("when usage is returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/jobs/job/resource-usage", "limit=50"),
+						ghttp.RespondWithJSONEncoded(200, []atc.BuildTaskResourceUsage{
+							{
+								BuildID:   2,
+								BuildName: "2",
+								StepName:  "unit",
+								Time:      1,
+								Usage: atc.TaskResourceUsage{
+									CPUTime:       1500000000,
+									PeakMemory:    256 * 1024 * 1024,
+									AverageMemory: 128 * 1024 * 1024,
+									PeakDisk:      2 * 1024 * 1024 * 1024,
+									OutputSizes:   map[string]uint64{"binary": 3 * 1024 * 1024, "docs": 512},
+								},
+							},
+							{
+								BuildID:   1,
+								BuildName: "1",
+								StepName:  "unit",
+								Time:      1,
+								Usage: atc.TaskResourceUsage{
+									CPUTime:       1000000,
+									PeakMemory:    1536,
+									AverageMemory: 1024,
+								},
+							},
+						}),
+					),
+				)
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints response in json as stdout", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{
+							"build_id": 2,
+							"build_name": "2",
+							"step_name": "unit",
+							"time": 1,
+							"usage": {
+								"cpu_time": 1500000000,
+								"peak_memory": 268435456,
+								"average_memory": 134217728,
+								"peak_disk": 2147483648,
+								"output_sizes": {"binary": 3145728, "docs": 512}
+							}
+						},
+						{
+							"build_id": 1,
+							"build_name": "1",
+							"step_name": "unit",
+							"time": 1,
+							"usage": {
+								"cpu_time": 1000000,
+								"peak_memory": 1536,
+								"average_memory": 1024,
+								"peak_disk": 0
+							}
+						}
+					]`))
+				})
+			})
+
+			It("lists the task resource usage", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "build", Color: color.New(color.Bold)},
+						{Contents: "step", Color: color.New(color.Bold)},
+						{Contents: "cpu time", Color: color.New(color.Bold)},
+						{Contents: "peak memory", Color: color.New(color.Bold)},
+						{Contents: "average memory", Color: color.New(color.Bold)},
+						{Contents: "peak disk", Color: color.New(color.Bold)},
+						{Contents: "outputs", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "2"}, {Contents: "unit"}, {Contents: "1.5s"}, {Contents: "256.0MiB"}, {Contents: "128.0MiB"}, {Contents: "2.0GiB"}, {Contents: "binary:3.0MiB,docs:512B"}},
+						{{Contents: "1"}, {Contents: "unit"}, {Contents: "1ms"}, {Contents: "1.5KiB"}, {Contents: "1.0KiB"}, {Contents: "0B"}, {Contents: "n/a", Color: color.New(color.Faint)}},
+					},
+				}))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/jobs/job/resource-usage"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Eventually(sess.Err).Should(gbytes.Say("job 'job' not found"))
+			})
+		})
+
+		Context("and the api returns an internal server error", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/jobs/job/resource-usage"),
+						ghttp.RespondWith(500, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Eventually(sess.Err).Should(gbytes.Say("Unexpected Response"))
+			})
+		})
+	})
+})
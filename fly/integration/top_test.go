@@ -0,0 +1,88 @@
+package integration_test
+
+import (
+	"io"
+	"os/exec"
+	"runtime"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/pty"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("top", func() {
+		BeforeEach(func() {
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines",
+				ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+					{Name: "some-pipeline"},
+				}),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/builds",
+				ghttp.RespondWithJSONEncoded(200, []atc.Build{
+					{ID: 42, Name: "7", PipelineName: "some-pipeline", JobName: "some-job", Status: "started"},
+					{ID: 41, Name: "6", PipelineName: "some-pipeline", JobName: "some-job", Status: "succeeded"},
+				}),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/queue",
+				ghttp.RespondWithJSONEncoded(200, []atc.Build{}),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/workers",
+				ghttp.RespondWithJSONEncoded(200, []atc.Worker{
+					{Name: "some-worker", State: "running", ActiveContainers: 3, ActiveTasks: 1},
+				}),
+			)
+		})
+
+		It("shows the dashboard until q is pressed", func() {
+			if runtime.GOOS == "windows" {
+				Skip("the pty stuff doesn't apply to Windows")
+			}
+
+			tty, err := pty.Open()
+			Expect(err).NotTo(HaveOccurred())
+
+			defer tty.Close()
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "top")
+			flyCmd.Stdin = tty.TTYR
+			flyCmd.Stdout = tty.TTYW
+			flyCmd.Stderr = GinkgoWriter
+
+			// gexec would replace the command's stdout with its own buffer
+			err = flyCmd.Start()
+			Expect(err).NotTo(HaveOccurred())
+
+			exited := make(chan error, 1)
+			go func() {
+				exited <- flyCmd.Wait()
+			}()
+
+			screen := gbytes.NewBuffer()
+			go io.Copy(screen, tty.PTYR)
+
+			Eventually(screen).Should(gbytes.Say("some-pipeline"))
+			Eventually(screen).Should(gbytes.Say(`42\s+some-pipeline/some-job #7`))
+			Eventually(screen).Should(gbytes.Say("some-worker"))
+
+			_, err = tty.PTYW.Write([]byte("q"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(exited).Should(Receive(BeNil()))
+		})
+
+		It("fails when not run in a terminal", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "top")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("fly top must be run in an interactive terminal"))
+		})
+	})
+})
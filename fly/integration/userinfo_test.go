@@ -73,6 +73,41 @@ var _ = Describe("Fly CLI", func() {
 					}`))
 				})
 			})
+
+			Context("when --format jsonpath is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--format", "jsonpath=.user_name")
+				})
+
+				It("prints the selected fields", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(string(sess.Out.Contents())).To(Equal("test_user"))
+				})
+			})
+
+			Context("when --columns is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--columns", "team/role")
+				})
+
+				It("prints only those columns", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(PrintTable(ui.Table{
+						Headers: ui.TableRow{
+							{Contents: "team/role", Color: color.New(color.Bold)},
+						},
+						Data: []ui.TableRow{
+							{{Contents: "other_team/owner,test_team/owner,test_team/viewer"}},
+						},
+					}))
+				})
+			})
 		})
 
 		Context("and the api returns an internal server error", func() {
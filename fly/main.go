@@ -21,11 +21,41 @@ func main() {
 
 	commands.WireTeamConnectors(parser.Find("set-team"))
 
-	_, err := parser.Parse()
+	parser.CompletionHandler = func(items []flags.Completion) {
+		items = append(items, commands.PluginCompletions(parser, os.Args[1:])...)
+		printCompletions(items, os.Getenv("GO_FLAGS_COMPLETION") == "verbose")
+		os.Exit(0)
+	}
+
+	args, err := parser.Parse()
+	err = runPlugin(args, err)
 	err = loginAndRetry(parser, err)
 	handleError(helpParser, err)
 }
 
+// runPlugin runs the fly-<name> plugin on $PATH when the command is not one
+// of fly's own, exiting with its exit status.
+func runPlugin(args []string, err error) error {
+	flagsErr, ok := err.(*flags.Error)
+	if !ok || flagsErr.Type != flags.ErrUnknownCommand || len(args) == 0 {
+		return err
+	}
+
+	plugin, found := commands.FindPlugin(args[0])
+	if !found {
+		return err
+	}
+
+	status, err := commands.RunPlugin(plugin, args[1:])
+	if err != nil {
+		return err
+	}
+
+	os.Exit(status)
+
+	return nil
+}
+
 func loginAndRetry(parser *flags.Parser, err error) error {
 	_, stdoutIsTTY := ui.ForTTY(os.Stdout)
 	_, stdinIsTTY := ui.ForTTY(os.Stdin)
@@ -81,5 +111,24 @@ func handleError(helpParser *flags.Parser, err error) {
 func showHelp(helpParser *flags.Parser) {
 	helpParser.ParseArgs([]string{"-h"})
 	helpParser.WriteHelp(os.Stdout)
+	commands.WritePluginsHelp(os.Stdout, helpParser)
 	os.Exit(0)
 }
+
+// printCompletions prints completions the same way go-flags does.
+func printCompletions(items []flags.Completion, showDescriptions bool) {
+	width := 0
+	for _, item := range items {
+		if len(item.Item) > width {
+			width = len(item.Item)
+		}
+	}
+
+	for _, item := range items {
+		if showDescriptions && len(items) > 1 && item.Description != "" {
+			fmt.Printf("%-*s  # %s\n", width, item.Item, item.Description)
+		} else {
+			fmt.Println(item.Item)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	FormatTable      = "table"
+	FormatJSON       = "json"
+	FormatYAML       = "yaml"
+	FormatGoTemplate = "go-template"
+	FormatJSONPath   = "jsonpath"
+)
+
+// OutputFormat is the format in which a command prints its result, as given
+// by the --format flag. Template holds the template or JSONPath expression for
+// the go-template and jsonpath formats.
+type OutputFormat struct {
+	Kind     string
+	Template string
+}
+
+func (format *OutputFormat) UnmarshalFlag(value string) error {
+	segs := strings.SplitN(value, "=", 2)
+
+	switch segs[0] {
+	case FormatTable, FormatJSON, FormatYAML:
+		if len(segs) == 2 {
+			return fmt.Errorf("format '%s' does not take an argument", segs[0])
+		}
+	case FormatGoTemplate, FormatJSONPath:
+		if len(segs) != 2 || segs[1] == "" {
+			return fmt.Errorf("format '%s' requires an argument, e.g. %s=...", segs[0], segs[0])
+		}
+
+		format.Template = segs[1]
+	default:
+		return fmt.Errorf("unknown format '%s'; must be one of table, json, yaml, go-template=..., or jsonpath=...", segs[0])
+	}
+
+	format.Kind = segs[0]
+
+	return nil
+}
+
+// IsTable returns true if the result should be rendered as a table, which is
+// the default.
+func (format OutputFormat) IsTable() bool {
+	return format.Kind == "" || format.Kind == FormatTable
+}
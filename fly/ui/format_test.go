@@ -0,0 +1,38 @@
+package ui_test
+
+import (
+	. "github.com/concourse/concourse/fly/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutputFormat", func() {
+	DescribeTable("UnmarshalFlag",
+		func(value string, expected OutputFormat, expectedErr string) {
+			var format OutputFormat
+
+			err := format.UnmarshalFlag(value)
+			if expectedErr != "" {
+				Expect(err).To(MatchError(expectedErr))
+			} else {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(format).To(Equal(expected))
+			}
+		},
+		Entry("table", "table", OutputFormat{Kind: FormatTable}, ""),
+		Entry("json", "json", OutputFormat{Kind: FormatJSON}, ""),
+		Entry("yaml", "yaml", OutputFormat{Kind: FormatYAML}, ""),
+		Entry("go-template", "go-template={{.name}}={{.id}}", OutputFormat{Kind: FormatGoTemplate, Template: "{{.name}}={{.id}}"}, ""),
+		Entry("jsonpath", "jsonpath={.name}", OutputFormat{Kind: FormatJSONPath, Template: "{.name}"}, ""),
+		Entry("json with an argument", "json=foo", OutputFormat{}, "format 'json' does not take an argument"),
+		Entry("go-template without a template", "go-template", OutputFormat{}, "format 'go-template' requires an argument, e.g. go-template=..."),
+		Entry("an unknown format", "xml", OutputFormat{}, "unknown format 'xml'; must be one of table, json, yaml, go-template=..., or jsonpath=..."),
+	)
+
+	It("defaults to a table", func() {
+		Expect(OutputFormat{}.IsTable()).To(BeTrue())
+		Expect(OutputFormat{Kind: FormatYAML}.IsTable()).To(BeFalse())
+	})
+})
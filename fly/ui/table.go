@@ -4,6 +4,8 @@ import (
 	"bufio"
 	"fmt"
 	"io"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/fatih/color"
@@ -30,6 +32,111 @@ func (d Data) Less(i int, j int) bool {
 	return d[i][0].Contents < d[j][0].Contents
 }
 
+// Select returns a table containing only the named columns, in the given
+// order. Column names are matched against the headers case-insensitively, with
+// dashes and underscores standing in for spaces.
+func (table Table) Select(columns []string) (Table, error) {
+	if len(columns) == 0 {
+		return table, nil
+	}
+
+	indices := make([]int, len(columns))
+	for i, column := range columns {
+		index, err := table.columnIndex(column)
+		if err != nil {
+			return Table{}, err
+		}
+
+		indices[i] = index
+	}
+
+	selected := Table{}
+	for _, index := range indices {
+		selected.Headers = append(selected.Headers, table.Headers[index])
+	}
+
+	for _, row := range table.Data {
+		selectedRow := TableRow{}
+		for _, index := range indices {
+			if index < len(row) {
+				selectedRow = append(selectedRow, row[index])
+			} else {
+				selectedRow = append(selectedRow, TableCell{})
+			}
+		}
+
+		selected.Data = append(selected.Data, selectedRow)
+	}
+
+	return selected, nil
+}
+
+// SortBy sorts the rows by the named column, comparing numerically when both
+// cells are numbers. A leading '-' sorts in descending order.
+func (table Table) SortBy(column string) (Table, error) {
+	if column == "" {
+		return table, nil
+	}
+
+	descending := strings.HasPrefix(column, "-")
+
+	index, err := table.columnIndex(strings.TrimPrefix(column, "-"))
+	if err != nil {
+		return Table{}, err
+	}
+
+	sorted := Table{
+		Headers: table.Headers,
+		Data:    append(Data{}, table.Data...),
+	}
+
+	sort.SliceStable(sorted.Data, func(i int, j int) bool {
+		a, b := cellContents(sorted.Data[i], index), cellContents(sorted.Data[j], index)
+		if descending {
+			a, b = b, a
+		}
+
+		an, aErr := strconv.ParseFloat(a, 64)
+		bn, bErr := strconv.ParseFloat(b, 64)
+		if aErr == nil && bErr == nil {
+			return an < bn
+		}
+
+		return a < b
+	})
+
+	return sorted, nil
+}
+
+func (table Table) columnIndex(name string) (int, error) {
+	normalized := normalizeColumnName(name)
+
+	for i, header := range table.Headers {
+		if normalizeColumnName(header.Contents) == normalized {
+			return i, nil
+		}
+	}
+
+	var names []string
+	for _, header := range table.Headers {
+		names = append(names, header.Contents)
+	}
+
+	return 0, fmt.Errorf("unknown column '%s'; available columns: %s", name, strings.Join(names, ", "))
+}
+
+func normalizeColumnName(name string) string {
+	return strings.NewReplacer("-", " ", "_", " ").Replace(strings.ToLower(strings.TrimSpace(name)))
+}
+
+func cellContents(row TableRow, index int) string {
+	if index < len(row) {
+		return row[index].Contents
+	}
+
+	return ""
+}
+
 func (table Table) Render(dst io.Writer, isPrintHeader bool) error {
 	dst, isTTY := ForTTY(dst)
 	bdst := bufio.NewWriter(dst)
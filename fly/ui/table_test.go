@@ -83,6 +83,87 @@ var _ = Describe("Table", func() {
 		})
 	})
 
+	Describe("Select", func() {
+		BeforeEach(func() {
+			table.Headers = append(table.Headers, TableCell{Contents: "created by"})
+			for i := range table.Data {
+				table.Data[i] = append(table.Data[i], TableCell{Contents: "someone"})
+			}
+		})
+
+		It("keeps only the given columns, in the given order", func() {
+			selected, err := table.Select([]string{"Created-By", "column1"})
+			Expect(err).ToNot(HaveOccurred())
+
+			buf := gbytes.NewBuffer()
+
+			err = selected.Render(buf, true)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(string(buf.Contents())).To(Equal("" +
+				"created by  column1\n" +
+				"someone     r1c1   \n" +
+				"someone     r2c1   \n" +
+				"someone     r3c1   \n"))
+		})
+
+		It("returns the table as-is when no columns are given", func() {
+			selected, err := table.Select(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(selected).To(Equal(table))
+		})
+
+		It("errors on an unknown column", func() {
+			_, err := table.Select([]string{"bogus"})
+			Expect(err).To(MatchError("unknown column 'bogus'; available columns: column1, column2, created by"))
+		})
+	})
+
+	Describe("SortBy", func() {
+		BeforeEach(func() {
+			table.Data = []TableRow{
+				{{Contents: "a"}, {Contents: "10"}},
+				{{Contents: "b"}, {Contents: "9"}},
+				{{Contents: "c"}, {Contents: "100"}},
+			}
+		})
+
+		It("sorts numeric columns numerically", func() {
+			sorted, err := table.SortBy("column2")
+			Expect(err).ToNot(HaveOccurred())
+
+			buf := gbytes.NewBuffer()
+
+			err = sorted.Render(buf, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(string(buf.Contents())).To(Equal("" +
+				"b  9  \n" +
+				"a  10 \n" +
+				"c  100\n"))
+		})
+
+		It("sorts in descending order when prefixed with '-'", func() {
+			sorted, err := table.SortBy("-column1")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(sorted.Data[0][0].Contents).To(Equal("c"))
+			Expect(sorted.Data[2][0].Contents).To(Equal("a"))
+		})
+
+		It("does not modify the original table", func() {
+			_, err := table.SortBy("-column1")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(table.Data[0][0].Contents).To(Equal("a"))
+		})
+
+		It("errors on an unknown column", func() {
+			_, err := table.SortBy("bogus")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Describe("Render", func() {
 		Context("when the render method is called without a TTY", func() {
 			It("prints the data with no headers", func() {
@@ -1,6 +1,8 @@
 package concourse
 
 import (
+	"bytes"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/url"
@@ -31,6 +33,99 @@ func (team *team) CreateArtifact(src io.Reader, platform string) (atc.WorkerArti
 	return artifact, err
 }
 
+// CreateIncrementalArtifact uploads an artifact given its manifest and only the
+// entries which are missing from the base artifact. A base artifact ID of 0
+// means the upload contains every entry. It returns false if the base artifact
+// can no longer be used.
+func (team *team) CreateIncrementalArtifact(src io.Reader, platform string, baseArtifactID int, manifest atc.ArtifactManifest) (atc.WorkerArtifact, bool, error) {
+	var artifact atc.WorkerArtifact
+
+	manifestJSON, err := json.Marshal(manifest)
+	if err != nil {
+		return atc.WorkerArtifact{}, false, err
+	}
+
+	params := rata.Params{
+		"team_name": team.Name(),
+	}
+
+	query := url.Values{"platform": {platform}}
+	if baseArtifactID != 0 {
+		query.Set("base", strconv.Itoa(baseArtifactID))
+	}
+
+	err = team.connection.Send(internal.Request{
+		Header:      http.Header{"Content-Type": {atc.IncrementalArtifactContentType}},
+		RequestName: atc.CreateArtifact,
+		Params:      params,
+		Query:       query,
+		Body:        io.MultiReader(bytes.NewReader(manifestJSON), src),
+	}, &internal.Response{
+		Result: &artifact,
+	})
+
+	if err != nil {
+		if unexpectedResponseError, ok := err.(internal.UnexpectedResponseError); ok {
+			switch unexpectedResponseError.StatusCode {
+			case http.StatusGone:
+				return atc.WorkerArtifact{}, false, nil
+			case http.StatusUnsupportedMediaType:
+				return atc.WorkerArtifact{}, false, ErrIncrementalArtifactsUnsupported
+			}
+		}
+
+		return atc.WorkerArtifact{}, false, err
+	}
+
+	return artifact, true, nil
+}
+
+// DiffArtifact returns the entries of the manifest which the given artifact
+// does not have, or false if the artifact can no longer be used as a base.
+// Servers without incremental uploads result in
+// ErrIncrementalArtifactsUnsupported.
+func (team *team) DiffArtifact(artifactID int, manifest atc.ArtifactManifest) ([]string, bool, error) {
+	var diff atc.ArtifactDiff
+
+	manifestJSON, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, false, err
+	}
+
+	params := rata.Params{
+		"team_name":   team.Name(),
+		"artifact_id": strconv.Itoa(artifactID),
+	}
+
+	err = team.connection.Send(internal.Request{
+		Header:      http.Header{"Content-Type": {"application/json"}},
+		RequestName: atc.DiffArtifact,
+		Params:      params,
+		Body:        bytes.NewReader(manifestJSON),
+	}, &internal.Response{
+		Result: &diff,
+	})
+
+	switch e := err.(type) {
+	case nil:
+		return diff.Missing, true, nil
+	case internal.ResourceNotFoundError:
+		// the route itself is missing
+		return nil, false, ErrIncrementalArtifactsUnsupported
+	case internal.UnexpectedResponseError:
+		switch e.StatusCode {
+		case http.StatusGone:
+			return nil, false, nil
+		case http.StatusUnsupportedMediaType:
+			return nil, false, ErrIncrementalArtifactsUnsupported
+		}
+
+		return nil, false, err
+	default:
+		return nil, false, err
+	}
+}
+
 func (team *team) GetArtifact(artifactID int) (io.ReadCloser, error) {
 	params := rata.Params{
 		"team_name":   team.Name(),
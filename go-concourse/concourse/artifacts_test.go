@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/ghttp"
@@ -52,6 +53,125 @@ var _ = Describe("Artifacts", func() {
 		})
 	})
 
+	Describe("CreateIncrementalArtifact", func() {
+		var manifest atc.ArtifactManifest
+
+		BeforeEach(func() {
+			manifest = atc.ArtifactManifest{
+				"some-file": {Mode: 0644, Digest: "some-digest"},
+			}
+		})
+
+		Context("when the base artifact exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/artifacts", "platform=some-platform&base=16"),
+						ghttp.VerifyHeader(http.Header{"Content-Type": {atc.IncrementalArtifactContentType}}),
+						ghttp.VerifyBody([]byte(`{"some-file":{"mode":420,"digest":"some-digest"}}some-contents`)),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.WorkerArtifact{ID: 17}),
+					),
+				)
+			})
+
+			It("sends the manifest followed by the contents", func() {
+				artifact, found, err := team.CreateIncrementalArtifact(bytes.NewBufferString("some-contents"), "some-platform", 16, manifest)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(artifact.ID).To(Equal(17))
+			})
+		})
+
+		Context("when the base artifact is gone", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/artifacts"),
+						ghttp.RespondWith(http.StatusGone, nil),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := team.CreateIncrementalArtifact(bytes.NewBufferString("some-contents"), "some-platform", 16, manifest)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the server does not support the upload", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/artifacts"),
+						ghttp.RespondWith(http.StatusUnsupportedMediaType, nil),
+					),
+				)
+			})
+
+			It("returns ErrIncrementalArtifactsUnsupported", func() {
+				_, _, err := team.CreateIncrementalArtifact(bytes.NewBufferString("some-contents"), "some-platform", 16, manifest)
+				Expect(err).To(Equal(concourse.ErrIncrementalArtifactsUnsupported))
+			})
+		})
+	})
+
+	Describe("DiffArtifact", func() {
+		Context("when the artifact exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/artifacts/16/diff"),
+						ghttp.VerifyJSON(`{"some-file":{"mode":420,"digest":"some-digest"}}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ArtifactDiff{Missing: []string{"some-file"}}),
+					),
+				)
+			})
+
+			It("returns the missing entries", func() {
+				missing, found, err := team.DiffArtifact(16, atc.ArtifactManifest{
+					"some-file": {Mode: 0644, Digest: "some-digest"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(missing).To(Equal([]string{"some-file"}))
+			})
+		})
+
+		Context("when the artifact is gone", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/artifacts/16/diff"),
+						ghttp.RespondWith(http.StatusGone, nil),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := team.DiffArtifact(16, atc.ArtifactManifest{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the server does not have the endpoint", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/artifacts/16/diff"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns ErrIncrementalArtifactsUnsupported", func() {
+				_, _, err := team.DiffArtifact(16, atc.ArtifactManifest{})
+				Expect(err).To(Equal(concourse.ErrIncrementalArtifactsUnsupported))
+			})
+		})
+	})
+
 	Describe("GetArtifact", func() {
 		Context("when getting the artifact fails", func() {
 			BeforeEach(func() {
@@ -138,6 +138,48 @@ func (client *client) AbortBuild(buildID string) error {
 	}, nil)
 }
 
+func (client *client) ApproveBuild(buildID string, comment string) (bool, error) {
+	return client.decideApproval(buildID, comment, atc.ApproveBuild)
+}
+
+func (client *client) RejectBuild(buildID string, comment string) (bool, error) {
+	return client.decideApproval(buildID, comment, atc.RejectBuild)
+}
+
+func (client *client) decideApproval(buildID string, comment string, requestName string) (bool, error) {
+	params := rata.Params{
+		"build_id": buildID,
+	}
+
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(atc.ApprovalRequest{Comment: comment})
+	if err != nil {
+		return false, fmt.Errorf("Unable to marshal approval request: %s", err)
+	}
+
+	err = client.connection.Send(internal.Request{
+		RequestName: requestName,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+		Params: params,
+		Body:   buffer,
+	}, nil)
+
+	switch e := err.(type) {
+	case nil:
+		return true, nil
+	case internal.UnexpectedResponseError:
+		if e.StatusCode == http.StatusConflict {
+			return false, nil
+		}
+
+		return false, err
+	default:
+		return false, err
+	}
+}
+
 func (team *team) Builds(page Page) ([]atc.Build, Pagination, error) {
 	var builds []atc.Build
 
@@ -423,6 +423,74 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 	})
 
+	Describe("ApproveBuild", func() {
+		var (
+			expectedStatus int
+			approved       bool
+			approveErr     error
+		)
+
+		BeforeEach(func() {
+			expectedStatus = http.StatusNoContent
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/builds/123/approve"),
+					ghttp.VerifyJSONRepresenting(atc.ApprovalRequest{Comment: "ship it"}),
+					ghttp.RespondWith(expectedStatus, ""),
+				),
+			)
+
+			approved, approveErr = client.ApproveBuild("123", "ship it")
+		})
+
+		It("approves the build", func() {
+			Expect(approveErr).NotTo(HaveOccurred())
+			Expect(approved).To(BeTrue())
+		})
+
+		Context("when the build is not awaiting approval", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusConflict
+			})
+
+			It("returns false", func() {
+				Expect(approveErr).NotTo(HaveOccurred())
+				Expect(approved).To(BeFalse())
+			})
+		})
+
+		Context("when the user may not approve the build", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusForbidden
+			})
+
+			It("returns an error", func() {
+				Expect(approveErr).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("RejectBuild", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/builds/123/reject"),
+					ghttp.VerifyJSONRepresenting(atc.ApprovalRequest{Comment: "not today"}),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("rejects the build", func() {
+			rejected, err := client.RejectBuild("123", "not today")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rejected).To(BeTrue())
+		})
+	})
+
 	Describe("team.Builds", func() {
 		expectedURL := "/api/v1/teams/some-team/builds"
 
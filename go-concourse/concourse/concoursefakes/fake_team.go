@@ -10,6 +10,22 @@ import (
 )
 
 type FakeTeam struct {
+	AddVersionLabelStub        func(string, string, int, string) (bool, error)
+	addVersionLabelMutex       sync.RWMutex
+	addVersionLabelArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 string
+	}
+	addVersionLabelReturns struct {
+		result1 bool
+		result2 error
+	}
+	addVersionLabelReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	BuildInputsForJobStub        func(string, string) ([]atc.BuildInput, bool, error)
 	buildInputsForJobMutex       sync.RWMutex
 	buildInputsForJobArgsForCall []struct {
@@ -152,6 +168,24 @@ type FakeTeam struct {
 		result1 atc.Build
 		result2 error
 	}
+	CreateIncrementalArtifactStub        func(io.Reader, string, int, atc.ArtifactManifest) (atc.WorkerArtifact, bool, error)
+	createIncrementalArtifactMutex       sync.RWMutex
+	createIncrementalArtifactArgsForCall []struct {
+		arg1 io.Reader
+		arg2 string
+		arg3 int
+		arg4 atc.ArtifactManifest
+	}
+	createIncrementalArtifactReturns struct {
+		result1 atc.WorkerArtifact
+		result2 bool
+		result3 error
+	}
+	createIncrementalArtifactReturnsOnCall map[int]struct {
+		result1 atc.WorkerArtifact
+		result2 bool
+		result3 error
+	}
 	CreateJobBuildStub        func(string, string) (atc.Build, error)
 	createJobBuildMutex       sync.RWMutex
 	createJobBuildArgsForCall []struct {
@@ -217,6 +251,35 @@ type FakeTeam struct {
 		result1 atc.Build
 		result2 error
 	}
+	CreateServiceAccountStub        func(string) (bool, error)
+	createServiceAccountMutex       sync.RWMutex
+	createServiceAccountArgsForCall []struct {
+		arg1 string
+	}
+	createServiceAccountReturns struct {
+		result1 bool
+		result2 error
+	}
+	createServiceAccountReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	CreateServiceAccountTokenStub        func(string, atc.ServiceAccountToken) (atc.ServiceAccountToken, bool, error)
+	createServiceAccountTokenMutex       sync.RWMutex
+	createServiceAccountTokenArgsForCall []struct {
+		arg1 string
+		arg2 atc.ServiceAccountToken
+	}
+	createServiceAccountTokenReturns struct {
+		result1 atc.ServiceAccountToken
+		result2 bool
+		result3 error
+	}
+	createServiceAccountTokenReturnsOnCall map[int]struct {
+		result1 atc.ServiceAccountToken
+		result2 bool
+		result3 error
+	}
 	DeletePipelineStub        func(string) (bool, error)
 	deletePipelineMutex       sync.RWMutex
 	deletePipelineArgsForCall []struct {
@@ -230,6 +293,19 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	DestroyServiceAccountStub        func(string) (bool, error)
+	destroyServiceAccountMutex       sync.RWMutex
+	destroyServiceAccountArgsForCall []struct {
+		arg1 string
+	}
+	destroyServiceAccountReturns struct {
+		result1 bool
+		result2 error
+	}
+	destroyServiceAccountReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	DestroyTeamStub        func(string) error
 	destroyTeamMutex       sync.RWMutex
 	destroyTeamArgsForCall []struct {
@@ -241,6 +317,22 @@ type FakeTeam struct {
 	destroyTeamReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DiffArtifactStub        func(int, atc.ArtifactManifest) ([]string, bool, error)
+	diffArtifactMutex       sync.RWMutex
+	diffArtifactArgsForCall []struct {
+		arg1 int
+		arg2 atc.ArtifactManifest
+	}
+	diffArtifactReturns struct {
+		result1 []string
+		result2 bool
+		result3 error
+	}
+	diffArtifactReturnsOnCall map[int]struct {
+		result1 []string
+		result2 bool
+		result3 error
+	}
 	DisableResourceVersionStub        func(string, string, int) (bool, error)
 	disableResourceVersionMutex       sync.RWMutex
 	disableResourceVersionArgsForCall []struct {
@@ -256,6 +348,23 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	DryRunPipelineConfigStub        func(string, []byte, bool) (atc.ConfigDryRun, []concourse.ConfigWarning, error)
+	dryRunPipelineConfigMutex       sync.RWMutex
+	dryRunPipelineConfigArgsForCall []struct {
+		arg1 string
+		arg2 []byte
+		arg3 bool
+	}
+	dryRunPipelineConfigReturns struct {
+		result1 atc.ConfigDryRun
+		result2 []concourse.ConfigWarning
+		result3 error
+	}
+	dryRunPipelineConfigReturnsOnCall map[int]struct {
+		result1 atc.ConfigDryRun
+		result2 []concourse.ConfigWarning
+		result3 error
+	}
 	EnableResourceVersionStub        func(string, string, int) (bool, error)
 	enableResourceVersionMutex       sync.RWMutex
 	enableResourceVersionArgsForCall []struct {
@@ -271,6 +380,22 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	ExplainJobInputsStub        func(string, string) (atc.JobInputsExplanation, bool, error)
+	explainJobInputsMutex       sync.RWMutex
+	explainJobInputsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	explainJobInputsReturns struct {
+		result1 atc.JobInputsExplanation
+		result2 bool
+		result3 error
+	}
+	explainJobInputsReturnsOnCall map[int]struct {
+		result1 atc.JobInputsExplanation
+		result2 bool
+		result3 error
+	}
 	ExposePipelineStub        func(string) (bool, error)
 	exposePipelineMutex       sync.RWMutex
 	exposePipelineArgsForCall []struct {
@@ -284,6 +409,41 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	FilteredResourceVersionsStub        func(string, string, atc.VersionFilter, int) ([]atc.ResourceVersion, bool, error)
+	filteredResourceVersionsMutex       sync.RWMutex
+	filteredResourceVersionsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 atc.VersionFilter
+		arg4 int
+	}
+	filteredResourceVersionsReturns struct {
+		result1 []atc.ResourceVersion
+		result2 bool
+		result3 error
+	}
+	filteredResourceVersionsReturnsOnCall map[int]struct {
+		result1 []atc.ResourceVersion
+		result2 bool
+		result3 error
+	}
+	FreezePipelineStub        func(string, int, string) ([]atc.FrozenResource, bool, error)
+	freezePipelineMutex       sync.RWMutex
+	freezePipelineArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 string
+	}
+	freezePipelineReturns struct {
+		result1 []atc.FrozenResource
+		result2 bool
+		result3 error
+	}
+	freezePipelineReturnsOnCall map[int]struct {
+		result1 []atc.FrozenResource
+		result2 bool
+		result3 error
+	}
 	GetArtifactStub        func(int) (io.ReadCloser, error)
 	getArtifactMutex       sync.RWMutex
 	getArtifactArgsForCall []struct {
@@ -375,6 +535,23 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	JobTaskResourceUsageStub        func(string, string, int) ([]atc.BuildTaskResourceUsage, bool, error)
+	jobTaskResourceUsageMutex       sync.RWMutex
+	jobTaskResourceUsageArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}
+	jobTaskResourceUsageReturns struct {
+		result1 []atc.BuildTaskResourceUsage
+		result2 bool
+		result3 error
+	}
+	jobTaskResourceUsageReturnsOnCall map[int]struct {
+		result1 []atc.BuildTaskResourceUsage
+		result2 bool
+		result3 error
+	}
 	ListContainersStub        func(map[string]string) ([]atc.Container, error)
 	listContainersMutex       sync.RWMutex
 	listContainersArgsForCall []struct {
@@ -401,6 +578,18 @@ type FakeTeam struct {
 		result1 []atc.Job
 		result2 error
 	}
+	ListLocksStub        func() ([]atc.Lock, error)
+	listLocksMutex       sync.RWMutex
+	listLocksArgsForCall []struct {
+	}
+	listLocksReturns struct {
+		result1 []atc.Lock
+		result2 error
+	}
+	listLocksReturnsOnCall map[int]struct {
+		result1 []atc.Lock
+		result2 error
+	}
 	ListPipelinesStub        func() ([]atc.Pipeline, error)
 	listPipelinesMutex       sync.RWMutex
 	listPipelinesArgsForCall []struct {
@@ -413,6 +602,18 @@ type FakeTeam struct {
 		result1 []atc.Pipeline
 		result2 error
 	}
+	ListQueueStub        func() ([]atc.Build, error)
+	listQueueMutex       sync.RWMutex
+	listQueueArgsForCall []struct {
+	}
+	listQueueReturns struct {
+		result1 []atc.Build
+		result2 error
+	}
+	listQueueReturnsOnCall map[int]struct {
+		result1 []atc.Build
+		result2 error
+	}
 	ListResourcesStub        func(string) ([]atc.Resource, error)
 	listResourcesMutex       sync.RWMutex
 	listResourcesArgsForCall []struct {
@@ -551,6 +752,35 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	ReleaseLockStub        func(string) (bool, error)
+	releaseLockMutex       sync.RWMutex
+	releaseLockArgsForCall []struct {
+		arg1 string
+	}
+	releaseLockReturns struct {
+		result1 bool
+		result2 error
+	}
+	releaseLockReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	RemoveVersionLabelStub        func(string, string, int, string) (bool, error)
+	removeVersionLabelMutex       sync.RWMutex
+	removeVersionLabelArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 string
+	}
+	removeVersionLabelReturns struct {
+		result1 bool
+		result2 error
+	}
+	removeVersionLabelReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	RenamePipelineStub        func(string, string) (bool, error)
 	renamePipelineMutex       sync.RWMutex
 	renamePipelineArgsForCall []struct {
@@ -615,6 +845,32 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	RevokeServiceAccountTokenStub        func(string, string) (bool, error)
+	revokeServiceAccountTokenMutex       sync.RWMutex
+	revokeServiceAccountTokenArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	revokeServiceAccountTokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	revokeServiceAccountTokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ServiceAccountsStub        func() ([]atc.ServiceAccount, error)
+	serviceAccountsMutex       sync.RWMutex
+	serviceAccountsArgsForCall []struct {
+	}
+	serviceAccountsReturns struct {
+		result1 []atc.ServiceAccount
+		result2 error
+	}
+	serviceAccountsReturnsOnCall map[int]struct {
+		result1 []atc.ServiceAccount
+		result2 error
+	}
 	SetPinCommentStub        func(string, string, string) (bool, error)
 	setPinCommentMutex       sync.RWMutex
 	setPinCommentArgsForCall []struct {
@@ -645,6 +901,19 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	UnfreezePipelineStub        func(string) (bool, error)
+	unfreezePipelineMutex       sync.RWMutex
+	unfreezePipelineArgsForCall []struct {
+		arg1 string
+	}
+	unfreezePipelineReturns struct {
+		result1 bool
+		result2 error
+	}
+	unfreezePipelineReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	UnpauseJobStub        func(string, string) (bool, error)
 	unpauseJobMutex       sync.RWMutex
 	unpauseJobArgsForCall []struct {
@@ -705,6 +974,72 @@ type FakeTeam struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeTeam) AddVersionLabel(arg1 string, arg2 string, arg3 int, arg4 string) (bool, error) {
+	fake.addVersionLabelMutex.Lock()
+	ret, specificReturn := fake.addVersionLabelReturnsOnCall[len(fake.addVersionLabelArgsForCall)]
+	fake.addVersionLabelArgsForCall = append(fake.addVersionLabelArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("AddVersionLabel", []interface{}{arg1, arg2, arg3, arg4})
+	fake.addVersionLabelMutex.Unlock()
+	if fake.AddVersionLabelStub != nil {
+		return fake.AddVersionLabelStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.addVersionLabelReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) AddVersionLabelCallCount() int {
+	fake.addVersionLabelMutex.RLock()
+	defer fake.addVersionLabelMutex.RUnlock()
+	return len(fake.addVersionLabelArgsForCall)
+}
+
+func (fake *FakeTeam) AddVersionLabelCalls(stub func(string, string, int, string) (bool, error)) {
+	fake.addVersionLabelMutex.Lock()
+	defer fake.addVersionLabelMutex.Unlock()
+	fake.AddVersionLabelStub = stub
+}
+
+func (fake *FakeTeam) AddVersionLabelArgsForCall(i int) (string, string, int, string) {
+	fake.addVersionLabelMutex.RLock()
+	defer fake.addVersionLabelMutex.RUnlock()
+	argsForCall := fake.addVersionLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) AddVersionLabelReturns(result1 bool, result2 error) {
+	fake.addVersionLabelMutex.Lock()
+	defer fake.addVersionLabelMutex.Unlock()
+	fake.AddVersionLabelStub = nil
+	fake.addVersionLabelReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) AddVersionLabelReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.addVersionLabelMutex.Lock()
+	defer fake.addVersionLabelMutex.Unlock()
+	fake.AddVersionLabelStub = nil
+	if fake.addVersionLabelReturnsOnCall == nil {
+		fake.addVersionLabelReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.addVersionLabelReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) BuildInputsForJob(arg1 string, arg2 string) ([]atc.BuildInput, bool, error) {
 	fake.buildInputsForJobMutex.Lock()
 	ret, specificReturn := fake.buildInputsForJobReturnsOnCall[len(fake.buildInputsForJobArgsForCall)]
@@ -1303,6 +1638,75 @@ func (fake *FakeTeam) CreateBuildReturnsOnCall(i int, result1 atc.Build, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CreateIncrementalArtifact(arg1 io.Reader, arg2 string, arg3 int, arg4 atc.ArtifactManifest) (atc.WorkerArtifact, bool, error) {
+	fake.createIncrementalArtifactMutex.Lock()
+	ret, specificReturn := fake.createIncrementalArtifactReturnsOnCall[len(fake.createIncrementalArtifactArgsForCall)]
+	fake.createIncrementalArtifactArgsForCall = append(fake.createIncrementalArtifactArgsForCall, struct {
+		arg1 io.Reader
+		arg2 string
+		arg3 int
+		arg4 atc.ArtifactManifest
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("CreateIncrementalArtifact", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createIncrementalArtifactMutex.Unlock()
+	if fake.CreateIncrementalArtifactStub != nil {
+		return fake.CreateIncrementalArtifactStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createIncrementalArtifactReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) CreateIncrementalArtifactCallCount() int {
+	fake.createIncrementalArtifactMutex.RLock()
+	defer fake.createIncrementalArtifactMutex.RUnlock()
+	return len(fake.createIncrementalArtifactArgsForCall)
+}
+
+func (fake *FakeTeam) CreateIncrementalArtifactCalls(stub func(io.Reader, string, int, atc.ArtifactManifest) (atc.WorkerArtifact, bool, error)) {
+	fake.createIncrementalArtifactMutex.Lock()
+	defer fake.createIncrementalArtifactMutex.Unlock()
+	fake.CreateIncrementalArtifactStub = stub
+}
+
+func (fake *FakeTeam) CreateIncrementalArtifactArgsForCall(i int) (io.Reader, string, int, atc.ArtifactManifest) {
+	fake.createIncrementalArtifactMutex.RLock()
+	defer fake.createIncrementalArtifactMutex.RUnlock()
+	argsForCall := fake.createIncrementalArtifactArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) CreateIncrementalArtifactReturns(result1 atc.WorkerArtifact, result2 bool, result3 error) {
+	fake.createIncrementalArtifactMutex.Lock()
+	defer fake.createIncrementalArtifactMutex.Unlock()
+	fake.CreateIncrementalArtifactStub = nil
+	fake.createIncrementalArtifactReturns = struct {
+		result1 atc.WorkerArtifact
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) CreateIncrementalArtifactReturnsOnCall(i int, result1 atc.WorkerArtifact, result2 bool, result3 error) {
+	fake.createIncrementalArtifactMutex.Lock()
+	defer fake.createIncrementalArtifactMutex.Unlock()
+	fake.CreateIncrementalArtifactStub = nil
+	if fake.createIncrementalArtifactReturnsOnCall == nil {
+		fake.createIncrementalArtifactReturnsOnCall = make(map[int]struct {
+			result1 atc.WorkerArtifact
+			result2 bool
+			result3 error
+		})
+	}
+	fake.createIncrementalArtifactReturnsOnCall[i] = struct {
+		result1 atc.WorkerArtifact
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) CreateJobBuild(arg1 string, arg2 string) (atc.Build, error) {
 	fake.createJobBuildMutex.Lock()
 	ret, specificReturn := fake.createJobBuildReturnsOnCall[len(fake.createJobBuildArgsForCall)]
@@ -1577,6 +1981,136 @@ func (fake *FakeTeam) CreatePipelineBuildReturnsOnCall(i int, result1 atc.Build,
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CreateServiceAccount(arg1 string) (bool, error) {
+	fake.createServiceAccountMutex.Lock()
+	ret, specificReturn := fake.createServiceAccountReturnsOnCall[len(fake.createServiceAccountArgsForCall)]
+	fake.createServiceAccountArgsForCall = append(fake.createServiceAccountArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("CreateServiceAccount", []interface{}{arg1})
+	fake.createServiceAccountMutex.Unlock()
+	if fake.CreateServiceAccountStub != nil {
+		return fake.CreateServiceAccountStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createServiceAccountReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) CreateServiceAccountCallCount() int {
+	fake.createServiceAccountMutex.RLock()
+	defer fake.createServiceAccountMutex.RUnlock()
+	return len(fake.createServiceAccountArgsForCall)
+}
+
+func (fake *FakeTeam) CreateServiceAccountCalls(stub func(string) (bool, error)) {
+	fake.createServiceAccountMutex.Lock()
+	defer fake.createServiceAccountMutex.Unlock()
+	fake.CreateServiceAccountStub = stub
+}
+
+func (fake *FakeTeam) CreateServiceAccountArgsForCall(i int) string {
+	fake.createServiceAccountMutex.RLock()
+	defer fake.createServiceAccountMutex.RUnlock()
+	argsForCall := fake.createServiceAccountArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) CreateServiceAccountReturns(result1 bool, result2 error) {
+	fake.createServiceAccountMutex.Lock()
+	defer fake.createServiceAccountMutex.Unlock()
+	fake.CreateServiceAccountStub = nil
+	fake.createServiceAccountReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateServiceAccountReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.createServiceAccountMutex.Lock()
+	defer fake.createServiceAccountMutex.Unlock()
+	fake.CreateServiceAccountStub = nil
+	if fake.createServiceAccountReturnsOnCall == nil {
+		fake.createServiceAccountReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.createServiceAccountReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateServiceAccountToken(arg1 string, arg2 atc.ServiceAccountToken) (atc.ServiceAccountToken, bool, error) {
+	fake.createServiceAccountTokenMutex.Lock()
+	ret, specificReturn := fake.createServiceAccountTokenReturnsOnCall[len(fake.createServiceAccountTokenArgsForCall)]
+	fake.createServiceAccountTokenArgsForCall = append(fake.createServiceAccountTokenArgsForCall, struct {
+		arg1 string
+		arg2 atc.ServiceAccountToken
+	}{arg1, arg2})
+	fake.recordInvocation("CreateServiceAccountToken", []interface{}{arg1, arg2})
+	fake.createServiceAccountTokenMutex.Unlock()
+	if fake.CreateServiceAccountTokenStub != nil {
+		return fake.CreateServiceAccountTokenStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createServiceAccountTokenReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenCallCount() int {
+	fake.createServiceAccountTokenMutex.RLock()
+	defer fake.createServiceAccountTokenMutex.RUnlock()
+	return len(fake.createServiceAccountTokenArgsForCall)
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenCalls(stub func(string, atc.ServiceAccountToken) (atc.ServiceAccountToken, bool, error)) {
+	fake.createServiceAccountTokenMutex.Lock()
+	defer fake.createServiceAccountTokenMutex.Unlock()
+	fake.CreateServiceAccountTokenStub = stub
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenArgsForCall(i int) (string, atc.ServiceAccountToken) {
+	fake.createServiceAccountTokenMutex.RLock()
+	defer fake.createServiceAccountTokenMutex.RUnlock()
+	argsForCall := fake.createServiceAccountTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenReturns(result1 atc.ServiceAccountToken, result2 bool, result3 error) {
+	fake.createServiceAccountTokenMutex.Lock()
+	defer fake.createServiceAccountTokenMutex.Unlock()
+	fake.CreateServiceAccountTokenStub = nil
+	fake.createServiceAccountTokenReturns = struct {
+		result1 atc.ServiceAccountToken
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) CreateServiceAccountTokenReturnsOnCall(i int, result1 atc.ServiceAccountToken, result2 bool, result3 error) {
+	fake.createServiceAccountTokenMutex.Lock()
+	defer fake.createServiceAccountTokenMutex.Unlock()
+	fake.CreateServiceAccountTokenStub = nil
+	if fake.createServiceAccountTokenReturnsOnCall == nil {
+		fake.createServiceAccountTokenReturnsOnCall = make(map[int]struct {
+			result1 atc.ServiceAccountToken
+			result2 bool
+			result3 error
+		})
+	}
+	fake.createServiceAccountTokenReturnsOnCall[i] = struct {
+		result1 atc.ServiceAccountToken
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) DeletePipeline(arg1 string) (bool, error) {
 	fake.deletePipelineMutex.Lock()
 	ret, specificReturn := fake.deletePipelineReturnsOnCall[len(fake.deletePipelineArgsForCall)]
@@ -1640,6 +2174,69 @@ func (fake *FakeTeam) DeletePipelineReturnsOnCall(i int, result1 bool, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) DestroyServiceAccount(arg1 string) (bool, error) {
+	fake.destroyServiceAccountMutex.Lock()
+	ret, specificReturn := fake.destroyServiceAccountReturnsOnCall[len(fake.destroyServiceAccountArgsForCall)]
+	fake.destroyServiceAccountArgsForCall = append(fake.destroyServiceAccountArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DestroyServiceAccount", []interface{}{arg1})
+	fake.destroyServiceAccountMutex.Unlock()
+	if fake.DestroyServiceAccountStub != nil {
+		return fake.DestroyServiceAccountStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.destroyServiceAccountReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DestroyServiceAccountCallCount() int {
+	fake.destroyServiceAccountMutex.RLock()
+	defer fake.destroyServiceAccountMutex.RUnlock()
+	return len(fake.destroyServiceAccountArgsForCall)
+}
+
+func (fake *FakeTeam) DestroyServiceAccountCalls(stub func(string) (bool, error)) {
+	fake.destroyServiceAccountMutex.Lock()
+	defer fake.destroyServiceAccountMutex.Unlock()
+	fake.DestroyServiceAccountStub = stub
+}
+
+func (fake *FakeTeam) DestroyServiceAccountArgsForCall(i int) string {
+	fake.destroyServiceAccountMutex.RLock()
+	defer fake.destroyServiceAccountMutex.RUnlock()
+	argsForCall := fake.destroyServiceAccountArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DestroyServiceAccountReturns(result1 bool, result2 error) {
+	fake.destroyServiceAccountMutex.Lock()
+	defer fake.destroyServiceAccountMutex.Unlock()
+	fake.DestroyServiceAccountStub = nil
+	fake.destroyServiceAccountReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DestroyServiceAccountReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.destroyServiceAccountMutex.Lock()
+	defer fake.destroyServiceAccountMutex.Unlock()
+	fake.DestroyServiceAccountStub = nil
+	if fake.destroyServiceAccountReturnsOnCall == nil {
+		fake.destroyServiceAccountReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.destroyServiceAccountReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) DestroyTeam(arg1 string) error {
 	fake.destroyTeamMutex.Lock()
 	ret, specificReturn := fake.destroyTeamReturnsOnCall[len(fake.destroyTeamArgsForCall)]
@@ -1700,6 +2297,73 @@ func (fake *FakeTeam) DestroyTeamReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) DiffArtifact(arg1 int, arg2 atc.ArtifactManifest) ([]string, bool, error) {
+	fake.diffArtifactMutex.Lock()
+	ret, specificReturn := fake.diffArtifactReturnsOnCall[len(fake.diffArtifactArgsForCall)]
+	fake.diffArtifactArgsForCall = append(fake.diffArtifactArgsForCall, struct {
+		arg1 int
+		arg2 atc.ArtifactManifest
+	}{arg1, arg2})
+	fake.recordInvocation("DiffArtifact", []interface{}{arg1, arg2})
+	fake.diffArtifactMutex.Unlock()
+	if fake.DiffArtifactStub != nil {
+		return fake.DiffArtifactStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.diffArtifactReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) DiffArtifactCallCount() int {
+	fake.diffArtifactMutex.RLock()
+	defer fake.diffArtifactMutex.RUnlock()
+	return len(fake.diffArtifactArgsForCall)
+}
+
+func (fake *FakeTeam) DiffArtifactCalls(stub func(int, atc.ArtifactManifest) ([]string, bool, error)) {
+	fake.diffArtifactMutex.Lock()
+	defer fake.diffArtifactMutex.Unlock()
+	fake.DiffArtifactStub = stub
+}
+
+func (fake *FakeTeam) DiffArtifactArgsForCall(i int) (int, atc.ArtifactManifest) {
+	fake.diffArtifactMutex.RLock()
+	defer fake.diffArtifactMutex.RUnlock()
+	argsForCall := fake.diffArtifactArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) DiffArtifactReturns(result1 []string, result2 bool, result3 error) {
+	fake.diffArtifactMutex.Lock()
+	defer fake.diffArtifactMutex.Unlock()
+	fake.DiffArtifactStub = nil
+	fake.diffArtifactReturns = struct {
+		result1 []string
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) DiffArtifactReturnsOnCall(i int, result1 []string, result2 bool, result3 error) {
+	fake.diffArtifactMutex.Lock()
+	defer fake.diffArtifactMutex.Unlock()
+	fake.DiffArtifactStub = nil
+	if fake.diffArtifactReturnsOnCall == nil {
+		fake.diffArtifactReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 bool
+			result3 error
+		})
+	}
+	fake.diffArtifactReturnsOnCall[i] = struct {
+		result1 []string
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) DisableResourceVersion(arg1 string, arg2 string, arg3 int) (bool, error) {
 	fake.disableResourceVersionMutex.Lock()
 	ret, specificReturn := fake.disableResourceVersionReturnsOnCall[len(fake.disableResourceVersionArgsForCall)]
@@ -1765,6 +2429,79 @@ func (fake *FakeTeam) DisableResourceVersionReturnsOnCall(i int, result1 bool, r
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) DryRunPipelineConfig(arg1 string, arg2 []byte, arg3 bool) (atc.ConfigDryRun, []concourse.ConfigWarning, error) {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.dryRunPipelineConfigMutex.Lock()
+	ret, specificReturn := fake.dryRunPipelineConfigReturnsOnCall[len(fake.dryRunPipelineConfigArgsForCall)]
+	fake.dryRunPipelineConfigArgsForCall = append(fake.dryRunPipelineConfigArgsForCall, struct {
+		arg1 string
+		arg2 []byte
+		arg3 bool
+	}{arg1, arg2Copy, arg3})
+	fake.recordInvocation("DryRunPipelineConfig", []interface{}{arg1, arg2Copy, arg3})
+	fake.dryRunPipelineConfigMutex.Unlock()
+	if fake.DryRunPipelineConfigStub != nil {
+		return fake.DryRunPipelineConfigStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.dryRunPipelineConfigReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) DryRunPipelineConfigCallCount() int {
+	fake.dryRunPipelineConfigMutex.RLock()
+	defer fake.dryRunPipelineConfigMutex.RUnlock()
+	return len(fake.dryRunPipelineConfigArgsForCall)
+}
+
+func (fake *FakeTeam) DryRunPipelineConfigCalls(stub func(string, []byte, bool) (atc.ConfigDryRun, []concourse.ConfigWarning, error)) {
+	fake.dryRunPipelineConfigMutex.Lock()
+	defer fake.dryRunPipelineConfigMutex.Unlock()
+	fake.DryRunPipelineConfigStub = stub
+}
+
+func (fake *FakeTeam) DryRunPipelineConfigArgsForCall(i int) (string, []byte, bool) {
+	fake.dryRunPipelineConfigMutex.RLock()
+	defer fake.dryRunPipelineConfigMutex.RUnlock()
+	argsForCall := fake.dryRunPipelineConfigArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) DryRunPipelineConfigReturns(result1 atc.ConfigDryRun, result2 []concourse.ConfigWarning, result3 error) {
+	fake.dryRunPipelineConfigMutex.Lock()
+	defer fake.dryRunPipelineConfigMutex.Unlock()
+	fake.DryRunPipelineConfigStub = nil
+	fake.dryRunPipelineConfigReturns = struct {
+		result1 atc.ConfigDryRun
+		result2 []concourse.ConfigWarning
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) DryRunPipelineConfigReturnsOnCall(i int, result1 atc.ConfigDryRun, result2 []concourse.ConfigWarning, result3 error) {
+	fake.dryRunPipelineConfigMutex.Lock()
+	defer fake.dryRunPipelineConfigMutex.Unlock()
+	fake.DryRunPipelineConfigStub = nil
+	if fake.dryRunPipelineConfigReturnsOnCall == nil {
+		fake.dryRunPipelineConfigReturnsOnCall = make(map[int]struct {
+			result1 atc.ConfigDryRun
+			result2 []concourse.ConfigWarning
+			result3 error
+		})
+	}
+	fake.dryRunPipelineConfigReturnsOnCall[i] = struct {
+		result1 atc.ConfigDryRun
+		result2 []concourse.ConfigWarning
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) EnableResourceVersion(arg1 string, arg2 string, arg3 int) (bool, error) {
 	fake.enableResourceVersionMutex.Lock()
 	ret, specificReturn := fake.enableResourceVersionReturnsOnCall[len(fake.enableResourceVersionArgsForCall)]
@@ -1830,6 +2567,73 @@ func (fake *FakeTeam) EnableResourceVersionReturnsOnCall(i int, result1 bool, re
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) ExplainJobInputs(arg1 string, arg2 string) (atc.JobInputsExplanation, bool, error) {
+	fake.explainJobInputsMutex.Lock()
+	ret, specificReturn := fake.explainJobInputsReturnsOnCall[len(fake.explainJobInputsArgsForCall)]
+	fake.explainJobInputsArgsForCall = append(fake.explainJobInputsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ExplainJobInputs", []interface{}{arg1, arg2})
+	fake.explainJobInputsMutex.Unlock()
+	if fake.ExplainJobInputsStub != nil {
+		return fake.ExplainJobInputsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.explainJobInputsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) ExplainJobInputsCallCount() int {
+	fake.explainJobInputsMutex.RLock()
+	defer fake.explainJobInputsMutex.RUnlock()
+	return len(fake.explainJobInputsArgsForCall)
+}
+
+func (fake *FakeTeam) ExplainJobInputsCalls(stub func(string, string) (atc.JobInputsExplanation, bool, error)) {
+	fake.explainJobInputsMutex.Lock()
+	defer fake.explainJobInputsMutex.Unlock()
+	fake.ExplainJobInputsStub = stub
+}
+
+func (fake *FakeTeam) ExplainJobInputsArgsForCall(i int) (string, string) {
+	fake.explainJobInputsMutex.RLock()
+	defer fake.explainJobInputsMutex.RUnlock()
+	argsForCall := fake.explainJobInputsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) ExplainJobInputsReturns(result1 atc.JobInputsExplanation, result2 bool, result3 error) {
+	fake.explainJobInputsMutex.Lock()
+	defer fake.explainJobInputsMutex.Unlock()
+	fake.ExplainJobInputsStub = nil
+	fake.explainJobInputsReturns = struct {
+		result1 atc.JobInputsExplanation
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) ExplainJobInputsReturnsOnCall(i int, result1 atc.JobInputsExplanation, result2 bool, result3 error) {
+	fake.explainJobInputsMutex.Lock()
+	defer fake.explainJobInputsMutex.Unlock()
+	fake.ExplainJobInputsStub = nil
+	if fake.explainJobInputsReturnsOnCall == nil {
+		fake.explainJobInputsReturnsOnCall = make(map[int]struct {
+			result1 atc.JobInputsExplanation
+			result2 bool
+			result3 error
+		})
+	}
+	fake.explainJobInputsReturnsOnCall[i] = struct {
+		result1 atc.JobInputsExplanation
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) ExposePipeline(arg1 string) (bool, error) {
 	fake.exposePipelineMutex.Lock()
 	ret, specificReturn := fake.exposePipelineReturnsOnCall[len(fake.exposePipelineArgsForCall)]
@@ -1867,30 +2671,167 @@ func (fake *FakeTeam) ExposePipelineArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
-func (fake *FakeTeam) ExposePipelineReturns(result1 bool, result2 error) {
-	fake.exposePipelineMutex.Lock()
-	defer fake.exposePipelineMutex.Unlock()
-	fake.ExposePipelineStub = nil
-	fake.exposePipelineReturns = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
+func (fake *FakeTeam) ExposePipelineReturns(result1 bool, result2 error) {
+	fake.exposePipelineMutex.Lock()
+	defer fake.exposePipelineMutex.Unlock()
+	fake.ExposePipelineStub = nil
+	fake.exposePipelineReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ExposePipelineReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.exposePipelineMutex.Lock()
+	defer fake.exposePipelineMutex.Unlock()
+	fake.ExposePipelineStub = nil
+	if fake.exposePipelineReturnsOnCall == nil {
+		fake.exposePipelineReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.exposePipelineReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) FilteredResourceVersions(arg1 string, arg2 string, arg3 atc.VersionFilter, arg4 int) ([]atc.ResourceVersion, bool, error) {
+	fake.filteredResourceVersionsMutex.Lock()
+	ret, specificReturn := fake.filteredResourceVersionsReturnsOnCall[len(fake.filteredResourceVersionsArgsForCall)]
+	fake.filteredResourceVersionsArgsForCall = append(fake.filteredResourceVersionsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 atc.VersionFilter
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("FilteredResourceVersions", []interface{}{arg1, arg2, arg3, arg4})
+	fake.filteredResourceVersionsMutex.Unlock()
+	if fake.FilteredResourceVersionsStub != nil {
+		return fake.FilteredResourceVersionsStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.filteredResourceVersionsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) FilteredResourceVersionsCallCount() int {
+	fake.filteredResourceVersionsMutex.RLock()
+	defer fake.filteredResourceVersionsMutex.RUnlock()
+	return len(fake.filteredResourceVersionsArgsForCall)
+}
+
+func (fake *FakeTeam) FilteredResourceVersionsCalls(stub func(string, string, atc.VersionFilter, int) ([]atc.ResourceVersion, bool, error)) {
+	fake.filteredResourceVersionsMutex.Lock()
+	defer fake.filteredResourceVersionsMutex.Unlock()
+	fake.FilteredResourceVersionsStub = stub
+}
+
+func (fake *FakeTeam) FilteredResourceVersionsArgsForCall(i int) (string, string, atc.VersionFilter, int) {
+	fake.filteredResourceVersionsMutex.RLock()
+	defer fake.filteredResourceVersionsMutex.RUnlock()
+	argsForCall := fake.filteredResourceVersionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) FilteredResourceVersionsReturns(result1 []atc.ResourceVersion, result2 bool, result3 error) {
+	fake.filteredResourceVersionsMutex.Lock()
+	defer fake.filteredResourceVersionsMutex.Unlock()
+	fake.FilteredResourceVersionsStub = nil
+	fake.filteredResourceVersionsReturns = struct {
+		result1 []atc.ResourceVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) FilteredResourceVersionsReturnsOnCall(i int, result1 []atc.ResourceVersion, result2 bool, result3 error) {
+	fake.filteredResourceVersionsMutex.Lock()
+	defer fake.filteredResourceVersionsMutex.Unlock()
+	fake.FilteredResourceVersionsStub = nil
+	if fake.filteredResourceVersionsReturnsOnCall == nil {
+		fake.filteredResourceVersionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.ResourceVersion
+			result2 bool
+			result3 error
+		})
+	}
+	fake.filteredResourceVersionsReturnsOnCall[i] = struct {
+		result1 []atc.ResourceVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) FreezePipeline(arg1 string, arg2 int, arg3 string) ([]atc.FrozenResource, bool, error) {
+	fake.freezePipelineMutex.Lock()
+	ret, specificReturn := fake.freezePipelineReturnsOnCall[len(fake.freezePipelineArgsForCall)]
+	fake.freezePipelineArgsForCall = append(fake.freezePipelineArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("FreezePipeline", []interface{}{arg1, arg2, arg3})
+	fake.freezePipelineMutex.Unlock()
+	if fake.FreezePipelineStub != nil {
+		return fake.FreezePipelineStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.freezePipelineReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) FreezePipelineCallCount() int {
+	fake.freezePipelineMutex.RLock()
+	defer fake.freezePipelineMutex.RUnlock()
+	return len(fake.freezePipelineArgsForCall)
+}
+
+func (fake *FakeTeam) FreezePipelineCalls(stub func(string, int, string) ([]atc.FrozenResource, bool, error)) {
+	fake.freezePipelineMutex.Lock()
+	defer fake.freezePipelineMutex.Unlock()
+	fake.FreezePipelineStub = stub
+}
+
+func (fake *FakeTeam) FreezePipelineArgsForCall(i int) (string, int, string) {
+	fake.freezePipelineMutex.RLock()
+	defer fake.freezePipelineMutex.RUnlock()
+	argsForCall := fake.freezePipelineArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) FreezePipelineReturns(result1 []atc.FrozenResource, result2 bool, result3 error) {
+	fake.freezePipelineMutex.Lock()
+	defer fake.freezePipelineMutex.Unlock()
+	fake.FreezePipelineStub = nil
+	fake.freezePipelineReturns = struct {
+		result1 []atc.FrozenResource
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) ExposePipelineReturnsOnCall(i int, result1 bool, result2 error) {
-	fake.exposePipelineMutex.Lock()
-	defer fake.exposePipelineMutex.Unlock()
-	fake.ExposePipelineStub = nil
-	if fake.exposePipelineReturnsOnCall == nil {
-		fake.exposePipelineReturnsOnCall = make(map[int]struct {
-			result1 bool
-			result2 error
+func (fake *FakeTeam) FreezePipelineReturnsOnCall(i int, result1 []atc.FrozenResource, result2 bool, result3 error) {
+	fake.freezePipelineMutex.Lock()
+	defer fake.freezePipelineMutex.Unlock()
+	fake.FreezePipelineStub = nil
+	if fake.freezePipelineReturnsOnCall == nil {
+		fake.freezePipelineReturnsOnCall = make(map[int]struct {
+			result1 []atc.FrozenResource
+			result2 bool
+			result3 error
 		})
 	}
-	fake.exposePipelineReturnsOnCall[i] = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
+	fake.freezePipelineReturnsOnCall[i] = struct {
+		result1 []atc.FrozenResource
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
 }
 
 func (fake *FakeTeam) GetArtifact(arg1 int) (io.ReadCloser, error) {
@@ -2288,6 +3229,74 @@ func (fake *FakeTeam) JobBuildsReturnsOnCall(i int, result1 []atc.Build, result2
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) JobTaskResourceUsage(arg1 string, arg2 string, arg3 int) ([]atc.BuildTaskResourceUsage, bool, error) {
+	fake.jobTaskResourceUsageMutex.Lock()
+	ret, specificReturn := fake.jobTaskResourceUsageReturnsOnCall[len(fake.jobTaskResourceUsageArgsForCall)]
+	fake.jobTaskResourceUsageArgsForCall = append(fake.jobTaskResourceUsageArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("JobTaskResourceUsage", []interface{}{arg1, arg2, arg3})
+	fake.jobTaskResourceUsageMutex.Unlock()
+	if fake.JobTaskResourceUsageStub != nil {
+		return fake.JobTaskResourceUsageStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.jobTaskResourceUsageReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) JobTaskResourceUsageCallCount() int {
+	fake.jobTaskResourceUsageMutex.RLock()
+	defer fake.jobTaskResourceUsageMutex.RUnlock()
+	return len(fake.jobTaskResourceUsageArgsForCall)
+}
+
+func (fake *FakeTeam) JobTaskResourceUsageCalls(stub func(string, string, int) ([]atc.BuildTaskResourceUsage, bool, error)) {
+	fake.jobTaskResourceUsageMutex.Lock()
+	defer fake.jobTaskResourceUsageMutex.Unlock()
+	fake.JobTaskResourceUsageStub = stub
+}
+
+func (fake *FakeTeam) JobTaskResourceUsageArgsForCall(i int) (string, string, int) {
+	fake.jobTaskResourceUsageMutex.RLock()
+	defer fake.jobTaskResourceUsageMutex.RUnlock()
+	argsForCall := fake.jobTaskResourceUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) JobTaskResourceUsageReturns(result1 []atc.BuildTaskResourceUsage, result2 bool, result3 error) {
+	fake.jobTaskResourceUsageMutex.Lock()
+	defer fake.jobTaskResourceUsageMutex.Unlock()
+	fake.JobTaskResourceUsageStub = nil
+	fake.jobTaskResourceUsageReturns = struct {
+		result1 []atc.BuildTaskResourceUsage
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) JobTaskResourceUsageReturnsOnCall(i int, result1 []atc.BuildTaskResourceUsage, result2 bool, result3 error) {
+	fake.jobTaskResourceUsageMutex.Lock()
+	defer fake.jobTaskResourceUsageMutex.Unlock()
+	fake.JobTaskResourceUsageStub = nil
+	if fake.jobTaskResourceUsageReturnsOnCall == nil {
+		fake.jobTaskResourceUsageReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildTaskResourceUsage
+			result2 bool
+			result3 error
+		})
+	}
+	fake.jobTaskResourceUsageReturnsOnCall[i] = struct {
+		result1 []atc.BuildTaskResourceUsage
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) ListContainers(arg1 map[string]string) ([]atc.Container, error) {
 	fake.listContainersMutex.Lock()
 	ret, specificReturn := fake.listContainersReturnsOnCall[len(fake.listContainersArgsForCall)]
@@ -2414,6 +3423,61 @@ func (fake *FakeTeam) ListJobsReturnsOnCall(i int, result1 []atc.Job, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) ListLocks() ([]atc.Lock, error) {
+	fake.listLocksMutex.Lock()
+	ret, specificReturn := fake.listLocksReturnsOnCall[len(fake.listLocksArgsForCall)]
+	fake.listLocksArgsForCall = append(fake.listLocksArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListLocks", []interface{}{})
+	fake.listLocksMutex.Unlock()
+	if fake.ListLocksStub != nil {
+		return fake.ListLocksStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listLocksReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ListLocksCallCount() int {
+	fake.listLocksMutex.RLock()
+	defer fake.listLocksMutex.RUnlock()
+	return len(fake.listLocksArgsForCall)
+}
+
+func (fake *FakeTeam) ListLocksCalls(stub func() ([]atc.Lock, error)) {
+	fake.listLocksMutex.Lock()
+	defer fake.listLocksMutex.Unlock()
+	fake.ListLocksStub = stub
+}
+
+func (fake *FakeTeam) ListLocksReturns(result1 []atc.Lock, result2 error) {
+	fake.listLocksMutex.Lock()
+	defer fake.listLocksMutex.Unlock()
+	fake.ListLocksStub = nil
+	fake.listLocksReturns = struct {
+		result1 []atc.Lock
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ListLocksReturnsOnCall(i int, result1 []atc.Lock, result2 error) {
+	fake.listLocksMutex.Lock()
+	defer fake.listLocksMutex.Unlock()
+	fake.ListLocksStub = nil
+	if fake.listLocksReturnsOnCall == nil {
+		fake.listLocksReturnsOnCall = make(map[int]struct {
+			result1 []atc.Lock
+			result2 error
+		})
+	}
+	fake.listLocksReturnsOnCall[i] = struct {
+		result1 []atc.Lock
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ListPipelines() ([]atc.Pipeline, error) {
 	fake.listPipelinesMutex.Lock()
 	ret, specificReturn := fake.listPipelinesReturnsOnCall[len(fake.listPipelinesArgsForCall)]
@@ -2469,6 +3533,61 @@ func (fake *FakeTeam) ListPipelinesReturnsOnCall(i int, result1 []atc.Pipeline,
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) ListQueue() ([]atc.Build, error) {
+	fake.listQueueMutex.Lock()
+	ret, specificReturn := fake.listQueueReturnsOnCall[len(fake.listQueueArgsForCall)]
+	fake.listQueueArgsForCall = append(fake.listQueueArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListQueue", []interface{}{})
+	fake.listQueueMutex.Unlock()
+	if fake.ListQueueStub != nil {
+		return fake.ListQueueStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listQueueReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ListQueueCallCount() int {
+	fake.listQueueMutex.RLock()
+	defer fake.listQueueMutex.RUnlock()
+	return len(fake.listQueueArgsForCall)
+}
+
+func (fake *FakeTeam) ListQueueCalls(stub func() ([]atc.Build, error)) {
+	fake.listQueueMutex.Lock()
+	defer fake.listQueueMutex.Unlock()
+	fake.ListQueueStub = stub
+}
+
+func (fake *FakeTeam) ListQueueReturns(result1 []atc.Build, result2 error) {
+	fake.listQueueMutex.Lock()
+	defer fake.listQueueMutex.Unlock()
+	fake.ListQueueStub = nil
+	fake.listQueueReturns = struct {
+		result1 []atc.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ListQueueReturnsOnCall(i int, result1 []atc.Build, result2 error) {
+	fake.listQueueMutex.Lock()
+	defer fake.listQueueMutex.Unlock()
+	fake.ListQueueStub = nil
+	if fake.listQueueReturnsOnCall == nil {
+		fake.listQueueReturnsOnCall = make(map[int]struct {
+			result1 []atc.Build
+			result2 error
+		})
+	}
+	fake.listQueueReturnsOnCall[i] = struct {
+		result1 []atc.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ListResources(arg1 string) ([]atc.Resource, error) {
 	fake.listResourcesMutex.Lock()
 	ret, specificReturn := fake.listResourcesReturnsOnCall[len(fake.listResourcesArgsForCall)]
@@ -3101,6 +4220,135 @@ func (fake *FakeTeam) PipelineConfigReturnsOnCall(i int, result1 atc.Config, res
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) ReleaseLock(arg1 string) (bool, error) {
+	fake.releaseLockMutex.Lock()
+	ret, specificReturn := fake.releaseLockReturnsOnCall[len(fake.releaseLockArgsForCall)]
+	fake.releaseLockArgsForCall = append(fake.releaseLockArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ReleaseLock", []interface{}{arg1})
+	fake.releaseLockMutex.Unlock()
+	if fake.ReleaseLockStub != nil {
+		return fake.ReleaseLockStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.releaseLockReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ReleaseLockCallCount() int {
+	fake.releaseLockMutex.RLock()
+	defer fake.releaseLockMutex.RUnlock()
+	return len(fake.releaseLockArgsForCall)
+}
+
+func (fake *FakeTeam) ReleaseLockCalls(stub func(string) (bool, error)) {
+	fake.releaseLockMutex.Lock()
+	defer fake.releaseLockMutex.Unlock()
+	fake.ReleaseLockStub = stub
+}
+
+func (fake *FakeTeam) ReleaseLockArgsForCall(i int) string {
+	fake.releaseLockMutex.RLock()
+	defer fake.releaseLockMutex.RUnlock()
+	argsForCall := fake.releaseLockArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) ReleaseLockReturns(result1 bool, result2 error) {
+	fake.releaseLockMutex.Lock()
+	defer fake.releaseLockMutex.Unlock()
+	fake.ReleaseLockStub = nil
+	fake.releaseLockReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ReleaseLockReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.releaseLockMutex.Lock()
+	defer fake.releaseLockMutex.Unlock()
+	fake.ReleaseLockStub = nil
+	if fake.releaseLockReturnsOnCall == nil {
+		fake.releaseLockReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.releaseLockReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RemoveVersionLabel(arg1 string, arg2 string, arg3 int, arg4 string) (bool, error) {
+	fake.removeVersionLabelMutex.Lock()
+	ret, specificReturn := fake.removeVersionLabelReturnsOnCall[len(fake.removeVersionLabelArgsForCall)]
+	fake.removeVersionLabelArgsForCall = append(fake.removeVersionLabelArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("RemoveVersionLabel", []interface{}{arg1, arg2, arg3, arg4})
+	fake.removeVersionLabelMutex.Unlock()
+	if fake.RemoveVersionLabelStub != nil {
+		return fake.RemoveVersionLabelStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeVersionLabelReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) RemoveVersionLabelCallCount() int {
+	fake.removeVersionLabelMutex.RLock()
+	defer fake.removeVersionLabelMutex.RUnlock()
+	return len(fake.removeVersionLabelArgsForCall)
+}
+
+func (fake *FakeTeam) RemoveVersionLabelCalls(stub func(string, string, int, string) (bool, error)) {
+	fake.removeVersionLabelMutex.Lock()
+	defer fake.removeVersionLabelMutex.Unlock()
+	fake.RemoveVersionLabelStub = stub
+}
+
+func (fake *FakeTeam) RemoveVersionLabelArgsForCall(i int) (string, string, int, string) {
+	fake.removeVersionLabelMutex.RLock()
+	defer fake.removeVersionLabelMutex.RUnlock()
+	argsForCall := fake.removeVersionLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) RemoveVersionLabelReturns(result1 bool, result2 error) {
+	fake.removeVersionLabelMutex.Lock()
+	defer fake.removeVersionLabelMutex.Unlock()
+	fake.RemoveVersionLabelStub = nil
+	fake.removeVersionLabelReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RemoveVersionLabelReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.removeVersionLabelMutex.Lock()
+	defer fake.removeVersionLabelMutex.Unlock()
+	fake.RemoveVersionLabelStub = nil
+	if fake.removeVersionLabelReturnsOnCall == nil {
+		fake.removeVersionLabelReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.removeVersionLabelReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) RenamePipeline(arg1 string, arg2 string) (bool, error) {
 	fake.renamePipelineMutex.Lock()
 	ret, specificReturn := fake.renamePipelineReturnsOnCall[len(fake.renamePipelineArgsForCall)]
@@ -3368,6 +4616,125 @@ func (fake *FakeTeam) ResourceVersionsReturnsOnCall(i int, result1 []atc.Resourc
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) RevokeServiceAccountToken(arg1 string, arg2 string) (bool, error) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	ret, specificReturn := fake.revokeServiceAccountTokenReturnsOnCall[len(fake.revokeServiceAccountTokenArgsForCall)]
+	fake.revokeServiceAccountTokenArgsForCall = append(fake.revokeServiceAccountTokenArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RevokeServiceAccountToken", []interface{}{arg1, arg2})
+	fake.revokeServiceAccountTokenMutex.Unlock()
+	if fake.RevokeServiceAccountTokenStub != nil {
+		return fake.RevokeServiceAccountTokenStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.revokeServiceAccountTokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenCallCount() int {
+	fake.revokeServiceAccountTokenMutex.RLock()
+	defer fake.revokeServiceAccountTokenMutex.RUnlock()
+	return len(fake.revokeServiceAccountTokenArgsForCall)
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenCalls(stub func(string, string) (bool, error)) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	defer fake.revokeServiceAccountTokenMutex.Unlock()
+	fake.RevokeServiceAccountTokenStub = stub
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenArgsForCall(i int) (string, string) {
+	fake.revokeServiceAccountTokenMutex.RLock()
+	defer fake.revokeServiceAccountTokenMutex.RUnlock()
+	argsForCall := fake.revokeServiceAccountTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenReturns(result1 bool, result2 error) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	defer fake.revokeServiceAccountTokenMutex.Unlock()
+	fake.RevokeServiceAccountTokenStub = nil
+	fake.revokeServiceAccountTokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RevokeServiceAccountTokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	defer fake.revokeServiceAccountTokenMutex.Unlock()
+	fake.RevokeServiceAccountTokenStub = nil
+	if fake.revokeServiceAccountTokenReturnsOnCall == nil {
+		fake.revokeServiceAccountTokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.revokeServiceAccountTokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ServiceAccounts() ([]atc.ServiceAccount, error) {
+	fake.serviceAccountsMutex.Lock()
+	ret, specificReturn := fake.serviceAccountsReturnsOnCall[len(fake.serviceAccountsArgsForCall)]
+	fake.serviceAccountsArgsForCall = append(fake.serviceAccountsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ServiceAccounts", []interface{}{})
+	fake.serviceAccountsMutex.Unlock()
+	if fake.ServiceAccountsStub != nil {
+		return fake.ServiceAccountsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.serviceAccountsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ServiceAccountsCallCount() int {
+	fake.serviceAccountsMutex.RLock()
+	defer fake.serviceAccountsMutex.RUnlock()
+	return len(fake.serviceAccountsArgsForCall)
+}
+
+func (fake *FakeTeam) ServiceAccountsCalls(stub func() ([]atc.ServiceAccount, error)) {
+	fake.serviceAccountsMutex.Lock()
+	defer fake.serviceAccountsMutex.Unlock()
+	fake.ServiceAccountsStub = stub
+}
+
+func (fake *FakeTeam) ServiceAccountsReturns(result1 []atc.ServiceAccount, result2 error) {
+	fake.serviceAccountsMutex.Lock()
+	defer fake.serviceAccountsMutex.Unlock()
+	fake.ServiceAccountsStub = nil
+	fake.serviceAccountsReturns = struct {
+		result1 []atc.ServiceAccount
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ServiceAccountsReturnsOnCall(i int, result1 []atc.ServiceAccount, result2 error) {
+	fake.serviceAccountsMutex.Lock()
+	defer fake.serviceAccountsMutex.Unlock()
+	fake.ServiceAccountsStub = nil
+	if fake.serviceAccountsReturnsOnCall == nil {
+		fake.serviceAccountsReturnsOnCall = make(map[int]struct {
+			result1 []atc.ServiceAccount
+			result2 error
+		})
+	}
+	fake.serviceAccountsReturnsOnCall[i] = struct {
+		result1 []atc.ServiceAccount
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) SetPinComment(arg1 string, arg2 string, arg3 string) (bool, error) {
 	fake.setPinCommentMutex.Lock()
 	ret, specificReturn := fake.setPinCommentReturnsOnCall[len(fake.setPinCommentArgsForCall)]
@@ -3499,6 +4866,69 @@ func (fake *FakeTeam) TeamReturnsOnCall(i int, result1 atc.Team, result2 bool, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) UnfreezePipeline(arg1 string) (bool, error) {
+	fake.unfreezePipelineMutex.Lock()
+	ret, specificReturn := fake.unfreezePipelineReturnsOnCall[len(fake.unfreezePipelineArgsForCall)]
+	fake.unfreezePipelineArgsForCall = append(fake.unfreezePipelineArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("UnfreezePipeline", []interface{}{arg1})
+	fake.unfreezePipelineMutex.Unlock()
+	if fake.UnfreezePipelineStub != nil {
+		return fake.UnfreezePipelineStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.unfreezePipelineReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) UnfreezePipelineCallCount() int {
+	fake.unfreezePipelineMutex.RLock()
+	defer fake.unfreezePipelineMutex.RUnlock()
+	return len(fake.unfreezePipelineArgsForCall)
+}
+
+func (fake *FakeTeam) UnfreezePipelineCalls(stub func(string) (bool, error)) {
+	fake.unfreezePipelineMutex.Lock()
+	defer fake.unfreezePipelineMutex.Unlock()
+	fake.UnfreezePipelineStub = stub
+}
+
+func (fake *FakeTeam) UnfreezePipelineArgsForCall(i int) string {
+	fake.unfreezePipelineMutex.RLock()
+	defer fake.unfreezePipelineMutex.RUnlock()
+	argsForCall := fake.unfreezePipelineArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UnfreezePipelineReturns(result1 bool, result2 error) {
+	fake.unfreezePipelineMutex.Lock()
+	defer fake.unfreezePipelineMutex.Unlock()
+	fake.UnfreezePipelineStub = nil
+	fake.unfreezePipelineReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) UnfreezePipelineReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.unfreezePipelineMutex.Lock()
+	defer fake.unfreezePipelineMutex.Unlock()
+	fake.UnfreezePipelineStub = nil
+	if fake.unfreezePipelineReturnsOnCall == nil {
+		fake.unfreezePipelineReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.unfreezePipelineReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) UnpauseJob(arg1 string, arg2 string) (bool, error) {
 	fake.unpauseJobMutex.Lock()
 	ret, specificReturn := fake.unpauseJobReturnsOnCall[len(fake.unpauseJobArgsForCall)]
@@ -3759,6 +5189,8 @@ func (fake *FakeTeam) VersionedResourceTypesReturnsOnCall(i int, result1 atc.Ver
 func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.addVersionLabelMutex.RLock()
+	defer fake.addVersionLabelMutex.RUnlock()
 	fake.buildInputsForJobMutex.RLock()
 	defer fake.buildInputsForJobMutex.RUnlock()
 	fake.buildsMutex.RLock()
@@ -3777,6 +5209,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.createArtifactMutex.RUnlock()
 	fake.createBuildMutex.RLock()
 	defer fake.createBuildMutex.RUnlock()
+	fake.createIncrementalArtifactMutex.RLock()
+	defer fake.createIncrementalArtifactMutex.RUnlock()
 	fake.createJobBuildMutex.RLock()
 	defer fake.createJobBuildMutex.RUnlock()
 	fake.createOrUpdateMutex.RLock()
@@ -3785,16 +5219,32 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.createOrUpdatePipelineConfigMutex.RUnlock()
 	fake.createPipelineBuildMutex.RLock()
 	defer fake.createPipelineBuildMutex.RUnlock()
+	fake.createServiceAccountMutex.RLock()
+	defer fake.createServiceAccountMutex.RUnlock()
+	fake.createServiceAccountTokenMutex.RLock()
+	defer fake.createServiceAccountTokenMutex.RUnlock()
 	fake.deletePipelineMutex.RLock()
 	defer fake.deletePipelineMutex.RUnlock()
+	fake.destroyServiceAccountMutex.RLock()
+	defer fake.destroyServiceAccountMutex.RUnlock()
 	fake.destroyTeamMutex.RLock()
 	defer fake.destroyTeamMutex.RUnlock()
+	fake.diffArtifactMutex.RLock()
+	defer fake.diffArtifactMutex.RUnlock()
 	fake.disableResourceVersionMutex.RLock()
 	defer fake.disableResourceVersionMutex.RUnlock()
+	fake.dryRunPipelineConfigMutex.RLock()
+	defer fake.dryRunPipelineConfigMutex.RUnlock()
 	fake.enableResourceVersionMutex.RLock()
 	defer fake.enableResourceVersionMutex.RUnlock()
+	fake.explainJobInputsMutex.RLock()
+	defer fake.explainJobInputsMutex.RUnlock()
 	fake.exposePipelineMutex.RLock()
 	defer fake.exposePipelineMutex.RUnlock()
+	fake.filteredResourceVersionsMutex.RLock()
+	defer fake.filteredResourceVersionsMutex.RUnlock()
+	fake.freezePipelineMutex.RLock()
+	defer fake.freezePipelineMutex.RUnlock()
 	fake.getArtifactMutex.RLock()
 	defer fake.getArtifactMutex.RUnlock()
 	fake.getContainerMutex.RLock()
@@ -3807,12 +5257,18 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.jobBuildMutex.RUnlock()
 	fake.jobBuildsMutex.RLock()
 	defer fake.jobBuildsMutex.RUnlock()
+	fake.jobTaskResourceUsageMutex.RLock()
+	defer fake.jobTaskResourceUsageMutex.RUnlock()
 	fake.listContainersMutex.RLock()
 	defer fake.listContainersMutex.RUnlock()
 	fake.listJobsMutex.RLock()
 	defer fake.listJobsMutex.RUnlock()
+	fake.listLocksMutex.RLock()
+	defer fake.listLocksMutex.RUnlock()
 	fake.listPipelinesMutex.RLock()
 	defer fake.listPipelinesMutex.RUnlock()
+	fake.listQueueMutex.RLock()
+	defer fake.listQueueMutex.RUnlock()
 	fake.listResourcesMutex.RLock()
 	defer fake.listResourcesMutex.RUnlock()
 	fake.listVolumesMutex.RLock()
@@ -3833,6 +5289,10 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.pipelineBuildsMutex.RUnlock()
 	fake.pipelineConfigMutex.RLock()
 	defer fake.pipelineConfigMutex.RUnlock()
+	fake.releaseLockMutex.RLock()
+	defer fake.releaseLockMutex.RUnlock()
+	fake.removeVersionLabelMutex.RLock()
+	defer fake.removeVersionLabelMutex.RUnlock()
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
 	fake.renameTeamMutex.RLock()
@@ -3841,10 +5301,16 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.resourceMutex.RUnlock()
 	fake.resourceVersionsMutex.RLock()
 	defer fake.resourceVersionsMutex.RUnlock()
+	fake.revokeServiceAccountTokenMutex.RLock()
+	defer fake.revokeServiceAccountTokenMutex.RUnlock()
+	fake.serviceAccountsMutex.RLock()
+	defer fake.serviceAccountsMutex.RUnlock()
 	fake.setPinCommentMutex.RLock()
 	defer fake.setPinCommentMutex.RUnlock()
 	fake.teamMutex.RLock()
 	defer fake.teamMutex.RUnlock()
+	fake.unfreezePipelineMutex.RLock()
+	defer fake.unfreezePipelineMutex.RUnlock()
 	fake.unpauseJobMutex.RLock()
 	defer fake.unpauseJobMutex.RUnlock()
 	fake.unpausePipelineMutex.RLock()
@@ -51,8 +51,9 @@ type ConfigWarning struct {
 }
 
 type setConfigResponse struct {
-	Errors   []string        `json:"errors"`
-	Warnings []ConfigWarning `json:"warnings"`
+	Errors   []string          `json:"errors"`
+	Warnings []ConfigWarning   `json:"warnings"`
+	DryRun   *atc.ConfigDryRun `json:"dry_run"`
 }
 
 func (team *team) CreateOrUpdatePipelineConfig(pipelineName string, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error) {
@@ -119,3 +120,59 @@ func (team *team) CreateOrUpdatePipelineConfig(pipelineName string, configVersio
 
 	return response.Created, !response.Created, configResponse.Warnings, nil
 }
+
+var errDryRunNotSupported = errors.New("the server does not support dry runs")
+
+// DryRunPipelineConfig validates the config against the server's rules and
+// returns what saving it would change, without saving it.
+func (team *team) DryRunPipelineConfig(pipelineName string, passedConfig []byte, checkCredentials bool) (atc.ConfigDryRun, []ConfigWarning, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
+	queryParams := url.Values{}
+	if checkCredentials {
+		queryParams.Add(atc.SaveConfigCheckCreds, "")
+	}
+
+	var configResponse setConfigResponse
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.DryRunConfig,
+		Params:      params,
+		Query:       queryParams,
+		Body:        bytes.NewBuffer(passedConfig),
+		Header: http.Header{
+			"Content-Type": {"application/x-yaml"},
+		},
+	}, &internal.Response{
+		Result: &configResponse,
+	})
+	if err != nil {
+		if unexpectedResponseError, ok := err.(internal.UnexpectedResponseError); ok {
+			if unexpectedResponseError.StatusCode == http.StatusBadRequest {
+				var validationErr atc.SaveConfigResponse
+				err = json.Unmarshal([]byte(unexpectedResponseError.Body), &validationErr)
+				if err != nil {
+					return atc.ConfigDryRun{}, nil, err
+				}
+
+				return atc.ConfigDryRun{}, nil, InvalidConfigError{
+					Errors: validationErr.Errors,
+				}
+			}
+		}
+
+		if _, ok := err.(internal.ResourceNotFoundError); ok {
+			return atc.ConfigDryRun{}, nil, errDryRunNotSupported
+		}
+
+		return atc.ConfigDryRun{}, nil, err
+	}
+
+	if configResponse.DryRun == nil {
+		return atc.ConfigDryRun{}, nil, errDryRunNotSupported
+	}
+
+	return *configResponse.DryRun, configResponse.Warnings, nil
+}
@@ -318,4 +318,77 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 		})
 	})
+
+	Describe("DryRunPipelineConfig", func() {
+		var (
+			dryRun   atc.ConfigDryRun
+			warnings []concourse.ConfigWarning
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			dryRun, warnings, err = team.DryRunPipelineConfig("mypipeline", []byte("jobs: []"), false)
+		})
+
+		Context("when the config is valid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/pipelines/mypipeline/config/dry-run"),
+						ghttp.VerifyBody([]byte("jobs: []")),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.SaveConfigResponse{
+							Warnings: []atc.ConfigWarning{{Type: "some-type", Message: "some-message"}},
+							DryRun: &atc.ConfigDryRun{
+								Diff: "some-diff",
+								Impact: atc.ConfigImpact{
+									TriggeredJobs: []atc.ConfigImpactReason{{Name: "some-job", Reason: "some-reason"}},
+								},
+							},
+						}),
+					),
+				)
+			})
+
+			It("returns the dry run and the warnings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dryRun).To(Equal(atc.ConfigDryRun{
+					Diff: "some-diff",
+					Impact: atc.ConfigImpact{
+						TriggeredJobs: []atc.ConfigImpactReason{{Name: "some-job", Reason: "some-reason"}},
+					},
+				}))
+				Expect(warnings).To(Equal([]concourse.ConfigWarning{{Type: "some-type", Message: "some-message"}}))
+			})
+		})
+
+		Context("when the config is invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/pipelines/mypipeline/config/dry-run"),
+						ghttp.RespondWith(http.StatusBadRequest, `{"errors":["fake-error1"]}`),
+					),
+				)
+			})
+
+			It("returns config validation error", func() {
+				Expect(err).To(MatchError(ContainSubstring("fake-error1")))
+			})
+		})
+
+		Context("when the server does not support dry runs", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/pipelines/mypipeline/config/dry-run"),
+						ghttp.RespondWith(http.StatusNotFound, "404 page not found"),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(MatchError("the server does not support dry runs"))
+			})
+		})
+	})
 })
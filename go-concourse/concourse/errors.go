@@ -1,6 +1,7 @@
 package concourse
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -13,6 +14,11 @@ var ErrUnauthorized = internal.ErrUnauthorized
 // ErrForbidden is returned for 403 response codes.
 var ErrForbidden = internal.ErrForbidden
 
+// ErrIncrementalArtifactsUnsupported is returned when the server predates
+// incremental artifact uploads, in which case artifacts have to be uploaded
+// in full with CreateArtifact.
+var ErrIncrementalArtifactsUnsupported = errors.New("the server does not support incremental artifact uploads")
+
 // GenericError is used when no more specific error is available, i.e. a
 // generic 500 Internal Server Error response with a message in the body.
 type GenericError struct {
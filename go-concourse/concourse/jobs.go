@@ -3,6 +3,7 @@ package concourse
 import (
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -158,3 +159,59 @@ func (team *team) ClearTaskCache(pipelineName string, jobName string, stepName s
 		return ctcResponse.CachesRemoved, nil
 	}
 }
+
+func (team *team) JobTaskResourceUsage(pipelineName string, jobName string, limit int) ([]atc.BuildTaskResourceUsage, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"job_name":      jobName,
+		"team_name":     team.name,
+	}
+
+	queryParams := url.Values{}
+	if limit > 0 {
+		queryParams.Add(atc.PaginationQueryLimit, strconv.Itoa(limit))
+	}
+
+	var usages []atc.BuildTaskResourceUsage
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListJobTaskResourceUsage,
+		Params:      params,
+		Query:       queryParams,
+	}, &internal.Response{
+		Result: &usages,
+	})
+
+	switch err.(type) {
+	case nil:
+		return usages, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
+
+func (team *team) ExplainJobInputs(pipelineName string, jobName string) (atc.JobInputsExplanation, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"job_name":      jobName,
+		"team_name":     team.name,
+	}
+
+	var explanation atc.JobInputsExplanation
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ExplainJobInputs,
+		Params:      params,
+	}, &internal.Response{
+		Result: &explanation,
+	})
+
+	switch err.(type) {
+	case nil:
+		return explanation, true, nil
+	case internal.ResourceNotFoundError:
+		return atc.JobInputsExplanation{}, false, nil
+	default:
+		return atc.JobInputsExplanation{}, false, err
+	}
+}
@@ -526,4 +526,111 @@ var _ = Describe("ATC Handler Jobs", func() {
 		})
 	})
 
+	Describe("JobTaskResourceUsage", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/resource-usage"
+
+		Context("when the job exists", func() {
+			var expectedUsages []atc.BuildTaskResourceUsage
+
+			BeforeEach(func() {
+				expectedUsages = []atc.BuildTaskResourceUsage{
+					{
+						BuildID:   1,
+						BuildName: "1",
+						StepName:  "some-task",
+						Usage: atc.TaskResourceUsage{
+							CPUTime:    1000,
+							PeakMemory: 2048,
+						},
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "limit=10"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedUsages),
+					),
+				)
+			})
+
+			It("returns the resource usage of the job's tasks", func() {
+				usages, found, err := team.JobTaskResourceUsage("mypipeline", "myjob", 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(usages).To(Equal(expectedUsages))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := team.JobTaskResourceUsage("mypipeline", "myjob", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("ExplainJobInputs", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/explain"
+
+		Context("when the job exists", func() {
+			var expectedExplanation atc.JobInputsExplanation
+
+			BeforeEach(func() {
+				expectedExplanation = atc.JobInputsExplanation{
+					Resolved: false,
+					Inputs: []atc.InputExplanation{
+						{
+							Name:     "some-input",
+							Resource: "some-resource",
+							Passed:   []string{"upstream"},
+							Candidates: []atc.CandidateExplanation{
+								{ID: 1, Version: atc.Version{"ref": "abc"}, EliminatedBy: []string{"upstream"}},
+							},
+						},
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedExplanation),
+					),
+				)
+			})
+
+			It("returns the explanation", func() {
+				explanation, found, err := team.ExplainJobInputs("mypipeline", "myjob")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(explanation).To(Equal(expectedExplanation))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := team.ExplainJobInputs("mypipeline", "myjob")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
 })
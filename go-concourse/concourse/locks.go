@@ -0,0 +1,43 @@
+package concourse
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) ListLocks() ([]atc.Lock, error) {
+	var locks []atc.Lock
+
+	params := rata.Params{
+		"team_name": team.name,
+	}
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListLocks,
+		Params:      params,
+	}, &internal.Response{
+		Result: &locks,
+	})
+
+	return locks, err
+}
+
+func (team *team) ReleaseLock(lockName string) (bool, error) {
+	params := rata.Params{
+		"team_name": team.name,
+		"lock_name": lockName,
+	}
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ReleaseLock,
+		Params:      params,
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
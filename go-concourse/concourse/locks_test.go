@@ -0,0 +1,81 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Locks", func() {
+	Describe("ListLocks", func() {
+		var expectedLocks []atc.Lock
+
+		BeforeEach(func() {
+			expectedLocks = []atc.Lock{
+				{
+					Name: "staging-env",
+					Holder: &atc.LockBuild{
+						ID:           1,
+						Name:         "12",
+						JobName:      "deploy",
+						PipelineName: "some-pipeline",
+						Since:        100,
+					},
+					Waiters: []atc.LockBuild{},
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/locks"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedLocks),
+				),
+			)
+		})
+
+		It("returns the team's locks", func() {
+			locks, err := team.ListLocks()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(locks).To(Equal(expectedLocks))
+		})
+	})
+
+	Describe("ReleaseLock", func() {
+		var expectedStatus int
+
+		BeforeEach(func() {
+			expectedStatus = http.StatusNoContent
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/locks/staging-env"),
+					ghttp.RespondWith(expectedStatus, ""),
+				),
+			)
+		})
+
+		It("releases the lock", func() {
+			released, err := team.ReleaseLock("staging-env")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(released).To(BeTrue())
+		})
+
+		Context("when the lock is not held", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				released, err := team.ReleaseLock("staging-env")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(released).To(BeFalse())
+			})
+		})
+	})
+})
@@ -121,6 +121,40 @@ func (team *team) UnpausePipeline(pipelineName string) (bool, error) {
 	return team.managePipeline(pipelineName, atc.UnpausePipeline)
 }
 
+func (team *team) FreezePipeline(pipelineName string, buildID int, comment string) ([]atc.FrozenResource, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"team_name":     team.name,
+	}
+
+	jsonBytes, err := json.Marshal(atc.FreezeRequest{BuildID: buildID, Comment: comment})
+	if err != nil {
+		return nil, false, err
+	}
+
+	var frozen []atc.FrozenResource
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.FreezePipeline,
+		Params:      params,
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, &internal.Response{
+		Result: &frozen,
+	})
+	switch err.(type) {
+	case nil:
+		return frozen, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
+
+func (team *team) UnfreezePipeline(pipelineName string) (bool, error) {
+	return team.managePipeline(pipelineName, atc.UnfreezePipeline)
+}
+
 func (team *team) ExposePipeline(pipelineName string) (bool, error) {
 	return team.managePipeline(pipelineName, atc.ExposePipeline)
 }
@@ -86,6 +86,70 @@ var _ = Describe("ATC Handler Pipelines", func() {
 		})
 	})
 
+	Describe("FreezePipeline", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/freeze"
+
+		Context("when the build is part of the pipeline", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.VerifyJSON(`{"build_id":42,"comment":"incident"}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.FrozenResource{
+							{Resource: "some-resource", Version: atc.Version{"ref": "abc"}},
+						}),
+					),
+				)
+			})
+
+			It("returns the pinned resources", func() {
+				frozen, found, err := team.FreezePipeline("mypipeline", 42, "incident")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(frozen).To(Equal([]atc.FrozenResource{
+					{Resource: "some-resource", Version: atc.Version{"ref": "abc"}},
+				}))
+			})
+		})
+
+		Context("when the pipeline or build doesn't exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := team.FreezePipeline("mypipeline", 42, "incident")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("UnfreezePipeline", func() {
+		Context("when the pipeline is frozen", func() {
+			BeforeEach(func() {
+				expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/unfreeze"
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, ""),
+					),
+				)
+			})
+
+			It("return true and no error", func() {
+				found, err := team.UnfreezePipeline("mypipeline")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+	})
+
 	Describe("ExposePipeline", func() {
 		Context("when the pipeline exists", func() {
 			BeforeEach(func() {
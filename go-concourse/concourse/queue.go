@@ -0,0 +1,23 @@
+package concourse
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) ListQueue() ([]atc.Build, error) {
+	var builds []atc.Build
+
+	params := rata.Params{
+		"team_name": team.name,
+	}
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListTeamQueue,
+		Params:      params,
+	}, &internal.Response{
+		Result: &builds,
+	})
+
+	return builds, err
+}
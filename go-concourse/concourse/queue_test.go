@@ -0,0 +1,52 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Queue", func() {
+	Describe("ListQueue", func() {
+		var expectedBuilds []atc.Build
+
+		BeforeEach(func() {
+			expectedBuilds = []atc.Build{
+				{
+					ID:           4,
+					Name:         "2",
+					Status:       "pending",
+					JobName:      "hotfix",
+					PipelineName: "some-pipeline",
+					TeamName:     "some-team",
+					Priority:     10,
+				},
+				{
+					ID:           3,
+					Name:         "1",
+					Status:       "pending",
+					JobName:      "fuzz",
+					PipelineName: "other-pipeline",
+					TeamName:     "some-team",
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/queue"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuilds),
+				),
+			)
+		})
+
+		It("returns the team's pending builds in queue order", func() {
+			builds, err := team.ListQueue()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(builds).To(Equal(expectedBuilds))
+		})
+	})
+})
@@ -50,6 +50,47 @@ func (team *team) ResourceVersions(pipelineName string, resourceName string, pag
 	}
 }
 
+// FilteredResourceVersions returns up to limit of the newest versions which
+// match the filter. Servers which do not support filtering ignore it and
+// return the newest versions instead.
+func (team *team) FilteredResourceVersions(pipelineName string, resourceName string, filter atc.VersionFilter, limit int) ([]atc.ResourceVersion, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"resource_name": resourceName,
+		"team_name":     team.name,
+	}
+
+	queryParams := Page{Limit: limit}.QueryParams()
+	for k, v := range filter.Fields {
+		queryParams.Add("match", fmt.Sprintf("%s:%s", k, v))
+	}
+
+	if filter.Semver != "" {
+		queryParams.Set("semver", filter.Semver)
+	}
+
+	if filter.SemverField != "" {
+		queryParams.Set("semver_field", filter.SemverField)
+	}
+
+	var resourceVersions []atc.ResourceVersion
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListResourceVersions,
+		Params:      params,
+		Query:       queryParams,
+	}, &internal.Response{
+		Result: &resourceVersions,
+	})
+	switch err.(type) {
+	case nil:
+		return resourceVersions, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
+
 func (team *team) DisableResourceVersion(pipelineName string, resourceName string, resourceVersionID int) (bool, error) {
 	return team.sendResourceVersion(pipelineName, resourceName, resourceVersionID, atc.DisableResourceVersion)
 }
@@ -121,6 +162,38 @@ func (team *team) SetPinComment(pipelineName string, resourceName string, commen
 
 }
 
+func (team *team) AddVersionLabel(pipelineName string, resourceName string, resourceVersionID int, label string) (bool, error) {
+	return team.sendVersionLabel(pipelineName, resourceName, resourceVersionID, label, atc.AddResourceVersionLabel)
+}
+
+func (team *team) RemoveVersionLabel(pipelineName string, resourceName string, resourceVersionID int, label string) (bool, error) {
+	return team.sendVersionLabel(pipelineName, resourceName, resourceVersionID, label, atc.RemoveResourceVersionLabel)
+}
+
+func (team *team) sendVersionLabel(pipelineName string, resourceName string, resourceVersionID int, label string, labelReq string) (bool, error) {
+	params := rata.Params{
+		"pipeline_name":              pipelineName,
+		"resource_name":              resourceName,
+		"resource_config_version_id": strconv.Itoa(resourceVersionID),
+		"label":                      label,
+		"team_name":                  team.name,
+	}
+
+	err := team.connection.Send(internal.Request{
+		RequestName: labelReq,
+		Params:      params,
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
 func (team *team) sendResourceVersion(pipelineName string, resourceName string, resourceVersionID int, resourceVersionReq string) (bool, error) {
 	params := rata.Params{
 		"pipeline_name":              pipelineName,
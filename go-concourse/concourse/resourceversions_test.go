@@ -14,6 +14,63 @@ import (
 )
 
 var _ = Describe("ATC Handler Resource Versions", func() {
+	Describe("FilteredResourceVersions", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/resources/myresource/versions"
+
+		var expectedVersions []atc.ResourceVersion
+
+		var versions []atc.ResourceVersion
+		var found bool
+		var clientErr error
+
+		BeforeEach(func() {
+			expectedVersions = []atc.ResourceVersion{
+				{ID: 2, Version: atc.Version{"tag": "v1.2.0"}},
+			}
+		})
+
+		JustBeforeEach(func() {
+			versions, found, clientErr = team.FilteredResourceVersions("mypipeline", "myresource", atc.VersionFilter{
+				Fields:      map[string]string{"tag": "v1.*"},
+				Semver:      ">=1.1",
+				SemverField: "tag",
+			}, 5)
+		})
+
+		Context("when the server returns the versions", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "limit=5&match=tag%3Av1.%2A&semver=%3E%3D1.1&semver_field=tag"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedVersions),
+					),
+				)
+			})
+
+			It("sends the filter as url params", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(versions).To(Equal(expectedVersions))
+			})
+		})
+
+		Context("when the server returns not found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("ResourceVersions", func() {
 		expectedURL := fmt.Sprint("/api/v1/teams/some-team/pipelines/mypipeline/resources/myresource/versions")
 
@@ -453,6 +510,120 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 		})
 	})
 
+	Describe("AddVersionLabel", func() {
+		var (
+			expectedStatus    int
+			pipelineName      = "banana"
+			resourceName      = "myresource"
+			resourceVersionID = 42
+			label             = "deployed-to-prod"
+			expectedURL       = fmt.Sprintf("/api/v1/teams/some-team/pipelines/%s/resources/%s/versions/%s/labels/%s", pipelineName, resourceName, strconv.Itoa(resourceVersionID), label)
+		)
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedURL),
+					ghttp.RespondWith(expectedStatus, nil),
+				),
+			)
+		})
+
+		Context("when the version exists and there are no issues", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusOK
+			})
+
+			It("returns true and no error", func() {
+				labeled, err := team.AddVersionLabel(pipelineName, resourceName, resourceVersionID, label)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(labeled).To(BeTrue())
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the version does not exist", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("returns false and no error", func() {
+				labeled, err := team.AddVersionLabel(pipelineName, resourceName, resourceVersionID, label)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(labeled).To(BeFalse())
+			})
+		})
+
+		Context("when the call fails", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusInternalServerError
+			})
+
+			It("returns an error", func() {
+				labeled, err := team.AddVersionLabel(pipelineName, resourceName, resourceVersionID, label)
+				Expect(err).To(HaveOccurred())
+				Expect(labeled).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("RemoveVersionLabel", func() {
+		var (
+			expectedStatus    int
+			pipelineName      = "banana"
+			resourceName      = "myresource"
+			resourceVersionID = 42
+			label             = "deployed-to-prod"
+			expectedURL       = fmt.Sprintf("/api/v1/teams/some-team/pipelines/%s/resources/%s/versions/%s/labels/%s", pipelineName, resourceName, strconv.Itoa(resourceVersionID), label)
+		)
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", expectedURL),
+					ghttp.RespondWith(expectedStatus, nil),
+				),
+			)
+		})
+
+		Context("when the version exists and there are no issues", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusOK
+			})
+
+			It("returns true and no error", func() {
+				unlabeled, err := team.RemoveVersionLabel(pipelineName, resourceName, resourceVersionID, label)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(unlabeled).To(BeTrue())
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the version does not exist", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("returns false and no error", func() {
+				unlabeled, err := team.RemoveVersionLabel(pipelineName, resourceName, resourceVersionID, label)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(unlabeled).To(BeFalse())
+			})
+		})
+
+		Context("when the call fails", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusInternalServerError
+			})
+
+			It("returns an error", func() {
+				unlabeled, err := team.RemoveVersionLabel(pipelineName, resourceName, resourceVersionID, label)
+				Expect(err).To(HaveOccurred())
+				Expect(unlabeled).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("UnpinResource", func() {
 		var (
 			expectedStatus int
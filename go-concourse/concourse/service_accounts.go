@@ -0,0 +1,130 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) ServiceAccounts() ([]atc.ServiceAccount, error) {
+	var accounts []atc.ServiceAccount
+
+	params := rata.Params{
+		"team_name": team.name,
+	}
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListServiceAccounts,
+		Params:      params,
+	}, &internal.Response{
+		Result: &accounts,
+	})
+
+	return accounts, err
+}
+
+func (team *team) CreateServiceAccount(accountName string) (bool, error) {
+	params := rata.Params{
+		"team_name":            team.name,
+		"service_account_name": accountName,
+	}
+
+	response := internal.Response{}
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.CreateServiceAccount,
+		Params:      params,
+	}, &response)
+	if err != nil {
+		return false, err
+	}
+
+	return response.Created, nil
+}
+
+func (team *team) DestroyServiceAccount(accountName string) (bool, error) {
+	params := rata.Params{
+		"team_name":            team.name,
+		"service_account_name": accountName,
+	}
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.DestroyServiceAccount,
+		Params:      params,
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// CreateServiceAccountToken returns the created token, which is the only
+// time its value is available. It returns false if the service account, or
+// the pipeline the token is scoped to, does not exist.
+func (team *team) CreateServiceAccountToken(accountName string, token atc.ServiceAccountToken) (atc.ServiceAccountToken, bool, error) {
+	params := rata.Params{
+		"team_name":            team.name,
+		"service_account_name": accountName,
+	}
+
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(token)
+	if err != nil {
+		return atc.ServiceAccountToken{}, false, err
+	}
+
+	var created atc.ServiceAccountToken
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.CreateServiceAccountToken,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+		Params: params,
+		Body:   buffer,
+	}, &internal.Response{
+		Result: &created,
+	})
+
+	switch e := err.(type) {
+	case nil:
+		return created, true, nil
+	case internal.ResourceNotFoundError:
+		return atc.ServiceAccountToken{}, false, nil
+	case internal.UnexpectedResponseError:
+		if e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusConflict {
+			return atc.ServiceAccountToken{}, false, errors.New(e.Body)
+		}
+
+		return atc.ServiceAccountToken{}, false, err
+	default:
+		return atc.ServiceAccountToken{}, false, err
+	}
+}
+
+func (team *team) RevokeServiceAccountToken(accountName string, tokenName string) (bool, error) {
+	params := rata.Params{
+		"team_name":            team.name,
+		"service_account_name": accountName,
+		"token_name":           tokenName,
+	}
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.RevokeServiceAccountToken,
+		Params:      params,
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
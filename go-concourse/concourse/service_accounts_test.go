@@ -0,0 +1,215 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Service Accounts", func() {
+	Describe("ServiceAccounts", func() {
+		var expectedAccounts []atc.ServiceAccount
+
+		BeforeEach(func() {
+			expectedAccounts = []atc.ServiceAccount{
+				{
+					Name: "deploy-bot",
+					Tokens: []atc.ServiceAccountToken{
+						{Name: "ci", Role: "member", ExpiresAt: 200},
+					},
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/service-accounts"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedAccounts),
+				),
+			)
+		})
+
+		It("returns the team's service accounts", func() {
+			accounts, err := team.ServiceAccounts()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(accounts).To(Equal(expectedAccounts))
+		})
+	})
+
+	Describe("CreateServiceAccount", func() {
+		var expectedStatus int
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/service-accounts/deploy-bot"),
+					ghttp.RespondWith(expectedStatus, ""),
+				),
+			)
+		})
+
+		Context("when the service account is created", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusCreated
+			})
+
+			It("returns true", func() {
+				created, err := team.CreateServiceAccount("deploy-bot")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeTrue())
+			})
+		})
+
+		Context("when the service account already exists", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusOK
+			})
+
+			It("returns false", func() {
+				created, err := team.CreateServiceAccount("deploy-bot")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("DestroyServiceAccount", func() {
+		var expectedStatus int
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/service-accounts/deploy-bot"),
+					ghttp.RespondWith(expectedStatus, ""),
+				),
+			)
+		})
+
+		Context("when the service account exists", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNoContent
+			})
+
+			It("returns true", func() {
+				found, err := team.DestroyServiceAccount("deploy-bot")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the service account does not exist", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				found, err := team.DestroyServiceAccount("deploy-bot")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("CreateServiceAccountToken", func() {
+		var (
+			request atc.ServiceAccountToken
+			handler http.HandlerFunc
+		)
+
+		BeforeEach(func() {
+			request = atc.ServiceAccountToken{
+				Name:      "ci",
+				Role:      "member",
+				ExpiresAt: 200,
+			}
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/service-accounts/deploy-bot/tokens"),
+					ghttp.VerifyJSONRepresenting(request),
+					handler,
+				),
+			)
+		})
+
+		Context("when the token is created", func() {
+			BeforeEach(func() {
+				created := request
+				created.Token = "cst_some-token"
+				handler = ghttp.RespondWithJSONEncoded(http.StatusCreated, created)
+			})
+
+			It("returns the token", func() {
+				created, found, err := team.CreateServiceAccountToken("deploy-bot", request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(created.Token).To(Equal("cst_some-token"))
+			})
+		})
+
+		Context("when the service account does not exist", func() {
+			BeforeEach(func() {
+				handler = ghttp.RespondWith(http.StatusNotFound, "")
+			})
+
+			It("returns false", func() {
+				_, found, err := team.CreateServiceAccountToken("deploy-bot", request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the token is rejected", func() {
+			BeforeEach(func() {
+				handler = ghttp.RespondWith(http.StatusConflict, "service account already has a token with this name")
+			})
+
+			It("returns the reason", func() {
+				_, _, err := team.CreateServiceAccountToken("deploy-bot", request)
+				Expect(err).To(MatchError("service account already has a token with this name"))
+			})
+		})
+	})
+
+	Describe("RevokeServiceAccountToken", func() {
+		var expectedStatus int
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/service-accounts/deploy-bot/tokens/ci"),
+					ghttp.RespondWith(expectedStatus, ""),
+				),
+			)
+		})
+
+		Context("when the token exists", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNoContent
+			})
+
+			It("returns true", func() {
+				found, err := team.RevokeServiceAccountToken("deploy-bot", "ci")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				found, err := team.RevokeServiceAccountToken("deploy-bot", "ci")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -22,12 +22,15 @@ type Team interface {
 	DeletePipeline(pipelineName string) (bool, error)
 	PausePipeline(pipelineName string) (bool, error)
 	UnpausePipeline(pipelineName string) (bool, error)
+	FreezePipeline(pipelineName string, buildID int, comment string) ([]atc.FrozenResource, bool, error)
+	UnfreezePipeline(pipelineName string) (bool, error)
 	ExposePipeline(pipelineName string) (bool, error)
 	HidePipeline(pipelineName string) (bool, error)
 	RenamePipeline(pipelineName, name string) (bool, error)
 	ListPipelines() ([]atc.Pipeline, error)
 	PipelineConfig(pipelineName string) (atc.Config, string, bool, error)
 	CreateOrUpdatePipelineConfig(pipelineName string, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error)
+	DryRunPipelineConfig(pipelineName string, passedConfig []byte, checkCredentials bool) (atc.ConfigDryRun, []ConfigWarning, error)
 
 	CreatePipelineBuild(pipelineName string, plan atc.Plan) (atc.Build, error)
 
@@ -44,10 +47,14 @@ type Team interface {
 
 	ClearTaskCache(pipelineName string, jobName string, stepName string, cachePath string) (int64, error)
 
+	JobTaskResourceUsage(pipelineName string, jobName string, limit int) ([]atc.BuildTaskResourceUsage, bool, error)
+	ExplainJobInputs(pipelineName string, jobName string) (atc.JobInputsExplanation, bool, error)
+
 	Resource(pipelineName string, resourceName string) (atc.Resource, bool, error)
 	ListResources(pipelineName string) ([]atc.Resource, error)
 	VersionedResourceTypes(pipelineName string) (atc.VersionedResourceTypes, bool, error)
 	ResourceVersions(pipelineName string, resourceName string, page Page, filter atc.Version) ([]atc.ResourceVersion, Pagination, bool, error)
+	FilteredResourceVersions(pipelineName string, resourceName string, filter atc.VersionFilter, limit int) ([]atc.ResourceVersion, bool, error)
 	CheckResource(pipelineName string, resourceName string, version atc.Version) (atc.Check, bool, error)
 	CheckResourceType(pipelineName string, resourceTypeName string, version atc.Version) (atc.Check, bool, error)
 	DisableResourceVersion(pipelineName string, resourceName string, resourceVersionID int) (bool, error)
@@ -57,17 +64,32 @@ type Team interface {
 	UnpinResource(pipelineName string, resourceName string) (bool, error)
 	SetPinComment(pipelineName string, resourceName string, comment string) (bool, error)
 
+	AddVersionLabel(pipelineName string, resourceName string, resourceVersionID int, label string) (bool, error)
+	RemoveVersionLabel(pipelineName string, resourceName string, resourceVersionID int, label string) (bool, error)
+
 	BuildsWithVersionAsInput(pipelineName string, resourceName string, resourceVersionID int) ([]atc.Build, bool, error)
 	BuildsWithVersionAsOutput(pipelineName string, resourceName string, resourceVersionID int) ([]atc.Build, bool, error)
 
 	ListContainers(queryList map[string]string) ([]atc.Container, error)
 	GetContainer(id string) (atc.Container, error)
 	ListVolumes() ([]atc.Volume, error)
+	ListLocks() ([]atc.Lock, error)
+	ReleaseLock(lockName string) (bool, error)
+
+	ServiceAccounts() ([]atc.ServiceAccount, error)
+	CreateServiceAccount(accountName string) (bool, error)
+	DestroyServiceAccount(accountName string) (bool, error)
+	CreateServiceAccountToken(accountName string, token atc.ServiceAccountToken) (atc.ServiceAccountToken, bool, error)
+	RevokeServiceAccountToken(accountName string, tokenName string) (bool, error)
+
+	ListQueue() ([]atc.Build, error)
 	CreateBuild(plan atc.Plan) (atc.Build, error)
 	Builds(page Page) ([]atc.Build, Pagination, error)
 	OrderingPipelines(pipelineNames []string) error
 
 	CreateArtifact(io.Reader, string) (atc.WorkerArtifact, error)
+	CreateIncrementalArtifact(src io.Reader, platform string, baseArtifactID int, manifest atc.ArtifactManifest) (atc.WorkerArtifact, bool, error)
+	DiffArtifact(artifactID int, manifest atc.ArtifactManifest) ([]string, bool, error)
 	GetArtifact(int) (io.ReadCloser, error)
 }
 
@@ -24,6 +24,10 @@ type Config struct {
 	TeamFactory db.TeamFactory
 	UserFactory db.UserFactory
 	Flags       skycmd.AuthFlags
+
+	ServiceAccountTokenFactory db.ServiceAccountTokenFactory
+	DeviceRequestFactory       db.DeviceRequestFactory
+
 	ExternalURL string
 	HTTPClient  *http.Client
 	Storage     storage.Storage
@@ -74,6 +78,10 @@ func NewServer(config *Config) (*Server, error) {
 		DexRedirectURL:  redirectURL,
 		DexHTTPClient:   config.HTTPClient,
 		SecureCookies:   config.Flags.SecureCookies,
+
+		ServiceAccountTokenFactory: config.ServiceAccountTokenFactory,
+		DeviceRequestFactory:       config.DeviceRequestFactory,
+		ExternalURL:                externalURL.String(),
 	})
 	if err != nil {
 		return nil, err
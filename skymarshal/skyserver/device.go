@@ -0,0 +1,365 @@
+package skyserver
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"html/template"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/skymarshal/token"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// DeviceCodeGrantType is the grant type used by devices polling the token
+// endpoint, as defined by RFC 8628.
+const DeviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+const (
+	deviceRequestExpiry   = 10 * time.Minute
+	devicePollingInterval = 5 * time.Second
+
+	userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength   = 8
+)
+
+type DeviceCodeResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// DeviceCode starts a device authorization grant for a client that can't
+// open a browser itself, such as fly on a remote host.
+func (s *SkyServer) DeviceCode(w http.ResponseWriter, r *http.Request) {
+
+	logger := s.config.Logger.Session("device-code")
+
+	if r.Method != "POST" {
+		logger.Error("invalid-method", nil)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if !s.validClient(logger, w, r) {
+		return
+	}
+
+	userCode, err := newUserCode()
+	if err != nil {
+		logger.Error("failed-to-generate-user-code", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	request := db.DeviceRequest{
+		DeviceCode: token.RandomString(),
+		UserCode:   userCode,
+		Expiry:     time.Now().Add(deviceRequestExpiry),
+		Interval:   devicePollingInterval,
+	}
+
+	if err = s.config.DeviceRequestFactory.CreateDeviceRequest(request); err != nil {
+		logger.Error("failed-to-create-device-request", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	verificationURI := strings.TrimRight(s.config.ExternalURL, "/") + "/sky/device"
+
+	w.Header().Add("Content-Type", "application/json")
+
+	json.NewEncoder(w).Encode(DeviceCodeResponse{
+		DeviceCode:              request.DeviceCode,
+		UserCode:                request.UserCode,
+		VerificationURI:         verificationURI,
+		VerificationURIComplete: verificationURI + "?" + url.Values{"user_code": {request.UserCode}}.Encode(),
+		ExpiresIn:               int(deviceRequestExpiry.Seconds()),
+		Interval:                int(request.Interval.Seconds()),
+	})
+}
+
+// Device serves the page on which a logged-in user approves a device's user
+// code. Users who aren't logged in are sent through the regular login flow
+// first, so every configured connector can be used.
+func (s *SkyServer) Device(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		s.showDevice(w, r)
+	case "POST":
+		s.approveDevice(w, r)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *SkyServer) showDevice(w http.ResponseWriter, r *http.Request) {
+
+	logger := s.config.Logger.Session("device")
+
+	userCode := normalizeUserCode(r.FormValue("user_code"))
+	if userCode == "" {
+		s.renderDevicePage(logger, w, http.StatusOK, devicePage{})
+		return
+	}
+
+	csrfToken := r.FormValue("csrf_token")
+	if csrfToken == "" {
+		redirectURI := "/sky/device?" + url.Values{"user_code": {userCode}}.Encode()
+		http.Redirect(w, r, "/sky/login?"+url.Values{"redirect_uri": {redirectURI}}.Encode(), http.StatusTemporaryRedirect)
+		return
+	}
+
+	_, found, err := s.config.DeviceRequestFactory.FindDeviceRequestByUserCode(userCode)
+	if err != nil {
+		logger.Error("failed-to-get-device-request", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		s.renderDevicePage(logger, w, http.StatusNotFound, devicePage{
+			Error: "The code '" + userCode + "' is invalid or has expired.",
+		})
+		return
+	}
+
+	s.renderDevicePage(logger, w, http.StatusOK, devicePage{
+		UserCode:  userCode,
+		CSRFToken: csrfToken,
+	})
+}
+
+func (s *SkyServer) approveDevice(w http.ResponseWriter, r *http.Request) {
+
+	logger := s.config.Logger.Session("approve-device")
+
+	tokenType, accessToken, expiry, csrf, err := s.sessionToken(r)
+	if err != nil {
+		logger.Info("invalid-session", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if csrf == "" || csrf != r.FormValue("csrf_token") {
+		logger.Info("invalid-csrf-token")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	userCode := normalizeUserCode(r.FormValue("user_code"))
+
+	approved, err := s.config.DeviceRequestFactory.ApproveDeviceRequest(userCode, tokenType, accessToken, expiry)
+	if err != nil {
+		logger.Error("failed-to-approve-device-request", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !approved {
+		s.renderDevicePage(logger, w, http.StatusNotFound, devicePage{
+			Error: "The code '" + userCode + "' is invalid or has expired.",
+		})
+		return
+	}
+
+	logger.Info("approved")
+
+	s.renderDevicePage(logger, w, http.StatusOK, devicePage{Approved: true})
+}
+
+// deviceToken answers a device polling the token endpoint, handing over the
+// approving user's token exactly once. Devices polling faster than their
+// interval are told to slow down.
+func (s *SkyServer) deviceToken(logger lager.Logger, w http.ResponseWriter, r *http.Request) {
+	deviceCode := r.FormValue("device_code")
+
+	request, found, tooEarly, err := s.config.DeviceRequestFactory.PollDeviceRequest(deviceCode)
+	if err != nil {
+		logger.Error("failed-to-poll-device-request", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		deviceTokenError(w, "expired_token")
+		return
+	}
+
+	if tooEarly {
+		deviceTokenError(w, "slow_down")
+		return
+	}
+
+	if !request.Approved() {
+		deviceTokenError(w, "authorization_pending")
+		return
+	}
+
+	if err = s.config.DeviceRequestFactory.DeleteDeviceRequest(deviceCode); err != nil {
+		logger.Error("failed-to-delete-device-request", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"token_type":   request.TokenType,
+		"access_token": request.AccessToken,
+		"expires_in":   int(time.Until(request.TokenExpiry).Seconds()),
+	})
+}
+
+func deviceTokenError(w http.ResponseWriter, code string) {
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+
+	json.NewEncoder(w).Encode(map[string]string{"error": code})
+}
+
+// sessionToken returns the token stored in the user's session cookie, along
+// with its CSRF token.
+func (s *SkyServer) sessionToken(r *http.Request) (string, string, time.Time, string, error) {
+	parts := strings.Split(s.config.TokenMiddleware.GetToken(r), " ")
+
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") {
+		return "", "", time.Time{}, "", errors.New("not logged in")
+	}
+
+	parsed, err := jwt.ParseSigned(parts[1])
+	if err != nil {
+		return "", "", time.Time{}, "", err
+	}
+
+	var claims jwt.Claims
+	var result map[string]interface{}
+
+	if err = parsed.Claims(&s.config.SigningKey.PublicKey, &claims, &result); err != nil {
+		return "", "", time.Time{}, "", err
+	}
+
+	if err = claims.Validate(jwt.Expected{Time: time.Now()}); err != nil {
+		return "", "", time.Time{}, "", err
+	}
+
+	csrf, _ := result["csrf"].(string)
+
+	return parts[0], parts[1], claims.Expiry.Time(), csrf, nil
+}
+
+func (s *SkyServer) validClient(logger lager.Logger, w http.ResponseWriter, r *http.Request) bool {
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		logger.Error("invalid-basic-auth", nil)
+		w.WriteHeader(http.StatusUnauthorized)
+		return false
+	}
+
+	if clientID != "fly" || clientSecret != "Zmx5" {
+		logger.Error("invalid-client", nil)
+		w.WriteHeader(http.StatusUnauthorized)
+		return false
+	}
+
+	return true
+}
+
+func newUserCode() (string, error) {
+	code := make([]byte, userCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(userCodeAlphabet))))
+		if err != nil {
+			return "", err
+		}
+
+		code[i] = userCodeAlphabet[n.Int64()]
+	}
+
+	return normalizeUserCode(string(code)), nil
+}
+
+// normalizeUserCode lets users type codes in any case and with or without
+// the separator.
+func normalizeUserCode(code string) string {
+	code = strings.ToUpper(code)
+	code = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(userCodeAlphabet, r) {
+			return r
+		}
+
+		return -1
+	}, code)
+
+	if len(code) != userCodeLength {
+		return code
+	}
+
+	return code[:userCodeLength/2] + "-" + code[userCodeLength/2:]
+}
+
+type devicePage struct {
+	UserCode  string
+	CSRFToken string
+	Approved  bool
+	Error     string
+}
+
+func (s *SkyServer) renderDevicePage(logger lager.Logger, w http.ResponseWriter, status int, page devicePage) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+
+	if err := deviceTemplate.Execute(w, page); err != nil {
+		logger.Error("failed-to-render-device-page", err)
+	}
+}
+
+var deviceTemplate = template.Must(template.New("device").Parse(`<!DOCTYPE html>
+<html>
+  <head>
+    <meta charset="utf-8">
+    <title>Concourse</title>
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <link href="/sky/issuer/static/main.css" rel="stylesheet">
+    <link href="/sky/issuer/themes/concourse/styles.css" rel="stylesheet">
+  </head>
+
+  <body class="theme-body">
+    <div class="dex-container">
+      <div class="theme-panel">
+        <h2 class="theme-heading">Log in a device</h2>
+
+        {{ if .Approved }}
+        <p>Your device is now logged in. You can close this window.</p>
+        {{ else if .UserCode }}
+        <p>Log in the device showing the code <strong>{{ .UserCode }}</strong>?</p>
+        <form method="post">
+          <input type="hidden" name="user_code" value="{{ .UserCode }}">
+          <input type="hidden" name="csrf_token" value="{{ .CSRFToken }}">
+          <button type="submit" class="dex-btn theme-btn--success">
+            <span class="dex-btn-text">Approve</span>
+          </button>
+        </form>
+        {{ else }}
+        {{ if .Error }}<p>{{ .Error }}</p>{{ end }}
+        <form method="get">
+          <input type="text" name="user_code" class="theme-form-input" placeholder="XXXX-XXXX" autofocus required>
+          <button type="submit" class="dex-btn theme-btn--primary">
+            <span class="dex-btn-text">Continue</span>
+          </button>
+        </form>
+        {{ end }}
+      </div>
+    </div>
+  </body>
+</html>
+`))
@@ -0,0 +1,328 @@
+package skyserver_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/skymarshal/skyserver"
+	"github.com/concourse/concourse/skymarshal/token"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Device authorization", func() {
+	var (
+		response  *http.Response
+		reqHeader http.Header
+	)
+
+	BeforeEach(func() {
+		skyServer.Start()
+
+		reqHeader = http.Header{}
+		reqHeader.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("fly:Zmx5")))
+		reqHeader.Set("Content-Type", "application/x-www-form-urlencoded")
+	})
+
+	JustBeforeEach(func() {
+		client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	})
+
+	post := func(path string, form url.Values) {
+		request, err := http.NewRequest("POST", skyServer.URL+path, strings.NewReader(form.Encode()))
+		Expect(err).NotTo(HaveOccurred())
+
+		request.Header = reqHeader
+
+		response, err = client.Do(request)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	Describe("POST /sky/device/code", func() {
+		JustBeforeEach(func() {
+			post("/sky/device/code", url.Values{})
+		})
+
+		It("creates a device request", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+			var codeResponse skyserver.DeviceCodeResponse
+			err := json.NewDecoder(response.Body).Decode(&codeResponse)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeDeviceRequests.CreateDeviceRequestCallCount()).To(Equal(1))
+			request := fakeDeviceRequests.CreateDeviceRequestArgsForCall(0)
+
+			Expect(codeResponse.DeviceCode).To(Equal(request.DeviceCode))
+			Expect(codeResponse.UserCode).To(Equal(request.UserCode))
+			Expect(codeResponse.UserCode).To(MatchRegexp(`^[A-Z]{4}-[A-Z]{4}$`))
+			Expect(codeResponse.VerificationURI).To(Equal("https://concourse.example.com/sky/device"))
+			Expect(codeResponse.VerificationURIComplete).To(Equal("https://concourse.example.com/sky/device?user_code=" + request.UserCode))
+			Expect(codeResponse.ExpiresIn).To(Equal(600))
+			Expect(codeResponse.Interval).To(Equal(5))
+			Expect(request.Interval).To(Equal(5 * time.Second))
+			Expect(request.Expiry).To(BeTemporally("~", time.Now().Add(10*time.Minute), time.Minute))
+		})
+
+		Context("when not using the fly client", func() {
+			BeforeEach(func() {
+				reqHeader.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("fly:wrong")))
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(fakeDeviceRequests.CreateDeviceRequestCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when storing the request fails", func() {
+			BeforeEach(func() {
+				fakeDeviceRequests.CreateDeviceRequestReturns(errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
+	Describe("GET /sky/device", func() {
+		var query url.Values
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(skyServer.URL + "/sky/device?" + query.Encode())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("without a user code", func() {
+			BeforeEach(func() {
+				query = url.Values{}
+			})
+
+			It("asks for the code", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(body)).To(ContainSubstring(`name="user_code"`))
+			})
+		})
+
+		Context("with a user code but no csrf token", func() {
+			BeforeEach(func() {
+				query = url.Values{"user_code": {"bcdf-ghjk"}}
+			})
+
+			It("logs in first, returning to the normalized code", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusTemporaryRedirect))
+
+				location, err := response.Location()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(location.Path).To(Equal("/sky/login"))
+				Expect(location.Query().Get("redirect_uri")).To(Equal("/sky/device?user_code=BCDF-GHJK"))
+			})
+		})
+
+		Context("after logging in", func() {
+			BeforeEach(func() {
+				query = url.Values{"user_code": {"BCDF-GHJK"}, "csrf_token": {"some-csrf"}}
+			})
+
+			Context("when the code is pending", func() {
+				BeforeEach(func() {
+					fakeDeviceRequests.FindDeviceRequestByUserCodeReturns(db.DeviceRequest{UserCode: "BCDF-GHJK"}, true, nil)
+				})
+
+				It("asks for approval", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeDeviceRequests.FindDeviceRequestByUserCodeArgsForCall(0)).To(Equal("BCDF-GHJK"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(ContainSubstring(`name="csrf_token" value="some-csrf"`))
+					Expect(string(body)).To(ContainSubstring("Approve"))
+				})
+			})
+
+			Context("when the code is unknown or expired", func() {
+				BeforeEach(func() {
+					fakeDeviceRequests.FindDeviceRequestByUserCodeReturns(db.DeviceRequest{}, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+
+	Describe("POST /sky/device", func() {
+		var (
+			form       url.Values
+			expiration time.Time
+			accessTok  string
+		)
+
+		BeforeEach(func() {
+			expiration = time.Now().Add(time.Hour).Truncate(time.Second)
+
+			oauthToken, err := token.NewGenerator(signingKey).Generate(map[string]interface{}{
+				"exp":  expiration.Unix(),
+				"csrf": "some-csrf",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			accessTok = oauthToken.AccessToken
+			fakeTokenMiddleware.GetTokenReturns(oauthToken.TokenType + " " + oauthToken.AccessToken)
+
+			form = url.Values{"user_code": {"bcdfghjk"}, "csrf_token": {"some-csrf"}}
+		})
+
+		JustBeforeEach(func() {
+			post("/sky/device", form)
+		})
+
+		Context("when the code is pending", func() {
+			BeforeEach(func() {
+				fakeDeviceRequests.ApproveDeviceRequestReturns(true, nil)
+			})
+
+			It("approves the request with the user's token", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				Expect(fakeDeviceRequests.ApproveDeviceRequestCallCount()).To(Equal(1))
+				userCode, tokenType, accessToken, tokenExpiry := fakeDeviceRequests.ApproveDeviceRequestArgsForCall(0)
+				Expect(userCode).To(Equal("BCDF-GHJK"))
+				Expect(tokenType).To(Equal("Bearer"))
+				Expect(accessToken).To(Equal(accessTok))
+				Expect(tokenExpiry).To(BeTemporally("==", expiration))
+			})
+		})
+
+		Context("when the code is unknown or expired", func() {
+			BeforeEach(func() {
+				fakeDeviceRequests.ApproveDeviceRequestReturns(false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the csrf token does not match", func() {
+			BeforeEach(func() {
+				form.Set("csrf_token", "other-csrf")
+			})
+
+			It("returns 400 without approving", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(fakeDeviceRequests.ApproveDeviceRequestCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when not logged in", func() {
+			BeforeEach(func() {
+				fakeTokenMiddleware.GetTokenReturns("")
+			})
+
+			It("returns 401 without approving", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(fakeDeviceRequests.ApproveDeviceRequestCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("POST /sky/token with the device code grant", func() {
+		JustBeforeEach(func() {
+			post("/sky/token", url.Values{
+				"grant_type":  {skyserver.DeviceCodeGrantType},
+				"device_code": {"some-device-code"},
+			})
+		})
+
+		Context("when the request has been approved", func() {
+			BeforeEach(func() {
+				fakeDeviceRequests.PollDeviceRequestReturns(db.DeviceRequest{
+					DeviceCode:  "some-device-code",
+					TokenType:   "bearer",
+					AccessToken: "some-access-token",
+					TokenExpiry: time.Now().Add(time.Hour),
+				}, true, false, nil)
+			})
+
+			It("returns the token once", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				var tokenResponse map[string]interface{}
+				err := json.NewDecoder(response.Body).Decode(&tokenResponse)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(tokenResponse["token_type"]).To(Equal("bearer"))
+				Expect(tokenResponse["access_token"]).To(Equal("some-access-token"))
+
+				Expect(fakeDeviceRequests.PollDeviceRequestArgsForCall(0)).To(Equal("some-device-code"))
+				Expect(fakeDeviceRequests.DeleteDeviceRequestCallCount()).To(Equal(1))
+				Expect(fakeDeviceRequests.DeleteDeviceRequestArgsForCall(0)).To(Equal("some-device-code"))
+			})
+		})
+
+		Context("when the request is pending", func() {
+			BeforeEach(func() {
+				fakeDeviceRequests.PollDeviceRequestReturns(db.DeviceRequest{DeviceCode: "some-device-code"}, true, false, nil)
+			})
+
+			It("tells the device to keep polling", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`{"error":"authorization_pending"}`))
+				Expect(fakeDeviceRequests.DeleteDeviceRequestCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the device polls too early", func() {
+			BeforeEach(func() {
+				fakeDeviceRequests.PollDeviceRequestReturns(db.DeviceRequest{
+					DeviceCode:  "some-device-code",
+					TokenType:   "bearer",
+					AccessToken: "some-access-token",
+				}, true, true, nil)
+			})
+
+			It("tells the device to slow down", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`{"error":"slow_down"}`))
+				Expect(fakeDeviceRequests.DeleteDeviceRequestCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the request has expired", func() {
+			BeforeEach(func() {
+				fakeDeviceRequests.PollDeviceRequestReturns(db.DeviceRequest{}, false, false, nil)
+			})
+
+			It("tells the device to give up", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`{"error":"expired_token"}`))
+			})
+		})
+	})
+})
@@ -27,6 +27,11 @@ type SkyConfig struct {
 	TokenMiddleware token.Middleware
 	UserFactory     db.UserFactory
 	SigningKey      *rsa.PrivateKey
+
+	ServiceAccountTokenFactory db.ServiceAccountTokenFactory
+	DeviceRequestFactory       db.DeviceRequestFactory
+
+	ExternalURL     string
 	SecureCookies   bool
 	DexClientID     string
 	DexClientSecret string
@@ -44,6 +49,8 @@ func NewSkyHandler(server *SkyServer) http.Handler {
 	handler.HandleFunc("/sky/callback", server.Callback)
 	handler.HandleFunc("/sky/userinfo", server.UserInfo)
 	handler.HandleFunc("/sky/token", server.Token)
+	handler.HandleFunc("/sky/device", server.Device)
+	handler.HandleFunc("/sky/device/code", server.DeviceCode)
 	return handler
 }
 
@@ -287,20 +294,16 @@ func (s *SkyServer) Token(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	clientID, clientSecret, ok := r.BasicAuth()
-	if !ok {
-		logger.Error("invalid-basic-auth", nil)
-		w.WriteHeader(http.StatusUnauthorized)
+	if !s.validClient(logger, w, r) {
 		return
 	}
 
-	if clientID != "fly" || clientSecret != "Zmx5" {
-		logger.Error("invalid-client", nil)
-		w.WriteHeader(http.StatusUnauthorized)
+	if grantType = r.FormValue("grant_type"); grantType == DeviceCodeGrantType {
+		s.deviceToken(logger, w, r)
 		return
 	}
 
-	if grantType = r.FormValue("grant_type"); grantType != "password" {
+	if grantType != "password" {
 		logger.Error("invalid-grant-type", nil, lager.Data{"grant_type": grantType})
 		w.WriteHeader(http.StatusBadRequest)
 		return
@@ -368,6 +371,11 @@ func (s *SkyServer) UserInfo(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if token.IsAPIToken(parts[1]) {
+		s.serviceAccountUserInfo(logger, w, parts[1])
+		return
+	}
+
 	parsed, err := jwt.ParseSigned(parts[1])
 	if err != nil {
 		logger.Error("failed-to-parse-authorization-token", err)
@@ -395,6 +403,36 @@ func (s *SkyServer) UserInfo(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(userInfo)
 }
 
+func (s *SkyServer) serviceAccountUserInfo(logger lager.Logger, w http.ResponseWriter, apiToken string) {
+	if s.config.ServiceAccountTokenFactory == nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	account, found, err := s.config.ServiceAccountTokenFactory.UseToken(token.HashAPIToken(apiToken))
+	if err != nil {
+		logger.Error("failed-to-find-service-account-token", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+
+	json.NewEncoder(w).Encode(UserInfo{
+		Sub:      "service-account:" + account.TeamName + "/" + account.AccountName,
+		UserName: account.AccountName,
+		Name:     account.AccountName,
+		Teams: TeamInfo{
+			account.TeamName: []string{account.Role},
+		},
+	})
+}
+
 func (s *SkyServer) endpoint() oauth2.Endpoint {
 	return oauth2.Endpoint{
 		AuthURL:   strings.TrimRight(s.config.DexIssuerURL, "/") + "/auth",
@@ -21,6 +21,8 @@ import (
 var (
 	fakeTeamFactory     *dbfakes.FakeTeamFactory
 	fakeUserFactory     *dbfakes.FakeUserFactory
+	fakeServiceAccounts *dbfakes.FakeServiceAccountTokenFactory
+	fakeDeviceRequests  *dbfakes.FakeDeviceRequestFactory
 	fakeTokenVerifier   *tokenfakes.FakeVerifier
 	fakeTokenIssuer     *tokenfakes.FakeIssuer
 	fakeTokenMiddleware *tokenfakes.FakeMiddleware
@@ -52,6 +54,8 @@ var _ = BeforeEach(func() {
 	fakeTokenMiddleware = new(tokenfakes.FakeMiddleware)
 
 	fakeUserFactory = new(dbfakes.FakeUserFactory)
+	fakeServiceAccounts = new(dbfakes.FakeServiceAccountTokenFactory)
+	fakeDeviceRequests = new(dbfakes.FakeDeviceRequestFactory)
 
 	dexServer = ghttp.NewTLSServer()
 	dexIssuerUrl := dexServer.URL() + "/sky/issuer"
@@ -73,6 +77,10 @@ var _ = BeforeEach(func() {
 		DexIssuerURL:    dexIssuerUrl,
 		DexHTTPClient:   dexServer.HTTPTestServer.Client(),
 		SigningKey:      signingKey,
+
+		ServiceAccountTokenFactory: fakeServiceAccounts,
+		DeviceRequestFactory:       fakeDeviceRequests,
+		ExternalURL:                "https://concourse.example.com",
 	}
 
 	server, err := skyserver.NewSkyServer(config)
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/skymarshal/token"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -762,6 +763,49 @@ var _ = Describe("Sky Server API", func() {
 				})
 			})
 
+			Context("bearer token is a service account token", func() {
+				BeforeEach(func() {
+					reqHeader.Set("Authorization", "Bearer cst_some-token")
+				})
+
+				Context("when the token is found", func() {
+					BeforeEach(func() {
+						fakeServiceAccounts.UseTokenReturns(db.ServiceAccountAccess{
+							TeamName:    "some-team",
+							AccountName: "deploy-bot",
+							TokenName:   "ci",
+							Role:        "member",
+						}, true, nil)
+					})
+
+					It("looks up the token by its hash", func() {
+						Expect(fakeServiceAccounts.UseTokenArgsForCall(0)).To(Equal(token.HashAPIToken("cst_some-token")))
+					})
+
+					It("outputs the service account", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+						var userInfo map[string]interface{}
+						err := json.NewDecoder(response.Body).Decode(&userInfo)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(userInfo["user_name"]).To(Equal("deploy-bot"))
+						Expect(userInfo["is_admin"]).To(Equal(false))
+						Expect(userInfo["teams"]).To(HaveKeyWithValue("some-team", ContainElement("member")))
+					})
+				})
+
+				Context("when the token is not found", func() {
+					BeforeEach(func() {
+						fakeServiceAccounts.UseTokenReturns(db.ServiceAccountAccess{}, false, nil)
+					})
+
+					It("errors", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+					})
+				})
+			})
+
 			Context("bearer token is signed with the wrong key", func() {
 				BeforeEach(func() {
 					wrongSigningKey, err := rsa.GenerateKey(rand.Reader, 2048)
@@ -0,0 +1,28 @@
+package token
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// APITokenPrefix marks the long-lived API tokens issued to service accounts,
+// distinguishing them from the JWTs issued after logging in through Dex.
+const APITokenPrefix = atc.ServiceAccountTokenPrefix
+
+// NewAPIToken returns a new random API token. Only its hash is stored, so
+// the token itself can only be shown once.
+func NewAPIToken() string {
+	return APITokenPrefix + RandomString()
+}
+
+func IsAPIToken(token string) bool {
+	return strings.HasPrefix(token, APITokenPrefix)
+}
+
+func HashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,36 @@
+package token_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/skymarshal/token"
+)
+
+var _ = Describe("API tokens", func() {
+	Describe("NewAPIToken", func() {
+		It("generates distinct prefixed tokens", func() {
+			first := token.NewAPIToken()
+			second := token.NewAPIToken()
+
+			Expect(token.IsAPIToken(first)).To(BeTrue())
+			Expect(first).NotTo(Equal(second))
+		})
+	})
+
+	Describe("IsAPIToken", func() {
+		It("does not match JWTs", func() {
+			Expect(token.IsAPIToken("eyJhbGciOiJSUzI1NiJ9.e30.c2ln")).To(BeFalse())
+		})
+	})
+
+	Describe("HashAPIToken", func() {
+		It("hashes the token consistently without revealing it", func() {
+			hash := token.HashAPIToken("cst_some-token")
+
+			Expect(hash).To(Equal(token.HashAPIToken("cst_some-token")))
+			Expect(hash).NotTo(ContainSubstring("some-token"))
+			Expect(hash).NotTo(Equal(token.HashAPIToken("cst_other-token")))
+		})
+	})
+})
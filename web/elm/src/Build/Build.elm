@@ -105,6 +105,7 @@ init flags =
           , build = RemoteData.NotAsked
           , duration = { startedAt = Nothing, finishedAt = Nothing }
           , status = BuildStatusPending
+          , debugUntil = Nothing
           , output = Empty
           , autoScroll = True
           , previousKeyPress = Nothing
@@ -576,6 +577,7 @@ handleBuildFetched build ( model, effects ) =
                 | build = RemoteData.Success build
                 , duration = build.duration
                 , status = build.status
+                , debugUntil = build.debugUntil
                 , output = output
             }
 
@@ -44,6 +44,7 @@ header session model =
         [ Views.Title model.name model.job
         , Views.Duration (duration session model)
         ]
+            ++ debugContainer session model
     , rightWidgets =
         [ Views.Button
             (if Concourse.BuildStatus.isRunning model.status then
@@ -139,6 +140,20 @@ duration session model =
                 }
 
 
+debugContainer : Session -> Model r -> List Views.Widget
+debugContainer session model =
+    case model.debugUntil of
+        Just until ->
+            [ Views.DebugContainer
+                { buildId = model.id
+                , until = format session.timeZone until
+                }
+            ]
+
+        Nothing ->
+            []
+
+
 timestamp : Time.Zone -> Maybe Time.Posix -> Time.Posix -> Views.Timestamp
 timestamp timeZone now time =
     let
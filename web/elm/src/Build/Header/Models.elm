@@ -21,6 +21,7 @@ type alias Model r =
         , history : List HistoryItem
         , duration : Concourse.BuildDuration
         , status : BuildStatus.BuildStatus
+        , debugUntil : Maybe Time.Posix
         , disableManualTrigger : Bool
         , now : Maybe Time.Posix
         , fetchingHistory : Bool
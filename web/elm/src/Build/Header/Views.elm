@@ -50,6 +50,7 @@ type Widget
     = Button (Maybe ButtonView)
     | Title String (Maybe Concourse.JobIdentifier)
     | Duration BuildDuration
+    | DebugContainer { buildId : Int, until : String }
 
 
 type BuildDuration
@@ -131,6 +132,17 @@ viewWidget widget =
         Duration duration ->
             viewDuration duration
 
+        DebugContainer { buildId, until } ->
+            Html.div
+                [ class "debug-container" ]
+                [ Html.text <|
+                    "the failed task's container is kept for debugging until "
+                        ++ until
+                        ++ "; run `fly hijack -b "
+                        ++ String.fromInt buildId
+                        ++ "` to get into it"
+                ]
+
 
 viewDuration : BuildDuration -> Html Message
 viewDuration buildDuration =
@@ -162,6 +162,9 @@ handleEvent event ( model, effects ) =
             , effects
             )
 
+        TaskResourceUsage _ ->
+            ( model, effects )
+
         Initialize origin time ->
             ( updateStep origin.id (setInitialize time) model
             , effects
@@ -124,6 +124,7 @@ type BuildEvent
     | InitializeTask Origin Time.Posix
     | StartTask Origin Time.Posix
     | FinishTask Origin Int Time.Posix
+    | TaskResourceUsage Origin
     | Initialize Origin Time.Posix
     | Start Origin Time.Posix
     | Finish Origin Time.Posix Bool
@@ -114,6 +114,13 @@ decodeBuildEvent =
                                 (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
                             )
 
+                    "task-resource-usage" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map TaskResourceUsage
+                                (Json.Decode.field "origin" decodeOrigin)
+                            )
+
                     "initialize" ->
                         Json.Decode.field
                             "data"
@@ -109,6 +109,7 @@ all =
                         , status = model.status
                         , duration = model.duration
                         , reapTime = Nothing
+                        , debugUntil = Nothing
                         }
                 in
                 ( model, [] )
@@ -142,6 +143,7 @@ all =
                         , status = model.status
                         , duration = model.duration
                         , reapTime = Nothing
+                        , debugUntil = Nothing
                         }
                 in
                 ( model, [] )
@@ -189,6 +191,7 @@ model =
     , history = []
     , duration = { startedAt = Nothing, finishedAt = Nothing }
     , status = BuildStatusPending
+    , debugUntil = Nothing
     , disableManualTrigger = False
     , now = Nothing
     , fetchingHistory = False
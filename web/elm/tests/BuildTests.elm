@@ -84,6 +84,7 @@ all =
                     , finishedAt = Just <| Time.millisToPosix 0
                     }
                 , reapTime = Nothing
+                , debugUntil = Nothing
                 }
 
             startedBuild : Concourse.Build
@@ -102,6 +103,7 @@ all =
                     , finishedAt = Nothing
                     }
                 , reapTime = Nothing
+                , debugUntil = Nothing
                 }
 
             fetchBuild : Application.Model -> ( Application.Model, List Effects.Effect )
@@ -127,6 +129,7 @@ all =
                                 , finishedAt = Nothing
                                 }
                             , reapTime = Nothing
+                            , debugUntil = Nothing
                             }
                         )
                     )
@@ -148,6 +151,7 @@ all =
                                             , finishedAt = Nothing
                                             }
                                       , reapTime = Nothing
+                                      , debugUntil = Nothing
                                       }
                                     ]
                                 }
@@ -268,6 +272,7 @@ all =
                                     , finishedAt = Nothing
                                     }
                                 , reapTime = Nothing
+                                , debugUntil = Nothing
                                 }
                         )
                     |> Tuple.first
@@ -342,6 +347,7 @@ all =
                                     , finishedAt = Nothing
                                     }
                                 , reapTime = Nothing
+                                , debugUntil = Nothing
                                 }
                         )
                     |> Tuple.first
@@ -411,6 +417,7 @@ all =
                                     , finishedAt = Nothing
                                     }
                                 , reapTime = Nothing
+                                , debugUntil = Nothing
                                 }
                         )
                     |> Tuple.first
@@ -480,6 +487,7 @@ all =
                                     , finishedAt = Nothing
                                     }
                                 , reapTime = Nothing
+                                , debugUntil = Nothing
                                 }
                         )
                     |> Tuple.first
@@ -605,6 +613,7 @@ all =
                                     , finishedAt = buildTime
                                     }
                                 , reapTime = buildTime
+                                , debugUntil = Nothing
                                 }
                         )
                     |> Tuple.first
@@ -658,6 +667,7 @@ all =
                                     , finishedAt = Just <| Time.millisToPosix 0
                                     }
                                 , reapTime = Nothing
+                                , debugUntil = Nothing
                                 }
                         )
                     |> Tuple.first
@@ -702,6 +712,7 @@ all =
                                     , finishedAt = Nothing
                                     }
                                 , reapTime = Nothing
+                                , debugUntil = Nothing
                                 }
                         )
                     |> Tuple.first
@@ -753,6 +764,7 @@ all =
                                     , finishedAt = Nothing
                                     }
                                 , reapTime = Nothing
+                                , debugUntil = Nothing
                                 }
                         )
                     |> Tuple.first
@@ -813,6 +825,7 @@ all =
                                     , finishedAt = Nothing
                                     }
                                 , reapTime = Nothing
+                                , debugUntil = Nothing
                                 }
                         )
                     |> Tuple.first
@@ -1531,6 +1544,7 @@ all =
                                                 , finishedAt = Just <| Time.millisToPosix 0
                                                 }
                                           , reapTime = Nothing
+                                          , debugUntil = Nothing
                                           }
                                         ]
                                     }
@@ -1580,6 +1594,7 @@ all =
                                                 , finishedAt = Just <| Time.millisToPosix 0
                                                 }
                                           , reapTime = Nothing
+                                          , debugUntil = Nothing
                                           }
                                         ]
                                     }
@@ -1626,6 +1641,7 @@ all =
                                                 , finishedAt = Just <| Time.millisToPosix 0
                                                 }
                                           , reapTime = Nothing
+                                          , debugUntil = Nothing
                                           }
                                         ]
                                     }
@@ -1781,6 +1797,7 @@ all =
                                                 , finishedAt = Nothing
                                                 }
                                           , reapTime = Nothing
+                                          , debugUntil = Nothing
                                           }
                                         ]
                                     }
@@ -1893,6 +1910,7 @@ all =
                                                 , finishedAt = Nothing
                                                 }
                                           , reapTime = Nothing
+                                          , debugUntil = Nothing
                                           }
                                         ]
                                     }
@@ -3459,6 +3477,7 @@ all =
                                             , finishedAt = Nothing
                                             }
                                         , reapTime = Nothing
+                                        , debugUntil = Nothing
                                         }
                                 )
                             |> Tuple.first
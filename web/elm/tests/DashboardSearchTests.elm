@@ -78,6 +78,7 @@ all =
                                                 , finishedAt = Nothing
                                                 }
                                             , reapTime = Nothing
+                                            , debugUntil = Nothing
                                             }
                                   , finishedBuild = Nothing
                                   , transitionBuild = Nothing
@@ -3619,6 +3619,7 @@ givenPipelineWithJob user =
                     , status = BuildStatusSucceeded
                     , duration = { startedAt = Nothing, finishedAt = Nothing }
                     , reapTime = Nothing
+                    , debugUntil = Nothing
                     }
           , transitionBuild = Nothing
           , paused = False
@@ -3744,6 +3745,7 @@ running j =
                     , finishedAt = Nothing
                     }
                 , reapTime = Nothing
+                , debugUntil = Nothing
                 }
     }
 
@@ -3784,6 +3786,7 @@ jobWithNameTransitionedAt jobName transitionedAt status =
                 , finishedAt = Nothing
                 }
             , reapTime = Nothing
+            , debugUntil = Nothing
             }
     , transitionBuild =
         transitionedAt
@@ -3803,6 +3806,7 @@ jobWithNameTransitionedAt jobName transitionedAt status =
                         , finishedAt = Just <| t
                         }
                     , reapTime = Nothing
+                    , debugUntil = Nothing
                     }
                 )
     , paused = False
@@ -3839,6 +3843,7 @@ circularJobs =
                     , finishedAt = Nothing
                     }
                 , reapTime = Nothing
+                , debugUntil = Nothing
                 }
       , transitionBuild =
             Just
@@ -3856,6 +3861,7 @@ circularJobs =
                     , finishedAt = Just <| Time.millisToPosix 0
                     }
                 , reapTime = Nothing
+                , debugUntil = Nothing
                 }
       , paused = False
       , disableManualTrigger = False
@@ -3893,6 +3899,7 @@ circularJobs =
                     , finishedAt = Nothing
                     }
                 , reapTime = Nothing
+                , debugUntil = Nothing
                 }
       , transitionBuild =
             Just
@@ -3910,6 +3917,7 @@ circularJobs =
                     , finishedAt = Just <| Time.millisToPosix 0
                     }
                 , reapTime = Nothing
+                , debugUntil = Nothing
                 }
       , paused = False
       , disableManualTrigger = False
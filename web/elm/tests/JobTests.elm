@@ -64,6 +64,7 @@ all =
                         , finishedAt = Just <| Time.millisToPosix 0
                         }
                     , reapTime = Just <| Time.millisToPosix 0
+                    , debugUntil = Nothing
                     }
 
                 someJob : Concourse.Job
@@ -216,6 +217,7 @@ all =
                                                 , finishedAt = Nothing
                                                 }
                                           , reapTime = Nothing
+                                          , debugUntil = Nothing
                                           }
                                         ]
                                 in
@@ -492,6 +494,7 @@ all =
                                             , finishedAt = Nothing
                                             }
                                       , reapTime = Nothing
+                                      , debugUntil = Nothing
                                       }
                                     ]
                             in
@@ -531,6 +534,7 @@ all =
                                             , finishedAt = Nothing
                                             }
                                       , reapTime = Nothing
+                                      , debugUntil = Nothing
                                       }
                                     ]
                             in
@@ -589,6 +593,7 @@ all =
                                             , finishedAt = Nothing
                                             }
                                       , reapTime = Nothing
+                                      , debugUntil = Nothing
                                       }
                                     ]
                             in
@@ -677,6 +682,7 @@ all =
                                             , finishedAt = Nothing
                                             }
                                       , reapTime = Nothing
+                                      , debugUntil = Nothing
                                       }
                                     ]
                             in
@@ -759,6 +765,7 @@ all =
                                     , finishedAt = Nothing
                                     }
                               , reapTime = Nothing
+                              , debugUntil = Nothing
                               }
                             ]
 
@@ -314,6 +314,7 @@ all =
                                         , finishedAt = Nothing
                                         }
                                     , reapTime = Nothing
+                                    , debugUntil = Nothing
                                     }
                                   ]
                                 )
@@ -352,6 +353,7 @@ all =
                                         , finishedAt = Nothing
                                         }
                                     , reapTime = Nothing
+                                    , debugUntil = Nothing
                                     }
                                   ]
                                 )
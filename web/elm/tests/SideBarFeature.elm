@@ -1640,6 +1640,7 @@ iAmLookingAtAOneOffBuildPageOnANonPhoneScreen =
                     , status = BuildStatusStarted
                     , duration = { startedAt = Nothing, finishedAt = Nothing }
                     , reapTime = Nothing
+                    , debugUntil = Nothing
                     }
                 )
             )
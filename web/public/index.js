@@ -36,6 +36,10 @@ function redrawFunction(svg, jobs, resources, newUrl) {
       if (edge.customData !== null && edge.customData.trigger === false) {
         d3.select(this).classed("trigger-false", true)
       }
+
+      if (edge.customData !== null && edge.customData.passedAny === true) {
+        d3.select(this).classed("passed-any", true)
+      }
     })
 
     function highlight(thing) {
@@ -421,12 +425,21 @@ function createGraph(svg, jobs, resources) {
     for (var j in job.inputs) {
       var input = job.inputs[j];
 
-      if (input.passed && input.passed.length > 0) {
-        for (var p in input.passed) {
-          var sourceJobNode = jobNode(input.passed[p]);
+      var upstreams = [];
+      for (var p in input.passed) {
+        upstreams.push({job: input.passed[p], any: false});
+      }
+      for (var p in input.passed_any) {
+        upstreams.push({job: input.passed_any[p], any: true});
+      }
+
+      if (upstreams.length > 0) {
+        for (var u in upstreams) {
+          var upstream = upstreams[u];
+          var sourceJobNode = jobNode(upstream.job);
 
-          var sourceOutputNode = outputNode(input.passed[p], input.resource);
-          var sourceInputNode = inputNode(input.passed[p], input.resource);
+          var sourceOutputNode = outputNode(upstream.job, input.resource);
+          var sourceInputNode = inputNode(upstream.job, input.resource);
 
           var sourceNode;
           if (graph.node(sourceOutputNode)) {
@@ -453,7 +466,7 @@ function createGraph(svg, jobs, resources) {
             sourceNode = sourceInputNode;
           }
 
-          graph.addEdge(sourceNode, id, input.resource, {trigger: input.trigger});
+          graph.addEdge(sourceNode, id, input.resource, {trigger: input.trigger, passedAny: upstream.any});
         }
       }
     }
@@ -472,7 +485,10 @@ function createGraph(svg, jobs, resources) {
       var input = job.inputs[j];
       var status = "";
 
-      if (!input.passed || input.passed.length == 0) {
+      var constrained = (input.passed && input.passed.length > 0) ||
+        (input.passed_any && input.passed_any.length > 0);
+
+      if (!constrained) {
         var inputId = inputNode(job.name, input.resource+"-unconstrained");
 
         if (!graph.node(inputId)) {
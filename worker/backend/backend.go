@@ -22,6 +22,7 @@ type Backend struct {
 	client    libcontainerd.Client
 	namespace string
 	clientTimeout time.Duration
+	network   Network
 }
 
 type InputValidationError struct{
@@ -40,18 +41,19 @@ func (e ClientError) Error() string {
 }
 
 func New(client libcontainerd.Client, namespace string) Backend {
-	return Backend{
-		namespace: namespace,
-		client:    client,
-		clientTimeout: 10 * time.Second,
-	}
+	return NewWithTimeout(client, namespace, 10 * time.Second)
 }
 
 func NewWithTimeout(client libcontainerd.Client, namespace string, clientTimeout time.Duration) Backend {
+	return NewWithNetwork(client, namespace, clientTimeout, NewIptablesNetwork())
+}
+
+func NewWithNetwork(client libcontainerd.Client, namespace string, clientTimeout time.Duration, network Network) Backend {
 	return Backend{
 		namespace: namespace,
 		client:    client,
 		clientTimeout: clientTimeout,
+		network:   network,
 	}
 }
 
@@ -119,12 +121,25 @@ func (b *Backend) Create(gdnSpec garden.ContainerSpec) (container garden.Contain
 		return
 	}
 
-	_, err = cont.NewTask(ctxWithTimeout, cio.NullIO)
+	task, err := cont.NewTask(ctxWithTimeout, cio.NullIO)
 	if err != nil {
 		err = ClientError{ InnerError: fmt.Errorf("failed to create a task in container: %w", err) }
 		return
 	}
 
+	// the task has not been started yet, so nothing runs in the container
+	// before its network is restricted
+	if !permitsAll(gdnSpec.NetOut) {
+		err = b.network.Restrict(ctxWithTimeout, task, gdnSpec.NetOut)
+		if err != nil {
+			_, _ = task.Delete(ctxWithTimeout, containerd.WithProcessKill)
+			_ = b.client.Destroy(ctxWithTimeout, gdnSpec.Handle)
+
+			err = ClientError{ InnerError: fmt.Errorf("failed to restrict the container's network: %w", err) }
+			return
+		}
+	}
+
 	container = &Container{
 		handle: cont.ID(),
 	}
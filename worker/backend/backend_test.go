@@ -4,7 +4,9 @@ import (
 	"code.cloudfoundry.org/garden"
 	"context"
 	"errors"
+	"net"
 	"github.com/concourse/concourse/worker/backend"
+	"github.com/concourse/concourse/worker/backend/backendfakes"
 	"github.com/concourse/concourse/worker/backend/libcontainerd/libcontainerdfakes"
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/errdefs"
@@ -22,13 +24,15 @@ type BackendSuite struct {
 
 	backend backend.Backend
 	client  *libcontainerdfakes.FakeClient
+	network *backendfakes.FakeNetwork
 }
 
 const testNamespace = "test-namespace"
 
 func (s *BackendSuite) SetupTest() {
 	s.client = new(libcontainerdfakes.FakeClient)
-	s.backend = backend.New(s.client, testNamespace)
+	s.network = new(backendfakes.FakeNetwork)
+	s.backend = backend.NewWithNetwork(s.client, testNamespace, 10*time.Second, s.network)
 }
 
 func (s *BackendSuite) TestPing() {
@@ -123,6 +127,73 @@ func (s *BackendSuite) TestCreateContainerSetsHandle() {
 
 }
 
+func (s *BackendSuite) TestCreateRestrictsNetwork() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.client.NewContainerReturns(fakeContainer, nil)
+
+	rules := []garden.NetOutRule{{
+		Protocol: garden.ProtocolTCP,
+		Networks: []garden.IPRange{garden.IPRangeFromIP(net.ParseIP("10.0.0.1"))},
+		Ports:    []garden.PortRange{garden.PortRangeFromPort(443)},
+	}}
+
+	spec := minimumValidGdnSpec
+	spec.NetOut = rules
+
+	_, err := s.backend.Create(spec)
+	s.NoError(err)
+
+	s.Equal(1, s.network.RestrictCallCount())
+	_, task, restrictedRules := s.network.RestrictArgsForCall(0)
+	s.Equal(fakeTask, task)
+	s.Equal(rules, restrictedRules)
+}
+
+func (s *BackendSuite) TestCreateWithoutNetOutRulesDeniesAllTraffic() {
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	s.client.NewContainerReturns(fakeContainer, nil)
+
+	_, err := s.backend.Create(minimumValidGdnSpec)
+	s.NoError(err)
+
+	s.Equal(1, s.network.RestrictCallCount())
+}
+
+func (s *BackendSuite) TestCreatePermittingAllTrafficDoesNotRestrictNetwork() {
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	s.client.NewContainerReturns(fakeContainer, nil)
+
+	spec := minimumValidGdnSpec
+	spec.NetOut = []garden.NetOutRule{{
+		Protocol: garden.ProtocolAll,
+		Networks: []garden.IPRange{
+			{Start: net.IPv4zero, End: net.IPv4bcast},
+			{Start: net.IPv6zero, End: net.ParseIP("ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff")},
+		},
+	}}
+
+	_, err := s.backend.Create(spec)
+	s.NoError(err)
+
+	s.Equal(0, s.network.RestrictCallCount())
+}
+
+func (s *BackendSuite) TestCreateRestrictNetworkFailure() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.client.NewContainerReturns(fakeContainer, nil)
+	s.network.RestrictReturns(errors.New("err"))
+
+	_, err := s.backend.Create(minimumValidGdnSpec)
+	s.Error(err)
+
+	s.Equal(1, fakeTask.DeleteCallCount())
+	s.Equal(1, s.client.DestroyCallCount())
+}
+
 func (s *BackendSuite) TestContainersWithContainerdFailure() {
 	s.client.ContainersReturns(nil, errors.New("err"))
 
@@ -0,0 +1,122 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package backendfakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/worker/backend"
+	"github.com/containerd/containerd"
+)
+
+type FakeNetwork struct {
+	RestrictStub        func(context.Context, containerd.Task, []garden.NetOutRule) error
+	restrictMutex       sync.RWMutex
+	restrictArgsForCall []struct {
+		arg1 context.Context
+		arg2 containerd.Task
+		arg3 []garden.NetOutRule
+	}
+	restrictReturns struct {
+		result1 error
+	}
+	restrictReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeNetwork) Restrict(arg1 context.Context, arg2 containerd.Task, arg3 []garden.NetOutRule) error {
+	var arg3Copy []garden.NetOutRule
+	if arg3 != nil {
+		arg3Copy = make([]garden.NetOutRule, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.restrictMutex.Lock()
+	ret, specificReturn := fake.restrictReturnsOnCall[len(fake.restrictArgsForCall)]
+	fake.restrictArgsForCall = append(fake.restrictArgsForCall, struct {
+		arg1 context.Context
+		arg2 containerd.Task
+		arg3 []garden.NetOutRule
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("Restrict", []interface{}{arg1, arg2, arg3Copy})
+	fake.restrictMutex.Unlock()
+	if fake.RestrictStub != nil {
+		return fake.RestrictStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.restrictReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeNetwork) RestrictCallCount() int {
+	fake.restrictMutex.RLock()
+	defer fake.restrictMutex.RUnlock()
+	return len(fake.restrictArgsForCall)
+}
+
+func (fake *FakeNetwork) RestrictCalls(stub func(context.Context, containerd.Task, []garden.NetOutRule) error) {
+	fake.restrictMutex.Lock()
+	defer fake.restrictMutex.Unlock()
+	fake.RestrictStub = stub
+}
+
+func (fake *FakeNetwork) RestrictArgsForCall(i int) (context.Context, containerd.Task, []garden.NetOutRule) {
+	fake.restrictMutex.RLock()
+	defer fake.restrictMutex.RUnlock()
+	argsForCall := fake.restrictArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeNetwork) RestrictReturns(result1 error) {
+	fake.restrictMutex.Lock()
+	defer fake.restrictMutex.Unlock()
+	fake.RestrictStub = nil
+	fake.restrictReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNetwork) RestrictReturnsOnCall(i int, result1 error) {
+	fake.restrictMutex.Lock()
+	defer fake.restrictMutex.Unlock()
+	fake.RestrictStub = nil
+	if fake.restrictReturnsOnCall == nil {
+		fake.restrictReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.restrictReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNetwork) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.restrictMutex.RLock()
+	defer fake.restrictMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeNetwork) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ backend.Network = new(FakeNetwork)
@@ -0,0 +1,177 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os/exec"
+	"strconv"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/containerd/containerd"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . Network
+
+// Network restricts the outbound traffic of containers to the destinations
+// permitted by their net-out rules.
+//
+type Network interface {
+
+	// Restrict denies the task's network namespace all outbound traffic
+	// other than that permitted by the rules.
+	//
+	Restrict(ctx context.Context, task containerd.Task, rules []garden.NetOutRule) (err error)
+}
+
+// NewIptablesNetwork returns a Network enforcing the rules through iptables
+// and ip6tables chains set up inside of the container's network namespace.
+//
+func NewIptablesNetwork() Network {
+	return iptablesNetwork{
+		nsenter:   "nsenter",
+		iptables:  "iptables",
+		ip6tables: "ip6tables",
+	}
+}
+
+type iptablesNetwork struct {
+	nsenter   string
+	iptables  string
+	ip6tables string
+}
+
+func (n iptablesNetwork) Restrict(ctx context.Context, task containerd.Task, rules []garden.NetOutRule) (err error) {
+	v4, v6, err := iptablesRules(rules)
+	if err != nil {
+		return
+	}
+
+	netns := fmt.Sprintf("--net=/proc/%d/ns/net", task.Pid())
+
+	for _, family := range []struct {
+		bin   string
+		rules [][]string
+	}{
+		{n.iptables, v4},
+		{n.ip6tables, v6},
+	} {
+		for _, rule := range family.rules {
+			args := append([]string{netns, "--", family.bin, "-w"}, rule...)
+
+			output, runErr := exec.CommandContext(ctx, n.nsenter, args...).CombinedOutput()
+			if runErr != nil {
+				err = fmt.Errorf("%s %v: %w: %s", family.bin, rule, runErr, output)
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// iptablesRules converts net-out rules into the iptables and ip6tables
+// arguments which only let through the traffic the rules permit. Loopback
+// traffic and replies to established connections are always permitted.
+//
+func iptablesRules(rules []garden.NetOutRule) (v4 [][]string, v6 [][]string, err error) {
+	base := [][]string{
+		{"-P", "OUTPUT", "DROP"},
+		{"-A", "OUTPUT", "-o", "lo", "-j", "ACCEPT"},
+		{"-A", "OUTPUT", "-m", "conntrack", "--ctstate", "ESTABLISHED,RELATED", "-j", "ACCEPT"},
+	}
+
+	v4 = append(v4, base...)
+	v6 = append(v6, base...)
+
+	for _, rule := range rules {
+		var protocol []string
+		switch rule.Protocol {
+		case garden.ProtocolAll:
+		case garden.ProtocolTCP:
+			protocol = []string{"-p", "tcp"}
+		case garden.ProtocolUDP:
+			protocol = []string{"-p", "udp"}
+		case garden.ProtocolICMP:
+			protocol = []string{"-p", "icmp"}
+		default:
+			err = fmt.Errorf("unknown protocol %d", rule.Protocol)
+			return
+		}
+
+		ports := [][]string{nil}
+		if len(rule.Ports) > 0 {
+			ports = nil
+			for _, portRange := range rule.Ports {
+				ports = append(ports, []string{"--dport", strconv.Itoa(int(portRange.Start)) + ":" + strconv.Itoa(int(portRange.End))})
+			}
+		}
+
+		networks := rule.Networks
+		if len(networks) == 0 {
+			networks = []garden.IPRange{
+				{Start: net.IPv4zero, End: net.IPv4bcast},
+				{Start: net.IPv6zero, End: maxIPv6},
+			}
+		}
+
+		for _, network := range networks {
+			if network.Start == nil || network.End == nil {
+				err = fmt.Errorf("network ranges must have a start and an end")
+				return
+			}
+
+			isV4 := network.Start.To4() != nil
+			if isV4 != (network.End.To4() != nil) {
+				err = fmt.Errorf("network range %s-%s mixes address families", network.Start, network.End)
+				return
+			}
+
+			for _, port := range ports {
+				args := append([]string{"-A", "OUTPUT"}, protocol...)
+				args = append(args, "-m", "iprange", "--dst-range", network.Start.String()+"-"+network.End.String())
+				args = append(args, port...)
+				args = append(args, "-j", "ACCEPT")
+
+				if isV4 {
+					v4 = append(v4, args)
+				} else {
+					v6 = append(v6, args)
+				}
+			}
+		}
+	}
+
+	return
+}
+
+var maxIPv6 = net.ParseIP("ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff")
+
+// permitsAll tells whether the rules let through all outbound traffic, in
+// which case there is nothing to restrict.
+//
+func permitsAll(rules []garden.NetOutRule) bool {
+	var v4, v6 bool
+
+	for _, rule := range rules {
+		if rule.Protocol != garden.ProtocolAll || len(rule.Ports) > 0 {
+			continue
+		}
+
+		if len(rule.Networks) == 0 {
+			return true
+		}
+
+		for _, network := range rule.Networks {
+			if network.Start.Equal(net.IPv4zero) && network.End.Equal(net.IPv4bcast) {
+				v4 = true
+			}
+
+			if network.Start.Equal(net.IPv6zero) && network.End.Equal(maxIPv6) {
+				v6 = true
+			}
+		}
+	}
+
+	return v4 && v6
+}
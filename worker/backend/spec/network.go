@@ -0,0 +1,46 @@
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/garden"
+)
+
+// NetOutAnnotation is the annotation under which a container's outbound
+// network rules are recorded.
+//
+// The backend enforces the rules itself by restricting the outbound traffic
+// of the container's network namespace before its task starts; the
+// annotation records them for inspection.
+//
+const NetOutAnnotation = "concourse.net-out"
+
+// OciAnnotations converts the garden properties and outbound network rules
+// into annotations on the OCI spec.
+//
+func OciAnnotations(properties garden.Properties, netOut []garden.NetOutRule) (annotations map[string]string, err error) {
+	annotations = map[string]string{}
+	for k, v := range properties {
+		annotations[k] = v
+	}
+
+	if len(netOut) == 0 {
+		return
+	}
+
+	for _, rule := range netOut {
+		if len(rule.Ports) > 0 && rule.Protocol != garden.ProtocolTCP && rule.Protocol != garden.ProtocolUDP {
+			err = fmt.Errorf("ports can only be restricted for tcp and udp rules")
+			return
+		}
+	}
+
+	rules, err := json.Marshal(netOut)
+	if err != nil {
+		return
+	}
+
+	annotations[NetOutAnnotation] = string(rules)
+	return
+}
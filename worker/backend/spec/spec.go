@@ -24,13 +24,15 @@ import (
 // x hostname
 // x mounts
 // x namespaces
+// x net-out (recorded as an annotation)
 // x rootfs
 //
 //
 func OciSpec(gdn garden.ContainerSpec) (oci *specs.Spec, err error) {
 	var (
-		rootfs string
-		mounts []specs.Mount
+		rootfs      string
+		mounts      []specs.Mount
+		annotations map[string]string
 	)
 
 	if gdn.Handle == "" {
@@ -52,6 +54,11 @@ func OciSpec(gdn garden.ContainerSpec) (oci *specs.Spec, err error) {
 		return
 	}
 
+	annotations, err = OciAnnotations(gdn.Properties, gdn.NetOut)
+	if err != nil {
+		return
+	}
+
 	oci = merge(defaultGardenOciSpec(gdn.Privileged), &specs.Spec{
 		Version:  specs.Version,
 		Hostname: gdn.Handle,
@@ -60,7 +67,7 @@ func OciSpec(gdn garden.ContainerSpec) (oci *specs.Spec, err error) {
 		},
 		Root:        &specs.Root{Path: rootfs},
 		Mounts:      mounts,
-		Annotations: map[string]string(gdn.Properties),
+		Annotations: annotations,
 		// Linux: &specs.Linux{
 		// 	Resources: &specs.LinuxResources{Memory: nil, Cpu: nil},
 		// },
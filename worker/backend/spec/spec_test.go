@@ -1,6 +1,7 @@
 package spec_test
 
 import (
+	"net"
 	"testing"
 
 	"code.cloudfoundry.org/garden"
@@ -73,6 +74,18 @@ func (s *Suite) TestContainerSpecValidations() {
 				Image:  garden.ImageRef{URI: "weird://bar"},
 			},
 		},
+		{
+			desc: "net-out rule restricting ports for all protocols",
+			spec: garden.ContainerSpec{
+				Handle: "handle", RootFSPath: "raw:///rootfs",
+				NetOut: []garden.NetOutRule{
+					{
+						Protocol: garden.ProtocolAll,
+						Ports:    []garden.PortRange{garden.PortRangeFromPort(443)},
+					},
+				},
+			},
+		},
 	} {
 		s.T().Run(tc.desc, func(t *testing.T) {
 			_, err := spec.OciSpec(tc.spec)
@@ -282,6 +295,28 @@ func (s *Suite) TestContainerSpec() {
 				})
 			},
 		},
+		{
+			desc: "net-out",
+			gdn: garden.ContainerSpec{
+				Handle: "handle", RootFSPath: "raw:///rootfs",
+				Properties: garden.Properties{"user": "some-user"},
+				NetOut: []garden.NetOutRule{
+					{
+						Protocol: garden.ProtocolTCP,
+						Networks: []garden.IPRange{garden.IPRangeFromIP(net.ParseIP("10.0.0.1"))},
+						Ports:    []garden.PortRange{garden.PortRangeFromPort(443)},
+					},
+				},
+			},
+			check: func(oci *specs.Spec) {
+				s.Equal("some-user", oci.Annotations["user"])
+				s.JSONEq(`[{
+					"protocol": 1,
+					"networks": [{"start": "10.0.0.1", "end": "10.0.0.1"}],
+					"ports": [{"start": 443, "end": 443}]
+				}]`, oci.Annotations[spec.NetOutAnnotation])
+			},
+		},
 	} {
 		s.T().Run(tc.desc, func(t *testing.T) {
 			actual, err := spec.OciSpec(tc.gdn)
@@ -37,6 +37,8 @@ type GardenBackend struct {
 	DNS DNSConfig `group:"DNS Proxy Configuration" namespace:"dns-proxy"`
 
 	RequestTimeout time.Duration `long:"request-timeout" default:"5m" description:"How long to wait for requests to Garden to complete. 0 means no timeout."`
+
+	RestrictNetwork bool `long:"restrict-network" description:"Deny containers any outbound traffic not permitted by their network rules, so that tasks with network restrictions can run on the worker. Always the case with the containerd backend."`
 }
 
 func (cmd WorkerCommand) LessenRequirements(prefix string, command *flags.Command) {
@@ -76,8 +78,10 @@ func (cmd *WorkerCommand) gardenRunner(logger lager.Logger) (atc.Worker, ifrit.R
 		runner, err = cmd.houdiniRunner(logger)
 	case cmd.Garden.UseContainerd:
 		runner = cmd.containerdRunner(logger)
+		worker.EnforcesNetworkRules = true
 	default:
 		runner, err = cmd.gdnRunner(logger)
+		worker.EnforcesNetworkRules = cmd.Garden.RestrictNetwork
 	}
 
 	if err != nil {
@@ -134,6 +138,12 @@ func (cmd *WorkerCommand) gdnRunner(logger lager.Logger) (ifrit.Runner, error) {
 
 	gdnServerFlags = append(gdnServerFlags, detectGardenFlags(logger)...)
 
+	if cmd.Garden.RestrictNetwork {
+		// containers are given a rule permitting all traffic unless their
+		// network is restricted
+		gdnServerFlags = append(gdnServerFlags, "--deny-network", "0.0.0.0/0")
+	}
+
 	if cmd.Garden.DNS.Enable {
 		dnsProxyRunner, err := cmd.dnsProxyRunner(logger.Session("dns-proxy"))
 		if err != nil {